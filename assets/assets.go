@@ -1,4 +1,4 @@
-//go:generate go-bindata -pkg=assets init-doc ../vendor/dir-index-html-v1.0.0
+//go:generate go-bindata -pkg=assets init-doc dir-index-html ../vendor/dir-index-html-v1.0.0
 //go:generate gofmt -w bindata.go
 
 package assets
@@ -31,7 +31,7 @@ func SeedInitDocs(nd *core.IpfsNode) (*key.Key, error) {
 
 var initDirIndex = []string{
 	"../vendor/dir-index-html-v1.0.0/knownIcons.txt",
-	"../vendor/dir-index-html-v1.0.0/dir-index.html",
+	"dir-index-html/dir-index.html",
 }
 
 func SeedInitDirIndex(nd *core.IpfsNode) (*key.Key, error) {
@@ -47,7 +47,7 @@ func addAssetList(nd *core.IpfsNode, l []string) (*key.Key, error) {
 			return nil, fmt.Errorf("assets: could load Asset '%s': %s", p, err)
 		}
 
-		s, err := coreunix.Add(nd, bytes.NewBuffer(d))
+		s, err := coreunix.Add(nd, bytes.NewBuffer(d), true)
 		if err != nil {
 			return nil, fmt.Errorf("assets: could not Add '%s': %s", p, err)
 		}
@@ -59,7 +59,11 @@ func addAssetList(nd *core.IpfsNode, l []string) (*key.Key, error) {
 		}
 	}
 
-	dir := dirb.GetNode()
+	dir, err := dirb.GetNode()
+	if err != nil {
+		return nil, fmt.Errorf("assets: could not build the directory: %s", err)
+	}
+
 	dkey, err := nd.DAG.Add(dir)
 	if err != nil {
 		return nil, fmt.Errorf("assets: DAG.Add(dir) failed: %s", err)
@@ -6,6 +6,8 @@
 // init-doc/quick-start
 // init-doc/readme
 // init-doc/security-notes
+// dir-index-html/dir-index-uncat.html
+// dir-index-html/dir-index.html
 // ../vendor/dir-index-html-v1.0.0/.gxlastpubver
 // ../vendor/dir-index-html-v1.0.0/README.md
 // ../vendor/dir-index-html-v1.0.0/dir-index-uncat.html
@@ -199,6 +201,46 @@ func initDocSecurityNotes() (*asset, error) {
 	return a, nil
 }
 
+var _dirIndexHtmlDirIndexUncatHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x55\xdf\x6f\xda\x30\x10\x7e\xef\x5f\x71\x4d\xa5\xbd\x25\xa6\x54\xeb\x26\x1a\xa8\x04\x74\xeb\x26\xd6\x76\x03\x69\x65\x2f\x93\x13\x9b\xc4\x23\x89\x23\xdb\x14\x58\xc4\xff\x3e\xdb\x49\x08\x6c\xb4\xe5\x65\x12\x72\xec\xbb\xfb\xce\xdf\xfd\x32\xfe\xe9\xf0\x7e\x30\x99\x3e\xdc\x40\xac\xd2\xa4\x77\xe2\xd7\x1f\x8a\x49\xef\x04\xc0\x4f\xa9\xc2\x10\xc6\x58\x48\xaa\xba\xce\x42\xcd\xdc\xf7\x0e\x20\xab\x3a\x75\x5d\x98\xdc\x0f\xef\x3b\x20\x29\x25\xa0\x62\x2a\x29\xb8\x90\xe2\x75\x40\x21\x61\x73\x6a\x44\x20\x15\x16\x8a\x0a\xa0\x2b\xe0\xc2\x4a\x96\x34\x58\xb0\x6b\x70\x5d\xeb\x25\x61\xd9\x1c\x04\x4d\xba\x8e\x54\xeb\x84\xca\x98\x52\xe5\x40\x2c\xe8\xac\xeb\x20\x96\xcf\x24\xfa\x9a\x3e\xf6\xdf\x3d\x8c\xbe\x7d\xbf\xbd\x0c\x06\x6c\x29\x3e\xc6\xed\x2f\xa2\xdf\xff\x75\x37\x1f\x3d\x5d\xa4\xd3\x8b\xcf\x64\xfa\x38\x60\xf3\xe9\x8f\xf1\x72\x74\x33\x44\x01\xe7\x4a\x2a\x81\x73\x2f\x65\x99\x17\x4a\xe9\x34\x74\x63\x9a\xe4\x9a\x8b\xe2\x10\xf2\x4c\x1b\x2d\x42\xa5\x29\x31\x09\xfa\x17\x53\x41\x3b\x3a\x0d\x2a\x97\x1d\x84\x22\xa6\xe2\x45\xe0\x85\x3c\x45\xa1\x58\xe7\x8a\xad\x10\x5d\xe5\x28\x48\x78\x80\x52\x2c\x75\x44\x88\xa5\x11\x95\x13\x3e\x18\x8f\x87\x58\x61\xa4\x3d\x3e\x51\xa1\xbc\x88\xff\xb7\xd0\x98\x61\x6d\x43\xb2\xfe\xad\x57\xb3\xf3\x32\x2c\x04\x5f\x42\xb1\x64\x44\xc5\x1d\x68\xe5\xab\xab\x8d\x91\xe7\x98\x10\x96\x45\x50\xe8\xaa\x88\x88\x65\x1d\x38\x6f\xd5\xca\x33\x53\x63\x9d\x8c\x42\xef\x01\x02\x1c\xce\x23\xc1\x17\x19\xe9\xc0\x59\xab\xd5\xba\xd2\x52\x6b\x95\x70\x1d\x50\x69\x13\x53\x16\xc5\xaa\x03\xed\xb7\xda\x85\x95\x34\x5e\x4b\x89\xbd\xd4\x44\xe6\x1a\xaa\x15\xac\x24\x75\x7e\xd9\x98\xf8\x68\x4b\xdd\x57\x4c\xe9\x5d\x51\x80\xf7\x80\x55\x0c\x9b\x8d\x8f\x4a\xd1\x89\x8f\xca\x2e\xf4\x03\x4e\xd6\xd6\x96\xb0\x27\x60\xa4\xeb\x94\xcc\x1d\x08\x13\x2c\x65\xd7\xd1\xa1\xdb\x84\x54\x16\x95\x34\xe4\x89\xbb\x92\x6e\xbb\x52\xed\xc0\x4d\x48\x5b\xb0\x65\x6b\x25\xbd\x37\x59\x20\xf3\x2b\x1f\x69\xbb\xca\x5d\xbd\x6d\x36\x81\x40\x5b\x2a\xfb\x17\x9d\xb7\x0f\x90\xc8\x71\x46\x13\xb0\xab\x4b\xe8\x0c\x2f\x12\xb5\xcf\x67\xd7\xce\x35\x71\xe9\x72\x6d\x2d\x6c\x8d\x05\xcf\xa2\xde\xa7\x8c\x98\x01\x9a\xc1\x5e\xa2\x2a\x65\xed\xaf\x21\x6e\xf2\x8a\x83\x84\xd6\xee\xcb\x83\x5d\x5d\x0d\x62\x39\x25\xbb\x97\x28\xd1\x1c\xcc\x91\xd4\xb8\xb2\xaf\x9c\x5d\xed\x3e\xef\xa6\xd6\x76\xf7\x33\x48\x70\x36\x3f\x90\xc9\x9a\xa1\x22\xcf\xdc\x54\x75\xea\xdf\x57\xe1\x6a\x5c\x8a\xc2\xeb\xeb\x0e\x1d\xe9\x89\xda\x6c\x9c\x9e\xe7\xf9\x08\xbf\xe2\xb9\xb7\x05\x5f\x4b\x2e\x54\x57\xad\x73\xea\xf4\x26\x7a\x35\xd8\x23\x00\xae\x64\xbf\x35\x62\xac\xd7\x23\x11\x19\x4e\x35\xe0\x16\xcb\xf8\x5f\x80\x3e\xed\x64\x59\x97\x51\xe0\x2c\xa2\xe0\x8d\x98\x54\x66\x44\x37\x9b\x17\xea\x71\x4c\x01\x8a\xc2\x7c\x3e\x08\x9e\xde\xac\x14\x78\x77\x9a\x8b\x49\xd5\xf1\xa5\x78\x36\xf5\xdb\x8e\x73\xec\x9c\x1a\xcf\xb6\xfd\x5e\xad\x80\xb1\x36\x09\x2f\xa7\xfa\xa0\xfa\x76\x91\xe2\xcc\xa4\xf8\x19\x9b\xc3\x9c\xca\xd7\xd3\xe2\x75\xb2\x0d\x33\xb0\x8f\x46\xc9\xb6\x96\xd9\x0b\xc6\xb1\xae\x4c\x25\x79\x91\xf2\x6e\x81\x34\x90\x66\xa4\xa9\x89\xd6\x99\xd9\x39\xfc\x2a\xf8\xa8\x7c\xa0\xf4\x7b\x65\xff\x3c\xff\x00\x0a\xf0\xc6\x1e\x54\x07\x00\x00")
+
+func dirIndexHtmlDirIndexUncatHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dirIndexHtmlDirIndexUncatHtml,
+		"dir-index-html/dir-index-uncat.html",
+	)
+}
+
+func dirIndexHtmlDirIndexUncatHtml() (*asset, error) {
+	bytes, err := dirIndexHtmlDirIndexUncatHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dir-index-html/dir-index-uncat.html", size: 1876, mode: os.FileMode(420), modTime: time.Unix(1792380502, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dirIndexHtmlDirIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\xbc\xe9\xae\xa4\x58\x96\x26\xfa\xbf\x9f\xc2\x2b\x4b\xad\xac\x2c\x4b\x0f\x30\x06\xc3\xf0\xe8\xce\x5b\xcc\xb3\x31\x83\xc1\xd5\x55\x8b\x79\x9e\x67\x42\xf1\xee\xcd\xf1\x88\xc8\xf0\xac\xca\x2c\x75\xa9\x43\xba\x71\xcc\x5d\x07\xd8\x03\x9b\xbd\xd7\xfa\x06\xc0\xce\xff\xf8\x27\x5a\xa5\x2c\x4f\x63\x3e\xe5\x73\x53\xff\xe5\xbf\xfd\x8f\x5f\x7e\x25\x41\xfc\x97\xff\xf6\xe9\xd3\xff\x68\x92\x39\xf8\x14\xe5\xc1\x38\x25\xf3\xff\xfc\xc3\x32\xa7\x9f\x9f\x7f\xf8\x04\x7c\x2d\xfa\xa7\xcf\x9f\x3f\xe5\x49\xdd\x27\xe3\xa7\xb9\xfb\x14\x75\xed\x34\x8f\x4b\x34\x7f\x9a\xf3\x62\xfa\x74\xfd\xcb\x93\x31\xf9\x72\xf5\x3b\xf7\xd3\x17\x00\xc8\x8a\x39\x5f\xc2\xef\xa2\xae\x01\xa2\xf1\xe8\xe7\x62\x07\x92\xbd\x07\xc2\xba\x0b\x81\x26\x98\xe6\x64\x04\x8a\x26\x4b\x26\xab\xa3\x4c\x93\x0e\xe6\x00\xb8\x7a\x5c\x93\x71\xfe\x2e\xeb\x3e\x7d\xfe\xfc\xf5\x8c\xd3\x7c\xd4\xc9\xc7\xd6\xc7\x28\x7f\x48\xbb\x76\xfe\x9c\x06\x4d\x51\x1f\x5f\xa6\xa0\x9d\x3e\x4f\xc9\x58\xa4\xdf\x7f\xde\x92\xb0\x2a\xe6\xcf\x73\xb2\xcf\x9f\xa7\xe2\x4c\x3e\x07\x71\xb9\x4c\xf3\x97\x3b\x08\xfe\xf7\xef\x3f\x37\xd3\xdf\x2f\xf9\x31\xec\xe2\xe3\x87\x26\x18\xb3\xa2\xfd\x02\xfe\x18\xfc\x10\x06\x51\x95\x8d\xdd\xd2\xc6\x9f\xa3\xae\xee\xc6\x2f\xf3\x78\x9d\xa4\x0f\xc6\xa4\x9d\x7f\x0c\xbe\x04\xd1\x5c\xac\xc9\x9f\x83\x2f\x79\x77\x8d\xf2\x87\x6e\x99\xeb\xa2\x4d\xae\x96\xd7\x2c\x74\x6d\xf6\xd3\xe8\xb6\xa4\xc8\xf2\xf9\x0b\x06\x82\x3f\x86\xcb\x3c\x77\xed\x97\x2f\x9f\x9b\xee\xfc\x9c\x76\xd1\x32\x7d\x2e\xda\x36\x19\xff\x5c\xb4\xfd\x32\xff\xc7\xe3\x3f\xf4\x41\x1c\x17\x6d\xf6\x05\xfc\x3e\xec\xc6\x38\x19\xaf\xae\xe7\x20\xac\x93\x1f\x7e\xda\xfd\x7c\x0d\x25\xfa\xb6\xfc\x63\x94\x75\xd0\x4f\xc9\x97\x5f\x36\x7e\x9c\xe3\x5f\x7b\xf9\x11\xf8\xd7\x7f\xfa\x64\x76\xcb\x18\xfd\xfd\x45\xc9\xd1\xb0\x07\x3e\xe6\x15\xfd\x1c\x76\x45\x9d\x8c\x7d\x1d\xcc\xc9\xdf\xac\xcf\x34\x46\x40\x34\x4d\xd7\x6e\xd1\x7e\x77\x6d\x7c\xfa\x57\xe0\xdf\x9a\x24\x2e\x82\x4f\xfd\x58\xb4\xf3\x0f\xff\xfa\xe7\x2f\x41\x7a\x55\xfc\xf3\x97\x30\x49\xbb\x31\xf9\xe1\xa7\x79\xfb\x67\x10\x04\xff\xa9\x68\xfa\x6e\x9c\x83\x76\xfe\xfe\xa7\xd9\xcf\x83\xb8\xdb\xbe\xb4\x5d\x9b\x7c\x53\xf4\xeb\x94\x7f\x01\x3f\x7d\xdb\xe6\x97\x35\x0d\xbb\xfd\x1f\x35\xfd\x47\x25\x3f\x06\xd7\x1a\xad\xc5\x54\xcc\x49\xfc\xc3\xd7\x73\xc7\x49\xd4\x8d\xc1\x5c\x5c\xab\x71\x9d\x29\x19\x3f\xd6\xed\xc7\xe0\xff\xcd\xc7\x24\xfd\xff\x7e\xba\x80\x6b\xe0\xed\x7c\x2d\xf3\x97\x3f\x7c\xfa\x97\x3f\x7c\x0a\xe6\x79\xfc\x97\x8f\xd2\x3f\x7d\xfa\xc3\x9f\xfe\xf0\xe3\xfc\xb1\x34\x59\xf2\x39\x1c\x93\xa0\xba\xd6\x6a\x2a\xe2\xe4\x4b\xb0\x76\x45\xfc\xe3\x77\x7f\xb3\x40\xff\x61\x3d\xbe\x19\xd4\x4f\x35\x3f\x5d\x0b\xf4\x1f\xc2\xec\x9f\xd3\x34\xfd\xa6\xe6\x8f\xff\xf6\x73\x9c\x47\xc9\xdf\x44\xfc\x1f\xb9\xfa\xe8\xf3\xe2\x23\xed\x3e\xf1\x41\x9d\x5e\x57\x91\x4d\x7f\xfc\xfe\x5a\xa3\x2f\xcb\x58\xff\xcb\x77\xdf\x01\x1f\xb5\x27\x20\xfb\x6b\xb5\xcf\xf9\x2f\xd5\x3e\x8f\x49\xb6\xd4\xc1\xf8\x5d\xd2\xcd\x7f\xfa\xaf\x37\xf9\x7f\xfe\xb9\x48\xd2\x62\xff\xd3\xa7\x6b\x91\x9b\x60\xfe\x97\x3f\x26\x4d\x98\xc4\x71\x12\x7f\xee\xfa\x6b\xd6\x8e\x3e\xf9\xe3\x9f\xfe\xfc\x7f\xde\xe3\xd6\xa5\x29\xf4\x6b\x67\x5f\x77\xff\xcb\x1d\xfc\x6d\xfb\xff\x52\xf3\x79\xfe\xa6\xf5\x05\x61\xc9\x7f\xf9\x0a\xa6\x35\xfb\xe7\x5f\x2b\xfc\xaf\xbf\x56\xf8\xb9\xfc\xd7\xde\xaf\x8a\x7f\xfc\xd3\x8f\xff\xfa\xc3\xdf\xc4\x73\x71\x7e\x24\xe8\xcf\x41\x73\x1d\xf9\xfe\x2b\x14\xfc\xfd\xa2\xbf\x7b\xf4\xc7\x7f\x97\x76\xbf\x71\xef\xbf\x82\xed\x07\x66\x5e\x60\xd9\xef\xbf\x82\x6c\xd0\x7f\xce\x2f\x8c\xab\x3f\x70\xee\xe7\x00\x1e\xb3\x30\xf8\x17\xf0\xcf\x5f\x3f\x7f\xfa\x09\x56\xbf\x0d\xdc\x3f\xf0\x49\xbd\x26\x73\x11\x05\x9f\x5e\xc9\x92\xfc\xe1\xcf\x7f\xdd\xff\x33\x31\x16\x41\xfd\xe7\x6f\xb0\xfc\x9b\xb3\x22\xd7\x59\x3f\x32\xf5\x73\xfe\x13\xa6\xde\xbf\x43\xa0\x27\x8a\xdd\x11\xf8\xfb\x9f\xf3\x06\x86\xe1\xef\xff\x6e\x36\x5d\x60\xfe\xd7\x2a\x58\x10\x62\xdf\xff\x7b\x18\xf8\x40\x8c\x0b\xd2\xbf\xa2\xef\x5f\x11\xfd\xe7\x26\x10\x8c\x42\x58\xf4\xfd\x7f\x82\x1c\x3f\xb5\xfb\x2b\x03\x5c\xf4\xd7\x7e\x8a\xbb\xf9\xc2\x9b\xef\x7f\x39\x86\xf6\xfb\xa7\x60\xb9\x58\xf2\x97\x79\xfb\x09\xe8\x2f\xd4\xcc\x7e\x1a\xe7\x2f\x35\x3f\x5f\xc1\x7b\x31\xed\x97\xcf\x50\xbf\xff\xf8\xdd\xd8\x6d\x3f\x33\xd2\x55\xf5\xe3\xa2\x3f\xdf\xaf\x9e\xbe\xff\xf9\x50\x9d\xa4\x3f\x1f\xf9\xf1\x42\xf0\xfa\xf3\x3e\x7d\xbe\x43\x7f\xfe\x65\x13\xfa\xa1\xef\x2e\xd0\xfb\x18\xeb\x98\x5c\x50\x7e\x91\xd5\xf7\xcd\xd5\xea\x97\xe9\xbb\xfa\xf9\x99\x1b\x7e\xee\xfb\x6b\xd7\xbf\x1c\xfa\xda\xf7\x3f\xee\x3a\xad\xbb\x60\xfe\xf2\x51\xe9\x9b\xf2\x1f\xb6\x22\x9e\xf3\x9f\xc8\xf4\xd7\xaa\x3f\x1f\x7c\x7c\xf7\xf8\xe9\x07\xfb\xef\xbf\xd0\xd8\x7f\xc6\xaf\x3f\x23\xe9\xaf\x3d\x5e\x17\xbd\x7f\xfe\x9b\xdd\xaf\x73\x10\x5e\xf3\xdc\x35\x5f\x20\xf0\x63\xa4\x5f\xdb\xfc\x65\xfe\x88\xb8\xbf\xcc\xe3\x5f\xbe\x61\xbf\xe7\x3f\x0e\x9e\x0f\x89\x71\xc5\x5e\xfd\x39\xb8\x62\xb8\xfd\x32\x77\xfd\x2f\x7c\x7a\x6d\x7e\x4c\xd3\xa7\xa9\xab\x8b\xf8\xd3\x3f\xc7\xf1\x2f\x00\xff\xf9\xa2\xf8\xa2\x4f\xe2\xbf\x9e\xea\x4b\x3b\xe7\xd7\xca\x7d\xfe\x00\x8e\x7f\xe9\xe2\xf8\x4f\x7f\x0f\xd5\xf1\x8f\xcf\x8f\xdf\x7d\x40\xc1\xe7\x0f\x7e\x19\xbb\xfa\x67\xd6\xbf\x98\x36\x4a\xf2\xae\x8e\x7f\x8d\x3a\x1c\xc7\xbf\xef\x3e\x08\x7e\x3e\xbe\xdc\xff\x5d\xa3\x0f\x09\xf3\x55\x34\xfc\x83\x86\x3f\x7e\xd7\x07\x6d\x52\xff\xf0\x1f\xa7\xe8\xef\xa7\xc7\x2f\xfa\xe2\xd7\x6b\xfd\x66\x25\x7e\x99\x8c\x31\x88\x8b\x65\xfa\x82\x7c\x93\xfb\xdf\x50\x2e\xf8\xe9\xa3\xf1\xc7\xff\x6f\x92\xff\x3b\x10\xfd\xd3\xf7\xff\x27\x95\x7e\x1e\xf0\xe7\x0f\xd1\x79\xad\xd6\x5f\x57\xed\x03\x69\x3e\x7d\x0d\xcb\xbf\xa2\xd1\xd7\x4b\xf9\x4f\x07\x7a\xad\xda\xd7\xe8\xfd\x65\xc4\xf0\xaf\xcd\x3f\x8a\xbe\xc6\xfa\x37\x65\x3f\x9f\xfb\x2f\x3f\x07\xdc\xdf\xce\x19\xf8\xb7\xa5\x5f\xea\x4b\x07\x7d\x8e\xf2\xa2\x8e\x7f\xf8\x9b\x11\xfd\x87\x5e\xff\x76\xc0\xff\x7e\x3c\xff\xb0\xd7\x9f\x02\xea\x6f\x0e\x8c\xff\x7f\x9f\xf4\xd2\x28\x5f\xd2\x62\xfc\x07\x63\xf8\x0d\x4f\xf2\x7f\x7c\x9d\x7f\x7b\x92\x9f\x7b\xfe\x66\x88\x1f\x5d\x7f\xb3\xfb\x55\x64\xfd\x9a\xd1\xbf\xac\xe9\x05\xe6\x69\xb0\xd4\xf3\x37\x6a\xed\x23\x1f\xbe\x66\xf9\xdf\x54\xf8\xcb\xbf\x8b\xce\xff\x9c\x6d\xd0\x8f\xcf\xf7\x7f\xa7\xcf\x0b\xcb\x7f\xa6\xe9\xaf\x9b\x3f\x53\x75\x5c\x4c\x57\x12\x1f\x5f\xbe\x5e\xca\xf7\xbf\xca\xce\x3f\x7c\xd3\xe0\x87\xa8\x4e\x82\xf1\x62\xe4\x39\xff\xf1\xdf\x3e\x92\x7f\x2d\x92\xed\x43\x1e\xfe\x0c\x8f\x71\xb2\x16\x51\xf2\x13\x38\xfe\xf8\x5d\xd1\xa7\xd3\xe7\xff\x15\xd6\x41\x5b\x7d\x8b\x42\x45\x73\x69\xd6\xaf\x32\xef\x8f\xf1\x65\xae\xbe\x7c\xdd\x07\xfa\x36\xbb\x2e\x62\x4a\x1e\xc8\x9f\x0b\x87\x54\x8d\x0d\x94\xb8\xac\x23\xae\x9f\x97\x69\xe7\x8c\x9d\x5d\x5b\xe4\xc7\x2e\xa1\x53\x84\xf7\xf1\x3b\x7d\x02\x78\xfe\xb1\xc1\xbd\x0d\xd6\xe5\x0d\x2b\x84\x7c\x30\x86\xd8\xc3\xd7\x49\xd2\xe7\xf0\xc2\x37\x49\x31\x74\xd9\xd6\x77\xc4\xda\x73\x0d\x34\x8a\xea\x5a\xfb\x68\xe0\x92\xa2\xc1\xb0\x76\xf2\x1a\x18\xbb\xbc\xcb\x3b\x43\xe8\x6d\x7c\x57\x1c\x02\xa9\x0f\x81\x71\xf7\x87\x2d\x48\x0c\x67\x33\x3b\x37\x85\x14\x3c\xcb\xe9\x26\x02\xb9\x05\x64\x98\x53\x53\xed\x12\x0e\xfb\x9b\xdc\x32\x40\x47\x72\x5d\x03\xe4\x5c\x92\xd1\xd6\x5f\xc8\x69\x5d\xb8\xd4\x45\x15\xee\x58\x4e\x05\x2b\xcf\x78\x75\x16\x03\xa5\x33\xaf\xf5\x6f\x29\x8e\x07\x05\xe6\xcc\xe8\x8c\x4b\xf8\xd2\x2e\x08\x2f\x6f\x89\x00\x0f\xae\xb7\x0b\x0f\x8d\xee\xa6\xf8\x00\xd3\x3c\x6a\xe7\x5d\x45\x10\x2a\x52\xc9\x53\x23\xb0\x26\x65\x8e\x85\xdf\x52\xac\x9d\xf5\x0a\x29\xa3\xf6\xd8\xd4\x5d\xa8\xd3\x5d\xa5\x5e\x5e\x20\x3b\x59\xf8\x88\xde\x61\x65\xc5\xb9\xf8\x1e\x20\x53\xa8\x5f\x0a\x05\xee\xc2\x90\xf3\xd1\x49\x87\xc5\x53\x75\xce\x5e\x36\xed\x00\x74\x93\xd0\x48\x9b\x22\x93\xf9\xa1\xbc\x33\x91\xe6\xb7\x57\xa7\x59\x48\x0b\xa3\xe7\x18\x07\x53\xb4\xcb\x58\x3e\x4a\x8b\xe4\x0f\x01\xeb\x07\x67\x64\xf5\x81\x09\xe7\x87\xc5\x34\x3e\xbe\xde\x5f\x06\x21\xbe\xa8\xfb\x6e\x5d\x63\xd5\x73\x40\x69\x0c\xbb\x6e\x58\x9d\xb9\x46\xaf\xba\xf7\x76\x20\x21\xcb\xad\xee\x00\x3c\x67\xa2\x03\x6e\x81\xb3\x56\xac\xc0\x24\x7e\xc8\x0f\x08\x53\x1f\xd2\x49\x34\xcc\x5b\xdd\x1f\x59\xcb\x38\xe2\xe2\x92\x67\x53\x19\x22\x69\x68\x1e\xe7\x93\xf4\xe4\x04\x6a\xdd\x9b\x99\x23\x32\xd0\xe1\x04\x44\x02\x80\xd5\x0e\xc0\xc1\x49\x1a\x2a\xe8\x4c\xa1\xc2\xfa\x0c\x4c\xd1\xa6\x2f\x6d\x89\x9f\x7a\x82\x93\xdd\x68\xff\xb1\x62\x39\xce\xd1\x8e\xba\xf9\xda\x06\x68\xdd\x2e\x2d\x40\x4a\x64\x1b\x21\x87\x34\xf1\x4e\x31\x3a\xd6\xab\xaf\x81\x62\xda\x8e\x6a\x48\x28\xe5\x09\xc2\xff\xfc\xe3\x9f\xbe\x4d\x91\x31\xe9\x93\x4b\x35\xb4\xdd\xcf\x5b\xdf\x96\x7d\xd5\x78\x1f\xe1\x7f\x59\xcb\x5f\x62\xf8\xc3\x65\xfd\xbe\x42\xb8\xc9\x7f\x0e\xe1\x7e\xb2\xcf\x79\x05\xef\x84\xa7\xa5\x71\xae\x1e\xc0\xdb\xf2\x9f\xa0\xe3\xdc\x9d\x8e\x1a\x6a\x02\x3a\xf5\xc1\xce\x36\x85\x61\x46\x97\x24\xe4\x15\xe1\x44\x3a\x65\xe8\x46\x65\xf8\x89\xb5\x06\x87\xb2\x98\x9d\x28\x0a\x53\x80\x74\x46\x12\x25\xc6\x74\x58\x96\x74\x3b\x10\xb1\x18\xd3\x38\xd3\x03\x8a\x30\xd9\x03\x2a\x79\x4e\xa1\xf8\x79\x43\x51\xf4\x5c\x15\xfc\x15\x08\xf7\x4b\x7d\xa2\xd3\x1a\x1e\xb8\xda\x86\x36\x43\xee\x19\x5d\x0b\x52\xe1\x96\x6f\x3d\x91\x8f\x3b\x0e\xbc\xef\xd8\xc4\xcc\x91\xe6\x41\xe3\xcc\x9b\xf8\x03\xe0\x17\xd0\x58\xdf\x6f\xf9\xbc\x45\xf6\xa9\x3c\xb1\xd3\xcc\x27\x01\xc2\xe9\x9e\xa7\xbc\x19\x61\x1e\x64\xb7\x23\x82\x7f\x5a\x6d\x48\xef\x98\x83\x21\x5c\x99\xab\x66\xba\x65\x0c\xc8\x43\x0f\xf8\x29\xe1\x39\xf8\x24\xe0\x82\xe7\x9c\xb0\xb4\x36\x2c\x7b\x78\x0d\x71\x6c\x00\x99\x25\x11\xff\xe2\x38\xb1\x5c\x53\x3d\x17\xd2\x26\x15\xd8\x2b\x0c\xc4\xbe\x5c\x96\xac\xc0\xe2\x52\x60\x1d\x01\xd1\xc0\xbe\x8e\xae\x08\xd5\xc1\xbd\x65\x13\x34\x51\x55\x10\x52\x37\xa6\x87\x9c\x2a\x37\xa9\x96\x7a\xba\x2a\x15\x0f\xd9\xd6\x9e\xec\x8e\x00\xc2\xd1\xf7\x9b\xe1\xbc\x6e\x6f\xef\x3d\x25\xd1\xe0\x13\xf7\x9e\x44\x9e\x49\x45\xb4\x1b\x0e\x20\x9c\xbf\xc3\x32\x88\xbc\x00\xae\xb3\x36\xa6\x7c\x23\xd1\x8b\x47\xd7\x5a\x4b\xcb\x99\x10\xbc\xfe\x19\xb9\xa3\xbe\xcc\xfb\x48\xa1\x4a\x17\xc3\xce\xf4\x76\x2c\x85\x35\x87\x39\x60\x6e\x59\x8d\xc7\x79\x60\xef\x0c\x34\xbf\xfd\x82\xc5\x5a\xaf\x28\x82\x59\x84\x57\x74\x7a\x1a\xc0\xfd\xb8\x52\x5b\x56\x97\xad\xac\x18\xb9\xb0\xc2\x86\xc2\x63\x91\xef\x85\x80\x23\xcc\x89\xae\x3a\x56\xe7\x44\x7d\xe3\x5d\x25\xe2\x5d\xd1\x53\x9b\x0b\xbc\xd4\x72\xd5\xbb\x79\x39\xe6\x19\x6d\x79\x45\x66\xb8\x8a\x3a\xf6\x87\x6e\xc3\x4f\xa4\xdc\x2a\x9b\x88\xa1\x69\x17\x43\x13\xd0\xcd\xdc\x9f\x33\xe0\x39\xf2\xe7\xa9\xab\xa7\x19\xb0\xf9\xae\x8f\xc4\xc3\xcb\x74\x45\xe7\xbb\x92\xb2\xeb\xac\xa5\x08\x62\x95\x58\xb2\x7b\xbf\x03\x3e\x25\x46\x93\xf2\x69\x06\xcc\x0e\x57\xcc\x58\x41\x6a\x56\x9b\x95\x54\x44\xb1\x5f\x42\x05\x05\xc8\x2d\x57\xe9\x99\xb6\x9d\x45\x04\x44\x0c\x86\x08\xc9\x7c\xf8\xb3\xd0\xc4\xd6\x41\xe8\x3a\x09\x87\xfe\xeb\x59\xe3\x33\x86\x0a\x6c\x0e\x8a\x8a\x1d\x3b\xd2\xd4\xe5\x93\xad\x0d\x46\xd6\x56\x01\x38\x7b\x59\x96\x79\x3d\xc5\x57\x12\x67\x4d\xbc\x80\xbe\x54\xa5\x2b\x49\xa4\xb0\x04\x8a\x4a\xfa\xf5\x8d\xc2\x84\xc0\xa4\xfa\x1c\xdc\x0b\x9b\x6a\x3a\xab\x20\x9b\x98\x19\x0b\xc8\xc8\xe2\x58\x62\xf3\x45\xec\x86\x10\x08\x52\xe0\x0a\x81\x18\x3e\xad\x97\xf2\xb0\xab\x40\x4d\x8b\xe9\x01\x05\xf6\x55\x87\x08\xdb\xf3\x36\xbe\x17\xcd\xbb\x39\xed\x59\xce\x38\x40\x9d\x04\x11\x36\x72\x49\x8d\x29\x59\x18\xd9\x6f\x0b\x11\x41\x10\xfd\xbe\x00\xa2\xfd\x2b\x40\x80\x76\x75\xd7\x18\x90\xf1\xd2\x79\x5c\x9c\x7a\x25\xec\x2a\x77\x18\xad\x23\xa3\x89\xe9\xe4\x0b\x8c\x3d\xf0\xae\x68\x7c\x49\x4d\x70\xb4\x76\x59\x64\x01\xc8\x20\x8a\x2f\xf4\xb5\xf2\x79\xd7\xe5\x91\xda\x6a\x1b\xe2\x31\x9c\x50\x51\xf9\xad\x93\x39\x52\x6a\x60\x1f\x6b\x7d\x38\x6e\x12\x68\x2d\xd5\xdc\x8a\xca\x42\x14\x57\xff\xd4\x56\x55\x79\x02\x3c\x07\x0d\x30\x84\xd2\x23\x5c\xc9\x3d\xe6\xdd\x8b\xb0\x17\xf6\x72\x42\xa4\x5c\x27\xfd\x74\x75\x1e\x13\x26\xa1\x4e\x08\x86\x65\xd2\xa5\xa8\x5f\x47\xdc\x31\x69\xf7\x00\xbd\x37\x45\xaa\xd2\x47\xb8\x9e\xcc\xb3\x39\xa9\x70\x24\x3a\x8b\x16\xac\x5c\xe9\xb8\x2b\x57\x7c\xc4\x33\x8b\x65\x4c\x6f\xfe\xfd\x9d\x2c\xd4\x20\x62\x6a\x79\x9b\x24\xb8\xd4\xa3\x17\xd1\x64\xcf\xbd\xe2\x74\xec\xde\x78\x49\x19\x11\x24\x82\xaf\x7d\x93\x2c\xa7\x78\x4d\x07\x78\x8b\xed\xf2\x21\x09\xc4\x9a\xf2\x94\x96\xc1\xaf\xdb\x42\xac\xc8\x9a\xe1\xa4\xba\x47\xd4\x45\xb2\xe7\xa2\x01\x59\xd2\x5e\x0d\x86\x9c\x51\xb2\x25\x4e\xee\xa0\xb8\x35\x37\x7a\xb1\x85\xc3\x8e\xad\x7a\x58\xa0\xf0\xe8\x94\xf4\x0d\xc4\x46\x32\x36\x64\xc2\xec\xfc\x64\x15\xee\xab\x80\x6f\xb5\xd2\x9f\xc6\x46\x57\xc5\xf3\xdc\x29\x81\xb0\x9a\x33\x4c\xd6\xb4\xa1\xea\xa5\x2a\x34\xf4\xc5\xe6\x62\xb6\x55\x65\x62\x58\x89\x6c\xe6\xb1\xda\x39\xe2\xd0\x5e\x5c\x55\x65\x14\xe1\x3f\x6e\x76\x2c\x53\xf8\xbc\x95\xba\xff\xec\xe3\xb3\xe8\xd8\xca\x27\x79\x0f\x1e\x1e\x24\x5c\x23\xb1\xc8\x30\x8c\xa6\x52\x09\x8d\x44\x9e\xc3\x63\xef\x29\xbd\xa3\xec\x8d\x15\x1a\x0a\xbb\xf2\xea\x45\x88\xa4\x68\x56\x4e\x77\xa1\xb5\xee\xe1\xde\x90\x15\x06\xe2\xfb\xa7\x11\x70\x64\x15\x6f\x12\x1c\xb2\x3b\x27\x1b\xbe\x9d\x92\xb0\xd1\x40\x3b\xb2\xe3\x14\x37\x4f\xf6\xce\xe3\xba\x57\x49\x02\x45\xb3\x8b\xbc\x4b\x44\x77\x72\xfe\x16\x5b\x03\x69\x4c\x10\x1b\xca\xf5\x3b\x2c\xb4\x98\xa1\x14\x8a\x0f\xe2\x12\x20\x02\xfa\xed\xbf\xd8\x21\x89\x29\x61\x6d\x8d\xc8\x44\xec\xde\xe8\xb0\x5b\x4e\xa1\xa7\xdd\x6c\x4f\xb5\x4f\xf0\xcd\x66\x8b\xf6\x9a\x70\x1f\x7b\x49\x33\x64\x58\x0d\x89\xe4\x92\xdf\x3d\xda\xe7\xa0\x21\xea\x70\xe7\xc9\x4a\xaa\x1d\x8f\xd0\xc9\xd1\xae\xcf\x82\x04\xdf\xba\x59\xa4\x88\xd7\xe8\x79\x35\x65\x2f\x41\xac\xf0\x22\x90\x73\x2d\x70\xf0\x10\xb0\x9b\xdb\x22\x9a\x54\x7c\x1e\x74\xc8\xf9\xba\x61\x56\x6b\xd9\xdd\x5a\x44\x7c\xed\x4b\xc8\xd9\xe6\x44\x95\x37\x9f\xea\x55\xda\x2b\x2b\x4e\x21\x5e\x34\x54\x61\xc9\x6e\x4f\x69\x5e\x51\xf1\x0d\xc2\x8f\x6c\x21\x9f\x4f\xb7\xc6\x58\x17\xe8\x1e\xb3\x85\x01\x59\xf4\x1e\x88\x8c\xbb\x54\x43\xbe\xdf\x65\x97\x2c\xc6\x84\x77\x5a\x0c\xf7\xb4\x07\x95\xeb\x70\x5b\x86\x40\xc2\xd6\xa9\x3b\x70\x83\x05\xf3\xb0\x5f\x73\x42\xce\x09\x50\x01\xd9\x2a\x60\x03\x5b\xb5\x5d\x6c\xdb\x05\x75\xb4\xd7\x2f\xe2\xfe\x35\x3b\x99\x9a\xb5\x2a\x73\xd1\x1b\x8a\xfa\xbf\x03\x8d\xe2\xf7\x85\x19\x15\xfa\xab\xa8\x00\xef\x2b\x53\x33\xb6\x66\x69\xa7\x3f\x90\x84\x1e\x98\xac\x5e\x88\x9e\xdd\xd4\x92\x97\x77\x16\x8b\xdc\x65\xd8\x0a\x17\x40\x07\x6e\xc4\x3a\xbd\x61\xb2\x07\x32\x6d\x5a\x5c\x79\x1c\x8e\x41\xc7\x2c\x7b\x57\x66\x62\x65\xef\xb3\xc7\x75\xef\xed\xb5\x61\xdb\x8a\x9d\x9b\x97\xcd\xd2\x1b\x96\x37\x18\xc3\x51\x0c\xed\xd1\x13\xc3\x54\xf0\xde\xe5\xee\x0d\xa0\x9d\xfc\x79\x96\x58\xe0\x49\x79\x5a\x73\x31\x87\xfb\x13\xb7\xc9\xaf\x23\x4e\xd3\xcc\x49\xc7\x3b\xee\xf1\xeb\xc4\x04\x37\x7f\x01\xda\xbd\x8d\xbb\x10\x35\x67\x5d\xba\xc5\xc7\x79\x21\xcf\xc5\x02\x99\x54\x37\x4a\x89\x09\x47\xc6\x2a\xb7\x7d\x7d\x45\x41\xdb\x23\xc0\x8c\xc6\x44\xd7\xbc\x79\xf2\x80\x6c\x9b\x54\x55\x48\x21\x6a\x80\xc4\x81\x48\x0b\x1f\x9a\x2d\x2f\x1d\xbf\x83\xae\x02\x26\x30\xb7\xf6\xb6\xba\xd3\xf0\xe6\x13\xa2\xbb\xcc\xfe\xe0\xd9\x5e\x33\x5c\xda\x14\x55\x95\x8c\x87\x3d\x5d\x0d\x65\x14\x77\x41\x60\xa9\xeb\x7e\x3c\x0c\x98\x4f\xb2\x4a\x62\x86\xc2\x25\x4d\xce\x41\x0c\x78\xd5\xb1\x49\xc8\xcd\xc8\xf2\x59\xab\xe7\x77\x98\x80\xee\x28\x89\x4e\xf5\xe2\x78\x80\xeb\x2a\x19\x76\x23\x2c\x12\xa8\x23\x79\x92\x7a\xab\x6b\xf9\x93\xbe\xa9\x0a\xcb\x2c\xd6\xf9\x2c\xaa\xea\x32\x06\xdb\x8c\x7b\xa2\x4f\xc2\xcf\xa8\x27\xe3\x4e\x27\x7c\x31\x96\x57\x8a\x23\x8f\xb4\xd3\xc8\xc7\x3b\xae\x70\xb0\xf7\x8e\xc7\x3b\x83\x23\x80\x0e\xdc\x8d\x18\x33\x10\xa7\x03\x0e\xad\xa9\xc9\x66\x5f\x60\x1f\x55\x3e\xd1\x95\x31\xf5\x6c\x90\x60\x21\x87\x80\x90\x17\x33\x4b\x4e\xcc\x21\xef\xc6\x4c\x9b\xb6\xab\xea\xab\x1d\x90\x32\x6b\x9f\x22\xa9\x88\x10\xbd\x35\x5a\x16\xdf\x2f\xac\x03\xdd\xce\xcc\xc4\xb4\xa4\xd7\xd1\x92\xf6\x79\x23\x84\x83\xa9\x08\x55\x47\x73\x1b\x00\xfc\x38\x43\x97\x6e\xa3\x6b\xfb\xa5\xb4\x9b\x2f\xbb\xe0\x64\x2e\xf6\x42\x50\x5e\xbe\xe9\xc6\xc6\xae\xe2\xbe\xf4\x54\xc9\x78\xb6\x1d\x45\x6a\xd5\x05\x52\x13\xab\xb5\xdf\x7a\x77\xab\xca\x88\xe8\x4d\xe4\x7b\xae\x6e\xf2\x44\x4f\x7c\xf7\x82\x17\x44\x45\x45\x8b\x4e\x1d\x8a\x7f\x08\x78\x9b\x96\x42\x0c\x67\x42\x66\xda\x84\xc5\x8a\x15\x90\x3b\xc9\xca\xcd\xaa\xb2\x99\xe0\x7b\xd3\x61\xe2\x24\x01\x76\xac\xa5\xec\xa9\x43\x98\x00\x95\xc1\xea\x74\xfe\xc8\x66\x1b\x8d\x0c\x4e\xd1\x13\xcc\xa1\xd4\x6d\xc0\x12\xe3\x19\x94\x00\x0d\xd2\x85\xf2\xae\x45\x82\xd5\x0f\x09\x1a\x9f\x69\xab\x28\x86\xdf\x23\xb5\x7e\x57\x02\x53\x46\x67\x1b\xb8\x57\x03\x51\x29\x54\x0e\xcd\x9e\x70\xc3\x6e\x55\x8e\x81\x51\xa9\x85\x23\x12\x32\x3e\x7a\x2f\xfd\xf1\x69\x89\x2d\x89\x6b\xe2\xbb\x3f\x61\x07\xb9\x32\x4b\x53\x75\x47\x6c\x21\xfd\x01\x72\xbf\x71\xee\xa7\xe9\xef\x2b\xfb\xbb\xbf\x2a\x86\xca\xc6\xe7\x81\xb9\x33\xf6\xdb\x6f\xfc\x9e\x81\x03\x8b\x94\x43\x91\xd5\xac\x99\xb5\x8d\xd9\xa4\xe6\x7c\xc4\x6c\xea\x90\x24\x56\x04\x70\xa2\x85\xbb\xec\x41\x64\x40\xf7\x4e\x3b\xbb\xc4\xba\x0c\x0f\x26\x8a\xf4\x24\xeb\xb2\xd1\x1a\x1f\xb8\x73\x3f\x60\x2f\x1b\x0a\x7c\x09\x3d\xd1\xcd\xc7\xf7\x5d\xe9\x95\xcd\xa7\x97\x64\x3b\xf1\x7e\x1c\x61\xc8\x2f\x54\x00\xa3\x5e\x01\xbd\xc3\xaa\x3c\x3f\x52\xb5\x24\x07\x2e\x03\x02\xc9\xa0\x04\xa1\xed\x9f\x37\xe9\x4c\x25\x1e\x4b\x97\xb8\x75\x58\xf4\xd9\x32\xf7\x47\x52\xdf\x80\xc3\x86\x1a\x92\x35\x5d\x5f\xea\x5d\x51\x72\x22\x50\xcd\x6c\xb2\x03\x21\x09\x79\xd1\x8d\xfd\xe8\x30\x54\xd9\x6f\x6e\xbc\xe0\x82\xfd\x66\x93\x42\x25\xae\xe9\xbc\xef\x7c\xe2\x05\x4b\xe6\xd0\xf1\xd9\x87\x3d\x7e\x1f\xb8\x9a\xb8\x24\xe8\xfe\x8c\xa9\xcb\xb5\xb8\xfd\x9e\x97\xed\xb4\x97\x80\x61\x6c\xd5\x13\xcd\x39\xf1\xd2\xe0\x77\xf5\x91\xb6\x89\x4b\x2c\xdd\x39\xd3\x9e\x69\xca\x09\xae\x5a\x0f\x73\x79\x93\x50\x14\xed\x18\xec\xe3\xcb\x52\x6d\xf4\x96\xd6\xd3\x3d\x03\x77\x5f\xf0\x00\x22\x06\xb8\xf6\x98\x81\xb7\x93\x86\x87\x37\x9a\xb4\xc1\x3c\xb5\x4b\x37\x90\x0f\xe0\x49\x3e\xf4\x25\xd8\x85\xcd\x20\x59\x65\x06\x0b\x81\x28\xae\x71\xca\xd4\x31\x3d\xcb\x94\x13\x19\xbb\xa8\x20\xb7\xf7\x55\x1a\x32\xf5\x14\xef\xe8\xd9\x19\xf4\xad\xeb\x29\xa2\xf0\x1e\x0f\xd6\x04\xba\x06\x0e\x31\x94\x72\x4c\x48\xa9\x6d\x8c\x10\x21\xb7\x10\x49\xca\x2f\x7d\x28\x16\x17\xa3\xa2\x45\x9f\xdd\x58\xc2\xb8\x56\x88\x2c\x03\x38\x7d\xa5\xbb\x07\x79\x4c\x47\xcd\x06\x55\xe2\x49\x03\x5b\x7d\x3c\x3d\xd8\x5e\x9c\x12\x8e\x22\x77\x13\xe4\x32\x8f\x23\xcf\x61\x7b\x40\xfd\x13\xc9\x5a\xaf\x25\x04\x88\x32\x43\x33\xd4\x72\xa6\x67\x89\x80\x81\x4e\x95\x0e\x6c\xa2\x76\xdd\x8d\xee\x4c\x94\xce\x13\x6e\x08\xdd\xde\x61\x00\x90\x6a\x06\xca\xc3\x87\x8a\x39\x84\x17\x19\x25\x21\xb4\x10\xb4\xf1\xd2\x9f\xa1\xe9\x12\x8e\x73\x0a\xac\xd4\x35\x94\x5e\x34\x37\xb0\x5e\x4c\xe4\x90\xbc\x7a\xe2\x6b\xe3\xf0\x3b\x8c\x99\x4c\x86\x82\x60\x09\x5d\x89\xe9\x9d\x03\x3c\x0e\x9d\x7e\xb5\x18\xeb\x9e\x90\x26\xda\x32\x3b\x74\xf4\x90\x34\xe4\xbb\xd6\x39\xa8\xe5\x99\xc0\x93\x9a\x63\xa2\x3f\x6a\x05\x9f\x7c\x5d\x92\x4c\xfd\x3c\x2e\x55\x35\x25\x86\x03\xe9\x1d\x71\x0a\x9e\xf7\x2c\x9b\x04\xa5\x5f\x78\x2a\x80\xb1\xde\xd9\x42\x49\xbc\xb8\x23\xd6\x70\x47\xc1\x34\x7f\xc3\x7b\x80\x28\xc8\xfe\xe5\x8d\x71\xb8\x01\x33\x78\x58\xe2\x60\xcc\xb1\x8d\xdb\x63\x2a\x52\xb6\xd2\x9b\xa2\x90\x85\xee\x39\x79\x09\x7a\x27\x23\xa7\xe0\x4f\x99\x4f\x06\xca\x18\x72\xff\xee\xbd\xef\x33\xbb\xbd\x52\xff\x9a\x2f\x5c\x85\x5f\x6e\x45\x44\xfc\x60\x3c\xc0\x47\x05\xb4\x00\x1c\x75\x97\x8c\x72\x00\x0f\x5b\x75\x40\xf5\x28\x3f\x59\xeb\xb1\x59\xe1\x08\xe4\xa6\xcb\xfd\x3c\x16\x18\x48\x11\x49\x21\xc8\xd5\x74\x53\x9a\x6f\x42\x09\xfa\xea\x3e\x48\xd1\xb0\x51\x66\xac\xc4\xcb\x0a\xfd\xcf\xff\x4b\xfb\xb1\xfe\xce\xa4\x44\x73\xff\x55\x4a\x3c\xe7\x05\x04\x09\x5b\x7b\xfb\xd1\x0b\x6c\x4f\xab\x1f\x20\x49\x87\x7b\x62\xab\x04\xa2\x75\xf9\x81\xea\x92\x64\x27\xc3\x1b\xa1\xdf\x08\xd2\x7f\x12\xd0\x74\x23\x84\x2a\x6e\xa0\xc7\x65\x64\x45\x81\x75\x25\xb2\xe2\xb3\xbb\xd4\x1b\x7c\x81\x76\x8d\xc8\x55\xa1\x08\x87\x1c\x5f\xde\x10\xe5\xce\xe8\xa3\xb5\x9a\x07\xa7\x69\x4b\x92\x24\xd1\xed\x76\x67\x32\x9d\xdc\xf9\xfe\x06\xe0\x4d\x78\x2c\x63\x61\xf8\xf1\x5e\x8a\x41\x21\x91\x34\xec\xde\x96\xfb\xad\xc7\x83\x27\xfa\x68\x82\xfd\x96\xd1\xea\x33\x09\xad\x9b\x6b\x41\x20\x73\x16\xf8\xcb\x2f\x80\x68\x1c\x82\x78\x6d\x21\x45\x27\x09\x2e\xed\x29\xb5\x1f\x5e\xe3\x02\xe1\x30\xb6\xee\x9a\xd0\x7b\x0f\xb5\x2d\xaf\xac\x87\xad\x2d\xaa\x70\x85\x2c\x4c\x1b\x6c\xa3\x94\x2f\xc6\x37\xdb\xf8\x6f\x58\x89\xf1\x6d\xa0\xec\xad\x60\x9c\x17\x70\x06\x7a\xca\xcf\xe0\x9a\x86\x64\x82\x2e\xfa\xb1\x68\x4e\xd6\xd7\xa8\xdf\xde\xef\x47\x28\x23\x40\x01\xd4\x95\x40\xa9\x1b\xda\x16\xba\xc1\x5d\x1e\xbd\x2b\x06\xe1\x41\x76\x08\xec\x42\x4b\xf8\x6c\xe1\xdc\x98\x65\xdf\xc2\x32\xeb\x68\xab\x47\x56\x51\x6f\x3c\x23\xd4\xbd\x27\xda\x3d\xe7\x7d\x7a\xa3\xee\x40\x20\xdc\x19\xa7\x10\xbb\xfa\x92\x62\xb9\x64\x28\xbc\x7f\xb7\xdf\x20\x37\xde\x6d\x53\x60\x5a\x94\xd5\x5c\xfe\xb8\x01\x9b\xd5\x55\xd5\xa8\xab\x03\xb4\x92\x01\xef\x93\x2f\x24\x6f\x3c\x5c\xdc\xd2\x11\x21\x5a\x60\x4e\x0a\x86\x44\x50\xff\xea\xf1\x62\xd0\xbc\x63\x76\x0b\x7c\xdd\x26\x8f\xa6\x16\xbb\xaa\xfa\x5a\x16\xea\xb7\x86\x00\x6f\xe6\x1d\x77\x37\xc3\xce\xe3\x9c\x34\xcd\xd4\xde\x66\xc7\xb2\xb9\xa7\x22\xf0\x12\xae\x3b\x83\x80\xd1\xc0\xe2\x3d\xd6\x11\xea\xb7\x86\xd2\xc6\xea\xbc\x59\x15\x9e\x6d\x84\xe1\xea\xb8\xbb\xf7\x7d\xf8\xf6\xf3\x57\xdb\x6f\xb7\x23\x40\x08\x94\xf7\x8b\x87\x8c\xd4\x82\xf1\x74\x0f\x2a\x92\x1f\x93\xe5\x17\x84\x5b\xba\x19\xd1\xf4\x7b\xd5\xa2\xb7\x6a\xb0\x82\x46\x7a\xb8\x15\xb5\x35\x8c\xd1\xc8\xb4\xbc\x96\x24\xa8\xc8\x78\x51\xb9\x6f\x4d\x66\x2f\x34\xf6\xd4\xa0\x87\xe5\x27\xef\xbd\x1e\x34\x1f\x87\x26\x10\xa3\x54\x62\x39\x64\x1b\x93\xe7\x13\x63\x29\x52\xf6\xaa\x97\x75\x08\x25\xa9\x27\x4d\x2b\x5c\x98\xe3\xa1\xb5\x37\x4a\xf7\x9b\xdd\xb9\x91\xd5\xc9\x4a\xc9\xf0\xc4\x21\xe8\x10\x49\x91\xe6\x0e\xbc\x9c\xfc\xe6\xdb\xf9\x38\x34\xcc\x40\x26\xa1\x09\xbb\x47\x1e\xcb\x19\x78\xce\xc0\x92\x11\x81\x50\xd5\x93\xc9\xc9\x3c\x25\x07\x8f\x93\x56\xb8\xdc\x87\xf8\xf2\xe0\xa6\xb6\x67\xb5\xe1\xc5\xb7\x1e\x94\x36\xfc\x0a\x1b\xa8\xa9\x1c\x8d\x17\x1a\xea\x74\x9a\x3a\x64\xa8\xc4\x05\x91\x7c\xd1\xa5\x6b\xbd\x9b\x11\xba\x4f\x92\x34\x0c\xad\x07\xc7\x85\xc1\xe0\x39\xf2\x46\x63\xa0\x3f\x33\xac\x6e\x15\xa4\x58\xa7\x71\x23\xe8\x04\x77\x33\x80\xd2\xc5\x8c\x25\x7e\x63\xa0\x08\x9b\xfe\x77\x06\x14\xfe\xaf\xf7\x29\x6e\xf7\xcb\x20\x32\x1e\x70\x87\x57\xdc\x73\xc0\x75\x80\xb6\x79\x16\x50\x2a\xee\x65\x3c\x60\x38\xd2\xb7\xe9\x15\x8c\xd3\xc7\xe3\x29\x3c\xda\x5b\x37\x82\xb6\x0e\x18\xcb\x42\x83\xcc\xc5\xe7\x84\x3f\x4e\x12\x8b\x10\xc6\x3d\x78\x50\xaa\xe4\xa2\xc3\x7b\x7d\x27\x11\x84\x69\x8b\x93\xc4\x01\x1c\x66\x11\xfb\x48\x9e\xc0\x91\xa0\x40\x8c\xb5\x85\xe2\xe7\x19\xb3\xb7\x12\x9e\x86\xd6\xd8\xa0\x7d\x48\x72\x8a\x4f\x5f\xc6\xfc\x18\x6e\xce\x91\x5b\x76\x60\x3d\x98\x0e\xf2\x79\x31\xd1\x34\xf7\x2d\xbe\x8d\x3c\x39\x61\xf2\x7e\xec\x8d\x02\xe8\x71\x34\xbf\x6d\x93\xca\x66\x07\xd1\x0e\x84\x5a\x3d\x38\xaa\x0e\x24\xb9\xef\x28\x5d\xcb\x54\xc0\x85\xe1\x59\xbe\xe9\xa8\x5b\x26\x0e\x55\xf5\x04\xe5\x35\xc3\xa4\x74\x4d\x42\xb4\x7e\x62\x14\xe0\xcd\x23\xee\x9a\x9b\x48\xdb\x25\x0b\x2c\x6e\x3c\xc7\x19\x85\x2d\xe7\x93\xd2\x49\xbe\x89\x08\x20\x37\x33\x02\x89\x37\x99\x2d\x8b\x1c\x01\xde\x70\x5a\x23\x7b\xd2\xc2\xa6\x34\x5d\xd3\xbb\x0b\xc8\xd9\x43\xcd\xc4\x25\xdd\x9e\x14\x48\x19\x1c\xf3\x76\x83\xf3\x82\x9c\xe2\x60\xf5\x92\x78\x86\x53\xe9\x48\x65\x20\x9e\xdd\xaa\xd0\x80\x8e\x66\xfb\x21\xe6\x1c\x14\xad\x47\xf2\x4e\xa0\x88\xb8\x38\x5a\x7f\x71\x64\x43\xae\x72\xc0\x2d\x16\x6b\x04\x74\x9a\xc3\xf7\x9a\xb4\xdc\x02\xef\x97\xa6\x0c\x25\x90\x44\x44\x7d\xa9\xe1\x55\xd5\x7b\xe5\x29\xc6\x53\xa5\xca\x63\x4c\xad\x74\x44\x97\xc4\x54\xce\x19\x6a\x09\x55\x57\x48\x72\xd0\x55\xca\xcb\x80\xa2\xd6\x0f\x87\x17\xa1\x1c\xd1\xc0\x61\xef\x90\x97\x0c\x28\x55\xce\x4d\x05\xe2\x69\x37\xb7\x05\x24\x0b\x52\x9c\x5e\xd3\x0a\xc9\x1b\x21\x12\x22\x25\xa0\xcb\x0e\x65\x6f\xef\x6a\x94\xcc\xd1\x89\x6a\x9d\x6a\x08\xf7\x88\x36\xc1\xf6\x49\xec\x9c\x8b\x11\xb7\x73\x65\x3c\x52\xf2\x21\xbf\x8e\x26\x66\x16\xe5\x30\x89\x95\x32\xd1\xc8\x80\xb2\x74\x25\xcb\x77\x04\x5d\xea\xc3\xad\xce\x5d\x71\xec\x2b\xc9\x14\x3d\x97\x53\x56\xbd\x3f\x3c\x62\xa0\xc6\x62\xd7\xda\xe6\x51\x0c\xd2\x68\x84\x90\x0b\xc1\x4c\xca\x22\x91\xfd\x32\x47\x3e\xc3\xc1\x68\xc4\x72\xfa\x20\x61\x58\xb1\x5a\xb3\xb1\x25\x42\x76\x26\x64\x11\x6a\x43\x2d\xf7\xec\x46\x4c\x5a\xce\x39\x35\x9c\x5e\x47\xa0\x59\x50\x49\xd0\x0b\xf0\x7b\x56\xa9\x05\xde\xec\x39\x11\xd4\x60\x52\x75\x63\x75\x2f\x22\xa0\xe2\xe4\x2b\x3b\x94\xfd\x80\xa4\xfb\x60\xe2\xce\xdd\xd7\xc3\x97\x95\x0b\x25\xc4\x70\xf9\x0d\x9a\xa7\x43\x6d\xcd\xa0\x78\x5e\x60\xbc\x2b\x2f\x81\x24\x6f\xb2\x61\x59\xb6\x3c\x22\x76\x3c\x81\xd2\xfd\x32\x24\x8b\x2a\xef\xc2\xd8\x84\xaf\xf3\xf9\x7e\x9b\x63\x4b\x98\x66\xbf\x84\xdc\x15\x94\x92\x71\x38\xf9\xbd\x05\x4e\x73\x83\xf0\x19\xef\xa8\x7b\x88\x60\x4a\xba\xd9\x57\xc2\xd9\x93\x5c\xb8\x23\x72\xb1\xe3\x6f\xea\x49\x7e\x67\xb7\x30\xa3\xfd\xd7\xc7\x74\xd5\x7d\xfc\x7a\x0b\xb3\xf1\xdb\x2a\xeb\xc4\x2b\xc3\x5e\x54\x92\x2f\xa2\x15\xd4\xb9\xe8\x9b\xb6\x4f\x3e\x1e\x01\xc8\x6f\xef\x49\x03\xf4\xf6\xc6\x2a\x37\xfc\x96\xcf\x07\x28\xbf\x3c\x6a\x84\x04\xcc\x76\x64\xc1\x05\xd9\x08\xcc\x7b\xbd\x37\x4d\x4c\xc5\x94\x51\xc5\x95\xa9\x5f\x56\xc7\x79\x7a\x4a\x7b\x09\xb9\x16\x4a\xd0\x1d\x74\x0d\x83\x55\x56\x0d\xd8\x1e\xae\x79\xa2\x65\x58\x00\xf8\x6b\x4f\xb5\x07\x0e\xbb\x3d\x4c\x8f\x22\xd5\x2a\x2e\x1a\x43\x4f\x0d\x0b\x6e\x02\x73\x47\xeb\x25\x35\x9e\xdd\xdd\x67\xcc\x79\xd3\xf2\xca\xe5\x27\x37\x1c\xfd\x5b\xf2\x62\xb4\x7d\x5a\x96\x90\x61\x1f\x0d\x68\x91\x2b\xa4\x23\x75\xa1\x75\xe9\x7b\x39\xd7\x64\xa9\xe7\xd9\x1c\x5c\xc8\xde\xf8\x1b\x57\x0e\x14\x98\x77\x63\x6f\xca\x50\x5b\xda\x4f\xfc\xc6\x1e\xca\x56\xd6\x56\xbd\xab\xa2\x62\x05\xb8\xaf\xf3\x27\xad\x0b\x65\xb6\xc5\x5d\x83\x9f\xf2\x99\xbe\xb5\x08\x43\xab\xb0\x55\x89\x69\x23\x45\xab\xa6\xa0\xf7\x38\xe8\x86\x22\xef\x79\x81\x20\x10\x9d\x91\x88\xcd\x92\x79\xa6\xf5\x0d\x4c\xa2\x7d\x46\xf2\x2f\xdb\xde\x75\x89\xe0\xa7\x67\xcf\xa7\xb0\xc5\x2c\xac\x92\xcc\x0a\x2e\x48\x23\x50\x40\x8d\xab\x6c\x14\x68\xb6\x8b\xc9\x5a\x1c\xe4\x49\x1f\x8f\x3f\x24\xcf\x89\x39\x15\xb3\xd8\xfc\xbc\xcc\x98\x90\xb4\x4e\x22\x53\xf6\x9c\xda\x46\xc6\x8c\x4b\xb4\xa3\x0c\x25\x19\x8c\x44\xda\x48\x0c\x93\xd8\xfd\xa9\xf1\xe7\x23\x12\x28\xce\x02\xee\xfa\xf4\x1c\x7a\x7d\xe6\xd4\xf8\xc8\x67\x8e\xb9\xf8\x9f\xd6\xd9\x41\x17\xcd\x84\x96\xf1\xe7\x82\x38\xa1\x1c\x48\x1b\x2e\x18\x84\xa8\x6d\xe5\xdc\x89\x5c\xed\x10\x1d\xad\x3f\x41\xf0\x96\x1a\xac\x65\x79\xef\xa8\xac\xa3\xf7\x0b\xca\x6e\xb7\x09\x7e\xf4\xa8\x7c\x9e\xf5\x9a\xdc\x0b\xc6\x50\x83\x27\xc9\xf1\x77\x26\x1c\x4d\x22\x19\xc6\x72\x67\x86\x22\xe2\xf5\xf9\x09\x78\x26\xcd\x2b\x7d\xf0\x9a\xb0\x4d\x24\x7a\x7c\xbd\x44\x17\xf4\xc4\x8a\x38\xec\x08\xa7\x32\x88\x00\x4d\x49\xef\xad\xe1\xe3\xae\x22\x4a\x96\x82\xfb\xaa\x69\x31\x9f\x11\x0c\xf1\x54\x3b\x2e\x46\xc0\xe2\x5e\x82\xbf\xed\x33\x81\xa8\xff\x9d\x71\x6d\x2a\xfe\x9a\x50\x13\xfe\xf1\x4c\xc0\x06\x1a\x1f\x72\xb3\xa1\x7f\xbf\x6f\xba\x40\xb3\x71\x50\x3b\x53\x9d\x3f\x5f\x35\xa1\xea\x81\x2a\xb1\xc4\x96\xae\xfc\xba\x59\x00\xab\x3c\x13\xc0\x06\x14\xb5\x25\x9b\x2d\x2a\x9f\x2e\x0d\x12\x14\xc3\x65\xb9\x50\x85\x36\x18\x06\x01\x33\xe7\x09\xac\x5a\x22\xe8\xbb\x0e\xf4\x18\x60\x4c\xf4\xe7\xc7\x33\x49\x80\x10\xc7\x60\xf8\xe0\x88\x2d\x53\x42\xf9\x0e\x01\xe9\x79\x0b\xce\xb0\x25\x34\x0f\x7a\x8d\x33\xe6\xa7\xc3\x89\x8b\xca\xe8\xf9\x37\x11\x01\x12\xa8\x57\xd9\xb2\x88\x0a\x63\x7d\xd7\x59\x8f\x72\x27\xdf\xb5\xef\x0a\xea\x1e\xe6\xd3\xb9\xdc\x96\x55\x92\xa3\x02\xec\x1c\x81\x28\xf2\x91\xa8\xa6\xe6\x3f\xcb\x6a\xc7\xe8\xdb\x72\x89\x6e\x98\x68\x8e\xfb\x21\x78\xc5\x49\xc2\x0f\xa2\xa6\xcc\x6a\x4b\x4b\x97\x36\x90\x65\x41\xc0\x43\xa9\x81\xe9\xf6\xde\xca\xc4\xb5\x72\x5d\x47\xfc\x87\xfe\x32\x5b\xb3\xf2\x8e\x2a\xa2\xdf\xc1\xa4\x21\x75\x93\xae\xaa\x6f\x99\x2c\x3e\x3c\x2d\x9a\x64\x58\x2d\x7c\xa1\xa1\x3a\x50\x59\x4d\x51\xd4\x2e\x81\xd6\xba\xfa\x87\xe0\x20\x94\xb0\x87\x59\x4f\xdd\x90\xac\x01\x34\xbb\x61\x29\x81\x79\x16\xa2\x76\x28\x0c\x90\x3c\xe5\xae\xc5\x4b\x84\x5f\x0f\xde\x93\x4b\xc9\xdc\x0c\xca\xbc\x56\x31\xc5\x6f\x77\xb4\x61\xc3\xfd\xa9\x9b\x0f\xca\x17\x95\x53\xc4\xcd\x9a\xa4\xb6\x37\x9c\x8a\xb4\xcc\x21\xda\x1b\xbb\x06\xb2\x55\x8d\xde\x88\x73\xcf\xe4\x6e\x80\x65\xf7\x78\x7d\xd5\xe6\x56\x6e\x49\x9d\x84\x27\x36\x13\x6f\xb9\xab\x73\xb0\x07\xd8\x3d\xc1\x53\x66\x94\x20\x97\x66\x39\x26\x93\xca\x3d\x7d\x95\x49\xf6\x06\x6b\xda\x8a\x80\x3c\x79\x2d\x2c\x8e\x03\x3c\xc7\x4c\x75\x24\xbd\xb7\xa3\x0b\x4e\xf7\x45\xc7\x35\x35\x3e\x6e\x74\x27\x66\x23\x79\xc6\x02\x68\xe1\xa3\xa7\x3e\x8e\x0c\x3a\xa7\x53\xa9\x03\x49\x81\xe9\x15\x55\xc9\x33\x5c\x0e\x7f\x39\x9b\x3b\x79\x7f\xd8\xc8\xeb\x3c\xa1\x14\x8e\x58\x75\x07\xb9\xe1\x0e\xb1\x78\x74\x5e\xc3\xdb\xdb\x68\x1a\xbc\x2e\xe0\x7b\x53\x90\xe6\x1d\x3c\xc5\xd8\x11\xd8\x9e\xd7\x1f\xfd\x01\xe1\xcd\xf2\xaa\xee\x8b\xf5\x7a\x42\x78\x07\x3c\x4c\x9e\x69\xf3\x27\xc8\xb3\x01\xf3\x78\x95\x8d\xdc\xba\xbb\x2d\x1e\x77\xf9\xb0\xea\x99\x84\xab\x27\x49\x66\x64\xc2\x24\xba\x55\xb8\xc6\x54\xfd\xd6\x82\x37\x9a\xa6\xdf\xd9\x4d\xf6\x6f\x9c\x71\x35\xaf\x97\xe0\xd5\x81\x05\xa5\x3b\x4a\xae\x01\x83\x90\x24\xc9\x5e\xdd\x46\xd8\x4b\xaf\x9d\xf5\x39\x5a\x60\x79\xfd\xfa\xf6\xc9\x5b\x9e\xef\x8f\xa8\x63\x29\x48\x9b\xba\x92\x09\x14\xc9\x9f\xa9\x81\x0d\xac\x98\xbb\x04\x9b\x09\x52\x1c\x4c\x1e\xb7\x35\x29\x60\x55\x25\x4d\xee\x6d\xda\xd8\x72\xbe\x0e\x1f\x7b\x9e\xf0\x69\xb0\x8f\xd5\x78\x78\xeb\xfb\x3c\xb5\x51\xb1\xa9\x23\x7d\x3c\x43\x12\x3f\xd5\x9b\x93\xf6\x86\xda\xa2\x79\xa5\xc6\xa9\xa6\xbd\x01\xa8\xe6\x81\x3a\x2d\xe4\x22\x3e\xe7\x37\xda\xbc\xa0\x52\xe6\x38\x70\x94\x64\xe6\x75\x05\x79\x5d\xb8\x7d\x7c\x6e\x1a\xc6\xaf\x83\x38\x2c\x65\xb3\x2e\x31\x6b\x23\x21\xeb\x3d\xdd\xea\xce\xfa\x93\x4a\xce\x52\xdf\xc7\x4f\x8f\xa2\x5f\x0b\x86\x7d\xdc\x0a\x89\xe1\x2c\xad\x53\xcb\x66\x23\x3b\xd7\xc0\x44\x93\x8b\xb0\x10\x0c\x7c\x2c\x4d\xb2\xbf\xcc\x61\x9a\x20\xb1\x13\x39\x8f\x38\x9f\x93\x1e\xd1\x78\xb4\x99\x8e\x9a\xf3\xe4\xf1\x4c\x8e\x90\x19\x23\xeb\x89\xb2\x5e\xc1\x03\xd4\xa1\x11\x29\x86\x64\x6a\x48\x41\xab\x92\x9f\x53\x61\xe4\x5a\xde\x53\x8f\x44\xb7\x95\x1b\x43\xdc\x7b\xf1\x14\x64\xa6\x2d\x0d\x73\x11\xa7\x72\xc1\x14\x71\x98\x6c\xca\x34\x92\xe2\x41\xf7\xf0\xf1\x1e\xa2\x98\xab\x79\x1f\x18\x65\x2d\x38\x75\x97\xa3\xda\xe7\xed\xe1\xb9\x48\x4f\x54\x8c\x0b\x9a\xe5\x69\x9f\x41\xeb\xd6\x54\x70\xa9\xeb\xc8\x00\xeb\x57\xda\xad\x16\x53\x3c\xea\x48\xa8\xce\x64\x6a\x15\xc0\x78\x1a\xf5\x0b\x14\x5e\x5c\x0f\xe7\x08\xcd\x77\xf4\xc4\xa0\x9e\xb1\xea\x47\x21\x15\x86\xa9\x9c\xc5\x45\x33\xaa\x09\xb9\xdd\xd0\xf9\xee\x82\xdc\x25\xcc\xd8\xce\x5a\x55\x09\x87\x56\x14\x85\xcf\x05\x6e\x65\x6b\x63\x17\xd9\x51\xf2\x5c\x30\xe9\x41\xc1\xcb\x28\xd8\xa4\x73\x24\xbc\xd7\x96\x58\xbe\x52\xe6\x35\x8d\xc1\xa9\x12\x0a\xb1\x3d\x9e\x60\xa3\x2d\xee\xed\x59\xb3\x6d\x77\xd7\x90\x37\x5d\x64\x46\xae\x16\x9b\x4e\x1c\xa2\x84\x59\xe1\xf4\x32\x01\x2d\x20\xb0\xac\x20\x04\x22\xf7\x4e\xe8\x30\x32\x52\x48\x27\x87\x78\x6e\x42\x32\x6b\xdc\x83\x25\x86\x3c\x7e\x8d\x14\xba\x80\xe9\xcd\xb2\x0f\x3e\x3b\x14\x8b\xa6\xad\x8e\x99\x81\x74\xd6\x27\x66\x75\x11\x46\xbb\xfc\x89\x1f\x8d\xeb\x06\x36\xf5\x95\x0c\xa3\xf5\xae\xb4\xb4\xbf\x04\xc6\xab\xd6\x3b\x6e\x2e\x09\x2a\xeb\xc7\x43\xbf\x74\xd5\x6b\xe6\x81\x76\x35\xde\x03\x29\xf6\x9d\xc4\x2b\x27\xf7\xaa\x5e\x24\xa8\xc6\xf5\x84\xc9\xaf\x83\xf0\xa4\xde\x1e\xee\xf1\x7c\x2f\x96\x95\xc4\x52\x83\xee\xf1\x6a\xc4\xe9\xfb\xae\xb2\x5b\xd5\x77\xd5\xf4\x5e\xa6\xe6\x1d\xf8\x57\x52\x80\x51\xe7\x3c\xf1\x11\x08\x1f\xc0\xc7\x8b\x3b\x95\xcd\xbc\x02\x3d\x30\xf6\x69\xfb\x6d\x19\xf8\x4a\xf1\xdf\x19\x03\x3b\xbf\x30\xb0\x62\x83\x77\xed\xe3\x1e\xbb\x96\x00\xaf\x00\x5c\xfa\xb9\xa9\x09\x33\x83\x95\xae\xf0\x33\x8a\x80\x73\xfd\x92\x54\x44\x19\x0e\xdb\x64\xe2\x14\x7c\xa3\xb4\xa7\x7b\x39\xbf\x1b\x79\x45\xf4\xe1\xd9\xda\x26\x20\xfa\x25\x6c\x88\xcc\x66\x32\x21\x74\x84\xec\x4a\xbf\x10\xea\x6b\x34\x84\xcf\x15\x8a\x78\xb2\x9c\x02\xcb\x52\x83\x5b\x82\x3f\x71\x35\x4d\x23\x2e\x91\x1e\x72\x6e\x25\x2b\xbb\xde\x54\xb1\xf3\x79\x8d\x57\xd3\xf1\x04\xa6\xfa\xf9\x90\x7a\x37\xcd\xf7\x56\x4e\xb0\xfa\x2d\xdf\x81\xee\x05\x28\xa6\x3f\x74\xad\xfa\xb6\x81\xea\x52\x45\x8f\x3a\x7d\xe5\x0c\x2b\x8c\xf5\xe5\x8e\x20\x4d\x39\xb4\x79\xbc\x13\x53\x24\xf7\x47\x76\x39\xe7\x59\xe0\x99\x31\x1f\x92\x13\xb9\x05\x5b\x13\x21\x68\x2b\x3c\x59\x66\xdd\x5e\x41\xa1\x2f\x24\x3f\x58\x91\xb9\x2b\xa5\x98\x37\x6f\x10\x50\xa7\xfe\xbe\x8f\x4f\xec\xc9\xee\x2e\x73\x6e\x4a\xd1\xee\xc4\xc9\x10\xc8\xce\x0a\x7d\xab\x96\x49\xcc\x5b\x52\xef\x85\xbb\x8b\xd8\x10\xa5\x21\xe5\x73\x71\xf7\xcb\xa3\x4d\x59\xae\x50\x19\x2b\x40\x0d\x9b\xe0\x7a\xc6\xfb\x25\x48\x06\xc8\x91\xb3\xec\x4d\x5a\x39\xd1\xea\x04\x43\x5e\x34\x0a\x48\xd2\xb6\xa4\x1f\x37\xe8\xb1\x91\x77\x79\x00\xf6\x2c\x34\x29\xf2\x2d\x25\xae\xc8\x01\xaa\x15\x57\xc7\x33\x72\xad\xd8\x82\x29\xdc\x68\x5c\x93\xab\xa0\xf7\x85\xbb\x6e\x87\xec\xd6\x2b\x4c\x5f\xe8\x90\x63\x5a\xff\x56\x30\x88\x2b\xfd\x84\x9f\xc1\xac\x1f\x70\x3d\x6c\x78\x64\xcb\xb9\xf0\xf6\xea\x6d\x60\x4c\x68\x1d\xcb\x23\xd7\x71\xde\xfb\xfa\x56\xf9\xfd\xa4\xf4\xae\xd7\x19\xe9\x2d\x31\xa1\xfc\xb8\x8c\x80\x10\x1d\x37\x99\x42\xb3\x07\x44\x7b\x17\xb5\xf2\x09\x05\xa6\x04\x72\xb6\xb1\x30\xf2\x6a\xb9\x66\xd0\x3b\x24\x74\xc3\x4c\xa7\xe5\xc0\xdf\x3b\x05\x0d\x67\x01\xf0\x28\xf9\x00\x1e\x4f\x17\x24\x26\xae\x72\x41\xe9\x10\x35\x52\x0e\x69\x15\x4c\x33\x58\x7c\x03\x94\xad\x3c\xef\x05\x18\xd2\x0f\x57\x4a\x1e\x25\x3e\xa8\x9a\x08\x33\x4a\xc4\x82\xb3\xf9\x9a\x58\xe2\x91\x3d\x82\x7a\x70\x6f\xce\xec\x93\xb6\x8c\x3d\x9d\xb9\xba\xa9\x43\x9b\x60\x36\x3e\x4e\xe8\xa0\xea\x54\x69\xe6\x89\xc8\x92\x09\x2b\xb0\x6a\xea\x2c\xa5\x69\x7b\x0b\x3c\x0a\x11\xb2\x0c\xbb\xbb\x62\x8f\x88\x20\x36\x6a\x0b\x57\x23\x84\xcd\xcc\xdb\xf5\xdf\x98\x85\xe3\x26\xfb\x9d\xbd\x1e\x83\x7f\xfb\xa8\x7b\xfc\x29\x11\xf1\xba\xaa\x10\xec\x70\x2d\x73\xa6\x9a\xf9\x95\xb3\xa6\xbb\x77\x13\xc4\xbc\x9d\xdc\x9c\x8c\x28\x98\x96\xdd\x73\x40\xd4\x79\xea\x29\x42\x67\x34\x40\xac\x94\x84\x32\xce\xbd\x63\x05\xac\xb0\xc9\xa1\xa1\x5e\x31\xbd\xf6\xfd\x70\x39\x2a\xd3\x3c\x99\xf3\x80\x57\x4d\xed\x59\x76\xb8\x2d\xf4\x2b\x35\x00\xb8\x6d\xd7\x25\x79\x27\x9e\x7d\x49\x30\x97\xbf\x03\x38\xf0\x62\xb0\x0a\x25\x25\xa3\x5d\x8c\x28\x65\xa1\x21\x6e\x1b\x71\xc0\xe9\x84\xc5\x67\xfd\x06\x15\xd3\xa2\x4c\xfb\x9b\x73\xf9\x1c\x08\xee\x0d\xe3\x4c\x9a\x68\x0a\x68\x6a\xda\xb0\xaa\x31\x25\x84\xdf\x05\xa1\x7e\x21\xb8\xf1\x3e\x41\x69\x38\x19\xbd\x14\x12\xdf\x04\x64\xd4\x03\xe0\x4d\xe3\x72\x7a\x17\x14\xa0\x00\x08\x2c\xf5\xdd\xcc\xe1\xc0\x19\x65\x02\x0e\xf4\xed\x00\x14\x39\xe5\x99\xad\xda\xe8\x37\xf2\x8c\x3e\x75\x6f\x5b\xf1\x7b\x93\x12\xc3\xa5\x65\xc1\x89\x18\xb2\xa5\x21\x59\xc1\xe2\xf4\xb3\xb7\xbd\x7c\x61\x41\x29\x35\x02\x6c\x0b\xe8\xe3\xfd\x7e\x77\x75\xef\x8c\x53\x0e\x80\x73\x66\xca\xf4\x88\x0a\xb7\xcd\xf6\xbb\xbb\xcd\x5f\x8a\x9b\x48\x8b\x48\x3b\xbd\x47\xb9\xb0\x66\x13\x1a\xda\x48\x01\xc1\x59\x0e\xb7\xb4\x8a\x54\x10\xcc\x88\xa1\xb3\x31\x12\x64\x2c\xa9\x4a\xdd\x70\xa1\xac\xd2\xf2\x92\x01\xed\x18\x93\x08\x96\x64\x8f\x2c\x33\x7a\xdf\x7c\x82\x72\xd9\x06\xf4\x74\x53\xf6\xa5\x9c\x8f\xc7\x07\x76\x03\xb6\x8b\x32\xc1\x38\x2b\xbd\x0e\x9e\x28\xe6\xfd\x3e\x42\x6f\x26\x69\xc7\xe4\xe5\x1e\x4a\x26\x58\xe8\x27\x41\x36\x21\x24\x42\xa0\xba\x22\xb3\x07\x7d\x1a\x65\x66\x9c\x14\xed\x03\xfb\x08\x72\x2f\x43\xdc\x36\xaf\x70\xb3\x73\xbe\xe5\x9e\x12\x6c\x75\xb2\x80\x0e\xbc\x85\x95\x75\xab\xec\x62\x54\x23\x4c\xe0\x44\xe2\x7c\x3c\x0d\x40\x0f\xaa\x3b\x49\xd4\x3b\x9c\x0f\x84\xa9\x71\x57\x1a\xbc\xfb\xb7\xba\xa2\xcf\xb8\x3a\xe1\x84\xc8\xf4\x4e\x6d\x90\xfc\x28\x51\xec\x95\xf9\x0d\xa8\xf0\x6b\x4f\x36\xd2\x43\x8f\xed\x38\x03\x68\x22\xc3\x23\xa3\xca\x8b\x88\x34\xe9\x40\x12\xe3\xa4\xbb\x87\xf1\xde\x18\x10\x3d\xbb\x34\x6d\xef\x35\x7a\xe7\xfd\x9b\xce\x5b\xc7\x44\xc3\xef\x58\x57\x71\x94\x4b\x57\xf0\x11\x24\x5c\x44\xc4\xe4\xe8\xc1\x54\x5e\x3c\xeb\x4d\x85\xb1\xc7\x53\x77\xdf\x98\x88\x0e\xf7\xb7\x7b\x38\xe6\x9e\x05\x65\xa1\xbe\xe5\x83\xe4\xa0\xfc\x5c\x46\x43\x72\xf0\xba\x99\x31\xc1\x7c\x55\x55\xd9\x3d\xf8\xfc\x75\x94\x9d\x74\x83\xb2\xb7\x31\x49\x0c\x8a\xf9\x02\x03\x75\xf3\x1d\xf4\xef\xe9\x09\xda\x09\x42\x90\xce\x06\xbf\x94\xb2\x75\xef\xc8\x28\x5c\xa2\xfc\x9a\xfc\x63\xeb\xac\x0c\x66\x54\xb6\x57\x15\xa4\x79\xbf\x52\x1e\xd7\x74\x3b\xe5\xea\x91\xd8\x48\xf5\x9a\xd7\x67\x5d\x86\xf3\xa2\xb8\xb9\xe1\x9e\x82\xa0\x8d\x55\x7b\xe3\x22\x2f\xca\x04\x97\xe9\xb6\xd0\xbf\x81\x03\x09\xc3\x08\x80\x08\x0a\x41\x33\x66\x39\x84\x2d\x1f\xf2\xe0\x6f\xfd\x80\x2b\xee\x7e\x67\x37\xa7\xfa\xfe\x57\x00\xc1\x70\x8d\x65\xbe\x7a\x69\x18\x5f\xfd\x4b\xf7\x66\x18\xdd\x55\x91\x06\x22\x24\x69\xda\x4d\xc1\x48\x94\x49\xed\x13\xc6\x14\x20\x28\x12\x9e\x0a\xbd\x6a\x4b\x3a\x94\xf1\x7d\xa3\xc4\x3c\xe0\xb6\xae\x37\x75\x30\x56\x66\x96\x99\x42\xda\x20\xb3\xc2\x67\x74\x81\x45\x04\x3e\xca\x6e\xd0\x0c\xf9\xcf\xc4\x47\x75\xba\x6e\x95\x7b\xe3\xe3\xb7\xe7\xe5\xa7\xd7\x34\x79\xbe\x18\x5b\x24\xc6\xd4\x7c\xe5\xa7\xc6\x81\x8e\x90\x26\x42\x41\x1b\x26\x5d\x6d\xf7\x8b\x9e\x66\xf8\xf5\x1c\x73\xee\x61\xc6\xa6\x0a\x71\x6b\xfb\x8c\xd3\x65\x49\x06\x7f\x5b\x5e\xfe\x50\xc1\xe2\x9e\x61\xc5\x2b\x12\x52\x32\xcc\xde\xf4\xc3\xa7\x9e\x0b\x3a\xa4\xe9\xf9\x7e\x26\x9d\x97\x1c\xf4\x4e\x61\xd8\x72\x64\x52\x1e\x75\x4b\xb7\x66\x8e\xad\x97\x77\x1e\xf1\x48\xa8\x5b\x5f\x0b\x8d\xdf\x66\xcb\xb2\xc1\x53\x71\xc8\x86\x03\x6e\xd4\x93\x10\xbc\xfc\xc9\xc2\x8f\x3b\x2d\x03\x07\x83\xf2\x2d\x41\x4b\x5e\xed\xc4\xd9\x43\xa1\x89\xc6\xc5\xa2\xe5\x44\x5d\x6e\xd9\x6e\xda\xe4\xd2\x1e\x47\xac\xa1\xfc\x64\x28\xd6\x9e\x11\x09\x03\x08\x3e\x06\x04\x3c\xc2\x48\x39\xd8\x8b\x96\x86\xe4\x18\xf5\xbb\x6b\x0e\x9f\x17\xa5\x1b\x79\x4d\x9a\x50\xd4\xbb\x21\x97\x9a\x73\x9a\xa7\x1b\xc5\xf4\x12\xd8\xda\x25\x15\xba\x0b\x37\xc9\x51\x61\xaa\x8c\xac\xe5\xd5\x00\x78\x59\xec\xc1\xf5\x83\x9f\xe4\xd4\x88\x64\x2f\xbd\xf5\xfc\xeb\x0a\x45\xb1\xc8\x18\xad\x92\x89\x43\x99\x0a\x46\xc4\x5e\xe3\xa0\x9f\xca\xbd\xc0\xb6\x23\xef\x14\x7f\x50\xf3\x55\x9f\x1e\x3e\xd8\xad\xef\x2c\x26\x44\x82\x32\xba\xa5\x66\x32\x94\x57\xb7\x51\x1d\x3b\xa9\x02\x4f\xd5\x83\x48\x7a\x76\x7a\x8b\x05\x29\xfb\x51\xc9\x95\x2e\xbe\x83\x1b\xf9\x62\x3a\x0e\xac\x15\x73\x22\x49\x49\x7f\xbb\x2f\xbd\x9a\x9b\x8e\x8b\x02\x2f\x31\x03\x9d\x12\x1c\x57\x5d\x6d\x4f\x27\x0a\xd7\x67\xf5\xb9\x42\x1b\x53\xe9\x2e\x52\x10\xe4\x99\x1c\xad\x94\x89\xd6\x82\x42\xc3\xcd\xd9\xf4\xa6\xcb\xf1\xc7\x46\x80\x45\x84\xc6\x24\x8a\xb5\x59\xd2\x05\x96\xa0\x51\x71\x08\x23\x27\x2d\x58\xde\x4b\x56\x55\x21\x71\xcc\xc9\xbd\xc5\x3a\x75\xc3\x6e\xaf\x8b\x98\x1e\xa5\x88\xcc\x1e\x30\xba\x2f\x07\xd3\x2e\x79\xb7\x82\x33\xd9\x69\x96\x82\x29\x48\x22\x89\x4e\xb6\x43\xac\xc5\xe0\x0b\xa2\x6b\xe6\x63\xbf\x55\xfc\x99\x6e\x40\xa3\x12\x65\x30\xba\x50\x2e\x72\x70\x7e\x3c\x4f\x21\x4a\xb6\x64\xf1\x6e\x9a\x31\x1b\x8c\x12\x9f\x61\x79\x46\x31\xea\x92\x83\x46\x3e\xf6\xc4\xe0\x6c\x85\x33\x4e\xbe\x74\x57\xa5\xd9\x75\x6c\x2e\x54\xf6\x2e\x92\xaf\x07\x5b\x58\xf1\xc3\x3c\x70\x63\x61\x43\xbe\x7f\x63\x97\xbb\x19\x54\x1a\x7c\x3e\x85\xe2\xd6\xf5\xa8\x6a\x13\x2a\x56\x1e\x57\xfb\x94\xdc\x46\xf6\x49\x65\xcf\xe6\xbd\xd0\x32\xd2\x26\xca\xfb\xcc\xd8\x2d\xdf\xfc\xf3\x96\xa7\x58\x80\xc7\xe8\xb1\xc0\xfe\x83\xb6\xb4\xd2\xd1\x05\x0f\xaf\xb0\x64\x54\x31\xef\x71\xb9\xde\xe8\xc9\x8c\x4c\x72\x0c\x0f\x31\x50\x1e\x49\x5a\x06\x05\xb2\x7e\x80\x0c\x29\x80\x34\xa3\x0d\x9a\x54\x15\xbf\x3d\xc8\xcc\xfb\xef\x4c\xa6\xc4\xff\x51\xa6\x58\xda\x29\x56\xe0\x71\x73\x73\xc5\x9c\xdd\x4b\x31\x38\xee\x6e\x3b\x8c\x6e\xb3\xa0\x70\x43\x18\x59\x2f\x28\xac\x3e\x91\x17\x7e\x82\xd6\x2d\xe7\xf0\x6d\x8c\x7d\xc9\xce\xe4\x61\xa9\xf3\x31\x34\x83\xec\x4e\x49\xbd\x05\x66\x98\xd1\x7b\xe5\xe0\x37\x69\x5a\x27\xe8\x8b\x91\x62\x85\xaf\xcf\x74\x5d\xdf\xab\xa6\x5d\x7e\x41\x45\x02\x9b\x69\x3b\x3c\x85\x9d\x19\x89\xad\xfe\x46\xbe\xd5\xe4\x96\x92\xe0\x02\xbc\x42\xa7\x5f\xb1\xfc\x89\xbf\xec\x7b\x79\xb7\x28\xe5\xc9\x63\xb7\xdd\xc3\x37\x71\xd3\x78\x5f\x09\xd5\xd2\x08\x62\x99\x58\x19\x00\xca\xac\x4e\xb4\x0a\x4b\xdb\x9f\xf8\x25\x49\x2f\x44\x00\x64\xeb\x06\x5a\x9a\xa0\xf5\x2a\x60\x9e\x87\x5e\x21\xe0\x4e\x29\x3b\x69\xfa\xb8\x0f\x53\x1d\xb1\xa3\xf4\xe5\x02\x78\x0b\x33\x43\x06\x7c\x9b\xfd\xb9\x05\x4f\xd5\x25\xf1\x52\x78\x31\x72\x57\xeb\xd9\x93\x30\x40\x26\xb3\xa5\xf6\xae\x09\xb5\xe0\xf6\xd5\x05\x22\x91\xf6\xda\x2b\x4b\xb5\xef\xd0\x3c\x4e\xcf\x1e\x47\x8d\x82\x78\xf7\x43\x70\xce\x43\xb7\x3c\x87\x87\x29\xe9\xa2\xd8\xf8\xe6\xc0\x6d\x53\x17\xb3\x93\x20\x56\xf7\x2a\x3a\x64\x6f\xc5\x84\x24\x48\x23\x79\x47\x78\xfb\x55\xb1\x2c\x52\x5e\x49\xb3\x2b\x3e\x48\xe2\xf4\x98\xd2\xd7\x51\x66\x99\xbc\xdc\x9d\x10\x4e\x63\xc4\x0c\xdd\xea\x03\x98\x49\xcf\x10\xd4\x84\xce\x7b\x66\xab\x4d\x18\xd9\xa3\x23\x28\x63\x7d\x84\xed\x4d\xb5\x20\xa5\x16\x55\x86\x34\x16\xee\xc5\xbb\x3d\x7e\x09\x7d\xe7\x4d\xa7\x7a\xc1\x3a\x80\x8d\x72\xa4\x59\xe8\x68\xff\x68\x33\x92\x09\xd0\x13\x4e\x0b\x21\xa7\x2e\xed\x2e\x28\x34\xb9\xa9\x71\xc7\x6b\x95\xd7\xf2\x9a\x73\xe4\x2f\x70\x66\x31\x06\xd1\xcd\x7e\xc4\x11\x8a\x51\x25\x46\x4e\xd3\xe1\x76\xe0\xe4\x70\xa3\x29\x11\x1b\x5d\xb2\xd3\x75\xd2\x09\x35\x03\x52\x1f\x1c\xa2\xd6\x93\x4c\x98\xa2\x32\x53\x7e\xaa\x0f\x0d\xf8\xd2\xd0\x0b\x7f\xda\xa0\x89\x2f\x73\xbd\x87\xc7\x22\x78\x33\x8d\x6d\x6e\x92\x85\xa0\x47\x9c\x84\xa3\x54\x95\xf0\x78\x6d\x9a\x82\xef\xcc\xc6\xd7\xdd\x41\x73\x44\x53\x61\x84\x64\xe2\x23\x61\x82\xac\xa4\xc6\x4b\x16\x7a\x09\xc1\x6b\x0b\x76\x49\x4d\xe5\xa6\x78\xd8\x00\xe7\x82\x34\x58\xac\xce\x37\x14\xfc\x8e\xd4\x57\x04\xa4\x7b\x9c\x2a\xf4\x58\x01\xc9\x06\x24\xf1\x79\xe4\xeb\x6a\x35\x24\x11\x5f\x7c\xed\x18\x03\x0f\xb3\x63\x90\x07\x0b\xfa\x18\x7a\x9f\xce\x3b\xec\xa6\xb5\x56\xf6\x4e\xd3\x02\xc8\x42\xde\x6b\x64\x66\xd5\x4c\x41\x27\x7b\x54\xb4\x1f\xd0\xe4\x9d\x77\x98\x7b\x80\xf8\x29\x91\xef\xb5\x53\x95\xd8\x6b\xa1\x33\x6b\xb0\x77\x06\x29\x8e\x22\x03\x08\xb3\xc0\x8b\x96\xed\x79\x75\x49\x9c\xe6\x55\x3f\x57\xa0\x80\xfa\xe8\x6d\x69\xcf\x59\x8a\x2d\x26\x0a\x5d\xd9\x1f\x68\xfd\xbd\x2d\x40\xfd\xe8\xd3\x2d\x64\x5a\x93\x9b\x2c\x9c\x75\x09\x02\x69\x62\x7e\x85\x00\xdc\x13\x91\xdf\xf8\x26\xc3\xf6\x3b\xf3\x36\x1d\xfb\x0d\x68\xcc\x1a\x03\x5d\xa0\x91\xaa\x19\x08\x3e\x72\x1a\x19\xf5\xfc\xe9\x66\x21\x65\x6e\x95\x94\x85\x2c\xd4\x6d\x4b\x19\x50\x85\x3e\xbd\xf7\xfe\x7d\xa3\x1f\x4a\x13\xce\x6f\x66\xe2\x29\xb9\x11\x88\x90\x79\xbc\xe4\xee\xe9\xd5\x9c\xb0\x1b\x8c\x30\xe5\xc2\xe0\xd4\xb7\x46\x7f\x94\x4f\xe0\x64\x1d\x2a\x44\xec\x79\xf1\x97\x00\xc3\x51\x14\xc3\xc6\x1a\x66\x29\x86\x41\xc9\x1a\xc3\x57\x2d\x46\x65\xde\x2b\x54\x0d\x8b\xf8\x3b\x61\xbb\xc5\x13\x3d\xe6\x66\xad\x83\xe9\x1e\x8a\x38\x83\x8e\x07\xd9\xce\x3c\x4c\xf6\x8b\x06\xbc\xf1\x02\xeb\xd6\xaa\x5e\x96\x82\x79\xbe\x70\x93\x4e\xfa\x40\xee\xa9\xc8\xa0\xa8\x21\x00\x1c\x9a\x0a\x94\x45\x28\x4d\x57\x83\x50\xbb\xaf\x1f\xed\x4d\xa7\x3b\xea\x7c\x78\x39\x26\x49\x67\x0e\x4f\x02\x24\x2f\x76\xd1\x68\xb3\x61\x1c\xc1\xd0\xc5\x25\xa8\xd0\x75\xe7\x15\x82\x65\x5a\xc5\xad\xe1\x21\xdc\x5d\x40\xc2\x4e\x71\x6a\xf2\x35\xb1\x73\xd1\xe8\x14\x6b\xe4\x29\x37\x54\x84\x56\x82\xd4\xa1\x44\xfd\xc0\x48\x3c\xb3\x39\x6f\x1f\x97\xf6\x86\xbe\x5a\xa5\x66\xfd\x1b\x27\x15\x3a\x41\x97\x50\x80\x11\xc3\x32\x25\xb2\xb0\x96\xca\x8a\x4a\x4a\x60\x92\x0b\x32\xb9\x4e\x31\xde\xe0\xe2\xe9\x08\x8e\xe4\x99\xe1\x01\xda\x56\xcc\xda\x4f\xbf\x5d\x9e\x84\x47\xf2\x7a\xe6\xa8\x22\xf3\x42\x17\xf5\x5d\x20\xf6\x14\x07\xdb\x66\x83\xac\x99\x3e\xac\xc0\xec\x8e\xed\x41\x1b\xeb\x52\x69\x55\xc6\xb9\x3d\x2d\x1d\x08\xfb\x0c\xe0\x52\x68\xa8\x71\x60\x69\xb6\x7d\x8c\xb9\xa4\x19\x88\xc3\xb5\xf9\xaa\x5a\x84\x07\xbb\x72\x54\x16\x69\xa4\x13\xd3\x60\xa4\xb9\x1d\x01\xdc\x70\x57\xa4\x57\x48\xe6\x78\x2b\x10\x0a\x5d\x32\x7d\xdf\x45\xfb\x84\xf3\x94\x52\xcd\x29\x67\x51\x9b\x06\x74\x2a\x59\xd9\xd2\xab\x02\x06\x16\xee\x69\x8f\x7a\x48\x73\xf5\x34\x52\xf7\x3c\x82\xa0\x93\xc6\x21\xa6\x03\x92\xde\x8e\xe0\xe9\xde\x1b\x10\xa0\x34\xca\x80\x2b\xf6\xc0\xa8\xa8\x6d\x5c\xdd\xb0\xc5\x21\x32\x44\xd6\x96\x66\xab\xd5\xa8\xc4\xc0\xee\xf2\xd6\x25\x1e\x8b\x43\x94\x77\x38\x2d\xc5\xfa\x3d\x65\x8d\xc6\x4c\xad\xe8\xdc\x41\xe6\xfc\x34\xd5\x51\xda\x0b\x6d\x60\x18\x02\x49\x2b\xcf\xba\x35\x02\x38\xc6\x5d\x62\x17\xfc\xfd\xed\x3b\x8b\xae\x5d\x1e\x4d\x1a\x36\x48\x6f\xd2\x22\xb5\xa6\x56\x25\xc8\xc2\x3d\xcc\x9a\xc7\x17\x1b\xe4\x73\x48\x19\xca\xc9\x77\x50\x79\xee\x35\xb3\xe3\x92\x17\x3e\x4a\x6d\x4e\xbe\xb1\x24\xef\x54\x53\xbe\xcc\x47\xda\x72\xea\xeb\xcd\x29\x8a\xfb\xc2\x76\x3b\x75\xea\xd8\xa3\xc3\x52\x81\x9b\x31\x47\x79\x8a\xce\xcc\x3b\x46\xe7\x7c\x46\xeb\x91\x60\xdf\xde\x21\xb9\xb7\xb4\xd5\xde\xf7\xbb\xbd\xe8\x91\x88\x17\x2b\x56\xa3\x3a\x9a\xdc\x4f\x99\x1b\x0b\x1c\x62\x68\x91\x6d\x3b\x22\xe3\xe7\x28\x1d\x1f\x5b\x18\x3a\x6f\x7b\x18\xee\x38\x7c\x03\x4f\x1c\x6b\x61\x5b\x9c\xef\xb7\x18\xbd\xb7\x58\xc0\x6f\x0c\x47\x10\xfc\x5b\xec\x88\xc5\x7f\xd5\xe7\x6f\xfa\xd8\x3d\xde\x7f\x6f\x6f\x02\x7f\xfb\x3d\x80\xf9\x43\x75\x78\x5a\xfa\x52\x9a\xf9\xa2\x28\xa8\xa2\x5f\x8d\x18\x06\x0e\x1b\x05\xa4\xc3\xf6\xc4\x64\x30\x7a\xcf\x6e\x1e\x18\x2e\x30\x5f\xea\xad\x55\xde\x1b\x27\xbe\x11\xaf\x68\xa1\xe0\x41\x2f\xf6\x66\x3c\x1e\x0c\x58\xb9\xac\x23\xe8\xb7\x50\x3a\xa0\x39\x64\xc5\x06\x4e\x9a\xe3\x6d\x2d\xe5\xa5\x97\x6f\xc8\xe5\x59\xa6\x24\x4e\xe5\xb6\x85\x35\x35\xbe\x9c\x87\xf9\xc0\xc6\xed\xbe\x52\x18\x9e\x54\x58\xea\x33\x67\xbf\xc0\x84\x67\x0f\x26\x3e\x4b\x3a\xe7\x6b\x72\x1f\xc0\xce\x5b\x03\x17\x96\x6a\x44\xe0\x39\xe3\xaa\x7c\x9d\x0c\x58\xc4\x30\x9a\xd0\xb0\x12\x8e\x70\x8d\xf9\x4e\x99\xcc\x50\x96\x98\x97\xd1\xac\x36\xe4\x46\xaf\x6b\x6c\x06\x4b\x63\x8a\x7c\x77\x39\x53\x13\x79\xe1\x31\x11\x88\x64\xb8\x0a\xe1\x3a\xb4\xb6\xcb\x5e\xb2\xc3\x05\xd5\xa4\xfd\x22\xe4\x77\x7d\x62\xcd\x43\xd9\x75\x15\xe1\xd6\x37\xcf\x6f\xaa\xf8\x78\xbb\xe6\x21\x44\xd5\x73\x7d\xf0\xfa\xd4\x96\xc4\xce\x46\xd8\x6a\x95\x90\xb3\x91\x19\x12\x70\xd8\xd3\x89\xaf\xbc\x67\xa9\xea\x16\x87\x4e\xb4\x2b\xf1\x96\xac\x78\x66\x99\x0c\x85\xa0\xe8\x24\x90\xea\x02\x49\xf2\xd4\x89\xec\x39\x04\x93\x86\xaf\x25\x95\xd0\x64\xed\x0d\x67\x8b\x38\xef\xf7\x16\x8c\x9c\xe4\xbf\x30\x6a\x8c\x51\x3d\x95\x69\x0b\xc0\xa4\x9e\x54\x9e\x48\x84\xa7\xac\xcd\x96\x6c\x0f\x6d\x71\xf5\x98\x9a\xb4\x36\x3e\x5e\x21\x53\x02\x8c\xc9\x52\x51\x84\x89\x08\x52\xa5\xba\x98\xa1\x9e\x69\x48\x4b\x4f\xdb\x2c\xee\xdb\xa5\xff\xb8\xd1\x62\xcf\x06\x57\x14\x56\x82\xa5\xef\xc2\x18\x1a\xe9\x26\x2d\x74\xfb\xb0\xfa\xd5\xeb\xb2\x86\x34\xc4\x68\x54\x37\x41\x94\x05\x77\x6f\x94\x5b\xac\x4e\x87\x2c\x8d\x87\x4e\x04\x62\x5f\x8a\x77\x80\xd8\x47\x4e\x85\xcd\x21\xf6\x67\xac\x92\x7c\x71\x3c\x45\x97\xcc\xd0\xbe\xe0\x99\xd7\xe2\x3d\x57\xe7\x91\x80\xb6\x7a\x24\x77\xeb\x48\xe7\x95\x42\xeb\x5b\xe6\x93\x4f\x93\x17\xad\xad\x37\x77\x64\x40\xc4\xc4\xb2\xa5\x60\xcf\xdf\x9d\x81\x62\x39\x66\xb4\x17\x5e\xb2\x5d\x2d\xc2\xfb\xaa\xe5\x41\xf8\xe0\x33\x91\x03\x7a\x11\x2b\xa7\x97\x86\x4c\xf6\xfb\x56\xfa\x05\x95\x31\x93\x1b\x03\x74\xc9\xa3\xc2\x68\x7a\x3d\x69\x13\x23\x78\xf0\xfd\x69\x76\x6f\x49\xbd\x28\x20\x78\xa8\x64\xde\xc6\x39\x78\x46\xef\xfb\x20\x78\x0a\x64\x08\xe1\xf2\x28\x40\xa9\xe0\x69\x94\x04\x38\x4b\xe1\x39\x10\xae\x2c\xae\x67\x64\x8c\x7f\x82\x1b\xcf\xaa\xee\x96\x0f\xb4\x7f\x33\xfb\x8a\x54\x67\x8a\xf6\x44\x3a\xf7\x19\x24\x33\xa2\x0b\x43\xbd\xa3\x25\x86\x90\x52\xc2\x77\xbf\xf5\x8e\xc6\x5e\xaa\x21\x03\x03\x49\xe4\x42\xef\x94\xee\x3d\xb2\x09\x49\x26\xd7\x11\xa3\x35\xf1\x7c\xa3\xcb\x77\x78\x46\xd3\xce\xd6\xd4\xd1\x1a\xdb\xe6\x10\x9b\xfb\xc8\xcb\x21\xc9\x40\x7f\x46\xa1\xbb\xe9\x51\x2e\x25\xae\xe1\x35\x33\x2d\x84\xdb\xf4\x8e\xf1\xd9\x38\xc1\x02\x53\xcc\xfc\xbb\xda\xe0\x52\x5d\xa1\xb4\xc6\x21\xfa\xe3\xb9\x27\x21\x5e\x06\xe7\x82\x70\x90\x79\xfd\xd6\x77\x5c\x93\xfe\x77\xf6\xdc\xb3\xa9\x7f\x7d\xd1\xef\x97\x2f\x17\xa5\xca\x25\x18\x1c\xe2\x22\x09\x73\x60\x08\x50\x72\x20\xe4\x61\x8b\xb1\x2d\x2a\x90\xdc\x41\xa2\x04\xe0\x6c\xf9\xbe\x34\xdf\x2c\x0e\xd6\xeb\x44\x68\x7c\xeb\xc6\x71\xd2\x99\x3d\xbc\x80\x87\xb5\x40\xcb\xeb\x41\xca\x7f\xf6\x02\x51\x1c\x4a\xaa\xac\x64\xbb\x36\x10\xdb\x05\xf8\xf2\xf1\x25\x23\x2c\xc2\x9f\x38\x86\xef\x18\x96\xbf\x14\x70\x33\x52\x6c\x49\xb5\x69\xc0\x7c\xc8\xd4\xbd\x8b\x85\x44\xa2\xa2\x77\x3d\xa8\x6d\x3f\x80\x5d\xe1\x99\x1f\x70\xd6\xf8\xe3\x1d\x0d\x97\xdb\xf9\xac\x04\x99\xac\x2e\xd6\xa3\xc4\xe2\x11\x2e\xa9\xa4\x94\x05\xf8\xca\xb7\x82\xd2\x8d\x47\x51\xcc\xd7\x24\x5a\xab\x8e\xca\x87\x2a\xcb\x4c\xe1\x46\xde\x52\x35\x70\x83\xf8\x79\x13\x0e\x77\xf2\x7d\x87\xd9\xa3\xdb\x17\x1e\xdb\x89\x3a\x5c\xfb\x41\x32\xa4\x7b\x6a\x81\x9a\x1b\x1a\x1b\xd3\xd0\xcc\x51\x1c\x77\xdd\xc9\x00\xe7\xce\xa9\x20\x34\xab\xf7\x59\x92\x19\x0f\xd2\x84\x03\x96\x18\xa2\x6e\x44\x83\x96\xdf\x21\x68\x25\xbe\x7c\x6b\xf1\x0c\xb9\xac\x99\x2c\xa2\xbc\x40\x2e\x5e\x6b\x9e\xef\xcb\x04\xd4\xd1\xa4\xcb\x15\x40\xe3\x7c\x97\xdf\xdd\x51\xb0\xcb\x5a\x8b\xfa\xc9\xe3\xf4\xd3\x84\x65\xb7\x0b\xc8\x7c\x95\x92\xb7\xab\xe8\x14\x4d\xdc\x69\xbc\x9f\x9f\x5a\xf0\x66\x0c\xbb\xdc\xd4\x92\xf1\x75\x36\xf7\xf5\x1e\x97\xa1\x83\x31\x8a\xc1\x33\xa8\x4a\x9b\x81\x13\x7d\x76\x99\xc5\x0d\xbc\x2e\x33\x77\x52\xce\xec\x86\x7a\xbc\x39\xfa\x92\x47\x45\x4f\x0a\xac\x54\x85\x79\xd0\x4a\x3c\xe7\xc9\x24\x3d\xe8\x88\xcf\xd0\xa0\x4a\x84\xa9\x5c\x77\x4f\x55\xd8\x37\x6e\xcd\x9d\xec\xb0\xf8\x9a\x80\xf7\xaa\x6b\x4a\x1e\xc8\x92\x8c\xcc\x68\xdb\x50\xd6\x47\x1a\xd0\x21\xe9\xf7\xaf\x1b\x30\xf3\x95\x4d\xf4\x55\x6c\x11\x80\xb6\xf9\x84\xb6\xb7\xf3\x16\xdb\x47\xe9\x11\xc0\xcd\x57\x06\x91\x2f\xe5\x4c\xb9\xc8\x1a\x78\x9c\xc6\x0c\x71\x99\x12\x08\x0c\x74\x0f\x1c\xa1\x6c\xcd\x06\xa4\x39\x2d\x6d\xf2\xf3\x88\xaf\x04\x23\x18\x88\x2e\x6f\x3d\xb6\x8c\x12\xa5\xad\x95\x5f\x2d\x78\x22\x0a\xb2\x6a\x2a\x76\x5b\xad\x4e\x2e\x74\x18\xe7\x09\x24\xbc\x9b\x92\x17\xe7\x1d\x71\x4f\xc6\x37\xe7\x48\xef\x7e\x65\x77\x7f\x8b\xc9\xae\x6d\xe9\xc7\x53\xd3\x31\x8f\xd9\x14\x44\x45\xf6\x4d\xd1\xf6\xe8\x69\x78\xcc\x13\xcb\x10\x56\x88\xce\x5b\xb5\xa6\x4d\x4b\x80\xde\x29\x88\xc2\x81\x61\x40\x03\xaa\xd4\x30\x59\x55\x45\x59\xc7\x24\x69\xa5\xc8\xc1\x6b\x21\x27\xc5\x03\xe6\x1a\xb8\xa2\xd4\x87\xed\x0e\x91\x92\x94\xe5\x9e\x44\x8a\xd6\xec\x53\xc7\x4a\xb3\xd7\xf5\x0f\xfb\x55\x8c\x9e\x8c\xbb\x73\x84\xaa\xfc\x70\x9e\xad\xf0\xe6\x87\x7b\x1b\x60\x6a\xaa\x88\x86\x1a\x49\xcf\x0b\xd7\x30\xa5\x74\xb1\x36\x3d\xfd\x78\x8c\xb1\xf2\xb4\x3e\xbe\x80\x44\xe5\xf0\x8b\x9b\x72\x98\x8b\xe2\xdf\x54\x75\x24\xfb\xef\xec\x4f\x1a\x74\x7f\x7d\x31\x02\xac\x9e\xf3\xd7\x67\xa3\xfb\x78\xf3\xcf\xd5\x10\xd5\x07\x54\xdb\x84\x1e\x48\x82\x0e\xb2\x8e\xc8\x96\x24\x19\x77\x42\x35\xd2\x34\xbf\xbc\xc5\x02\x79\xb5\xfc\xfc\xbe\xf1\x54\xe9\x22\xe0\x53\x07\xf4\x86\xb7\x8c\x80\x32\x8c\x2e\x17\xc4\x22\xcd\x8a\x82\x14\x28\x4b\x5f\x20\xbc\xe1\xd3\x36\xc2\x14\x3e\xba\x29\x35\x43\x49\xf1\xee\x9f\x28\x8e\x9e\xe9\x2d\xc2\x60\xec\x0a\x86\xb0\x53\xbd\x73\x17\x65\xea\x71\xe5\x7a\x52\x62\x09\x00\x21\x7c\xa5\x62\x18\x3e\x61\xdc\xa3\x51\xca\x88\xd9\x52\xc9\x87\xee\x37\x20\xbe\x25\xcd\xcd\x08\x83\x2c\x60\x93\x15\x7a\x0e\x4c\xef\x6f\xa7\x94\xc1\x7e\x54\x0d\x94\xc6\xdf\xdb\xd4\x7c\xe6\x66\x24\xc4\x47\x4e\x26\x8f\x0b\xc0\x38\x58\x4b\x0f\xcb\x4a\xe4\x71\x63\xf3\x35\x94\xe2\x03\x07\xf6\x02\xd5\xb3\xfa\x2d\x83\x07\x27\x4e\x12\x1d\x45\xbe\x87\xc9\xb4\x70\x71\x0a\xdf\x9b\x69\x72\xd9\xfc\xfc\x66\xbc\x9e\xed\xe5\x20\x8e\xda\x61\x8c\xa7\xe2\x3d\x34\xbe\x04\x17\xb3\xb7\x25\x30\xdf\xcf\xd4\x6a\xdc\xc9\x9e\xdb\x8e\xd9\x54\x78\x4c\xc6\x07\x52\x32\xc7\x5e\x34\x0f\x80\x3f\x1e\x18\xfd\x0e\x78\x66\x5f\xc5\x37\x5c\x6e\x18\xf5\x7e\xbe\xcb\x54\x35\xec\x98\xae\xd5\x2e\xcf\x6d\x24\x25\x9c\x52\x90\x29\x2d\x6c\x70\xe7\x7e\x08\x83\x04\xb1\x99\x37\xdf\xfc\x66\xf2\xa3\x15\xa9\xf9\xe7\x2b\x44\x97\xfe\x4d\x63\xb7\xdc\x51\x4a\xc1\xd0\x83\xe7\x90\xd0\xd9\xd3\xb6\x3c\xcd\x46\x23\x2b\xda\xcd\x0a\x69\x4d\x0c\xc4\xdf\x16\x8d\xc2\x7e\xde\xd3\xb7\x8b\x8f\x09\xad\xbc\x59\xe4\x89\xc3\xa9\x5f\x9c\x68\xc7\x3b\x08\xa1\xdd\x53\x2e\xae\x0f\xde\x17\x2a\xc1\xaf\x46\x01\xe3\x8e\xbc\x95\x70\xca\x7f\x1f\x37\xa2\xc8\x1e\xfd\xfd\xc6\xeb\x84\x07\x82\x97\x98\x61\x9f\x84\x33\x73\x5c\x2a\xbb\xdc\xd5\xaf\x8a\x28\xb2\x8f\x46\x32\xe8\x12\xc5\xde\x59\xc8\xe9\x34\x1a\x4f\xd0\x66\xa1\x74\x70\x7e\xf2\x04\xb0\x67\x09\xed\xb2\x65\xf8\xca\x9e\x3c\x4c\x5c\x3a\xa5\x7d\x83\x55\xc7\x44\x5a\x6c\x2d\xc0\x51\x67\x06\xe7\x67\x6a\xf9\x5a\xe1\xe7\xd9\x99\x6f\xea\x49\xfa\x22\x6d\x48\x6a\xbd\x71\x8c\x03\x3a\xa2\xc3\x29\xe0\x81\x94\x1d\xa1\xa9\xf7\xa9\x7f\x87\xfb\x63\x7a\xf1\x09\x7d\x5f\x0c\xc1\xe3\x6c\x6a\x77\x4c\xa3\xa6\xe7\x7b\x4a\x3d\xc7\x74\x8d\xe8\x76\x6a\xc4\x4b\x28\x05\xf2\x95\x05\xd3\x83\x70\xe4\xcb\x50\x8a\x98\x1b\xe7\x4c\x65\x1f\xd6\x93\x13\x7b\x32\xad\x4d\x32\x72\x24\xda\x5a\x7b\x9a\xdc\x20\xee\xd4\x07\xdd\xba\xab\x59\xe0\x0f\xb6\x9a\xcf\xac\x6e\x5f\xa2\xa8\x75\x2c\xc7\x7d\xf5\xd9\x81\xde\x3d\xd9\x91\xfa\x1c\x9b\x06\xc9\xab\x08\x84\xb8\x9b\x11\x93\x5a\x8f\xc7\xda\xa0\x15\x05\xdf\xba\x34\x7f\xbc\xaf\x20\x65\x75\x34\xec\xab\x95\x41\x74\x17\x56\x50\xa2\xef\x8e\x6e\xcc\x6b\x9c\x20\x05\xa9\x65\xb3\x57\x6c\xa9\x39\xf4\xaa\x44\xee\xe1\x9a\x29\x9e\x18\xa4\x38\x37\xec\x26\xd3\x62\x33\x16\xf6\x99\x04\x6a\xe5\xad\x6b\xf4\xfe\xb0\x31\x82\xf3\xe2\x1f\xd1\x07\x29\x54\xbf\x29\xa0\xa4\xf5\xfa\x3b\x53\x1c\xf3\xaf\x36\xa6\xfe\xfa\x27\x10\x6c\x2d\x9c\x26\xd9\xb3\xa9\x77\xdc\x07\x14\x9e\xb9\x9d\x69\x65\xc4\xc1\x16\x59\x61\x70\xde\x4d\x2a\xd7\x65\xb1\x46\x00\x21\x10\x44\xd1\x80\x6c\x07\x32\xc5\x4b\x37\x61\x0a\x5a\xd0\x28\x85\x92\x2b\x44\xc1\xcf\x4c\x81\x63\x85\xee\x6d\xd2\xe0\x0c\xfb\x70\xdc\xc6\x18\x78\xf6\x82\x15\x9c\x8b\xdf\x88\xa7\xf6\xf5\x19\x6f\x13\x28\x8c\xae\x53\x3b\x5c\xee\x0f\xdc\x74\xe0\x00\x96\x5c\x65\x4f\xe6\xde\xcc\xa4\x5d\x83\x1b\x08\x81\xe8\x0e\x81\x4d\xfd\x80\x2a\xc0\x33\x8e\x78\x8d\xa7\x07\xd6\x5e\x1c\x00\x57\x37\xd6\x30\x5b\xac\x06\xc2\x38\x43\xe7\x78\x22\x16\x05\xce\x13\x00\xdd\x12\x9a\x7a\xae\x72\x3c\x68\x72\x26\x08\x7d\x18\x40\x6d\xb1\xec\x28\xd4\x64\x00\x40\x0f\xf5\xe4\x99\x19\x5c\x9c\xb3\x8a\x35\xd1\xf9\x82\x69\x11\xbe\x91\x07\x25\x67\x14\xc9\x23\xb9\xca\x32\x2c\x16\xd1\xfd\x79\x59\xfc\x43\xed\x83\x44\xe6\xde\x66\x04\x69\x32\xcc\x87\xad\x0b\xbf\x03\x43\xa7\x34\x26\x73\x05\x48\x99\x0c\x87\x70\x86\x71\x0b\xdf\x78\xc8\xef\x61\xae\x4a\x7d\x21\x02\xe1\xd9\xd3\xea\x08\x55\x8f\xa7\x03\x91\xb3\xf1\x3a\x95\x79\xa0\x99\x41\x4b\x4a\x0f\x40\x4e\x93\x2b\x43\x14\x21\x15\xb0\xd1\x9f\x15\xb2\x4f\x74\x27\x28\xc1\xf2\xde\xdb\xc1\x53\x69\x4c\x6b\x36\x33\xd2\xe0\x7b\x0c\x9a\x26\x90\x10\x4b\x7e\xaf\xc7\xae\x66\x7b\x81\xd4\x5f\xfa\x06\xf5\x83\x18\x0f\x13\x51\x0b\x51\x42\xde\x3f\xbe\x1a\x50\x55\x0c\xc0\xfa\x53\xf5\xa6\x75\xc7\x78\x6f\x0f\xc1\xe3\x0b\x06\x6c\x49\x26\x0a\xb4\xb7\x69\xae\x62\xcb\x6d\x6e\xa5\xbf\x09\x06\x09\x22\x22\x0f\x77\x4d\x11\x7b\x35\xe6\xb7\xa4\x53\xf2\x08\x0f\x21\xf6\x79\x6e\x52\x71\x86\x17\xe0\x68\xb2\xc3\x63\xba\x9c\xdd\x44\x89\xf4\xce\xae\x1b\xf7\xb3\x3c\xe1\xce\x38\xed\xce\xea\x02\x66\xc3\xa5\x43\x7d\xbe\x75\x17\x25\x18\xb4\x1c\x59\xeb\x41\x84\x84\xe7\x38\xaf\x9d\xf2\x41\x29\x80\xcd\xee\x46\x9b\x16\xd1\xd1\x45\x52\xa2\xc7\x66\x1d\x66\x58\x4f\x77\x95\xa1\xbb\x30\x67\x6b\x25\x7a\xef\x23\xbf\xdd\x88\x50\x78\x31\xe5\xeb\x9d\x85\xd8\xf1\x20\xdb\x58\xce\xab\x67\x64\x54\x3c\x1f\xd2\x97\x8c\xc8\x98\xbc\x10\xd9\x7d\x8a\xf3\x91\x1e\x82\xa0\x21\x03\x5a\x92\x92\x91\xe3\x4c\x69\xd3\x04\xbc\x32\x70\xc6\xa7\x82\x17\x81\x2e\x21\x83\x11\xe8\xf4\x14\xd8\x7c\x1a\x77\xbd\xca\xc4\xdc\xef\x02\x00\x7c\xec\x14\xba\x1b\x27\xb4\xde\x60\x52\x2d\xd9\x3a\x1c\x22\xae\x1e\x96\x4b\x5a\xb4\xb1\x85\xaf\xde\x0d\xea\x09\x31\x4e\x7c\xe3\x91\x0a\x30\x8a\x52\x99\x4e\xbd\x20\x02\x51\xef\x1b\x96\x55\x3a\x16\xc5\xb2\xad\x12\x52\x9c\x3b\x8f\x85\x35\x57\xb8\x8a\x81\xf6\xd9\x58\x8f\x67\x42\x3d\x63\xc9\x95\x5b\x0f\x61\xa7\x34\xf1\xf5\x1c\x6b\x1d\x67\x06\x01\x42\xf7\x88\x29\x45\xb3\x9b\xb9\x5e\x69\xaf\xff\xb6\x37\x4b\xb3\xe2\x77\x76\xaf\xa3\x71\xbe\xb1\x25\x3f\xa9\x0e\x60\x1e\xfb\x9a\x18\x6a\x19\xac\x83\x62\x43\xc0\x9d\x30\xc9\x83\xb9\x52\x9f\xe5\x9c\xd6\x5c\xcb\x7b\x79\xcb\xdc\xfd\xf5\x16\x07\xcc\x19\xc3\x8b\xcd\xb1\xfc\x0e\x46\xe6\x2b\x57\x0c\xbe\x2b\x99\x85\xa0\x1c\x52\x6a\xf8\x87\x29\xea\x87\x98\xbc\x8d\xfd\x3c\x57\x3c\x3e\x7c\xad\xa0\xe6\x17\x5a\x2b\xc9\xf3\x89\xc2\x67\x9b\xe2\x28\xbc\x47\x11\x92\x9f\x33\x7d\xfa\xf7\x1b\xf5\x48\x75\x85\x27\xd2\x18\x01\x14\x92\xe5\x6e\xfd\x23\x07\x5a\x44\x25\x12\xa2\xe3\x5e\x56\x57\x00\x89\xda\xdc\x08\x14\x94\x25\x23\x90\xf3\x47\xd0\x74\xf3\x01\xad\x4e\xef\xc7\xdd\xfc\x7a\x47\x91\xc2\x48\x97\xe9\xf1\xf7\x00\x0a\x27\xda\x8c\x47\x62\x48\xb4\x15\x68\x64\x05\x9d\xcd\x58\xd9\x3c\xd2\x1b\x8e\x75\xc3\xcf\x38\xb7\x5b\x40\x46\x71\x0d\x51\xb9\x99\x7b\x0e\xb5\x4f\x75\x3c\x84\x22\x00\x1d\x53\xb1\x4e\x52\xfb\xe0\x2f\xb7\x25\x1b\x5a\xb7\x73\xf1\x69\x7b\xa1\x49\xde\x43\xaf\x6e\xce\x84\xee\x11\x72\xba\xcb\xcd\x81\xf9\xbc\x5d\xe6\xc7\xc1\x03\x70\x41\xcd\xdb\x3d\x4b\x09\x92\x29\xae\x29\x8b\xed\xac\xc1\x9f\x3c\xbd\xd9\x7a\x45\x5b\x4f\xf1\xdd\x69\xda\x70\x23\x3b\xe5\xa2\x32\xaf\x61\x2d\xbc\x1c\x8f\xf6\x7e\xcb\x2b\x62\xc6\xf2\x84\xdc\x9f\x71\x1e\x9a\x1b\x41\x35\x16\x61\x6f\x56\x16\xd7\xff\x9b\xbd\xf7\xd8\x95\x55\xcb\xb6\x45\x3f\x88\x02\xde\x15\xf1\x10\x78\x02\x5f\xc3\x13\x78\x08\xfc\xd7\x3f\xe6\x36\x6b\xef\x4c\xe5\x31\x4f\x37\xaf\x94\x85\xbb\xb4\x90\x66\x04\xd0\x47\x1f\xdd\xb6\x86\x19\x11\x83\x9c\xaf\xbe\x12\x6d\x50\x60\x89\xa7\xdc\x4f\x1b\x7f\xac\xb7\x43\x49\xe7\x27\xa5\xb2\x5a\xa6\xf1\x3e\x76\xcf\xea\x41\x47\x87\x4c\x02\x95\xa8\x7f\x8f\x39\x1b\x5b\xc5\x49\x1b\xdd\x44\x64\x9e\x1b\x62\xd8\xdf\x99\x65\x6a\x84\x7e\x50\xe3\x8c\x02\xb2\xf8\xf5\x8a\xa4\xaf\xf6\xd5\x3f\x31\xc5\x54\x87\x30\x27\x11\xa1\xa7\x84\x63\x3b\x28\xfa\x12\x6b\x49\xe0\xee\xa7\x26\x96\x8c\x18\x1a\x1b\xbd\x03\x8e\x6f\x38\x71\xa0\xe8\x1b\xff\x32\xb6\x07\xca\x24\xfc\x95\x79\xa2\x54\x73\x66\xb5\x6e\xcc\x2a\xfa\x3c\x43\xa1\x12\xce\x50\xee\x22\xf2\x07\x20\xe2\xb6\xce\x9c\x8c\xfa\x7a\x9d\x37\x72\x3e\xa5\xd4\xb1\x5e\x52\xda\x03\x0b\x77\x35\xe3\x1a\x17\xab\x8c\xf1\xd2\x9d\xbc\xdf\x05\xcf\xa0\x35\x16\x77\x98\x03\xbd\x4c\x21\x0e\xdc\x49\xb9\x45\x6c\x00\x7b\x9a\xc6\x53\x23\x9f\xe3\x83\x25\x7a\xa8\x12\xcb\x15\x65\x5a\x62\xbf\x01\x35\x0a\x0e\xf1\xfb\x24\xba\x72\x86\xca\x2a\x64\x0f\x06\x61\x1c\x5d\x58\xd5\xdc\xaf\xe2\x14\x28\x0f\x06\x92\x10\x0e\xa9\x43\x1d\x8a\xdd\xd8\x70\xb2\x77\x09\xa5\xf0\xd4\x87\x1f\xf2\xe1\x88\x8d\x02\x68\x65\x32\xf4\x78\xe9\xd8\x5f\x95\x62\x95\x53\xcd\x16\x95\xfc\x80\x9b\x52\x59\x80\xfb\x28\xde\x39\xc2\x2c\x1b\x78\x79\x82\xfb\xe5\x61\x9b\xcc\x0a\x84\x67\x62\x15\x1f\xcc\x2d\xf8\x12\x13\x6d\xd3\xcf\x2c\x2c\xc4\x96\x70\xb7\x17\x0d\xf9\x90\x6c\xb6\xf0\x67\x20\x5f\x14\xc3\x34\x7d\xd5\x5a\x65\xe3\x01\x9f\xef\xbf\xb7\x38\xd4\xff\x59\xa5\x21\x75\xfe\x7a\x58\xb3\x85\xb5\x03\x62\xec\xa1\x84\x8e\x51\x85\xd6\xd5\x9f\x27\xa8\x3e\x66\x9f\xeb\x00\x8f\x15\x1c\x97\xc6\x44\xf2\x24\x29\xae\xc4\xbc\x1b\x26\x69\xde\x85\x31\x91\x0a\xd2\x48\x5e\x9d\x96\x8d\x1c\xcd\x98\xf9\x35\x4f\xac\x58\x33\xd3\x4d\xfb\xc2\x86\xd4\x85\x59\xc0\xbf\x62\x29\x2d\x2e\xe0\x24\x6d\x4f\xb0\x2b\x65\x76\x21\xd2\xd2\xf0\x40\xad\x65\x0d\x97\x31\xe2\x05\x20\xc8\x8c\x20\xfb\x37\x98\xd2\xe0\xc8\x07\x07\x1b\x5f\x14\xa4\x19\x9f\x1b\xc9\x6e\xc9\x6b\x04\xa3\xde\x1b\x2c\x5b\x15\x01\x0b\x96\x86\x0a\x7b\xcb\xeb\x54\x8d\x1e\xb8\x28\x33\xc5\x5b\xa0\x1b\xd8\x54\xdf\x2e\x97\x37\xf9\xb2\x7e\xb4\x7c\x21\xd5\x87\xa0\xdf\xc2\x7e\x04\x44\xbb\x70\x34\x22\x10\x2d\xa6\xe6\x74\xfa\x5a\x71\xf2\xe5\xe3\xbb\xa8\x22\xea\x13\x30\x8e\x0f\x38\x8c\xde\xde\x67\xd5\x45\x0a\xec\x3c\x29\x80\xe4\x01\xbc\x4c\x81\xd3\xd8\x23\xee\x4b\x9f\x2c\x52\xc6\x25\xf0\xc7\x51\xdc\x6d\x9f\xe3\x98\x46\xa2\xcc\xfe\x80\xf5\x4a\xa2\x18\xfd\xe1\x26\xac\xc7\xbf\x07\xae\x6c\x41\x45\xe2\xe4\xa3\x44\x79\x8e\x07\x49\xaf\x36\x35\x76\x11\x5b\x7e\x2b\x26\xb0\xe6\xbd\xf7\x9b\xdb\xe1\x4f\x34\x62\x8b\x52\xce\xd2\xd1\x7b\x6f\xe3\xd5\x94\x51\xca\x66\xb6\x5a\xc7\x07\x94\xb0\x3a\x83\xb1\x18\xa5\x1d\x6c\xcd\xae\x60\x66\x1a\x14\x24\xf7\x3a\x6d\xd8\x44\x19\x56\x60\xd0\xb5\x1b\xe6\x1e\xbd\x11\xf0\x77\x62\xcf\xb7\x2c\x01\x90\xf5\x56\xc7\x1c\xcf\x64\xdc\xaf\x11\xe7\xc3\xd4\x91\x9a\x51\x7c\xe7\xc3\x9e\xf5\xbe\x36\x51\x97\xae\xca\xef\xf4\x0a\x06\x2d\x82\xe5\x08\x7e\x0e\x6d\x95\x6a\x8c\x98\x0f\x9f\x40\x81\x4b\x04\xf6\x51\xec\xd0\xc3\x48\x4c\x3e\x97\x7f\x40\xcb\xeb\xba\xb5\xea\x8b\xfa\x7a\x7b\x9a\x8d\xf4\xf3\x2b\x01\x3e\x14\x06\x5c\x1d\x43\x99\x82\x7c\xc0\xfc\xde\xc3\xbc\x74\x73\xc8\x2e\x69\x98\xd5\xad\xa5\xbe\x8c\xbb\x52\x4b\xb3\x3f\x93\xe0\x9a\xe6\x84\x0f\xe5\xdf\x96\x25\x5c\x39\x8d\x0a\xcd\x52\xc4\xc8\x7f\xf3\x9a\x63\xf5\x7f\xda\xfb\x45\x45\xfd\xf7\x17\xf6\xf6\x9f\x97\xfe\x65\x15\x0c\xd5\x12\xbe\xe2\x5c\x78\xfb\x0e\x1b\x36\xce\xdb\x11\x67\xdd\xf6\x59\x06\x57\x32\x5c\x41\xf9\xe1\x10\xc0\x6a\x1a\xb4\xe1\x92\x50\xad\x0a\xab\xee\xc4\x3c\x9e\xd7\xe2\x37\xe4\x04\x2d\xaf\x7d\x9c\xf3\xbd\x7d\x5b\x7a\x23\x1e\xc8\x5a\xf4\x69\xaf\x55\x41\x9c\x86\x24\x80\xa4\x43\xdc\xd1\x0f\xfc\x85\xaa\x71\x44\x53\xb4\x2c\x4d\x70\xc8\xf2\xfd\x2a\x1b\xb9\x28\x89\x19\xe1\xbb\xfd\x4b\xd9\x48\xbb\xde\x0b\x4c\x00\x6f\x8d\x80\x9a\xba\x8e\x09\x3a\x7d\x10\x1f\xc5\x75\x1a\x47\x1e\x74\x98\xab\x0f\x03\xa4\xca\x4d\x87\x16\x38\x58\x5e\x12\x56\x85\x4d\x35\x7b\x30\x0f\xe9\x52\x86\xb4\xac\x7b\xad\x1d\x40\x26\x74\xd8\x07\x59\x07\x2b\x2c\x58\xc7\xaa\x50\x67\xd9\x8c\x27\xaf\x17\x58\xa0\xa9\xbb\x91\x41\x05\x73\xfe\x48\x2e\x86\xd6\x39\xb5\x57\x63\xa3\x3e\x84\x23\x3f\x5e\xf5\x28\x4b\x57\xd0\x7b\x05\x60\x7b\xa9\xa0\x29\x09\x19\x50\x5c\xc3\x3c\xc0\xf1\xce\xef\x22\x6b\xb9\x43\x34\x20\x82\x71\x99\x81\xb2\xfb\x9f\x57\x04\xa4\x4e\xdf\x8e\x60\xcb\xa6\x91\x93\x8d\x02\x6b\xd8\x96\xd1\x8b\xa1\x89\x76\xc7\x72\xaf\x0f\x8e\xbe\xf6\xed\x08\x29\x66\xea\xba\x82\x29\xd8\xcc\x11\x17\x99\x58\x42\xe5\xd4\x3e\x3d\xad\x80\xd3\x07\xb5\x19\xd8\xa9\xd5\x24\x3a\xf3\xa6\x35\x28\xe5\x48\x3a\x4e\x57\x8e\xe0\x4b\x77\x8d\xde\xd6\xd0\x71\xb7\x00\xad\x93\xc7\x53\xa0\x38\x54\x8b\x8d\xf0\xdc\x0b\xa4\x06\x32\x54\xde\x68\x58\x2e\xd4\x9e\x07\x89\x5b\x36\xb5\xe1\xa0\xd0\x4f\xc7\xc2\x4f\xc0\x73\xc5\xec\xa1\x36\x05\x6b\x1c\x68\x62\x70\xfa\x80\xff\x13\x31\x90\x97\x6f\x21\xcb\xc7\xa1\x0a\xd7\x3b\xfb\x0f\xe4\xde\xec\xb1\xa8\xb1\xfe\x9d\xf5\xf1\xa6\xf4\x2f\x5f\x83\x7c\xb0\x05\xb5\xc7\xaa\x9e\xa3\xa9\xa7\xad\x14\xa6\x1e\x8c\xd3\x1a\x59\xc3\x1b\xce\x00\xc5\x88\xd9\xf6\x50\xde\x63\x6f\xbe\xbf\x3d\x1b\xbf\x49\x16\x40\x03\xf3\x8e\xf7\x45\x95\xee\x56\x67\x3e\xd2\x6c\x5c\x64\x05\x68\xbd\xd3\x3a\x65\xd1\xb2\x03\x63\x5c\x08\x4c\xa0\x56\x1c\x2e\xef\x74\xb1\x89\x3d\xab\x61\x3a\xbc\xa9\xd2\xe2\xe5\x4b\x43\x69\xfb\x6e\x65\x56\x2d\xf2\xb0\x29\x37\xe0\x42\x9f\xe0\xf5\x50\x52\x31\x1e\x1a\x3c\xfe\x7b\xd7\xec\xfa\x6d\x09\xfa\xff\x2c\x04\xfb\xb7\xd5\x6c\xdb\x3f\x10\x6c\x1f\x11\x10\x9e\x00\x4f\xbc\xbb\xac\xa1\x3a\x3c\x33\x19\x4b\xa0\x38\x0c\x36\x08\xac\x17\xe7\x3d\x57\x3d\x5d\x04\xd3\x55\xbc\x04\x44\x23\xdf\xd0\x54\xdc\xe1\x17\x2c\x84\x26\xcf\x39\xf0\xeb\xf5\xd6\xdd\x63\x9a\x3d\x8b\x30\x3e\xdc\x54\xc0\x50\xfc\x90\xa6\xba\xc1\xf5\x1e\xfd\xbc\xaf\xb4\x43\xc9\x66\x28\x81\x0c\x47\x9b\x9e\x4f\x8d\xe9\x43\x17\x29\x40\x88\x6b\xf6\xf9\x16\x09\x0d\xd1\x69\x60\xdc\x46\x4a\xe4\xc7\xfd\x0e\xd5\xb6\x56\x56\x70\x81\x31\x00\x87\xf1\x99\x9d\xfb\x07\x3f\xc9\xd3\xac\x26\x55\x8d\x3f\xbc\x73\x9c\xd2\x6e\x52\xd2\x02\x68\x07\x7e\x12\x99\xda\x4a\x52\x84\x3f\x20\x57\xdd\x1d\x95\xec\x7a\xc3\xe4\xef\xc2\x62\xab\xb8\x53\xe9\xd1\x0b\xde\x53\xef\x1b\xf9\xa4\x78\x94\x0b\x34\x89\xfe\xbd\xf4\x84\xc7\x74\x3e\x8b\xfd\x04\x30\xea\x97\x6e\x40\xd0\x01\x0d\x84\x8f\x0c\x06\x7a\xd9\x55\x2e\x07\x71\xf2\x04\xd1\x1d\x59\xee\xd8\x2b\xcc\xea\x42\xae\xec\xaf\x96\xf4\x00\xa3\x14\x64\xd3\xe6\xd0\xc4\x76\x1f\x10\x65\x5d\x23\x29\x9a\x3a\xf5\xe2\xef\xaf\x5c\x93\x55\xde\x5f\x4c\x50\xd6\xf8\xed\xcf\x90\xb4\xd5\x15\xe6\x30\xb2\xe8\xd5\x7d\xb9\xcf\x68\x03\xa3\x58\x12\x8e\x54\x9a\x03\x8a\x3b\xe8\xa2\x69\x8e\xbb\x87\x37\xa9\xe4\x8c\xf1\x61\x9c\xe2\x00\x67\xe7\xc6\x28\x19\x1f\x80\xdf\x98\x6f\x18\x30\xc3\x36\x80\x35\x4e\xf3\x75\x9d\x73\x52\x0c\x93\x82\xde\x14\x55\xe9\xa5\x35\xd0\xf2\xfe\x80\x65\x3b\x7e\x13\x4e\x72\x3f\x2d\xb9\x11\xce\x5d\xfc\x50\xae\x94\xf3\xef\x0b\x0c\x20\xa1\x2f\x31\xed\xd9\x49\x7d\x77\x18\x66\xfb\xf2\x7e\x1f\xad\x95\xf5\x24\xf6\x1a\x69\xe1\x03\x49\x71\xd5\xb7\x07\x65\x73\x66\x32\x52\x66\x98\x25\x37\x88\xef\xef\x78\xb0\xf2\x61\x42\x99\x69\x66\x59\xad\x0d\x5e\x02\xc4\x7d\x48\xe6\x7d\xa7\x4a\xcd\x69\x9a\x0d\x0b\xd0\xc7\x6c\x59\xbe\xa6\x8d\xc9\xab\x3e\x5e\xd1\x8c\xd4\x68\x6c\xaf\xe3\x1a\xa0\xc8\x62\xd8\x75\xc0\x61\x2f\x12\xc5\xcf\x2e\xa1\x17\x2b\x71\x46\xdc\x6e\xfc\x9a\x81\x7a\x00\xc4\xb6\x82\x9a\x4b\x34\xb1\x09\xe3\x70\xea\x56\xb6\x28\x8b\x87\xca\x27\xb8\xea\x8f\x86\x9b\xfc\x44\x01\x62\x29\xca\x32\xa0\x7e\xd4\xf7\x1c\xa8\x9e\x46\x95\x1c\x68\xdc\x83\xd4\x59\x6c\x7f\x03\x08\x96\xc7\x8d\x3a\xf0\x10\xf7\xe2\x33\x7d\xbc\x02\xe8\x3d\x87\x9b\xbe\xca\xac\x78\x90\x4b\xad\x30\x3c\x2c\x2c\x86\x67\x24\xe5\xa9\xb2\x46\xb1\x27\xd3\xb1\x11\xb8\x31\x09\x36\x26\xce\xa1\xc2\x00\x8e\x9e\x13\xfe\xc1\x5a\x68\x66\xbd\x05\x27\x25\x2a\x4d\x11\x95\x99\x80\x01\x6f\x96\x82\x44\xcc\xb3\xc5\x5f\xc1\xae\x67\xb6\xa0\x61\x3e\x48\xf2\xd1\xc5\xa3\x37\xa3\x4d\x79\x81\x06\xa1\x23\xd4\x7e\x28\x3a\xa3\x8f\x06\x7f\x55\xd8\x92\x79\xf4\xbf\xb5\x2a\x7c\xb2\xff\xb0\xbb\x6d\xb5\xf3\xb7\xa2\x20\xfe\x7a\xd5\x37\x5f\x12\x32\xd5\x9e\x94\x45\x98\xd3\x16\xd5\xa4\x6b\x5f\xcb\x58\x2b\xdc\x27\x6a\x43\x2b\xa9\xc2\xe5\xc1\xb0\x7c\x89\xa9\x18\x25\xf1\xec\xdd\xdb\x85\xa5\xe9\xb5\x67\xf2\xc6\x69\xe3\xd9\x47\x68\x5f\xfe\x83\x63\x5f\x52\xaf\x07\x0b\xc7\xb8\x4e\x9a\x23\x45\x36\xbe\xf1\x0d\x4d\xc6\xbd\x2f\xee\xef\xbe\xd1\xbf\x3d\x9d\x6c\x7d\xd4\xae\x56\xb0\x50\x23\x33\x64\xc1\x23\xed\x69\x20\x8a\xa0\x09\x55\xad\x31\xe8\x06\x6e\xf9\xd9\xcb\x83\xd5\x97\x52\x00\x06\xdb\x0e\x68\xd3\xf1\xa0\xdb\xc2\x89\xd8\x93\xb4\x8a\x40\x85\x0e\x33\x8a\xea\x2c\x3c\xaf\xac\x95\x7c\xb8\xcc\xd3\x21\xdd\xf4\x6f\x98\xb5\xdd\x6c\x39\x2f\xfb\x14\x75\xf5\xc6\x9c\x93\xe7\x56\xdf\xc4\xbe\xe8\xd9\x39\x4d\x30\xb2\x90\x18\x35\x9d\x6d\x09\x02\xfb\x29\xb8\xfa\x50\x39\x47\xd2\x4f\x7c\xd3\xd6\x44\xfd\xc2\x16\x5e\x7c\x28\x45\xed\xa4\x04\x7c\xe6\xfa\xc0\xbc\xb5\x73\x05\xbe\xfb\x0e\x07\xd8\xeb\x61\xcd\xbe\xb2\xf9\x7c\xab\xc4\xde\x65\x53\x82\x59\xc2\xc4\x8d\xa3\x77\x29\xf3\x17\xd7\x89\x5a\x82\x47\x84\xf3\x8e\x99\x81\x68\x38\x13\xb3\x48\x2b\xc1\x6f\x73\x67\x21\x89\x0b\x1f\x5b\x19\x1b\x85\x26\x9f\x8e\xea\x46\x0e\xd6\x1c\x2e\x5b\x02\x0b\x29\x31\x47\x0d\x31\x27\xf7\xd1\x88\x97\xee\x82\x10\xb6\xdb\x24\xbd\x51\x89\x82\x98\x20\xf9\xba\x2b\x82\x1e\x09\x69\xa4\x8a\xe3\x61\x97\x49\xbd\xb2\x13\xc3\xd7\xb2\xaa\x0d\x2a\x58\xf8\x69\xab\xe7\xab\x63\x06\xca\x4e\x41\x86\x8d\x7c\xad\x7a\xc8\x18\x5a\x5e\x8f\x01\xa0\xeb\x58\x82\x96\x88\xe0\x6f\xf1\x78\x3d\xb8\x24\xaf\xbf\x50\xfc\x5d\xbd\x8b\x5a\xf2\xed\xb0\xde\xe0\x91\x83\x45\xdd\x7f\xd0\xf6\x98\xb1\xdb\x95\x3f\x81\x57\x85\x13\x20\x6c\x1a\x25\xee\xc6\x2e\x76\xb8\x33\x60\x27\xf3\xa6\x88\xcf\xa5\x20\x6d\x84\x0e\x90\xbe\x63\x14\x7b\x2d\xa6\xdd\x27\x0f\x7a\x7a\x4c\x04\xa9\x59\x83\x7a\xf8\x67\x63\x10\xfa\x64\x8a\x4e\xe5\x3c\x79\x11\x94\xe4\xba\xbb\x35\xa9\x24\x44\xc0\x94\xa3\x15\x13\xbb\x7a\x73\xc4\xc3\x0f\x20\xfa\x63\x40\x39\xa5\xac\xf8\x66\xf8\xf8\x20\xe1\xdf\xea\x95\xab\x11\x07\x49\x54\x30\xba\x66\xbc\xb3\xca\x5b\x34\x04\xf6\x1c\xbe\xdb\xcd\xa3\x52\x88\xed\x25\xff\xfd\xde\xf7\xcc\x6c\x50\xd3\xbe\x6c\xbe\xc8\x39\x29\x65\x09\x26\xa8\x70\x6c\x8a\x52\x6b\x04\x0c\xc9\xb7\x72\xf7\xe7\xdd\xfc\xd9\x9e\x49\xc8\xba\x56\x45\xfb\x37\xbf\x9b\xff\xf9\x8e\xff\x59\x09\xdb\xfc\x75\x7b\xbc\xfd\xae\x8b\x07\xfd\xdc\xfd\x02\x5b\x7c\x4e\x5f\x77\x6e\x45\x93\xd4\x4e\x5f\xef\x35\x32\xc8\x0c\x8f\x5a\xe3\xe6\x1f\xe9\xa3\x70\x78\xb6\x85\x72\x77\xee\x34\x2b\x98\xcb\x7b\x75\x77\x76\xb0\x77\x67\x79\x33\xca\xb7\x68\xb8\xa5\x9b\x9f\x73\x9c\x19\xa9\xb9\x97\x61\x03\xae\xa1\xf5\xf8\x96\x81\x27\xaa\xf7\xf7\xab\x46\xb1\x9e\x37\x86\x7d\x1f\x8c\xdb\x14\x30\x49\x32\x8f\x93\x02\x56\x3a\xb4\xdb\x72\x80\x7c\x01\x8a\x70\x90\x8c\x81\x56\xee\x81\x7c\x37\xeb\x35\x47\x2d\xfa\x39\xf7\x30\xe2\x9a\x1c\x82\x44\x8f\x56\xc3\xeb\x43\x50\xcc\x56\x70\xef\x0c\x12\xa4\x5e\x78\xe2\x38\xd5\x75\xf7\xd4\x79\x4f\x0e\xdf\x48\x02\x92\xf8\x32\xe6\xbb\x73\x3e\x8c\x5e\x54\x81\x61\x2b\xd4\xfd\x05\x70\x27\xca\xd7\x0c\xbe\x3e\x20\x58\x1c\xbb\xbd\xc2\xf5\x13\x15\x4f\xfa\xdd\xcb\xd0\x4b\xa9\x48\x3a\x1e\xd1\x06\x93\x18\x5e\x57\xc6\xf6\xe5\xaa\x6b\x93\x96\x8c\x1a\x7a\x58\x04\x22\xc6\xfe\x2e\x2f\x99\xc4\x5d\xd1\x3c\x13\x9a\xeb\x17\x28\x1c\x3a\xeb\x27\xaa\x0b\xd3\x26\x0e\x43\xe7\xbf\xa3\x18\x12\x4f\x55\x9f\xed\xce\xf0\x61\xf5\x6b\x6e\xc6\xf1\x06\x19\x49\x6c\x04\x4f\xe1\xeb\x7e\xc0\x01\xf0\x41\xda\x30\x01\xd2\x9f\x8a\x1d\x0f\xe6\x16\x55\xe5\x7b\x58\xaf\x85\xd0\x9b\xee\x14\x2d\xf4\xeb\x43\x58\x95\x58\xa4\x73\xeb\x56\x41\x9c\x7b\xa0\xc3\x95\xe1\xb1\x3a\xe2\xa9\x51\xc7\x26\x1b\x6a\x57\xb5\x34\xd5\x15\x42\xbf\xd0\x8a\xef\x34\xed\xd3\x05\x46\xce\xa3\x55\xbb\x2b\x0a\xef\xd4\x3c\x7d\x49\x05\xd2\x73\x3e\xe4\x63\xb2\x18\x6e\xdc\xe9\xe2\x0f\x13\xa1\xf8\x1b\xfd\x2a\x2d\xf3\x85\x50\x31\xe9\xf8\x93\xd5\xed\xf7\x4a\xb3\x0e\xab\xd7\xc6\x90\x6d\x26\x99\x5f\x1e\x42\x65\xf4\x3a\x94\x14\x07\xda\x50\xbc\x3a\x98\xa4\xf4\x85\xfd\x6e\x28\xc6\xd2\x1c\x99\x24\x11\x36\xc8\xaa\x8f\x68\xf2\x65\xc9\xed\xe0\x4a\xc9\xf4\x6a\x42\x3b\xea\x34\x3e\xe9\xcf\x6d\x51\x84\x5a\x77\xf3\xe5\x1e\x35\x0d\x45\x6d\x61\xdd\x61\xaf\x43\xf9\x2e\xd1\xd9\x6b\x11\xf8\x4c\x22\xca\x3a\x65\x97\x04\xba\x89\x4b\xc3\x5a\xde\x1c\x8f\x82\x76\xa5\x5d\x45\xe0\x32\xbc\x4f\x1c\x01\xcd\x2c\x97\x92\x71\x2d\x0b\x6d\x30\x33\x83\x23\x67\x6d\x7d\xb7\x95\xa3\x48\x22\xbb\x52\x17\x89\xc6\x2f\xd8\xc1\x69\x8a\x39\x2f\xd1\xe0\x69\x0c\x8c\x59\xef\x71\x9e\xde\x2f\x81\xf1\x3e\x5d\xbc\xf0\x24\xf9\xde\x51\x4d\x05\x32\x05\x02\xd8\x82\xca\x98\x34\xed\x19\x73\x19\x53\x28\xa9\x56\x1e\xa3\x61\x2d\x2a\xdb\x71\xde\x87\x2b\xd6\xbe\x81\xd3\x32\x60\xd5\x1e\x0c\xab\x61\x6f\x54\x23\xa9\x30\xba\xfe\xad\x1d\xb9\x49\xf6\xe4\x3f\x2c\xc3\xff\x5c\xce\x66\xa2\x7e\x1e\x80\xf9\x61\xc7\x83\xf1\x31\x85\xab\x98\xec\xab\xb6\xc7\xd4\x61\xdf\x9d\xaf\x8c\x0e\xc7\x0a\x96\xcb\x34\xf6\xf7\x2d\x0c\x85\x00\x3c\xf4\x50\xd4\x7f\x32\x5d\x33\x46\x3b\xdf\x14\x46\x40\xe7\x71\x52\x8d\x17\xa3\x28\xd3\xc4\x20\xcd\x62\x3a\x34\x9a\x24\x04\x68\x50\x57\xbc\x79\xe7\x75\xb6\x4b\xb8\x3c\x84\xb8\xbf\xb3\x3b\x75\x6d\x87\xad\x64\x8e\x42\x87\x06\xcd\x12\x84\x7a\x62\x95\x18\x4e\xa2\xdc\x87\x7b\x31\x8c\x25\x9f\xb0\xf1\x93\x5e\x5e\x5f\x5c\x74\x69\xb1\xc5\x2d\x54\x81\x2f\x6e\x91\x96\x5f\x7b\xf7\x40\x65\x3d\xc2\xca\x0e\x4e\x9c\x37\x40\x4f\xb3\x85\x0b\x1d\xd3\xf6\xd9\xd0\x0e\xce\xb1\x99\x80\x71\x3d\x65\x1f\x14\xdc\xa8\xbc\x1a\x3a\x58\xa6\x78\xe8\xa7\x73\x40\x40\xb3\x85\xdf\xc7\x00\x4a\xfb\x3a\xc8\x78\x8b\xb9\xda\x56\x60\xad\x51\x9e\xa3\x38\x4e\x8b\x63\xef\xb0\x7b\x9b\xe9\x13\x21\xca\x86\x02\x31\x4f\x8e\x3c\x28\xf7\x64\xb8\x9d\xf7\xec\x78\x06\xbf\xe6\xed\xa6\x7d\xdc\x3e\xc0\x57\xf1\xcc\x0a\x36\xec\x86\xb0\xb2\x5d\xc6\x74\xde\xfa\x8a\x8c\x52\x71\x7b\xaf\xd7\x6b\x51\xe9\x5c\x0c\x72\x41\x26\x95\x16\xcb\x59\x84\xd6\x3e\xc2\xbb\xf0\x84\x57\x98\x93\x60\xce\xb8\xa8\x06\xc0\x9f\xbd\xe3\xe4\x85\x32\x84\x7b\x5b\x43\x97\xdd\xd3\x97\x65\xbd\x73\x13\x64\x64\xfc\xb8\xbe\x0f\x46\xd0\x41\x58\xbe\x39\xe9\x41\x8d\xf7\xcf\xc3\xab\x1b\x52\x70\x5b\x4e\xc4\xc8\xab\x2d\x10\xfd\x70\xeb\xc9\x0b\x35\xc2\x18\x90\x98\xf1\x45\xb7\x33\x0e\x12\x4a\x55\xe8\xe3\x0c\x12\x33\xd3\xf3\x21\xaf\x66\xef\x3a\xd3\xf7\x84\x8d\xaf\x7c\xa9\x8e\x3f\x18\xd6\x30\xea\xa3\x4a\xb7\x19\xc2\x36\x29\x8f\xed\x2d\xfe\x92\x7c\xdc\x68\xd9\x51\xb0\x74\x4c\x14\x07\x6e\x2d\x5e\x62\x60\xbe\xb1\x61\x95\x85\x72\xaa\xed\x4b\x4d\x44\x37\x10\xb5\x47\x36\xc6\xbc\x10\x22\x16\x4f\x30\x16\x84\x0f\x1a\x5d\x11\x64\xc2\x7c\xbf\x60\x82\xea\x7a\x32\x43\xd5\xde\xf0\x26\xbe\x9f\x9f\xf7\x5f\x64\x2d\x1a\x2b\x20\x45\x28\x87\x73\x36\x23\x74\xc0\x8f\xed\x4d\x96\x27\xd8\x80\xe1\xd7\x2b\xa1\x8d\xf9\xd0\x9c\x80\x14\x46\x15\xff\x16\x9d\x61\xa6\x49\x21\x47\xa6\xf0\xdd\x85\xb5\xf7\x2e\xa4\xfa\x8c\x32\xfc\xab\x76\x46\xa8\xe3\xbc\xa4\x39\x88\xab\x1f\x65\xec\x5b\xcc\xcf\x0b\x3c\xab\x64\x04\x17\x5c\x0a\xb1\xa7\x16\x23\x36\x67\xd8\xf5\x55\x00\x91\xf2\xdb\xaf\xa6\xbc\xca\x28\xe3\x69\x3b\xaa\x58\x90\x37\x2c\x7d\x5a\x03\xf6\x81\x50\x4f\x8b\xa7\x88\xb3\xe7\x75\x97\x1d\x41\x2b\xc8\x21\x8d\x25\xfc\xb8\xcc\x85\xf4\xe5\xfe\xbe\x8a\x2e\x83\xcb\xca\xdd\xed\x74\xbe\xfe\x7b\x1f\x62\x69\xa6\xea\x3f\xf5\x29\xb7\xaf\x47\x75\xdb\x6f\xcb\xd9\x7d\xb1\xbc\xd7\xa7\x36\x38\x9d\x71\x92\x5e\x86\x91\x7e\x8e\xba\x85\x57\x65\x4d\xa5\x35\x94\x17\xa8\xce\x8e\x7e\xaf\xcc\x3c\xe8\x72\x82\xdd\xb0\x6c\x0c\xb7\x7e\x75\x04\x01\x8d\xd5\x8d\x12\x2b\xb3\xe3\xe0\x56\x19\xc3\x95\x43\x1b\xab\xcc\x33\x90\xb2\x3c\x65\xba\x5b\x56\x27\x8f\x12\x28\xc1\xfa\xbb\x7e\x11\x2c\xca\xee\xad\x58\x24\x2f\x83\xfa\x91\xed\x22\xf7\x99\x08\xf0\xa1\xbe\x69\x1e\xae\xcc\xdd\x28\xae\xb3\xce\x28\xdd\x2e\x24\xfa\x05\xa5\x85\xe6\x5d\x2f\xb2\xcc\x22\xe9\x8f\x3d\x1a\xe4\x7c\xc9\x1d\xe8\x3d\xf0\x45\xba\x71\xe9\xf7\x78\x49\xf3\x14\x02\x5e\x49\x23\xf2\xdc\x93\xf5\x2d\x32\x12\x88\xb9\x95\xb1\xc0\x1b\x77\x08\x2f\x68\x5d\x32\x96\x7b\x6a\x98\x9a\xc2\xa0\x65\xb7\xf2\x4a\xbf\xce\xb7\x36\x68\x84\xcb\x02\x11\x72\x33\x8a\x6e\xbe\x58\x49\x0b\xfb\xf5\x9b\x0a\x4c\xc4\x7e\x4f\x4a\x1e\x66\x4e\x52\xfd\x77\xc6\x84\xd4\x26\x97\xb8\x30\xcd\xbd\x14\xee\x30\xe5\x9b\x84\xcf\x0b\x05\x64\x85\x5f\x95\x61\x0d\x0a\x60\x98\xea\x93\xa4\x7d\x24\x1a\xc6\xcb\xe5\x6d\xc4\xf1\xc4\x8c\x02\x62\x5a\x82\x4c\xcb\xc1\x2c\x35\x3a\x76\x35\x59\x3e\x90\xfe\x1c\x17\x81\xa6\x2d\x71\x4c\xd2\xd4\xba\xe1\x60\x03\x1f\xeb\x89\x32\x2c\x2a\x2b\x75\x24\xf4\xb6\xf4\x77\x96\xb5\xb0\x61\x4b\x68\x08\x72\x4c\xa0\x09\xe8\x53\x16\x02\x1f\xaf\x1d\xc7\x57\x5e\xa7\x19\x18\xed\x77\xb1\x60\xfa\x2b\x56\xfa\x3c\x33\x45\xea\x49\x91\xac\x2b\xc4\x5a\xcc\x10\x43\x8f\xae\xcf\xd4\xf3\x86\x7c\xcb\x34\x8a\x9a\xaf\x46\x76\x5c\x8c\x12\x14\x5a\x31\x24\xe4\xa2\xae\x4a\x82\x2c\xc6\x1a\xa3\x09\x21\x90\x74\xb0\xb4\x3b\x1d\x25\x73\x53\x5a\xa5\xaf\xc1\x00\x1a\xad\x4f\x09\x72\x35\xb2\x14\x9c\x91\x43\x74\x70\x17\x1b\x77\x82\xdf\xca\xd9\xac\xbb\xaa\x38\xb4\x7d\x51\xbf\x5c\xd3\xda\x85\x92\x1b\xd4\x0f\x54\x32\x17\xc3\xbf\xd4\x32\xe4\xc0\x9a\x42\xdd\x24\x76\x5f\x4d\x92\xe0\x1d\x63\x68\x93\x29\xce\x4c\xb8\x76\x02\x33\x2e\xcc\x61\xd2\x1b\xfe\xbd\x5c\xdc\x3b\xb5\xd2\x83\x0a\x74\x84\x45\x7d\x83\x4f\x6c\x23\x4d\x3e\x9a\x21\x84\x55\x19\x9d\xf1\xdf\xcb\xdb\xd0\x16\x15\xd6\x6b\xcd\x6a\xa8\xde\x10\xa1\x5d\xec\x01\x0e\x8e\xf7\x43\x65\x8f\x30\x14\x30\x46\x1a\xe0\xe0\x81\xd3\xc9\x2d\xbc\x0f\x2f\x40\xd9\xdb\x7b\xeb\xc5\x0b\x19\x9f\xd6\x8f\x7d\xad\x6d\xd9\xed\xe8\xed\x68\x47\xf7\x18\x8b\xe8\xdf\x49\x74\x7d\xa0\xa0\x9b\x98\xd7\x22\x3a\x27\x3a\x6e\x35\x0e\x76\xfa\x8a\x3f\xb8\x86\xd5\x8e\x70\x82\x74\xac\x7b\x4f\x91\x06\x93\x05\xb2\xa9\xf8\x61\x11\x5d\x03\x66\x48\x6c\x0d\x88\xa3\x00\x91\x4b\x95\xfc\x3e\x8f\x57\x51\xf9\xda\x55\x4a\x78\xcb\xaa\x89\x6d\x9b\xe5\x2e\x22\x93\x96\x01\x9f\xf2\xc9\xbf\x8f\x2d\xa3\x96\x90\xc1\x1f\xf0\xdf\x5b\x20\xfe\xc3\x78\xf9\xf5\x9a\x7c\xb1\x86\x02\x01\xd6\xcd\xde\xd8\xd3\x37\x5e\xff\x7e\x3c\x8e\xa5\x21\xf3\xfb\x3f\xfe\x00\x0b\x99\xad\x23\x64\xed\x72\x8e\xfd\xc4\x41\x3e\xa5\x0d\xf4\x21\xc9\x0d\x54\x3e\xec\x14\xf3\xd0\xc7\xbf\x7d\x43\x17\xe0\xc3\x46\xfc\x31\xf1\x6a\x22\xeb\x7d\xb7\x68\x71\x2f\x42\xa7\x25\xba\xbb\x56\x69\x28\x40\xe1\x4f\xcc\x1c\xea\x35\x93\xe0\x2e\x97\x84\xaa\x90\xe0\x6f\x3a\xe8\x44\xf1\x9c\xff\xe8\xb4\x47\xbd\x47\xfc\x7c\x4e\x03\x1f\x8a\xde\xd4\x47\x91\x2b\xe2\x39\xe6\xc8\xa5\x2f\xad\xb4\x62\x9b\x22\xaf\x4e\x11\x6b\xc3\xe3\x58\x3e\x45\x9f\xbf\x79\x6f\xd3\x2f\xb8\xd1\x79\xe1\x54\xf8\x08\xd1\x1a\x01\x32\xdc\x08\xd1\xdf\x47\xa5\x37\xcc\xa9\x7f\xa8\xe3\x67\x33\x3e\xd0\x69\xf0\x23\x6c\x34\xe3\x65\x5c\x4c\xa5\x70\x7f\x6c\x0d\x56\x59\xf2\xab\x8d\x9b\xe9\xed\x08\xd1\x2f\x7d\xb2\xde\xe9\xad\xf7\x6b\xcc\x65\xe7\x30\x3f\xd4\x9e\xa3\x39\xaa\x0d\xd9\xad\xf5\xf4\x15\x5f\xd4\x69\xba\x2d\xae\xdd\xcc\xa5\xdd\xca\xa5\x85\xcf\xf9\x1f\xf8\x2e\x02\x1c\x8a\xc2\x6a\x7d\xce\x6f\xfe\x26\x57\x88\x43\xa3\xc9\xfa\xee\x99\x43\xb7\xa7\x1f\xf6\x8a\xa5\x88\x88\x82\xd7\x9e\x87\x36\xad\x7c\x94\xbf\x6c\xf0\xcc\xf3\xef\x63\x3e\xdf\x6d\x7f\xd8\x64\x8b\x10\x7a\xd5\xd0\xba\xce\x38\xea\xd4\x1a\x66\x7f\x6c\x8e\xa5\xc1\xb9\x65\xf7\xf4\xf8\x88\x35\x5c\x17\xfa\x24\xb2\x03\x65\xfc\xb8\x6b\x08\xfe\xe8\xf9\xbb\xad\xb4\xdf\xfc\x49\x63\x51\xc8\xec\xfa\x1b\x3b\x34\x04\x5e\xb5\xeb\xaf\x31\x33\xd4\x79\xc7\x41\x44\x2b\x7d\x0d\xe5\x32\x43\x68\x17\xbd\x65\xd7\x2f\xff\x37\x29\x02\xed\x85\x24\x1e\xda\x2d\x6c\x3a\x47\xdf\xbe\xdc\x1d\xf1\x9b\x7e\x3f\x73\x7a\xf4\x7f\x35\x3f\xb1\x14\x7f\xf4\xc7\x4f\x8f\x4f\xf9\x89\x7f\x7c\x5e\xe7\x12\x7d\xf9\x12\xbd\xa7\x8f\x4f\xed\xdf\xf5\xaf\x3c\xa9\xde\x9f\xef\xef\x44\xa2\x0f\x45\x30\x5c\xe3\xc3\x8c\x3e\xd2\x6d\xcf\x38\x68\x76\xb5\xbf\x9f\x2f\xc0\x86\xd9\x76\xdb\xa3\x53\x9d\xf6\x46\xf7\xf6\x1e\xfb\xfc\xc4\x0a\x87\x4f\x49\x60\x13\x8e\x67\xe3\x4f\x45\xc1\x6d\xc8\x90\x74\x57\xc0\x74\x57\x14\x75\xb7\xe3\x9d\xa6\xe3\x0d\xc8\x67\x6d\x37\x42\x6d\xb7\x85\x9e\x7d\x7f\x93\xf7\x8c\x8f\xfa\x6b\xfc\xf8\xe6\x6f\xf2\xda\x7f\x94\xe7\xfc\x8f\xf2\xac\x0f\x43\xfd\xf8\xc7\xf5\x20\xc2\x91\xfc\x2b\x09\xe3\x2e\x16\xe2\xeb\xb1\x4f\xf5\x87\x0d\x89\x24\xc0\xef\x5c\x7a\x60\x2a\xe2\xbf\x9c\x67\xee\x3f\xc7\x6b\x7d\xf7\xe4\xc6\x24\x3a\x7c\x8b\x19\x8f\x5c\xdb\x8b\x4e\xdd\xad\x4e\xdd\xf3\x4e\xd3\x33\x24\xd3\xd3\xd1\xa7\x9b\xb0\x46\x93\xb3\xa6\x6b\x9f\xfa\x5b\xf9\x25\xef\xb1\x4d\x93\x07\x70\x97\x0e\xce\xdf\xe4\x39\xff\x28\xaf\xfd\x1f\xe5\xed\x3f\xba\x6b\xe8\xbf\x88\xc5\x9f\x18\xe5\xe8\xdf\xe2\xd1\x6b\x1d\xe9\xf7\xe3\x7e\xcf\xb7\xdf\xf2\xcf\x7d\xf6\xf3\x34\x96\x3d\x4c\x35\x41\x7c\x48\x91\xfc\xed\x27\xcf\xb3\x8f\x02\x5a\xa3\x4b\xdd\x36\xf5\x14\x86\x57\xfe\xf6\x1c\xd6\x97\x9b\x24\x17\x8d\xd4\x3d\x0d\x47\xb0\x70\x64\x25\xdf\x50\xda\xae\xa9\x2a\x32\xbb\xeb\xf9\xc9\x41\x40\xd1\xdc\x0a\xa7\xe7\xf2\xc4\xc8\x93\xd8\xcc\x81\x17\x0f\x1e\x13\x58\xd5\x14\x85\x87\x82\xa0\x40\x0e\x73\x45\xb6\xf7\xd1\x27\x85\x95\xf4\x36\x72\x88\x11\x41\x93\xf4\x03\x90\xe8\x53\xbf\x33\x08\xdb\x61\xcd\x31\xf5\x75\x88\x2d\xd7\x2a\xcb\xdd\x32\x64\x5d\xba\x34\x9e\x25\xbf\xe9\x0b\xc9\x3e\xde\xbb\xfb\xa0\x1e\x8a\x7f\xfb\x56\xa5\x57\xf2\xa4\x77\x72\x40\x72\x1e\xac\x28\xd5\x6b\xbd\x23\x98\x0b\x0b\xdd\xd5\x1b\x41\x8d\xf3\x25\xdd\xd5\x61\x9e\x90\xc3\x3d\x6d\xa4\x52\x46\x20\x50\xb7\xdd\xc2\x09\xbd\xf0\xee\x0b\x19\x5e\x42\x4b\x59\xc1\x57\x7a\xf9\xbe\xbb\xd5\x41\x3a\x55\xbd\x7c\x60\xa4\xc8\x92\x39\x4d\x05\x15\xd6\xfb\x81\x8a\x3f\x5d\xe9\x7b\x9a\x15\xea\x27\xa8\xd5\x8b\x13\x60\x23\x56\xee\xcd\x5b\x22\x7a\x3a\x0d\xee\x07\x60\x12\x99\x85\xa6\xe7\xd1\x63\x44\x78\xd4\x30\xa3\x39\x28\xb8\x6b\x70\x01\xd4\x52\x93\x65\x74\x3b\xbe\x33\xef\xeb\xbe\x67\x34\x1b\x7d\xde\xfe\x86\x2f\xf4\xb8\x47\x5c\x16\xbe\x68\xd6\x55\xb1\xa1\xbb\x7e\x7e\xc2\x2e\x24\x4c\x64\x36\x10\x20\x68\x3b\xf6\xa1\xd7\x37\x43\x6c\xb1\xac\xf1\x78\xa6\x88\x82\xf8\x56\x15\x0f\x90\xa8\x87\xdd\xae\x29\xa4\x7e\xa5\x58\xcd\xdc\xd7\x28\x5c\x16\x44\x31\xe9\x94\x69\x17\xf1\x4d\x2d\x35\xb7\x97\x4f\x95\x33\x52\xc2\x1f\xc1\xf9\xe5\x65\x78\x93\xd2\xee\xc3\x45\x4f\x9c\xe9\x54\x20\x5a\x15\xa5\xcf\xb8\xd5\xdc\xf9\xb5\x61\x4d\x94\x72\x02\x86\x09\xc5\x14\xea\xf2\x76\x84\x05\xc0\x50\x27\xaa\xaa\xd1\x19\x7d\x9b\x3d\xf5\xee\xfe\x61\x5f\x13\xdb\x47\x79\xa5\xc0\x63\xd4\xbc\xf5\x54\xc3\x98\x11\x8e\x42\xbd\xcd\x74\x84\xcf\x91\x73\x48\x99\xa4\xb7\x6c\x66\xae\x60\xc9\x74\xb4\x38\xbf\xb2\x3e\x72\xf3\xd7\x2c\xaa\x2d\xc2\x5b\x0e\x5a\xf6\x87\x82\xdd\x2c\xbf\x54\xb4\xc3\xf0\xf3\x69\x31\xa9\xc5\x7e\x4a\x24\x70\xdf\x2c\x4e\x61\x20\x0a\xba\x52\x51\x09\xe6\x67\x05\x78\x27\xdb\x6d\xcc\x8c\x5f\x1f\x58\xab\x0e\xc5\x56\x7d\xae\x42\x3e\xc4\x90\xc2\x0a\x29\xa3\xec\x82\xda\xc1\xec\xd2\x13\xcb\x58\xf4\xf6\xe4\xf1\xd9\xe4\xef\x27\xfa\xe7\xb3\x18\xbd\xb6\xf4\x07\xc1\x0c\x28\xaf\xd5\xae\x56\x70\x5c\x23\x6c\x5a\x9d\xac\x51\xe5\x20\x0c\x63\x97\x0f\xe2\xd5\x6c\x35\x53\xfb\x4b\x9a\x40\x80\x5d\x30\xda\x78\x67\x0a\x3d\xd8\x0e\xe4\xef\x4f\x80\x64\x60\xdc\x9f\x9c\x21\xed\x0a\x6b\x67\x13\x2f\x11\x5b\xce\x20\x48\x7b\x05\x08\x2d\x73\x24\x72\x6c\xd4\x25\xe1\x3f\x97\x85\x3e\xef\x68\x73\xc0\xee\x42\x80\x8b\xe6\x18\xcc\xc8\x8a\x40\xeb\x47\x12\x26\x5d\xf8\x83\x7b\xda\xfc\x32\xd6\x64\xd2\x28\x8e\x67\xb8\x6f\x7b\x5a\x62\xec\x9c\xda\xf1\xef\x7e\xad\xa6\x2d\xae\xff\x2c\x40\xd1\xc5\x7f\x11\x0e\xf8\xcf\x87\x5c\xf5\x07\x2a\x20\xcc\xfa\xf2\x02\x8f\x98\x3f\x8f\xe1\xa2\x11\x17\xfa\xde\xe2\x39\x9e\x66\x64\x8c\x78\x10\x79\x26\x0c\x4d\x02\x02\x1c\x4b\x53\xf4\x0d\x44\x80\xae\x5a\x95\xfe\xe1\xbf\x91\xcd\x70\x59\x9a\x04\x2c\x3b\x56\x11\x4f\xff\x2c\x3f\xb2\xe5\x64\xdc\xd9\xf1\x37\xbd\xd5\x18\x4d\x3b\xf4\x6e\xd0\xe1\xf1\x6e\xa5\xac\x4d\x54\xf0\x26\xaa\x77\x41\x5f\x40\x69\xe3\xad\x35\x55\x50\x60\x69\x22\xab\x7c\x9f\x68\x38\x7b\x42\x8b\x43\x5b\xb9\x02\x46\x3e\xd4\x79\x9f\xa1\xef\xae\x34\xac\xd6\x8f\x5d\x8a\xbb\x08\x08\xe2\x47\x45\x79\xef\x7e\xa9\x46\x6b\xb7\x97\x21\x3c\x11\x44\xd8\x8f\x4a\xf4\xd5\xe3\xbc\x79\xd9\xc1\x2e\x88\x69\xfa\xe5\xc0\x37\x5e\x21\x76\xfd\x6e\x55\x4f\x25\x97\x93\x30\xef\xfd\x9e\xb5\xe9\x3b\xee\x4c\x9d\x30\x27\x83\xf2\x1e\x6b\x0f\x88\xeb\x89\xdf\x8e\x54\x0f\xb7\xec\xb1\x98\xe6\x47\x13\xd7\x02\x72\xb3\xc2\xcf\xcf\xe2\xf3\xe5\x40\xc6\xaa\x08\xd1\xfb\x65\x9d\x21\xf2\x09\x32\xc3\x69\x55\x29\xbe\xa1\xde\x0e\xd4\xd1\xf8\x52\x8c\xcf\x12\x27\x7c\x39\x11\x32\x45\x43\x86\x68\xda\x58\x6e\xa1\x7b\xea\x15\xd3\xf5\x5d\xa8\x94\x43\xc5\x9d\xc6\x29\xdc\x3a\xc0\x5b\x3b\x61\xd8\x09\xc2\x5e\x95\x56\xc5\x57\x48\xbc\x5c\x0a\x1d\x2a\xd9\x35\x70\x89\xd1\xa0\xe0\x3d\x7a\x76\xfb\xb6\x87\xb0\xbd\xd8\x1a\x6c\xa1\x27\xc5\x5c\x26\xe9\x72\x1d\x81\xbe\xae\xba\x17\x2b\x70\x5d\xb5\x12\x33\x93\xc0\x25\xbd\x06\x35\x0f\x21\x47\x47\x91\xab\x4f\xd4\x1d\x27\x1d\x73\x0b\xc1\xb6\x90\x2a\x23\xd8\x4a\x52\x8e\x14\x53\xa5\x87\xdf\xd7\x5c\xab\x4b\x67\x55\x21\xed\xab\xb8\x52\xe7\x13\xeb\x18\xce\x53\x01\x01\xcf\x02\xd3\x0e\xef\x7e\x92\xdf\x2b\x5b\x5f\xc7\x06\x0d\xa2\xbf\x4d\x6f\xce\x65\x32\x1d\x13\xb8\x63\x4e\xa6\x1d\x30\x6c\x42\x7b\xd3\xaf\x47\xbb\x51\x57\x94\xd1\x8a\xf8\x26\xfe\x08\x1a\x7c\xca\x6b\x7a\x99\x28\xcd\xd8\x1e\x9f\x30\xa4\x03\x33\x32\x82\xf2\xef\xe2\xcd\x60\xf5\x8e\x3b\x12\xf8\x50\xa0\x73\xec\xad\xc8\xa2\xbe\x07\xcd\x60\x6e\xc2\xd1\x1f\xb9\x6d\xbc\xa0\x5f\x56\x69\x4f\x2f\x0e\x7e\xf0\x7f\x81\x4a\x16\xd4\xa3\x41\x80\x29\x78\x2d\xb0\xc2\x60\x29\xef\x35\xbc\xaf\x4d\x54\xce\x14\x13\x5a\x5d\x78\x91\xb4\x9d\x80\xa7\x9c\xaa\x27\x97\xeb\xe4\x59\x70\xc7\xed\xc5\x66\x53\x09\xc7\xb0\xdd\x2b\x39\x6b\x37\xe1\x3e\xd8\xe4\xdd\xdb\x16\x86\x6e\x77\x53\x28\x1f\xf7\xa5\x13\x9f\x91\x08\x60\x9a\x05\x0d\x8d\x53\x75\x5f\xe2\xee\x96\x1b\x35\x07\x84\xba\xa5\x34\x15\xa4\x34\x2e\xb0\xde\x54\x0e\x16\xdd\xaf\x0a\x2e\xa9\x64\x14\xba\xc3\x51\xb9\x3c\xc9\xec\x55\x6e\xc0\xce\x3c\x5c\x48\x03\xa1\x66\x67\xfc\xd8\xee\xfe\xcd\x37\x10\xba\xe2\xfb\xff\xa8\xc5\xff\xa3\x16\xff\x8f\x5a\xfc\xff\xa6\x16\x4d\x2c\xfc\x6c\x86\xfb\xfa\x8b\x0a\x78\xca\x61\x0a\xfe\xf3\xbd\x8d\xeb\x6e\x2c\xe8\xad\xcf\x3a\x82\xf7\xbf\xa3\x16\xbf\xe4\x19\xff\xa3\xbc\xff\x43\x6a\x21\x19\x0f\x74\x37\x5a\x1b\xd6\x85\xbf\xa8\x80\xdd\x30\x98\xe3\x3d\xdf\xf3\xed\xf9\xec\xbb\x1e\x4a\x20\x38\xff\x3b\x6a\xf1\x97\x3c\xef\x7f\x94\xf7\x7f\x87\x5a\x74\xf0\x41\x93\xca\x0f\xb5\xa8\xff\xa4\x16\xf5\xcb\xd0\xd2\xf3\xe5\x88\x7a\x09\x1e\x16\xa3\xe8\xca\xa9\xb7\x9e\x42\xae\x6f\xc8\x8f\xe6\x4e\xe2\x35\xa9\x6e\x72\xb5\x9d\xaf\x18\xc1\xb2\x97\x68\xd0\x08\x9e\x17\xc0\xcf\x72\x30\xf2\x13\xbb\x0d\xd6\x92\xae\xc1\x13\x6f\x69\x84\xfd\xe0\xe8\x27\xa4\x7d\x6b\x75\x37\xeb\x7c\x6a\x53\x64\x75\x5b\x51\x16\x91\x2d\xf6\x54\xc1\x17\x91\xf6\xf9\x81\xd3\xd4\xbd\x0f\xbb\x0e\xd2\xf8\xe4\xf9\x91\xdf\x27\xc0\x77\xcf\xc6\xfd\xa4\x2e\x7d\x10\x63\x99\xd1\x5f\xd6\x8b\x7d\x95\xfc\x3d\xe3\xfb\x14\x12\x00\xb8\xf3\xf8\x3c\x82\x37\xc9\x9b\x3a\x9b\x8f\x60\x8e\x10\x2b\xd1\x88\xfa\x0b\xfd\x44\x38\xdc\xb0\x37\xa6\x03\x9a\x45\x1c\xa7\x70\x60\x90\x74\x21\xe8\x1c\xdd\x2c\x2a\x9b\x64\x39\xf5\x53\xcc\x99\x7d\x29\x94\x09\x19\xa6\x98\x71\x9a\x0b\x87\x3d\x06\xb4\xdc\xb1\x40\x2e\x55\x40\x55\xd8\xca\xad\xc3\x30\x57\x39\x3f\x49\x5a\x78\x97\x33\x36\xab\xed\xa7\x61\x71\x30\xac\x8a\x5d\xb9\xe4\x53\xe0\x10\xcc\x7b\xf7\xc5\xc1\xcf\x3c\xc2\xb0\xe7\x96\x26\x9a\xd3\x96\x01\x71\xeb\x73\x13\x48\x5d\x4d\x82\xec\x75\x31\xeb\x19\x0d\xa6\x35\x68\x90\x8d\x00\x95\x24\xf5\xaa\x93\x1c\xd4\xed\xb1\xaf\xc4\xfd\xbc\x71\xdb\x34\x61\xf1\x35\x6c\x0a\xa3\x53\x75\xf6\x49\x94\x40\xae\x3e\x94\x91\xbd\x8d\x15\xe8\x1e\x8e\x12\x51\x2f\x36\xac\x91\xb1\x79\x31\x0e\x2c\xa7\xee\xdb\x78\x22\x7a\xf2\x29\xfe\xcc\x9a\x0a\x1a\xde\x11\xbb\xac\xec\x99\x6b\xe9\xac\xc0\x9f\xba\xe0\xbc\x9a\x0e\x43\x43\xda\x16\xe6\xe0\x72\xa1\x4d\x69\xfb\x62\xdf\x62\xd2\xd3\x2e\x5f\x8e\x56\x0c\x6b\x5e\x05\xb3\x32\x42\x29\x9f\x43\x60\x1c\xd7\x67\x6c\xf3\xce\xc4\x47\x27\x39\x9e\xb4\x31\xa1\x93\x3d\x22\x34\x58\x2a\xbf\x50\x86\x22\x2b\x66\x79\x44\x80\x74\xc1\xd7\xa7\x34\x66\x5c\x0e\x88\xfb\xc2\x43\x58\xae\x51\x20\xa0\x73\x2c\xcd\x47\x20\xdf\xd1\x84\xdc\x77\x57\x15\xeb\x36\x76\xbe\xb8\xaf\x1f\x5e\x4b\x1d\x47\x15\x5d\x26\xe0\xce\x13\x91\x40\xb5\xd3\xd7\x50\xac\x03\x6b\x36\xef\x6f\x20\xbf\x0e\xf6\x7c\xa0\x9b\xba\x23\x0e\xd9\x1f\xe2\x8d\x76\x07\x0d\xe2\xbc\xc6\x81\x3a\xe6\x7d\x11\xe7\xf0\xa1\x0a\xf5\x70\x2a\x7c\x0d\x22\x99\xef\xcb\x97\x35\xc7\x10\x62\xd2\xb4\x68\x40\xfe\xd1\x54\x57\x15\x14\x2a\xdd\x07\x03\xa0\x48\xc0\x20\x3d\x2e\xd3\x99\x6d\xef\x75\xf4\x81\x76\x01\x24\x83\xbc\xca\xee\x81\x60\x1c\x86\xb6\x2d\x76\x61\xbf\x77\x36\x81\xe1\x3e\x5d\x0f\x41\x49\x6f\x1b\x72\xbb\xef\x4c\x74\xdd\xa9\xd5\x05\x9d\xf2\x58\x26\xea\x0b\x1d\xe6\x8d\x26\x4f\xda\x0e\xbc\x35\x89\x8d\x64\x26\xfa\xf8\x1b\xdc\x1a\xe6\xc4\x24\x8e\x1b\xa4\x5c\xd1\x54\x9f\x03\xba\xa5\x5a\x94\xde\x2a\x9c\x1c\xc0\x1d\xa6\x7b\xa9\x82\x3f\xaf\xed\x5b\x07\xc3\x33\x5f\x5c\x9a\xa4\x7f\xf3\x13\xf0\xdd\x58\xfd\x9b\x9e\x4b\xf8\xed\xa3\xc0\xfc\x81\x2a\x9a\x2e\xcb\xfb\xdf\xa8\x90\x94\xb3\xae\x27\x30\x8c\x26\x59\x1c\x78\xd6\xec\x6f\x04\xa9\x3b\xde\xe2\x6f\x8b\x9a\x68\xc2\xc1\x30\xdc\xa9\xb3\xcc\x6b\xce\x7e\x7e\xf0\x90\xe5\xe2\xff\x05\xaa\xf8\x3f\x40\x03\xdd\x3f\xa2\x00\x83\xc3\x8e\xa7\xeb\xaa\x3f\x5d\xfd\xff\x52\x47\x57\xff\x44\x0d\xff\xcb\xce\xfe\xeb\xf8\x3f\xb7\x3f\x75\x79\x8e\xef\xe3\xe6\xbf\xed\xd8\x50\x12\xc4\xfd\xef\x5d\x97\xfa\x70\x9f\x7f\x94\xf3\xd3\xdd\x52\x09\xbf\xcd\xde\xc7\x92\xff\xa1\x7b\xc7\x61\x3d\xc5\x9f\x3f\x50\x43\x35\xfe\xa3\x1c\xee\x17\x8a\x68\xa3\xfb\x6f\xfa\xa0\x2c\x9c\xf5\xc7\xf6\xd8\x76\xd0\x10\xa7\xd1\x10\xff\x1b\xff\xde\x89\xee\xdf\x74\x7a\xff\x37\x3a\xfd\xf8\x93\xff\x5f\xa2\x91\x4b\x01\xfe\x41\x0e\x7f\xfe\x36\x6f\xb3\x7b\x75\x19\x42\x7f\xf3\xd0\x99\x52\x04\xf7\xd3\xbe\x83\xac\x46\x79\xba\xd3\xd3\xfd\xfa\xa7\x5b\xf5\xfe\x9d\x22\x27\xfc\x9b\xdd\x3b\x7f\x4b\x42\xfb\x5f\xcb\x69\x9f\xf1\xc2\xc7\x2f\xa1\x71\x27\x01\xbd\x59\x8d\xf7\x4b\x86\xfd\x20\xaa\x4c\x7e\xc6\x41\x8d\x67\x0c\xec\xbf\xd0\xa3\xfe\x0d\xe9\xa4\xb2\x0f\xfd\x7e\x7e\x76\x3d\x1d\xf3\x5f\xef\xfb\x47\xdb\x52\xbf\xfb\x78\xb2\x9e\xf8\xf8\xb1\x5b\xfd\x47\xc7\x3d\x7f\x9d\xdf\xd2\x57\x12\xf8\xdb\x83\x18\xfe\xbb\xf3\xe3\x7f\x98\x6b\x83\xa1\x7a\x73\xec\xff\xc5\xbe\x7f\x88\x37\x4b\xfa\x2d\x36\x08\x4f\xea\xb0\x38\x38\x23\x47\xea\x7e\x50\xd0\x1f\x76\x88\x20\x9d\x3f\x7e\x8f\x8d\x66\xb2\x93\xb0\xee\x52\xb1\x16\x92\xdf\x7c\xfc\x2f\xed\xd1\x15\xcf\x98\xbf\xd9\xf3\x41\x77\x1e\xfa\xc3\x04\x3c\x40\x77\x7f\xc9\xe0\x1f\x3d\xf6\xac\x33\x8e\x28\x30\xba\x7f\x9e\xcb\x3f\x8e\xd3\xfd\xc3\x38\x7a\xe3\x21\x8f\x4d\x7e\x97\xdf\xb1\x53\x21\xf9\xdf\xc0\x7b\xfc\xfe\xd8\x26\x43\xfe\xd5\xbc\x9c\xc6\x1c\x0c\x38\xea\xa7\x2e\x42\xff\xc9\xef\xbf\xeb\xfa\x5b\x1e\xda\xbd\x38\x68\xf7\x3f\x9d\xcb\xd1\x4f\x9c\x4f\x77\x1e\xbc\xe6\x38\x30\xa0\x7f\xd6\xf3\xa7\xb6\x98\x2d\xbc\xc7\x8f\x2e\x85\xf7\xe3\xb3\x67\x8e\x0f\x8b\x30\xdc\xf1\xd0\x9b\xf1\x7c\x50\xeb\xa1\xf3\xbf\xfd\x8d\x99\x8f\xfd\xfe\xd5\xf1\xff\x64\xb7\x9f\x5a\x47\xd8\x4f\x8d\x8a\x42\xe7\xb1\x8f\xb3\xa7\xc8\x01\xfc\xe1\x93\xf5\xb7\xef\x3e\xcc\xad\x35\xfa\xf6\x63\xcb\xdf\xe5\x19\x57\x1c\x88\xd0\x93\x7b\x5e\xfa\xe4\xc0\xdf\x74\xfc\xaf\x50\x9c\xfa\xc7\xdc\xfe\x42\x72\xd5\xf8\xcf\x48\x4e\x9d\x67\x13\x44\x7e\xe8\x22\xf3\xc7\x53\xdf\x8c\xbc\x0e\x76\x91\x85\x62\x18\x83\x00\x18\x20\x79\x9f\x93\x5e\x8b\xf4\x27\x46\xd0\xdf\x57\x69\xbf\xe4\x50\x55\xb9\xcf\x87\x41\x3c\xa1\x03\x54\xe7\x73\x29\x94\xb1\x30\xd4\xa8\xf8\x85\xef\xdb\x02\x23\x38\x9e\xed\x38\x07\xa3\x28\x75\xaf\x7c\x6a\x96\xa9\xe0\x57\x2d\x15\x6b\xff\x26\x43\x78\x88\x91\x1c\x01\x49\xb0\x44\x9f\x09\xd7\x02\x8a\xa0\x5a\xe7\x92\xdf\x9b\xe2\x27\x40\xde\xf0\x1e\xc7\xe9\xf3\xde\xc1\xd2\x44\x0d\x20\xa3\xf0\x7b\xb8\x52\xc3\xcb\x26\x8d\x09\x92\xad\xb0\xe6\x69\xc1\x31\x4c\x76\x66\xcd\x19\x97\xdb\x81\x62\x0b\x28\xbf\xd1\x20\x7f\xa7\x97\xc0\x4f\x93\xe0\x30\xec\x6f\x5b\xf6\x8c\x3a\xb4\x78\xa1\x63\x78\xf9\x9c\x0c\xcf\xc8\x82\x82\xa8\x40\x6c\x9b\xf6\x1e\x95\xf2\x23\x24\x12\x55\xf5\xcd\xc8\x57\x87\xb9\x72\x17\x4e\x4a\x84\x41\xde\xc8\x2a\x7e\x43\x2d\x9f\x73\x08\x63\xa1\xa7\xab\x54\xcc\x6f\x9b\x6a\x33\x87\xdd\x32\xb2\x55\x29\xbb\xee\x75\xc4\x59\x84\xf9\x83\x55\xf3\x5d\x54\x68\xc9\x59\x00\xdb\xc7\x5f\xb9\x70\x20\xe2\x92\x4b\xae\x60\xed\x1b\x05\xca\xd7\xf2\x55\x5d\x41\xaa\x7b\xd3\xf9\x1a\xb9\xec\x66\x9b\x65\x2d\x0a\x48\x0e\xf5\x87\xd3\xa5\xac\x7a\x1b\x6e\xa7\x0b\xce\x8f\xb1\x9e\x2d\x1b\x89\xa7\x8f\x32\x66\x68\xbd\x2c\xd4\x42\xac\x16\xdc\x13\x93\xc0\x76\xf5\xb3\xad\x23\xc8\xf3\xab\x09\xb4\x31\x0e\x97\x81\x54\x69\x47\xc8\x86\x3e\x58\x8e\x98\xce\x89\xef\x8b\xc2\x40\xb2\x70\xc9\xb9\x0a\x1d\x8f\xf9\x25\x4f\x9f\xe6\x47\x1e\x2b\x85\x24\x49\xe7\x20\x91\xe1\x77\x96\x21\xbb\xeb\xcd\xdd\x97\xef\x1c\x7f\xaf\xca\x07\x73\x9f\x6d\x81\x0e\x80\x48\x85\xe5\xfd\xc1\xe6\xef\xd1\xf2\x0c\xd1\xaf\xdb\x03\xa4\x88\xbd\x20\xbd\x0d\x02\x49\x40\x33\x24\x31\x0d\x5c\x4e\xf9\x30\xbf\x6f\x35\xe3\xd4\x9c\xcd\xe8\x97\x72\x01\xef\xad\x05\x8a\x2d\xf4\x77\x97\x32\x3e\xf3\x70\x26\x7e\xf7\x46\xcd\x8b\xfa\x2e\x1f\x06\x32\x82\xc4\x6c\x46\x02\x4b\x6c\x17\x59\x9e\x13\x6d\x88\xb2\x9b\x3d\x5d\x51\xf4\xa6\x77\x28\x95\x41\xb0\xfc\x36\x0d\x72\xa2\x70\x54\x23\x62\xf5\x4b\x75\x87\xe2\x3c\xf6\xf9\x5f\x86\x53\x8a\x99\x95\x07\xee\x84\xd6\xf3\xfe\x06\x18\xac\x92\xee\xcb\xbb\x9a\x38\xdb\x93\xce\x0b\x1a\x0d\xf0\x93\x20\x17\xe5\x37\x24\xc5\x1c\x1b\x67\x07\x1b\xb6\x20\xd4\x88\x1a\x1d\x8c\x7b\xf2\x6e\x6e\xd1\xb3\x83\x82\x24\x68\x81\x72\xc9\xf1\xff\x3e\xc6\x9b\x8d\x58\x2e\x63\xdb\xd6\x83\xcf\x8d\xc8\xe8\xd5\xba\xb1\x65\x83\xd3\xf2\x34\xc7\xf9\x75\xa4\x67\xf4\x29\x89\xf0\xbd\x27\x6e\x91\x27\x85\xc4\xc2\x53\x8c\x24\xb4\x4b\x60\x95\x51\x82\xc6\xbd\x4e\x43\x00\x0f\xc7\xaf\x00\x51\x2a\x8d\x52\xde\x0c\x4f\x10\xe8\x8c\x91\x5f\xe4\xbd\x06\xfb\x40\xfa\x37\x7e\xb2\x14\xd7\xc5\xa1\xdc\x08\xf6\xa7\x70\x65\x02\x48\xfb\x8c\x6c\x3c\x40\x55\x0b\x11\x73\x68\x45\xcf\x9f\x56\x6c\x71\x25\xa4\xbd\x93\x86\x06\xe8\xc2\x12\x25\x0c\x5c\x92\x27\x7f\x04\x9b\xf9\x7d\x3b\xd8\x4c\x78\x31\x2c\x9d\x39\x43\x96\x03\xaa\xee\x27\xdf\x19\x59\xf3\x0d\x90\x44\x27\x2b\xa5\xf7\xae\x6b\x9c\xb2\xee\xd6\x0d\x18\xd0\x82\xb4\xbb\xc4\x3f\x95\xb8\x02\x74\xfe\x5b\x12\x01\xbc\x80\xa1\x05\x98\x23\x45\x90\x74\xf2\x69\x8a\xf6\x5e\x92\x9b\xfa\x65\x07\x41\x91\x21\x61\x62\x18\x97\x03\xc1\x4d\x21\x81\x36\xdf\x77\x93\xd3\xdf\x7c\x47\xe7\x81\x38\x25\x69\xb9\xe3\x67\xf0\x3a\xd7\xc2\x84\xbd\x01\x67\xab\x02\x2a\x25\x73\x98\x88\x88\x86\x56\x19\xbc\xb1\x84\xa6\xf1\x22\x2f\x10\xd8\xa1\x7f\xe5\x9e\xc3\xb1\x38\x6b\xda\x8c\x70\x00\x18\x66\xe7\x98\xaf\x2f\x03\xba\xdc\xc3\x0e\x50\x99\xb4\xbe\xe2\x22\xac\xbf\x5e\xd5\x62\x21\x0e\x50\x61\xe2\x79\x94\x22\x9e\x99\xa7\x4b\xa4\x49\xce\xfc\xe7\x00\x5e\x40\x09\x50\xf7\x55\x18\xeb\xdb\xfa\xcb\x0c\x76\x55\xd8\x6e\xcb\xb0\xba\xd5\x5b\x96\x03\x36\x50\xdf\xf8\xf0\x06\x67\x34\x5d\x6c\x6f\xcf\xac\xf8\x6f\x2c\x39\xa5\xd5\x10\x9b\x4d\x2e\xd8\xd2\xba\xde\xed\x32\x5f\x5e\xc6\x05\x45\x8c\x0c\xf7\x4e\x20\x92\x27\xa9\x62\x16\xa9\x1b\x3c\x3b\x8c\xa6\x5d\x69\xe8\xa6\x8d\xeb\x57\xc8\xff\x43\x71\xbb\x92\xed\x27\xcf\x0d\x83\x45\x4b\x2e\x8a\x11\x34\xf5\x57\x8d\x82\x38\xa3\xd4\xae\x6a\x06\x84\xb8\x7e\x17\x8c\x4f\xe4\x5f\xbd\x87\x1a\x3c\x6d\xf1\xa7\x22\xc9\xc0\x11\xd4\x7b\x04\x98\x3b\x7f\xdf\xb7\x70\x96\xf9\x2e\xb3\xb5\x69\x7a\x39\x64\x8b\xe2\x2f\xe5\x19\x17\x79\x7b\x0c\x97\xbd\xe8\x11\xda\x77\xc9\x01\x48\xec\xa2\x86\x7a\xd9\xc8\xe5\x81\x9c\x1a\x81\x28\x4e\x2d\x87\xb1\x03\xce\x88\x6f\xb9\x6f\x79\x85\xf9\xbe\xa9\xc9\x3a\x95\x6b\x26\xee\x82\x65\x66\xb0\x88\x81\x91\x07\xa3\xca\xc5\xb2\x83\x31\x38\x84\x3b\x38\xa6\x07\xb1\x24\xee\xdf\x62\x45\xd1\xdb\x27\x56\x38\x97\x37\xea\x95\x42\x32\x7a\x03\xc8\x6a\xfa\x64\xc5\x46\x22\xed\xb4\xa5\x86\x02\x19\x7c\xe1\x0a\xaa\x45\x99\x87\x56\x3b\xd9\x94\x7a\xf0\xda\x71\x3c\x25\x29\x01\x4f\x16\x72\x49\x87\x40\x6d\x57\xdc\xc3\xe2\xe2\xf4\x08\xd5\x8b\x40\xd9\xbb\x25\x96\x12\x02\x6e\xa7\xa4\xf9\x91\x52\x0f\xdd\xe1\xb8\x81\x5d\x36\x34\x47\x8e\xe9\x5d\x7b\xe5\xb6\xbc\x6f\x9c\xbc\x1b\x51\xcc\xc9\xb7\xfd\x57\xa9\x98\x18\x88\xa9\xd5\x87\xc9\x58\xcb\xb2\xc9\xd6\x5e\xb2\x1c\x5d\xc4\xb2\xbc\xaf\xf8\xa8\x69\x33\x6d\x5d\x1c\xc8\xa8\xaa\x26\xea\x6c\x07\xd3\x76\xc2\x04\x03\xec\x4f\x5d\xee\x5a\x2b\xc5\x1d\x54\x36\xbf\x77\xf8\x9c\xc6\x24\x95\xa4\x62\x58\x32\xed\x4c\xd8\x8c\xdb\x38\x8a\xd9\x03\xb7\x35\x49\xe8\xba\x4c\xbc\xe9\xc5\xf1\x61\x6e\x77\x86\x06\x91\xb8\x91\x86\xa3\xf2\x26\xef\x8d\xf9\x5a\x45\x1f\x9d\x73\x12\xe9\x6a\x0b\xaa\x44\x58\x20\xa1\x88\x32\xd0\x40\xb4\x79\xbf\x03\x48\xf2\xd3\x40\xfb\x7b\x4d\x13\xaa\x5a\x61\xb8\x88\x9d\xa7\x6f\x8f\x92\x0f\xce\x08\xc4\x03\xc1\xb0\x1d\x87\x3b\xbc\xbb\x5e\x68\x0a\xb7\x5f\x59\x49\x0e\xbd\xba\x17\x66\x16\x03\xaf\xf3\xd7\x3a\x48\x8d\xef\x38\xa9\xef\x2b\xa3\x53\xd6\x5d\xf9\xd0\x47\x03\xbb\xf1\xa3\xd6\x93\x8f\xb3\xca\x4f\xb3\xca\x9d\xd3\x71\x72\x44\x7b\x45\x50\x15\x2e\x66\x2c\xca\xc4\xf5\x26\xea\x79\x31\xd9\xf7\x05\x18\x33\x70\xed\x3c\x53\x19\x61\x88\x48\x1f\x71\xbb\x67\xc3\xb9\x9a\xf5\xcb\x0b\xdd\xab\x38\xb5\x49\xa2\x2d\xea\xa2\x54\x74\x90\xa1\x33\x7b\xfa\x92\xfb\x72\xdb\x72\x9f\x3f\x22\x16\xfe\x0a\x2b\x47\x3c\xdc\x82\xf3\xd5\x2f\x0b\xe7\x7e\x78\xd8\x1b\xe7\x95\xd7\x41\xef\x7d\x19\x2e\x2b\xe0\xf9\x62\x69\xe4\xce\x1e\xd4\x91\xb2\xfb\x3c\xa5\x45\x33\x4a\xf8\xc1\x6b\x0b\x51\xf8\x53\x34\x4d\x42\x70\x8e\x7b\xd5\x77\x37\x02\xb0\x45\x6b\xc8\x1c\xa6\xb6\x7b\x2e\xce\x11\xc2\x66\xe3\x78\xe2\x18\x4c\xd7\xa1\x97\x30\x49\x2f\x4f\x2a\xce\x46\x59\x5a\x9f\x0f\x87\x3b\xc1\xfd\x64\x02\x5d\xeb\xbf\xf2\x9d\x65\xb9\x9f\x7c\x67\x1c\x2d\x37\x06\xab\xcc\xce\x75\x48\xfd\x0f\xf1\x74\xb1\x37\x42\xd7\x90\x1d\xab\xe4\x7b\x85\x05\xea\x01\xef\x51\x14\x87\xe6\x2c\xef\x22\xac\xde\xa9\xf5\x71\xae\xf2\xc2\x6d\x29\x68\xe2\x08\xf4\x9b\x6b\x6d\x31\xf6\x96\x69\x06\x15\x36\xf1\x05\xd1\x26\x5a\x6c\x7c\x9c\x04\x25\xf8\x06\xb4\x0b\xc7\xf5\x58\xa6\xbd\xee\xfb\x51\x18\xe5\x8f\xed\x69\xb2\x38\xc3\x0c\xaf\x53\xf3\x9e\xa4\x8e\xa9\x40\xa2\xc6\x28\xec\x3a\xda\x09\xd8\x88\x58\x09\x6e\xdc\x94\x06\xc8\xd5\x18\x28\xf7\x77\xa3\x62\x1e\x18\xf6\x5d\x3e\xf8\x7e\x24\xb4\xa7\x0a\x92\x07\x22\x55\x90\x15\x54\x06\x49\x77\x15\x9f\x06\xc8\x76\x8f\x9b\xe6\x23\x2f\x1e\x8b\xe5\x22\x74\x77\xd0\xda\x0b\x05\xa3\x08\x82\xf6\xde\x5d\x57\x1a\x84\xe6\x3b\x25\xfb\x67\xb1\xb3\x62\xd9\x66\x5e\x47\x89\xda\x6f\x1a\x26\x8b\x4f\x0a\x90\x71\x36\x28\x99\x79\xcb\xe7\x13\x7c\xef\xf9\xde\x1e\xab\xdd\xf6\x49\x71\xa2\x13\x22\xb9\x44\x9d\xeb\xd4\xd9\x84\x04\x2a\x49\x20\x6c\x36\x2f\xac\x34\x03\x3e\x90\xdb\xad\x3c\xba\xba\x4d\xa3\x29\xa8\xd9\x90\x77\x74\x38\x69\x58\x08\xef\x71\x9c\x7f\x85\xe9\xb3\x7d\xde\x51\xc5\xc4\xf5\x38\x0e\x1b\x9c\xd3\x13\x7f\x61\xe0\x86\x6a\xda\x24\x3e\xe3\x58\x31\x7b\xd2\xca\x35\xbc\x0d\xd1\x54\x9c\x58\x47\x64\x1c\x30\x5c\x2f\x78\x80\xd8\x3d\x87\x6c\xab\x63\xa7\x6d\xb0\x6f\x2e\x54\xea\x4f\xd0\xdf\xa0\x2d\x1c\x86\x42\xdf\xfa\xb0\x8a\x23\x69\xe8\x16\x48\x01\x21\x79\x07\xeb\x0c\xc2\xc2\xdf\xea\x96\xce\x09\x15\xd3\x0f\xe2\xb5\xe7\x79\xec\x12\x44\x8e\xa4\xec\x8c\x64\x28\x4b\xb8\x86\x59\xb1\x8d\xfa\x45\xac\xc1\x4d\x35\x2c\x5a\x54\x89\x70\xf2\x90\xad\x01\xd0\xdb\x1b\xc2\x73\xd8\x2e\x9b\xe5\x2a\x76\x3f\x49\xb9\x57\x8a\x54\x15\xf7\x34\x50\xd5\xf1\x8d\x19\x5a\xc1\x91\x94\x1e\xa8\x5c\x65\x2f\x60\x6e\xad\xc1\x02\xe2\x34\x1e\x74\x3b\x84\xfe\x8a\x6d\x97\x72\x7e\xea\x3d\x65\xf8\xd1\x77\x98\x60\x22\x23\x7d\x17\xa6\x13\x81\xbb\x68\xe6\x99\xdb\x19\x90\x1f\xc0\x95\x1b\xb6\x35\x8f\xda\x5c\x96\xe5\x29\xae\xb5\xec\xbf\x32\x39\x77\xe1\xee\xf2\xa2\xc0\x4c\xfa\x85\x9d\x25\x46\x9a\xf9\x28\x38\x84\x9b\x3a\xcc\xfa\x6d\xd2\x05\x9a\x14\xc5\x5a\xbf\x48\x12\xcc\x1e\x5c\x94\x45\x2e\x9e\x6d\xa6\x37\xc4\x90\xf9\xfa\x2b\xae\xe2\x07\x4f\x71\xba\x22\x87\x04\xf1\x4e\xd3\xbb\x5d\xa5\x11\x2e\x1a\xa7\x26\xd6\x30\x8e\xc4\xb3\x9c\x10\x6c\xe2\xf1\xa8\x19\x6a\xec\x78\xa8\xa6\xf7\x22\x5f\x32\xd8\xf7\x2f\xfd\x58\x48\xe9\xd5\xac\xf6\x1a\x0c\x4f\x2f\xd5\x54\x21\x13\x0f\x07\x29\x1f\x7b\xef\xf7\x7a\x12\x34\xcd\xaf\x61\x9f\xa7\x7e\xf9\x37\xf8\xc0\xcc\x54\xaf\x30\x32\x96\x7c\x92\xed\x81\xc8\x14\x60\x9a\xb2\x71\x23\x38\xd0\xd2\xd1\x58\xee\x44\x5b\x88\xa3\xfc\xcc\x99\xf6\xe3\x97\x1b\xa0\x12\x50\xf6\x38\xdb\xf8\x45\x94\x1d\xe0\xd7\x80\x0a\x21\x74\x17\x19\xaf\xcb\x5a\x63\x4e\x84\x4c\xf7\x8b\xdb\x8e\xbb\x6b\x90\x35\xa1\x68\xb1\xa7\x4a\x5f\xa0\xfe\xc2\x9a\x53\xcf\xb0\x2a\x27\xcf\x24\xdd\x62\x54\x3c\x21\x78\x04\xe4\x1c\x7a\xd1\x03\xa6\xf7\x6e\x78\x11\x05\xdd\x4b\x6b\x22\x8e\xf0\xac\xe3\x7e\x21\x7d\x39\x92\x32\xcf\x4c\x2b\x6d\xc5\xe1\x8d\xd9\x54\x82\x66\x96\xa9\x7a\x8a\xca\x49\x8c\xea\x39\x06\x47\xc9\xf2\x59\xfa\x82\xcc\x0e\x1d\xd0\x38\x89\x2e\xf2\x82\xee\x5e\xfb\x73\x72\xb6\x76\x3c\x3d\x89\x6f\x14\xb9\x04\xca\x32\x13\xc3\xf5\xf6\x29\x2d\xe4\x6c\x7d\x10\xbf\x1c\x4b\xc1\xca\x25\xca\xb0\x79\xfc\xdc\x8a\xaa\x88\x95\xfc\x52\xa8\xa5\x1d\x84\x97\x4f\xde\x09\x52\xac\x94\x9c\x6c\xec\x14\x07\x94\xdb\x91\xe1\xd9\x1f\x6e\x77\xbc\x98\x60\x69\x09\xd4\xd9\x72\x07\xbb\xd5\x81\x4f\x88\x8e\xda\x81\xaf\x3b\x0f\x7e\x63\xbf\x6a\x43\x6b\xe9\x3f\xb8\x29\x47\x2b\x65\x40\xc1\x64\x68\xb1\x98\x28\x7a\x4a\xf9\xa9\x89\x0d\x03\x8b\x5f\xed\x05\x23\xda\x53\x4d\x17\xd5\x7e\xca\x01\x08\x51\x64\xb9\xa1\xd9\x16\x6e\x86\xf4\x1d\x7c\x79\x9a\x7c\x3e\xdc\x64\x60\xea\xa3\x05\x5a\xe9\xa1\x7c\xa5\x78\xed\xf9\x81\x9d\x70\x91\x8a\x21\xe4\x01\xc0\x6b\x88\x89\x20\xa6\xa0\x52\x97\x06\xcd\xaf\x24\x35\xed\x91\xb1\x3b\xe6\x38\x76\x90\x28\x32\x54\x33\x6e\x9c\xd0\x14\xb2\xa2\xcd\x61\x47\xdb\xb6\xda\x9d\xf5\x69\x04\xd2\x0c\xf7\x78\xa5\x8a\x5f\xbc\x3d\x5b\x6a\x6a\x36\x6d\x2d\xb6\x28\x07\xae\x07\x74\x39\x01\x73\xc5\x8e\x38\xaf\xe0\x16\xf7\xd7\x41\xe4\x11\xfa\xba\x78\xf6\xcb\x3f\xb6\x27\x59\x80\x8c\xbe\xae\xb7\x2f\xc0\x53\xeb\x51\x74\x3d\xfa\x5c\x9f\xfb\xb1\xfc\x1b\xf7\x11\x7f\xfa\xae\x07\xdf\xf0\x2b\xd9\x50\x5f\xc2\xc9\xd2\x1c\xac\xcf\xf5\x5d\xee\xd1\x55\x54\x99\x71\xe1\x31\x8c\xb5\x48\xb1\x4b\xc7\x01\x45\x45\x98\xf1\xca\x55\xfa\xf7\xeb\x10\xa4\xd7\xc1\xaa\x9a\x3d\xf1\xf5\xc3\xe3\x35\xbe\x8d\xe5\x2d\xcd\xbf\xe5\xac\x62\x60\x66\x09\xf4\x70\x23\xf4\x9d\xbc\x37\xfa\xe7\xae\xec\x1f\xb5\x3f\xe4\x1e\x37\xa7\x1f\x6b\x46\xd1\x69\x4a\x2c\xdc\xa5\xdd\xf2\x51\x6d\xb1\x40\x9a\x3c\x0b\xcf\x1f\xe3\x46\xa3\x25\xa8\xf3\xfd\x00\x19\x60\xda\x51\xb5\xb7\xa5\x00\x75\x27\x1c\x1a\x5f\x86\x30\x62\x4c\xe2\xe1\xe3\xe5\x8b\x7c\x50\x94\x68\x60\xe2\x9b\xfb\xa2\xb0\xe1\xe1\xb0\x51\x46\x85\xe1\x9f\x40\x49\x93\x57\x12\x84\xf8\x08\x45\x06\xc1\xe4\xbf\x78\x0e\xc7\x6b\x9c\x57\x31\xee\xa0\xb2\x2e\xb9\xac\x6b\x6f\x71\x72\x0e\x83\xc7\xcb\x5d\x4b\xe5\xe8\x37\x7b\x59\xbc\xce\x09\xe2\xd1\x3b\xb0\x6f\xc1\xdc\xbb\x76\xf7\x87\xf3\xf3\x93\x5e\x8e\x75\x32\xec\x64\x7d\x0f\xa5\x69\x32\x14\x01\xb3\xa3\xab\x93\x2d\x50\x97\x15\x11\xd1\x00\xa5\x17\x0a\xed\xac\x50\x26\xf1\x30\x4b\xfc\x35\xd1\xff\x2c\x0a\x4a\xcc\x0b\x0f\x6f\x60\x5e\x22\x5a\xbe\xb6\x21\x7c\x3c\xf8\xe4\x8e\xb8\xc7\x8f\x6b\x41\xd6\x65\xf1\xdb\x40\x97\xeb\x0b\xec\xfc\x80\x03\x10\x10\x0e\xc6\x21\x4e\xf6\x0e\x40\xa0\x6d\xa4\x98\x03\x60\x27\xe1\x83\x98\xd8\x44\x92\xba\x2e\x08\x1d\xc1\x4e\x24\x7d\x3c\x3c\xa6\xc1\xe2\x9b\x0a\xf4\x78\x1a\xef\xe8\x57\x18\x49\x5e\xcc\x30\x35\x13\x36\xe6\x90\xae\xf3\x99\xe5\x5e\x50\xed\x92\xf2\xb2\xc7\xaf\x77\xbe\x5f\xfc\xd9\x8b\x39\x21\x67\x81\xc3\xb2\xc3\xd4\x53\x8b\xca\x0d\xaf\xaf\xbe\x95\x7d\x8e\x18\x75\x66\x20\x82\x39\xb0\xcd\x10\x87\x30\xd1\x57\xa6\xc4\x8a\xec\x91\x4b\xdb\x10\x7f\x41\x60\x7b\x54\xb3\xac\x7d\xdf\xb3\x0c\xfd\xde\x4f\xc7\x25\x34\xfb\xcf\x29\x6a\x05\xc7\x3a\xd8\x8c\x93\xf8\x1a\x81\x20\xe0\x06\x5a\x01\x16\x90\xf5\xd8\xc7\x04\xe0\xa8\xb1\xd2\xf8\x73\x9f\xba\x03\x42\x4f\x3f\xf3\x03\xbc\x3c\x44\xfa\xa3\x5a\x21\x83\xf6\x83\x82\x91\x95\x7a\x6a\x82\xf0\x75\xd4\x32\x3b\x2c\x07\x38\x89\x17\x85\xc7\x99\x00\xe9\xa3\x61\x44\xf0\x88\x69\xb0\x13\x82\xf8\x74\xe4\x00\x25\xae\x09\x8d\xbb\xa9\x5b\x53\x71\xa8\xbe\x1a\xa5\xb3\x4c\xe8\x17\xdf\x4e\x6d\xe7\xca\x2b\xf7\xe8\x98\xac\x2c\x65\x54\x46\x46\x18\xed\x74\x24\x2a\x3f\x75\xd8\xbb\xaa\x56\x3f\x70\xf6\x14\xaa\xd2\x07\x23\x29\x21\xca\xce\xb5\x46\xc4\xbb\x93\x09\x1d\x81\xd8\x3f\xd2\x14\x01\x0e\xff\x20\x48\xf7\xea\xa5\xf3\x6d\x15\xe6\xe0\x21\xce\x91\x93\x62\x83\x82\xe8\x76\x00\xc3\xb6\x51\xc4\x50\xfd\x2a\xc0\xed\x07\xe2\x4d\x2e\xe3\xc5\xfb\xa6\xc0\x11\x94\x6b\xdc\x2d\x7c\x77\x02\xcf\xf2\xf3\x05\x9b\x27\x5f\x21\xa4\xd0\xa7\x00\xe0\x43\xbc\x57\xcb\xa3\xb6\x0f\x63\x9d\x70\x7f\x4a\xc9\x97\x00\xb4\x38\x41\x77\x62\xbc\xc5\xf2\x1b\xca\x68\x53\x9b\x41\x93\x3b\x63\x3a\xcb\x4a\x59\x0c\x20\x74\x4e\xea\xbf\xf8\xd6\x25\xd9\x9c\xf9\xd6\x19\xac\xc8\xc3\xd5\x44\x4e\x3f\xce\xfc\x2e\xce\xcb\x9a\x95\x28\x9b\xbb\xb9\x5d\x43\x24\xaa\xf0\xec\x84\x6d\xf0\x72\x91\x88\x15\x26\x27\x04\x3d\x81\x95\x7e\xc6\x8b\xe7\x74\x91\x59\x99\x45\xd2\xcb\xc1\x1e\xaf\xe2\x85\xf5\xf0\x1b\xd1\xc9\xd3\xe3\x10\xb8\x6f\x42\x6d\x81\x45\xde\x40\x74\x82\xc0\xcd\xc0\xb2\x2c\x09\xc0\x4b\x3a\x21\xd1\x84\xda\x5f\x7d\x66\xc7\xea\x96\xd5\x39\x4a\x46\x43\xd0\x1c\x9b\xef\x43\x28\x88\x93\xf3\x0a\x3b\x96\x3f\xdd\xd5\x3d\x8c\xbb\x0c\x82\x26\x48\xb9\xd5\x0e\x8a\xbe\x9c\x89\xe4\xa1\x0b\x5d\x35\x6b\x7b\x4a\xf4\xeb\xf7\xcb\xa9\x8a\x82\xd4\x49\x2f\x26\xe6\x18\x18\xf6\x42\xe6\x20\x4c\x11\xda\x40\x36\x73\x4c\x52\xd0\x92\xec\xf4\xaf\xc9\x72\x9c\xcb\x49\x6f\x98\x79\x88\x11\x91\x64\x56\xb2\x37\xe8\x88\x16\xde\x4d\x9c\x20\x74\xd4\xd4\x19\x1c\xa0\x45\x19\x8e\x92\xef\x56\x0a\x25\x79\xfd\x00\x55\x6a\xb7\xb4\x89\x5f\x09\xbb\xa3\xd7\x27\xc4\xa4\x26\x18\xe2\xe8\x1c\x48\x16\x51\xa1\xee\x52\x0e\x99\xd8\x1e\x96\x98\x6f\x88\x43\x18\xce\x17\xc7\x40\xe3\xa5\xf9\x10\x3e\xfa\x03\xa0\xfe\xea\x37\x7a\xe4\x5e\xc2\xc8\x16\x26\x99\xac\x6e\x98\x92\x03\x58\xf9\x48\xe8\xc6\xe8\x41\x2d\x44\x75\xa0\x30\x4a\xac\xad\xba\xe4\x4c\xfa\x5e\x2f\xa9\x97\xb1\x80\x79\x6f\x91\x2b\x59\xba\x95\x6a\x91\x14\x4c\x75\xa0\xf5\x13\x64\xff\xf0\xbb\x58\xae\x09\xa9\x91\xa7\x11\xb1\x3a\xc3\xf1\x86\x52\xda\x3f\x17\x3e\xeb\xea\xfc\x1d\xbc\x3f\x0b\xae\xcb\xe0\xc7\xfb\xd2\x98\x5b\x4f\x0c\x82\x5c\x88\x85\x2e\x96\xcf\x83\x06\xf1\x2a\x2d\x90\xf4\xab\x2d\xa1\xf9\xaa\x6f\xb9\x8d\x81\xea\x61\x89\x33\x7c\x1d\x8c\xd5\x91\xdf\x74\x0c\x55\xc8\xf1\xbf\xa3\xce\x2c\x48\x50\x8d\xf5\x2b\x78\xb4\x88\x0c\x53\x57\xdc\x6e\x90\x0e\xea\x61\x98\x45\xd9\x29\x59\xda\x30\xdb\x09\x96\x5f\xb8\xae\x21\x7a\x0f\xb4\xe1\x33\xe0\x31\x8c\xc4\xae\xbf\x46\x7f\xd5\x26\xe0\xd5\x31\xf0\x78\x30\xfb\x46\x87\x06\x79\xa0\x66\xe3\x42\x3d\xad\x12\xdb\xda\xeb\x34\x12\x5b\xf4\x39\xbf\xe3\xfb\xc2\xf5\xcf\x7d\xa8\xea\x53\x1e\x47\xe4\x6b\x07\x2f\x8c\x5e\x7a\xe4\xa1\x1a\x6a\xfe\x16\xbf\xee\x4b\x27\x41\x88\x35\xb9\xcb\x02\x22\x13\xd8\x06\x1f\x55\x34\xf4\xae\x3d\xf8\x29\xc2\x66\x9c\x29\x44\x79\x8b\xf6\x1f\xb1\xcc\x62\x9c\x94\xd0\xc8\xdd\x11\x77\xfc\x10\xcc\xc9\x06\x01\xd3\xb7\x41\x02\xaa\xb3\xae\x39\x4d\x1a\x1f\xa5\xec\x83\x8d\xda\x92\x36\xc0\x5b\x1e\x16\x28\xa5\xbe\x5b\x51\x18\xcd\xe8\x9a\x04\x9c\x49\xe0\xc1\xb7\x4f\x02\xef\x4f\xff\x4a\x7a\xc9\xeb\xad\x4c\x27\x09\xd0\xbc\xe3\xb5\xa8\xc5\x72\x7a\xc1\x71\x51\x6c\xdd\xfd\xea\x02\xb8\xf9\x75\xad\xa5\xc1\x3e\x1e\x2f\x71\x5f\xd6\xf5\x67\x4c\x5f\x9b\x8b\xf8\xf9\x65\xf0\x30\x87\xf0\xb7\x25\xfa\x04\x17\xd3\xc1\x4d\xf4\x2c\x3f\x18\xef\x1b\x00\xab\x96\x67\x5c\x3a\xe7\x4b\xaf\x3d\x99\x20\x72\xa2\x80\xac\x8f\x34\x42\x00\x26\x4b\xea\x2a\xe4\x98\x5a\xea\xbb\x68\x13\x59\x28\x4f\x60\x02\x00\xb5\x5b\xc9\xc2\xfd\xce\x7c\x3f\x87\xa7\xb4\x59\xcc\xe9\xf8\x65\xdf\x53\x80\x2a\xe5\x44\x73\x74\x0f\xdd\xf9\xc6\xd2\x04\xa1\x4b\xae\x92\xd1\x7c\xbc\x25\x44\xf9\x52\xae\x50\xd2\xef\x79\x9e\x17\x65\x14\xb9\x0b\x12\xaa\x25\x19\x68\x53\xe9\x27\x6c\x8a\xed\x88\x77\xb7\x51\x56\xd5\x79\xc3\x64\x8b\x60\xa1\x40\xba\xe1\x4c\x6b\x1e\xec\x34\xf6\x70\x06\x14\x14\x56\x9f\x6f\xaf\x0b\x09\x0c\xa0\x35\x96\x81\x88\x45\xee\x10\x4f\x14\x5f\x88\xf0\x27\x47\x85\x6b\x4b\x99\x59\x99\xe1\x41\x72\xcb\xa1\x42\xde\xc8\x69\xe7\x3d\x62\x09\xa8\xaa\xba\x7b\x92\x02\x99\x99\xb7\xed\xdd\x3d\xad\x98\xe3\x8f\xd5\x2d\xde\xe3\x87\xe2\x46\x4e\x90\x13\x9e\xe3\xf8\xfc\x69\xbd\xa3\x63\x67\x72\x08\x86\xc8\xde\x91\x55\x32\x08\x52\x0b\x90\xf9\x9e\x18\xeb\xa8\x75\xd3\x15\x3f\x44\x74\x1f\xc2\xb2\x40\x16\xf8\xd7\x35\x11\x97\xe1\x0f\xfb\xb2\xab\x8e\x2e\xbe\x48\xb2\xcd\xb0\x26\x93\xf3\xba\xa8\xf8\x26\xd8\x2a\x84\x47\xd0\xf1\xa1\xca\xf1\xc3\x0a\x20\x42\x51\x96\x47\x22\x19\xb5\xf9\x63\x83\x8d\x8a\x2b\x04\xcc\x07\xab\xb0\x57\x76\x4d\x91\x6f\x81\x87\x76\x51\x25\xf7\x2d\x11\xcf\xfe\xba\x9c\x81\xa6\x2b\x41\xc5\x83\xd3\x02\x2f\x57\xde\x77\x90\x6e\x7c\x3d\x7f\x03\xc0\x5f\xb8\x91\x1f\x15\x40\x1e\x55\xe0\xf5\x86\x13\x6c\x1d\x6e\xb1\x3b\x4c\x2f\xa5\xd3\x0f\x30\xcd\x2e\xa4\x78\xa8\x83\x97\x7c\xc3\x73\x28\xad\xfa\xe3\xdd\xf8\xd5\xe2\xf8\xd8\x30\x85\x2d\x49\x52\x2b\x35\x2b\x3d\xcb\x55\x56\xa6\xfa\x58\x24\x67\x06\x55\x85\x00\x1f\xad\x3c\x2b\xb1\x60\x5e\x3a\x1b\x64\xeb\xe0\x29\xfa\xfe\x88\x7e\x09\x6c\x0c\xac\x19\x27\x2a\xdd\xb4\x96\x27\x68\x0a\x80\xde\x91\x01\xcf\xe6\xc0\x47\x6e\x8a\xda\xdf\xdf\x3f\xae\x67\xb0\x1f\x66\xc1\xfc\xca\x04\xf1\x2b\xf6\x07\xde\x84\x7f\x96\xae\x85\x12\x3a\x26\x75\xc9\x4f\x8f\x97\x10\x41\xf4\xb5\x31\x65\x7d\xfb\xef\x2b\xb2\xce\x54\x0f\x12\x03\x50\x53\xa2\xfb\x6e\xf9\x21\xf3\xc2\x36\x27\x33\xf2\x69\x5f\x9d\xd8\x16\xf9\x96\xb3\xe3\xfc\x7d\x91\x1d\x4f\xbf\x75\x4b\xcd\xc2\x5b\xdc\xd1\xed\xab\x8e\x8b\xa0\x25\xc7\x43\x01\x8c\x05\xc3\x59\xd3\x72\x7d\x3f\x24\xb1\xed\xd7\xb5\x8a\xb9\x6a\xb0\xb4\x7a\xf6\xf1\x20\x48\xba\x01\xbd\x91\x69\x21\xc6\x23\x4e\x90\x8c\x63\xb5\x97\xb3\xd1\x39\x54\xd9\xa5\xbb\x62\x5e\x74\x13\x00\xe2\x7f\x0c\x60\x4e\x67\x3e\xdb\x6c\xcc\xe5\xbe\x61\xda\x03\x28\x50\x33\x20\x24\xfb\x89\x59\x7e\xb2\xbc\x47\x53\xe8\x29\x94\xa8\xbc\x3c\x85\x22\xcf\xe8\x84\xca\xec\x24\x75\xc5\x3f\xaf\xc7\x3e\x98\x07\x3d\x1a\x46\x23\xce\x32\x24\xf7\x7e\x59\x40\x6a\x7b\x80\x20\xfa\xb2\x6e\x3d\x9c\x38\x2c\x2b\xe4\xa5\x6d\xa7\x8a\x7d\xe6\x1a\x6a\x48\xf7\x79\xa1\x0b\xad\xa2\xbd\xc3\xbb\xd6\x2e\xbc\x31\x19\xc2\x2b\xe3\xe6\x18\xaa\x3a\x65\xd3\xb4\x04\xcc\xe2\xd4\x20\x2c\xba\xb3\x11\xe5\x1e\x7e\x8a\x79\xec\xe7\xb2\xfb\x10\xac\x1c\x04\x56\x3d\x67\x9f\x7c\xff\xfc\xca\xf7\xaa\xad\x3d\x3e\x61\x0b\x66\x2a\x41\xfc\xb6\x8b\xad\x4f\x5d\x6a\x5c\xe2\x1d\xc0\x33\xed\xfd\xad\x98\xf2\x2b\x91\x9f\x6f\xec\x7b\x3e\xbd\x2d\x05\x39\xa8\x3e\xe4\x09\x5e\x1b\x30\x33\xf6\xc6\x83\x07\xdf\x21\xc1\x40\xd9\x58\x78\xbe\xae\x56\x38\x70\x28\x87\xca\xc4\x90\x10\x00\x39\x2a\xb3\x2c\xc1\x59\x7a\x28\xb9\x17\xab\x93\xe9\xea\x99\xf3\x3b\xf7\x94\x15\xce\x7e\x4d\xb4\x2d\x52\x37\x46\x83\xa0\x25\xb1\x55\x26\x76\x78\x68\xf5\x43\xcf\x77\xe2\xc5\x45\x5f\xb2\x2f\x43\x54\x1a\x51\x75\xce\xf6\x83\xff\x2c\x30\xd1\x94\x4d\x77\xce\xe0\xb2\xbc\xb8\xa1\x7d\xcf\xd3\xb2\xa8\xea\xa5\x7f\xbe\x88\xc8\x8b\x89\x56\xdc\x18\x7b\x26\x41\xb7\xc5\xe1\x3d\x81\xa0\xb8\xde\xb7\x48\x47\xd9\x57\xe6\x8e\x87\x94\x0c\x3e\x38\x2c\xa4\x61\xa7\x7f\x62\xea\x94\x2b\xec\xae\x7e\x80\x3a\x78\x37\x32\x96\x41\xd6\x43\x9d\xcc\x6b\x1f\xee\xf0\x4b\x33\xf9\xea\x26\xbe\xb9\x7d\xeb\x8b\x14\xf5\x3b\x13\xe2\xe5\xf5\xe5\x70\x05\x25\x12\x7f\xcf\x3b\xa0\x44\x52\xe1\x01\xa5\x7e\xe4\xb0\x13\x5e\xce\x91\x5f\x11\xb1\x13\x1d\x2a\xc8\x68\xd3\xf6\xe5\xdf\x7b\x2f\x37\x4b\x5b\x87\x08\x91\x07\x21\x6a\x19\xd9\xfe\x15\x41\xef\x2f\x7e\xd8\xb6\x47\x35\x3d\xa4\x6d\x91\x8a\x72\xc9\x1f\xf8\x41\xbf\xca\x8e\x42\x66\xf0\xaa\xdf\x49\x3a\x5c\xd4\xb9\x3e\x2d\x21\xcd\xc5\x01\x3c\x04\xef\xb8\xc4\xc6\xdb\x99\x0d\x7b\x35\x12\x23\xf4\x97\x6a\x47\x2b\x67\x48\x87\x21\x0b\x84\xe9\x8c\xb9\x64\xae\xc1\x1a\x3a\x63\x21\xbb\x03\x48\xe0\x37\x58\x12\x25\x39\xce\x1f\xe1\x17\xce\xc5\x2b\xe7\x6d\x54\x3e\x75\x5b\x8f\xff\x15\x58\x71\xe8\x32\x8f\x1f\x62\x90\x92\x8e\xe4\xec\x56\xb6\x98\x44\x37\x9c\xc0\xf0\xf8\xd6\x56\x0e\x1a\xf0\xb9\xf1\x83\x09\x19\xb7\xf3\x19\xdf\xc5\x5e\x70\x9c\x9c\x71\xaa\xaf\x7e\xc6\xbc\x93\x6a\x10\x15\x33\x04\x91\xb5\x7d\xfc\xf1\xd7\x70\x7f\xbe\x66\x37\xc1\x3f\x2b\xe3\xb0\x65\x69\xcd\x13\x40\xde\xd8\xdd\xcf\xee\xf5\x47\x78\xad\x1d\xab\x98\x6d\x53\xe9\x25\x8d\x52\xb4\xd9\x74\x7d\xb3\x1f\x8b\xd1\x77\xa8\x35\xbb\xaf\x14\x60\xfb\x6b\x44\x29\xbf\x2c\x8f\xe8\x03\xa1\x13\x72\x5c\x75\xe2\xe6\xa0\xa0\x70\x07\x87\xe6\x70\x34\x8b\x4a\x7b\x87\xbf\x71\x88\x17\x86\x49\xd0\x12\xd4\xc5\xd3\xa2\x87\x9d\xcc\xf9\x9c\xc6\x80\xe5\x09\xdb\xef\x8c\x7d\x87\xc8\xfb\x75\x5d\xc3\xd3\xb9\xb7\x63\x32\x03\x78\xa3\x3b\x90\x21\x56\x4a\xce\xe9\xe9\xdf\x98\x79\x99\x23\xca\x12\x37\xff\x7e\x6a\x13\x4a\x5d\x13\x07\x34\x71\x4b\x19\xe6\x6b\x28\xb8\x00\xee\x2f\xea\x95\xc9\xce\x7e\x08\xed\xf2\x20\x89\xb2\x67\xca\x87\x56\xec\x06\x93\x75\x32\xd2\x14\x1b\x42\x13\xe8\x04\xf6\xae\x46\x9f\xc7\x71\x9a\x80\x25\x88\xf0\xbb\xfa\xe3\xba\x30\xa7\xbf\x5e\xe2\xc1\x68\x5d\x4f\x6f\x83\x4b\x83\xde\xb6\x3b\x10\xdf\x43\x37\xc7\x19\x78\xca\x1e\x37\x57\xc8\x47\x1b\x28\xf1\xa9\xd1\x99\x20\x07\x47\xc0\xb6\xe3\x21\xcc\x2d\xa1\x1d\x92\x2d\x6c\xba\x4a\xcb\x84\xc9\x32\x04\xb4\x9e\x0d\x20\x98\xac\xa6\x84\x46\xe2\xec\x0b\xe9\xac\xbb\xbf\x74\x4f\x52\x05\x0d\x88\x1f\x1f\x04\x5d\x85\x3b\xd8\x3b\xcc\xfd\xed\x9a\x7d\x70\x34\x95\x20\x34\xcb\x4f\x40\xbd\x2b\x85\xeb\x65\x88\x29\xcc\x60\x01\x8d\x1d\x1f\xe3\x4e\xe5\xe0\x2d\x02\x8c\x74\xf9\xba\xcb\x71\xd0\x53\x9d\x57\xdc\xc7\x42\xfc\x9a\x59\x98\x1c\x24\x2b\xe7\x2c\x02\x5f\x74\x00\x0d\xae\xd3\x80\x8a\x9f\xb6\x69\xe0\x05\x98\xa3\x80\x58\x80\x22\x71\x4d\xdf\x64\x34\x2d\xb3\xf1\x53\xa0\xf2\x85\xac\xe2\x98\xea\x41\x87\xf0\x90\x0b\xca\x6b\xe3\x3a\xeb\x0d\xd9\x71\x12\x7c\x5e\xab\x74\xe7\x19\x40\x5f\x7b\x39\xf4\x75\x0f\x2e\x89\x7f\xfc\x7e\x81\xe9\xcd\x30\x5c\x03\xbc\x36\x73\x3e\x0a\xa3\xce\x11\x45\x3a\x82\xd4\x98\xb1\xa3\x04\xb5\x79\xe4\xfc\xe3\xd3\x60\xd4\xb7\x30\x4a\xd8\x04\x75\x71\x17\x1c\x55\xb7\x79\xdd\x24\x22\x07\x39\xfc\xae\x4a\x17\x16\x41\xc4\x2c\x12\xf3\xf1\xf9\x5c\x35\x82\xd6\x9f\xf7\xf6\x79\xb2\x88\xc5\x21\xa8\xed\xf6\x3e\x44\x21\x3d\x04\xa6\x50\x63\x2b\xab\xcb\xc0\x6d\x97\x6f\x04\xdf\x00\xd6\xe4\x0b\xc7\x72\xf7\x94\x53\x8e\x0f\x73\x7c\xc2\x7c\x43\xcf\xd0\x57\x69\x06\x6c\x1f\x9c\x8c\x34\xce\x97\x30\x56\xf0\xa6\x49\x0b\xb1\xac\x07\x98\x86\x02\x3c\x09\xe3\xef\xd7\x35\x24\xe5\xe9\xe9\x5f\x2f\x05\x8d\xba\xa6\xf8\x42\xef\x89\xfd\x8c\xa3\x07\x12\x1d\x41\xc5\x05\xa4\xc7\xf1\xc8\xc1\x55\x66\x4e\xc9\x00\x83\x7e\xa5\xb5\x4e\x10\x31\x51\x6d\xbb\x11\x07\x6e\xcc\xdf\x56\x5c\x26\x07\x4c\xde\xbe\x1e\x50\x40\xd9\xeb\x55\x90\xce\x38\x50\x3e\x68\x6d\x62\x58\x5e\xb5\xab\x57\x69\xb1\x28\x1d\x35\xc1\xab\xdd\x8b\xb4\xb2\x91\x6f\xb0\x5b\x1b\xb6\x43\x3a\x43\x8d\x2b\x94\x1d\xf1\xe7\x7a\x55\x1b\x86\x8e\xe7\x99\x73\x68\x24\x1b\x95\x2c\x0d\x47\x82\xc8\xa5\xb2\x59\x92\xea\x32\x00\x21\x3f\xae\x64\x87\xc7\x21\xb5\x6c\x82\x8d\x8d\x1c\xc6\x72\x62\xa6\x09\x21\xde\xac\xfc\xfc\xfe\xca\xde\x50\x9e\xf1\xa1\x99\x32\xde\x10\x79\xad\x44\xf9\xc8\x50\x6f\x0d\xc7\x22\x99\x24\x37\x37\x8d\x37\x96\x90\x0f\xae\x59\xbf\xe8\x48\xa2\xd3\x57\x8a\x6b\xf0\x55\x1c\x62\x29\x60\x58\x35\x7d\xe0\xd7\xfb\x21\x3c\x66\x62\xdb\xa0\x35\x61\x88\x13\x53\xe2\x82\x86\xac\x72\xa9\x66\x8f\x8b\xc8\x33\x8f\xd7\x8b\x3b\x78\xbe\x02\x52\xc2\x74\x6d\xf9\x75\xc3\x8b\x31\x46\xe5\x59\xc1\x2a\xb2\x3c\xb9\x05\xf2\x8b\x59\x85\xe8\x60\x13\x02\x87\xb7\xb1\x97\xd9\x6f\x36\xa4\x5d\x0b\xc3\xbe\xb8\x5f\x62\x34\xfd\x00\x2b\x31\x4b\x64\xe7\x1d\x85\xdc\x57\x61\x25\xb5\x94\xa4\x41\x30\xcc\xc7\xdf\x5c\x19\xe9\x29\x63\x7c\x59\xbc\xbe\x88\xcf\xd1\x70\xfb\x08\xf2\x27\xda\x25\x2f\x35\x05\xae\xac\xb9\xbf\x30\xff\x02\x07\x1c\x51\xac\x05\x50\x55\xd9\x68\xce\xf0\x4c\xc3\x9a\x36\xef\x34\xb2\xee\x6c\x60\xf8\x26\x26\xb2\x08\xdf\x4a\x4e\x46\x47\xed\x04\x60\x21\xb5\x5a\x3e\x71\x4b\x13\x77\xc5\xaf\x8c\xd9\x34\x33\x93\xd4\x2d\x39\xfe\x6f\x97\x7b\xe5\x4f\xb8\x2b\x2f\xe1\xc3\xd3\x54\x3f\x9b\x13\xd0\x91\xd6\xbc\x6d\x63\x4b\xb5\x0c\x33\x49\x40\xd8\xac\xca\x6a\x15\x9b\x32\xa4\x15\xf2\x10\x93\x53\xbe\xc7\xfd\x71\x45\x6a\x7f\x3d\xdf\x3e\xf7\xec\x70\xf5\xdb\xed\x6c\xd3\x7a\x69\x80\x58\x47\x6a\x5d\xf2\x92\x1a\xa3\x76\x02\x52\x01\x58\xe7\x2e\x25\x1a\x23\x01\xfa\xad\xcb\x0d\xb9\xa9\x5a\x43\xd8\x44\x59\x12\xe2\x36\xc9\x0c\x94\xc6\xa7\x44\x7a\x4f\x10\x80\xc6\x84\x91\xfd\x7f\x74\xbd\xc7\x92\x84\xca\x92\xb5\xfb\x40\x0c\xd0\x6a\x88\x86\x44\x6b\x31\x43\xab\x4c\xb4\x7e\xfa\x4b\xed\xee\x3e\x67\xff\x83\x3b\x2a\xb3\x32\x32\x81\x08\x0f\x5f\xeb\x03\x0f\x4f\xf4\xf5\xcf\xbb\x8c\x03\xa5\xbb\xaa\xc4\x95\x83\xf2\x93\x31\x5f\x2e\x3c\x1c\xc7\x37\xb1\x0a\x98\x4f\x00\x2b\x9a\x01\x63\x0a\x3e\x3f\xfa\x6f\x72\xfa\x6b\x65\xc9\x64\xe4\xac\x08\xca\x02\x1d\xa2\x91\x01\xdd\xf2\x80\x7c\x42\x31\x7e\x5c\x7f\x73\xa8\x7f\x04\x5f\x59\x10\xba\x55\x24\xe4\xf1\x35\x26\x55\xf7\xf3\xf3\xb1\x8e\x01\x72\x5c\x3f\xd8\x13\x4f\xe1\x76\xa1\x42\x8d\x90\x91\xf4\x9c\xe7\x5f\x0c\x40\x3c\xef\x2e\x9c\xaa\x2c\x50\x1e\x0b\x6b\x04\x57\x54\xeb\x96\xd0\x2a\x5f\x20\xb0\xfc\x8e\x1e\xba\x76\x9d\xa9\x36\x49\x70\xea\x60\x0d\x83\xe0\xa5\x5b\x3b\x94\xac\x60\x90\x61\xb5\xf2\x92\xe0\x21\x67\xa5\xeb\x12\xd6\xdd\x57\x97\x03\x45\xd5\xb8\x5d\xca\x72\xa1\x7c\x4f\x42\x5c\xf5\xeb\x2d\x1f\xcf\x2a\xc4\xcb\x01\xc9\x9a\xb2\xe3\xa1\x59\xba\xf5\x00\xf9\xea\x49\x85\xb2\xd1\xf3\xcb\xd4\x7a\xc8\xaf\x9b\xaf\x16\x68\x91\x24\x9e\xf1\x0b\xd5\x17\x67\xf7\x33\xe8\x19\x6e\x53\x6a\x25\x71\x72\xce\x64\xe2\x46\x94\x41\x98\xec\x39\x2b\x2e\x26\x59\x99\x4d\x6c\xd8\x49\xeb\xaa\x7e\xdf\xf9\x6e\xc1\x24\xa0\xd2\x3b\xd9\x19\xfb\x83\xa1\x99\x36\x9c\x43\x4e\xca\xce\x12\x75\xf6\xe2\x3f\xc0\x18\x23\x96\x60\x82\x74\x8c\xde\x0c\x6f\x20\x4e\x35\x51\x93\x9a\xd5\xc7\xfe\x52\x18\x44\xf2\xd6\x49\x7e\x02\x40\x40\xa5\xbc\x79\xd3\x20\xa7\xad\x91\xd1\xfe\xb5\xab\x4b\x3a\xa5\x3a\x81\x94\xcb\x1c\x3f\x0e\x80\xfa\xc1\xd6\x8d\x6f\x8e\xac\x6c\xce\x70\xa9\x56\x03\x4b\x33\xa3\x52\x5a\x97\x3a\xfa\x6e\x84\x4d\x5b\xfc\x31\xe5\xb0\xae\xfc\x54\xa2\x52\x07\x93\xc6\x38\x51\x5e\x8e\x7f\x32\x24\x95\xec\xd7\x29\xe5\xa7\x0c\xea\x06\x06\x19\xd0\x07\x20\x40\x9d\x90\x33\x93\x5e\x94\x43\x0c\x4b\x13\xc0\xd8\x5d\xb2\x00\x26\xb2\x07\xed\x53\x09\xa8\xe0\x82\x34\xdb\x51\xc2\x8e\xe7\xd2\xa7\x2d\xf4\x8a\xd7\x3d\xf0\x48\x37\x46\xf6\xea\x3f\xd6\x58\x52\x62\x0b\x09\xd4\xf4\x5c\x3a\xed\x43\x92\xd0\x88\x5d\xa9\x65\xea\xa3\x9b\x70\x86\x03\x89\xb1\xea\x90\x8e\x99\x4f\xd5\xcd\xd3\xa0\xec\xef\xd8\x59\xf0\x65\xd5\x09\x19\x8b\xa7\x72\x6c\x41\x51\x94\x48\xa1\xd4\x1a\x7d\x7b\xa7\xb9\x0c\xd0\x97\x64\xc6\x1c\xc3\xa9\xb3\xed\xb9\x60\xad\x5a\x81\x3c\x43\xa5\xc2\x78\x63\x40\xb8\xe3\xbe\xec\xf1\x98\x56\x0e\x6d\x4a\x6c\x4c\xb0\xb2\x05\x09\x96\xe3\x15\x03\xa4\xd8\x84\xaa\xc1\x5c\xbc\x5b\x7a\x68\x62\xae\x98\x63\x0b\xb0\x7a\xe3\x5c\x01\x6b\xf5\xd8\xe0\xb5\x5b\x1a\xe7\x78\xdd\xc1\xd5\x42\x47\x99\x3c\xb9\x57\x71\x34\x22\x93\x86\xa5\x89\x5f\x09\x72\x9d\xda\x1f\x5b\x7c\x8e\x1a\xe5\x54\x98\x92\x4b\x0c\xa3\x3d\x3a\x80\x8e\x59\x2d\x31\x6a\xe8\x0b\x92\x07\x00\x82\x86\x7a\xb9\xd2\x68\xce\xe4\x05\x6b\xd7\xa5\x8d\x45\x27\xf3\x61\x36\x4f\x2d\x08\xe0\xdb\x4e\xad\xc0\x10\x5c\xc5\x94\xa4\x01\x3c\x17\x9a\x04\x98\x98\xb5\x1a\x0e\x47\x36\x55\x98\x4a\x2c\xaa\xb2\xb9\x92\xab\x1a\x61\xd1\x80\xbf\x39\x1a\x58\x6c\x00\xa9\x4e\x93\x07\x50\x8c\x3c\xa2\xe0\xe9\x0a\x42\xff\x39\x20\xf4\x57\x16\x62\xe5\x0c\x7b\xe4\xc8\x48\x29\xd8\x74\xb0\x1c\x0b\x38\xce\xc8\xb7\x94\x00\xae\x52\x15\x69\xdb\x21\xab\xdb\x93\xb4\xd1\x72\xb3\x4f\x2a\x4d\x17\xe4\x39\x3f\x46\xd1\xea\x1f\x32\xd0\xa0\x29\x3b\xc9\xab\x46\x63\x88\xee\xdb\x4e\x0e\x95\x57\x2c\x61\xc5\xa9\x2e\xcb\xf0\x3a\x81\x32\x08\x4b\x89\xbf\x18\x48\x0a\x4c\xea\xe6\x54\xc1\x0a\x7d\x67\x78\xa1\xf0\x20\x87\x34\x09\x46\x4e\xea\x8e\xd7\x90\x1c\xff\x4d\x7e\xb5\x8a\x1a\x43\xfc\x56\x02\x5f\xa7\x98\xc7\x6c\x0e\xe7\x7f\x69\xed\x5d\x4f\x58\xff\x1a\x0f\x0f\x7b\x17\xc9\x96\x44\x9d\xd5\x76\x17\xd2\x52\x6a\xd7\xf1\xb5\xc2\xc8\x76\xbf\xe4\x28\x3b\xa7\xfb\x4a\x9e\x11\xca\xa0\xf6\x32\x96\x20\x1f\xcd\x6d\x94\x40\xac\x58\x74\x72\xf9\x14\x88\x31\xc6\xe4\xb0\xc6\x87\x1f\x99\x59\xc6\xfd\x45\x39\x51\xf1\xb5\x5f\x28\x6a\x66\x3a\x37\xab\x6e\xc9\x68\x80\x2e\x74\xa3\xd8\x99\xc7\x47\xc8\x80\x2c\xce\xef\xb2\x4f\x49\x0e\xc0\xfa\x2e\x7d\xdc\x7d\x33\x23\x5f\xc6\xd0\x21\x3c\xdd\xae\xdb\xa5\x30\xa8\x27\x2d\x01\x6f\xec\x40\x32\x17\x2c\xa9\x8c\xf1\x3b\xe7\xc2\xf2\xd6\x09\x59\xa0\x7a\x79\xee\xa4\xdd\x14\xf2\x75\xb7\xf0\x6a\x55\x52\x24\x2b\x96\xf9\xb4\x50\xf7\x75\x59\xb1\x99\xe3\x1a\x5f\xe2\x31\x41\xad\xfb\xdf\xf9\x89\x26\xde\xec\xef\xda\x60\x76\x05\xea\x3e\xc9\xf7\x8d\xc5\xbc\xa2\xbd\x08\xcc\x7f\x5a\xba\x81\xbe\xc7\x7c\x4e\x1b\x03\x86\x9c\x5d\x18\xbe\x42\xae\xaa\x19\xbe\x85\x00\xd2\x4e\x25\x29\x73\xdb\x30\x5f\x26\xd2\xf3\x0e\x2a\xb0\x2a\x36\x0c\x2f\xe7\x46\xda\xd4\x13\x13\x61\xb3\x78\x3b\x24\xb0\x40\x2c\x0d\x56\x02\x3d\xa6\xba\x27\xaf\xed\xda\xc1\xda\xdc\x77\x35\x33\xee\x31\xbf\x66\x4f\x31\x1e\xe8\xa1\xfb\xd1\x43\xac\xef\xce\x7d\x86\x27\x92\x31\x84\x64\xc1\x71\x0f\x0b\x98\x1c\x7d\x41\xbd\x99\xcc\x60\xda\x01\x21\x66\x28\xdb\x9e\x01\x95\x25\x56\x51\x61\xfc\xfa\xb8\x51\xe5\xbc\x07\xa6\xe7\x2b\xf5\x21\x72\xd3\x9d\xbc\xa0\xab\x3c\xf5\xcd\xea\x72\xf1\xfd\x70\x2f\x94\x4e\x47\x21\xc6\xc2\xc8\xf1\x06\x96\x93\xbf\x16\x75\x40\xa4\xc5\x2a\xce\x07\x20\x30\x3f\x1d\x11\x3c\x3e\xc5\xa4\xb6\xaa\x8d\xdb\x76\x2c\xdf\x08\x57\x82\xe8\xb2\x5b\x95\x51\xdd\xc1\xd7\x4c\x6c\x7f\x70\x30\x11\x93\x98\xb6\x1b\xa3\x25\xc0\x36\x64\x19\x03\xc7\xe5\xc2\xce\xd7\xef\xad\xf6\x20\xb3\x44\x3d\x2a\x23\xd2\x6b\xbc\x58\xbd\xd4\x65\x3c\x17\x5d\xbb\x9a\x0f\x78\xd5\x8d\x3a\xc3\xc4\xeb\x53\xed\xcd\x2a\xfe\x4e\x47\xab\x8f\x45\x60\xc8\x1f\xc2\x24\xfe\x5f\xc2\x65\xe1\x59\x94\x11\xa8\xf5\x55\x5b\xc1\x94\x5f\x93\xae\x97\x14\xed\x86\xdd\x31\x50\x8a\xc7\xc1\x6c\x7a\x6b\xa3\x8c\xca\x25\x94\xcc\xab\xef\xc4\xaf\x99\x37\x25\xbd\x98\x02\xd6\x7f\xaf\xb1\x33\x80\x17\x74\xbe\x68\x44\x92\x2b\x93\xe4\x95\xdd\x0a\x36\x10\xbf\xd0\x7e\x8a\x37\x00\x42\x48\x9f\x73\xf6\xc0\x42\x9d\x50\xe4\x8d\x64\xde\xa0\x05\xb0\xfe\xf9\xdd\x6c\x9b\xea\xc8\x8b\xd3\x2c\x01\x00\x1a\x49\x57\xda\xe1\x6f\xdb\xc9\x40\x62\x19\x2d\x67\x31\x8b\xe2\xd6\x5e\x7d\x65\x19\x49\x8e\x0b\x03\xd9\x58\x16\x45\x8a\x1f\x32\x12\x90\xbf\xf7\x66\xb1\x27\x22\x4a\x67\x41\x69\x25\xab\x1a\x4a\xa7\x56\x14\x9b\x17\x97\xd3\x66\xdd\xfc\x06\xa1\xb9\xbc\x73\xc9\x60\xa2\x73\xbe\x41\xaf\x71\xe7\x6a\x30\x61\x93\xc6\x96\x0f\x8e\x72\xf1\x23\x53\x83\x8d\x91\xa4\xcd\xdc\xbb\xf6\x93\x15\x16\x45\x56\x3f\x95\x5c\x75\x8f\x37\x65\x1e\xab\xf6\x02\x47\xde\x16\xcd\x87\x4a\xd0\xeb\x5d\xe3\x27\xc0\x3f\x94\x50\xd6\x44\xc0\x31\x96\x12\x0f\xac\xd6\x31\xf8\x0d\xf8\xfa\x43\x9a\x27\x82\xc4\xc6\x89\x21\xab\x24\xa3\xb6\x70\xb6\xab\xd1\x7f\xd7\xe3\xac\x65\x48\x1a\xb0\x3c\xb8\xbd\x4b\x19\x24\xf8\xf9\x2a\x99\x57\x84\x6e\xe0\xa5\xbe\xfc\x81\xac\x27\xec\x71\x96\xc1\x41\x04\x59\x5f\x8c\x49\xaa\x06\xbd\x2e\xe7\x60\x41\x94\x95\x29\x7b\xca\x90\x5a\xbe\xae\x20\x6d\x08\x34\xc9\x9a\x4a\xa3\xaa\x4a\x66\xe0\x5e\xfe\xab\x1a\xad\x28\xd1\x3c\x9d\x1f\xb5\x7d\xb7\x73\xc0\xac\xf3\x51\xe7\xe9\xe5\x0d\xaa\x16\x93\x48\xc4\x77\xca\x16\x97\x5f\x43\x68\x23\x0a\xac\x38\x1d\x83\x02\x5b\xf9\x7c\xb3\x7a\xe4\x3e\xdf\x3e\x35\x5e\x5b\x70\x01\x42\x05\x0b\x81\xef\xd4\x79\xad\x41\x2f\x55\x8c\x48\x68\xc1\x1b\xe9\xc4\xb4\xd5\x3f\x5a\x76\x82\xa9\x7b\xff\x2c\x58\xcd\xb7\xc3\xaa\xa9\x2f\x9b\xfa\x88\x25\x6f\xbb\xd7\x0a\x9b\xe5\x64\x73\x09\x6c\x0b\xf6\xd3\x15\x9e\x54\x1a\xc1\xc2\x63\x37\xff\x68\x98\x77\xd3\xb2\x9d\x19\xdd\x9b\x23\xae\x9c\x62\xc8\x77\xae\xda\x96\x23\x8a\x72\x59\xe5\xab\x5b\x2b\x7c\xbb\xd3\xd7\x89\x81\x5b\x65\xa3\xf0\xf7\xf5\x99\x27\x02\x06\x40\x19\xf0\xf2\x65\x58\x0a\x32\x63\xd1\x72\xed\x5f\x8a\xd5\x1d\x86\x46\x7e\xa4\xba\x5e\x17\x59\xbd\x98\x20\x02\x14\x4f\x71\x68\xa4\x26\x8d\x09\x32\xe0\x24\x57\x35\x72\x9a\xc8\xd0\x69\x0a\x29\xa0\x77\xc5\x03\x62\x8f\x0b\x5a\x7a\xac\xf5\x03\x17\x00\xba\x78\x19\x00\x0c\x5f\x85\x0d\xd9\x7a\x5c\xf3\x65\x78\xb4\x37\x39\xfe\xf6\x55\xd5\x3f\x3f\x84\xd3\x57\x39\x68\x3e\x6d\x39\x94\xb3\x3d\xee\xce\xf1\x00\x75\xd4\x8e\xf4\xe3\x23\x9d\x00\x69\xf1\xe3\x9e\x2c\x43\x67\xd6\xe0\x04\x2f\x36\x7e\x99\xa5\x05\xf7\x4f\xab\x40\x24\xca\x87\x66\xb4\x4c\x3b\xc7\x67\xd8\x0a\xa2\xb3\x0e\x19\x77\xc8\xc8\x90\xd9\x84\x99\xb9\x61\xf5\x68\xc2\x2b\x43\x9a\x81\xb3\xd3\xe5\x53\xbe\xa8\x6c\xfb\xb9\xb3\x85\x08\x61\xce\x28\xec\x39\x2b\x1b\x45\x8f\x8b\x05\xc0\xa0\xbd\x61\x92\x67\xab\x42\x5f\xfb\x6f\xe7\x29\xde\x6c\x58\x48\x73\x69\x97\x97\x21\x38\xc9\x30\x87\x96\xaa\xc4\x00\x57\x43\x4e\xde\xe9\x11\x76\x65\xc1\x09\xe3\x71\xbf\x4e\xf4\xf4\x22\xbf\x40\x34\x13\x83\x67\x0b\xf2\xd1\x1a\x22\xfc\xb6\x5d\x14\xe3\xcc\xa5\x5c\x0d\x45\xe3\x80\xb8\x1d\xab\x8f\x16\xfe\x4e\x5c\x1d\x6a\x29\x63\x25\x5d\x6b\x12\x73\x8c\x39\xfc\x06\xbf\xf2\xe7\x07\x7b\xd4\x1e\x09\x41\x36\x7c\x57\xa4\xc8\xb6\x38\x2f\xd8\x43\x10\xbb\xa1\xa9\xc5\x29\xf5\xc3\x8f\xaf\xf1\x24\x3b\xf3\x1d\x29\x70\xe3\x09\x82\xc2\xc3\xe4\x91\x2b\x72\x50\xf0\xc6\xbb\xc8\xf6\xe8\x92\x5f\x10\xb6\x6c\x08\x44\x2d\xc1\x7d\x64\x28\xe1\xff\xd0\xa4\x02\xee\xf0\x53\x9b\xd0\x7e\x5a\xef\xfd\x3e\x29\x29\x0c\x20\x56\x2f\x3c\x1d\x18\xfd\x86\x4e\xc5\xce\x8a\x9b\xe3\x16\xdc\x1c\x2d\x9d\x3d\x77\x03\xb4\xbe\x66\x46\xa6\xd7\xa9\xe1\xf8\x3e\xc5\xa3\x07\xff\x0e\x78\x2e\x6f\xb6\x10\x8b\xbd\xb0\x32\x34\xca\xb8\xa4\x90\x57\xf5\x09\xa0\xfc\x6a\x86\xa7\xc3\x66\x12\x8b\x5e\x9b\xd2\x6c\xba\x2d\xee\x1f\x51\xeb\x3f\x84\x82\x81\xbf\xa6\x03\xf5\x88\x05\x98\x61\xe1\x23\x9a\xad\xea\x09\x91\xcb\x7b\xed\xae\x02\x2c\x79\xf9\xd5\xff\x8c\xcf\xa8\x6a\x96\x63\xeb\x6e\x68\x66\x8d\x27\x8c\x4e\x8a\x55\x29\xd7\x0a\x54\x57\x8b\xfa\x82\xf6\xd7\x63\x0d\x80\xfc\xf5\xba\xb9\x55\xaa\xd6\xde\x85\x78\xe2\x92\x95\x76\x0f\x74\xf6\x6d\x03\xc7\xd5\x2a\xcf\xe9\xa2\x7d\xe0\x29\x76\x31\x3f\x7f\x3f\x39\x6b\xce\xdd\xc3\x84\x3a\xc8\xeb\x65\x34\x9e\x64\x15\xc5\x3a\x21\x2f\x3e\xc4\x01\xc2\x98\x92\xa6\x98\xe4\x84\xf6\x1a\x25\x71\xaf\x7c\xad\x7a\x1d\xcc\xcb\x40\xfa\x47\x8d\x25\xaf\xf3\x9a\xdb\x07\x75\xf2\x16\x7b\x68\x64\x90\x8c\x45\x3d\xb5\x8f\xa2\xb0\xe4\x6f\x33\x1e\xde\xec\x28\x0f\x39\x71\x6e\x75\x9c\x1d\x72\x74\x3e\xb2\x7a\x5c\xdd\x46\xfa\x3a\xf3\x18\x98\x27\x79\xbf\xd7\xbe\x8b\xb9\xc1\x9c\x72\xcc\x92\x47\x51\x06\xaa\x8b\x8c\x5e\xf9\x2b\x90\x4a\xec\x0b\x9c\x0a\x9d\x27\x2c\xc6\x5d\x2d\x13\xee\x10\xb8\x90\xa5\xcb\x8c\xd2\x11\x0c\x5a\x5c\x36\x64\x58\xe7\x58\x90\x4f\x85\xe5\xe1\xc8\x0e\xac\x23\x21\x9f\x80\x0d\xda\x80\xd9\xb5\xb8\x7d\xd8\x83\x5a\xea\x33\xaf\xb4\x91\xdf\x34\x2a\x22\x9e\x5f\x5d\x37\xae\x58\x0d\xc4\x74\x0e\xa7\xa4\x3c\x1d\x1b\x96\x71\x76\xea\x66\x76\x19\xa5\x72\x8b\x59\xb4\x96\x1f\x10\x85\x7f\x6c\xa6\xd2\xca\xc7\xf8\x1e\xba\xba\x9b\x3f\x4a\x24\x3a\xaa\xc2\xae\x3d\x07\xf2\x6d\x78\xff\xe9\x39\x8e\x14\x3b\x05\x13\x61\xba\x17\x85\x72\x37\xe2\xad\x63\x48\xd3\xa2\x42\xca\xa4\xd4\x0c\xb9\x43\x0a\x3f\xf8\x05\xb9\xc3\x60\x8c\xae\x1b\x29\x42\xe7\xf2\x12\x56\x6a\xb2\x33\x62\x18\xa5\x04\x6b\x34\xce\xf2\x0c\x5d\xc6\xca\xe3\xe6\xf4\xd6\x13\xec\xf3\x66\x80\xd0\x1d\x00\xfa\x97\xf2\x14\x7d\xab\xf0\x8d\x31\x3b\xe1\x2d\xb3\x86\x58\x43\xda\x80\x00\xb1\x74\x80\x1f\x89\x14\x2b\xe5\xcc\x05\xb2\xc4\x22\xdb\x12\xdc\x52\x71\xf5\xea\xa9\x87\x93\xd7\x49\x41\x45\xea\x0e\xe3\x43\xd3\x4b\x1d\x3b\xf8\x0d\xfb\x3c\x48\x33\x00\x57\xdc\xed\x77\xfc\x9a\x67\x7f\x5a\x22\x5b\x8d\x4f\x0e\x5a\x4f\xa9\x59\x5c\x1d\x32\xe8\xf6\x9d\x27\xe9\xbc\x29\x9c\x54\xf1\xc3\xe4\x8e\x6a\x2b\x87\xfb\xa8\x6a\x6f\xa0\x85\x23\x26\x1c\xd2\xea\x2a\xdc\x4d\x28\xbd\x67\x78\x84\xf3\x24\x0d\xde\x88\xcf\xa6\x0d\xdd\xe9\xff\x70\xe9\xb5\x78\x87\x19\x44\x67\xe8\x0c\xef\x6d\x1c\xcc\x0a\xa6\xc1\xeb\xd9\x31\x3d\x0d\x32\xda\x17\xe4\x10\x32\x8a\x21\x88\x0f\x99\x4e\xce\x96\xcf\xa7\x8a\xe7\x3d\xac\xee\x33\x9b\xaa\xc0\xa7\x85\xb3\x5e\x4c\x77\xd4\x9d\xf8\x70\x19\x5a\x5f\x53\xbb\xcf\xa7\xcd\xfb\x2a\xf9\x88\x8c\x5c\xda\xf0\x53\x9f\x4e\xd4\x00\x62\xd0\xa0\xdc\x29\xca\x0a\x04\xea\xdb\xa9\x58\x17\xf1\x2e\x24\x01\x8f\x61\x31\x0c\x9c\xb5\xf2\x9e\x5d\xad\xc5\xd7\xc6\x6a\xe4\x71\x0e\x28\x18\x56\xae\x3c\x42\x7a\x7e\x55\x86\x6b\x8d\x2e\x72\x8c\x14\x22\x67\xd4\xa4\x21\xc8\x94\xed\xca\x47\x37\xe8\xa3\x8d\x8b\x9f\xe9\x2c\x88\x17\x5c\x15\x75\xd3\x11\x7f\x16\x1e\x9c\xbf\x82\xad\xfa\x4c\x21\x10\x06\x4f\x91\x2d\x55\x4f\x3a\x1c\xaa\x44\xb6\x52\xa5\x8a\xa5\xc1\x79\x67\x62\x8b\x52\x35\x6b\x7f\x68\x56\xb1\xbc\x69\xfb\x7a\x27\xfe\xbc\x00\xf1\xcd\x99\x45\x50\x82\x69\x45\x49\x52\x3e\xc6\x40\xe6\x63\x53\xa1\x42\x6c\x04\xa4\x71\xcb\xb0\xbc\x10\x3d\xe8\xfa\x84\xca\xe2\x37\xfa\x24\xc5\xf7\x2b\xd3\xcf\x7b\x22\x1e\xe3\x2b\x87\xdd\x07\xa8\x7a\x19\x94\xa3\xaf\xdc\x98\x1e\x21\x2a\x0a\xca\xd8\x29\x9a\x93\xe6\x36\x65\x19\x15\x3b\x8a\x77\xb6\xe5\xe9\x00\xb8\x12\x0b\xda\x68\xb7\x04\xd1\x78\xb1\x31\xe7\x7a\x9e\xc7\x18\x29\x98\x0d\x08\xd3\x28\x48\x34\xd1\x1b\xf9\x55\xc9\x3e\x63\x6a\x02\x34\x11\x55\xcb\x40\x42\xf5\x96\x05\xbf\x5a\x10\xb1\xcc\x59\xf3\xdb\x69\x9f\xa3\x76\x22\x96\x62\x0d\xca\x1c\x83\x34\x39\x18\xa5\x4b\x9f\xbd\x5f\x4e\x1d\x95\x0f\x9a\x55\x9f\xad\x12\xa2\xed\xfa\xa3\xa9\x4d\xa3\x23\xee\x40\x8e\xdf\x98\xd0\x9a\xa5\x01\x1c\xf5\x17\xdb\x09\x6f\xb3\x95\x5c\x22\xd8\x60\x31\x39\x47\xa2\x67\x76\xe9\x5d\xc4\xf9\xae\xad\xd9\x15\x9a\xf3\x22\xb8\x9e\xb6\xdc\x52\x89\xab\xcf\x68\x83\x1f\x49\x11\x68\xf2\x29\x57\x1c\xc4\xc8\xbc\x03\x8c\xa2\xdd\x1a\x42\x7a\x6f\x67\xe0\x94\xfd\x85\x3c\x4f\xf4\x3d\x2a\x78\x05\x4e\xd3\x82\x79\xc0\xe6\xea\xa4\xa2\x98\x47\x0a\x12\x70\x95\xc9\x9d\xae\xcb\x95\x8a\x58\xb3\x26\x20\xa3\xf2\x33\x21\x22\xca\x2f\x0a\x37\x0f\xe5\xa6\x12\x21\xca\x27\xc8\xc9\x77\x07\x29\x2e\x34\x53\xe0\x87\x2a\x8c\xa0\x20\xdd\xf3\xc5\x10\x93\x79\xa8\xb0\x03\xc4\x87\x92\xeb\xaa\x4f\x25\x8e\xc2\xfd\x16\xf2\xe3\x1d\x41\x76\x63\xb3\xad\xd0\xb5\x77\xc6\x48\xb5\xc8\xb4\xa9\x0d\xc5\xa6\x09\x29\xe7\xf6\x98\x7f\x7d\xdb\xf3\x02\x07\x99\x32\xcf\xde\x0f\xcd\x50\xba\xa2\x98\xd0\x2f\xe5\x68\x19\x44\x9a\x0b\x38\x8b\x1d\xfd\x9d\x9a\x6d\x4d\x90\x98\xa4\xa1\xb9\xa1\x0e\xdc\xe7\xc2\x78\xf0\xdd\x66\xa1\x94\x2e\x7f\x34\x21\x2c\x52\xd5\x9a\xc6\xc5\x34\x90\x7d\xc9\x15\x1a\x52\x14\x6b\xd6\x75\x6d\xc6\x5a\xe6\xfb\x35\x6b\x4d\xb6\xac\x5a\xec\xd2\x4f\x81\xdd\xdd\xe5\x9b\xb5\x87\x94\xfc\x1d\x04\x6e\x31\xb1\x22\x79\x8c\x61\x44\x24\x05\xda\x8d\x19\x31\x4f\x93\xc8\x5c\xf5\x97\x69\x2e\xbf\xda\x15\x51\x6d\x14\x94\x0d\x38\xd8\x2b\x6e\x9f\x5c\x9c\xdb\xba\xaf\xb0\x17\xaa\x51\xf6\x26\x0a\x11\x57\x8c\x3f\xbe\xd8\x4c\xb8\x15\xf3\x2d\xee\x7c\xdd\x41\xb9\x0a\x19\xd7\x5a\xbb\x69\x75\x2d\x0a\x90\x00\xf5\x5d\xf3\x6d\x9f\x8a\xb9\xdb\xc8\x6e\x53\xf6\x22\x5e\x56\x83\x60\xf8\xc8\xef\x1c\x6a\x8a\x54\x9c\xe1\x12\x7f\xce\xd8\xa0\x81\xc7\x44\xfd\xc3\x90\x2d\xe3\xd6\x07\x82\xf9\xc0\x50\x49\x21\x99\xd6\x4a\x67\x69\xb3\x64\xd7\x75\x7c\x06\xb5\xe4\x19\xec\x0d\x0c\x68\xac\xd8\xf5\xf5\xe0\x52\x78\x1d\x1e\x8a\xa7\x7b\xd0\xf9\x5d\x43\x45\xe4\xc8\x82\x8a\x7c\xd3\xb8\xd9\x56\xc7\xb2\xbf\x67\x22\x11\x97\x67\xf8\x96\x01\x88\x1b\x78\xfa\xf6\x45\xd2\x73\xa4\x26\x56\xd1\x15\x5c\xbf\x1c\xeb\x26\x11\x9f\x95\x58\x99\xb3\xce\x04\x3f\x0f\x8c\x0a\x0d\x33\x9f\x09\x0b\x1c\xa2\x3d\x05\x5a\x69\xed\xdb\xda\xa4\xc0\xe8\xf5\x5a\xf2\x36\x81\x4c\x01\x7c\x51\x87\x9c\x67\x64\x4c\xb9\xd0\x7d\xa3\xcb\xc5\x28\x5f\xcc\xf7\x90\x69\x1a\x89\xdb\x34\xdb\xc5\xdb\xad\xec\x4d\xc3\xe2\x0a\x6b\x19\x60\x9e\x17\x6b\x69\xa0\x6c\x15\xdb\xc9\xe2\x87\x43\x57\x5c\x2b\xa5\x1d\xf1\xeb\x9b\xad\xb8\x00\x66\xf9\xda\x75\x69\xf1\x28\x67\xa4\x18\x7a\xc8\xaf\x7e\xc4\x50\xe2\xbf\xbb\xd7\x35\xeb\x2e\x8e\x65\x53\x7f\x1b\x67\xda\x36\x93\x67\x37\x86\x2c\x56\x18\x49\x25\x45\xc9\xd8\xb0\x46\xb2\x6f\x8a\x1c\xb6\xbb\x11\x21\x8d\x41\x2a\x73\x48\x03\xc5\x68\x99\xb6\xcb\x1b\x4f\xbf\x9e\xf5\x39\x48\x92\x15\x16\xd5\x0d\x68\x1d\x8d\xae\xae\x48\xc3\x81\x82\x7c\x74\x6a\xcb\x22\xd8\xa2\xc1\x13\xfc\xc3\x6c\x76\xde\xa6\xbd\x75\xbe\x92\xb1\x3b\x1b\x87\x1c\xa9\x47\x4b\xa0\xf9\x38\xe8\x0b\xc1\x80\xe3\xb7\x83\x2b\x1d\x59\xc0\xb2\xe7\x64\x20\x51\x11\x9c\x57\xc3\x8e\x76\x0f\x46\xe7\xae\x04\x35\x6f\x38\x5f\x88\x7c\x8d\xbe\x58\xac\xa9\xa1\xfa\xf5\xcf\x1f\x51\xff\x50\xe9\xfc\xaf\x18\xed\x6b\x9f\xdf\xfe\x6b\x73\xd0\x72\x4a\x39\xe0\xf5\xae\xa4\x36\x75\xb8\x7c\x7f\xc8\x53\xe9\x30\x07\xea\xad\x43\x2b\x4a\xde\x38\x76\x45\x64\x23\xf6\xf1\xc4\x12\xe7\x21\x6f\xf0\x11\xc4\x22\xd9\x29\xe3\xb4\xfb\x06\x67\xa9\x9d\xd3\x61\xa9\x58\x5b\x2c\x6d\x70\x22\xf4\x86\xe8\x89\x2a\x80\x7e\x23\x45\x2e\xcc\xe1\x6d\x80\x98\xea\x8d\x6b\x43\xb5\x4d\x18\x5a\x64\x24\xb6\x12\xef\xf1\xd1\xfc\xb5\xd6\x97\xd8\xb3\xfa\x60\xf9\x0c\xab\xbb\xe0\xda\xbc\xc4\x79\x3c\x0b\x97\x6d\xe4\xc6\x3b\xaa\x68\x2a\xe0\xce\xb1\x7b\xf3\x18\x6b\xca\xd8\x17\xd0\x99\x7d\x78\x50\x59\x5e\xa2\xe9\xc4\x6a\xa7\xca\x03\xfd\x0a\x6e\x2a\x0e\xf5\xd1\x93\xc7\x4c\xa2\x32\xef\x1e\x91\x8d\x57\x23\xeb\xa7\x8c\x11\x33\x9b\x58\x51\x3f\x0b\xd6\xc2\xf4\x8f\xfa\xc1\x05\xba\x7c\xd2\x50\xd9\xf5\x5d\xf3\x8f\x6f\x42\x99\xb0\x0b\x89\xb1\x14\x71\x06\x7f\x59\x34\xc9\x6d\x32\x03\x89\x11\x65\x8f\x56\xab\xc1\xf8\x2f\x19\x6f\xdd\xa5\x0e\x02\xeb\x06\x19\x56\x7e\xab\x38\x0a\x26\xcf\x83\xdc\xcc\x9c\x04\xd4\xfc\xe6\x01\x71\x95\x16\xfa\xad\xa1\x44\x91\xda\xdf\x67\x65\x2d\x25\xb6\x1f\x02\x33\x3f\x78\xa3\x37\xb7\xa9\xf4\x34\x9f\x01\x71\x07\x60\x8d\x30\x46\x07\x7e\x2f\x77\xc4\xa4\xda\xaf\x42\x92\x06\x95\xa5\xb1\x7b\x06\xb4\x08\xec\x0c\x2e\x81\x15\x92\xd6\x67\xd4\x92\xc5\x6e\x98\x0d\x54\x77\x02\x94\xd7\x84\x5f\x22\xc3\xad\x6c\x53\xe1\xb7\x54\xbe\xbc\x51\xa7\x67\xf4\x72\x9d\x6f\x6d\x3c\x9e\xb3\x80\xa0\x9a\x96\xaf\x0b\x61\xea\x46\xd2\xaf\xf1\x7e\x46\xa8\x34\x8e\xe3\xe7\xf1\x6f\xf3\xbe\x5f\x99\xef\xaf\x00\x27\xe1\xb0\x4e\x1c\xdc\xaa\x5d\x22\xbe\x0d\x5b\xfc\x91\x0b\x37\x90\xf0\x96\x75\x41\x02\x9b\x37\x4c\xbc\x69\x53\x74\xad\xef\x90\xa0\x30\xcb\xf5\x9c\x81\x74\x93\xb0\x39\x21\xeb\xda\x5f\x4c\xaa\xfc\xc9\x56\xd6\xff\x19\x13\x26\x55\x0a\x7c\x56\xe9\xc4\x5e\x60\x07\x8c\xf4\x14\x2f\x71\xe5\x64\x3e\x2c\x04\x20\xe6\xe0\x25\x69\x19\x8c\x7f\xfa\xa7\xa4\x1f\xeb\xfa\xca\x7d\x73\x36\x92\x42\x83\x18\xc3\x0a\x1f\x54\xd7\x11\xf0\xdb\x08\xde\x66\x82\x3f\xd7\x43\x89\x43\x87\xf3\x6d\x89\xf7\x82\xbe\xda\x69\x31\xa8\xf0\x35\x14\x20\x44\x84\x06\x50\x1d\x5a\x56\x28\x46\x0e\x00\xf1\x5e\xdb\x52\x6e\x1e\xc6\x75\xb5\x56\xff\x93\x18\x72\xc2\x31\xd2\x5a\x68\xc3\x54\x94\xa8\xc9\x7d\x92\x4f\x9f\x53\x7c\x08\xf0\xb4\x8e\x25\xea\x71\x40\xd0\xbe\xcc\x88\x3e\x66\x64\x35\xb8\x12\x9e\x7a\x65\x65\x5c\xf3\x35\x75\x51\x51\x4b\x90\xd1\xb3\x6b\xc4\xbf\x09\x86\xd7\x08\xdb\xe8\x3e\x31\xeb\x2f\xce\xc3\x84\x52\x56\x11\xe1\x55\x68\xc9\x73\x97\x28\x80\x06\x8a\x03\x96\x3a\x17\xc7\x0f\x0d\x33\xe5\x48\x2c\xf1\xef\x1d\x8e\xab\x73\x01\x4f\x79\x19\x44\x64\x87\xf6\x0b\x25\xba\xa7\x96\x0a\x4a\x9b\xf1\x9d\x25\xe5\x44\x7b\xfd\x7f\x7e\x4b\x79\x0e\x2b\x5b\xc0\xd4\x37\x11\x90\x32\x78\x4d\xee\x8b\xd4\x2b\x2f\xab\x72\xb8\x55\xcd\x4f\xfa\x65\xa8\xde\x9c\x13\xbc\x18\xb0\x8c\xed\x55\xf4\xbc\x3c\x8e\x34\x84\x8a\x28\x0d\x13\x31\x31\x49\x5d\x6f\x36\xfc\x96\xaa\xc5\x7c\xb5\x57\xbf\x6c\xec\xd7\xef\x1a\x0c\x0c\xb8\x57\x61\xaf\x19\xf0\x5b\xb9\x56\x70\xcd\x66\x8a\x58\x78\x73\x69\x2c\x86\x13\x6b\xf0\x3f\x56\x42\xd3\xd2\xcf\x41\x88\xf7\x56\x5e\xa0\xb4\xbe\xa4\x9a\x65\x26\x97\x42\xbb\x88\xbc\xc8\xef\x7b\xbc\x84\x71\x88\x17\xa6\x4a\x1f\x35\x6b\x86\xf0\xf8\x72\x3c\x08\x20\x04\x7a\xc6\xef\xf9\xcb\x6b\x02\xf6\x4d\x45\x4d\xef\x86\xc5\x58\xc0\x38\x9e\x97\x82\xec\x02\xe2\x4b\x65\x72\x9b\xb5\xe2\x26\x7c\x01\xd2\x0a\x2e\xf4\xa7\x6f\x08\xff\x9b\xec\xac\xba\x2f\xf8\x82\xbf\x25\x6c\xb0\xef\xad\xb2\xb7\xfc\x90\xce\x1d\xa9\x8d\x53\xff\x38\xd0\x9b\xf9\x46\xa8\xda\xde\x0b\x22\x84\xae\xc1\xfe\xb8\xbf\xba\xa7\xc6\xdd\x2f\x9f\xf5\x7a\x32\xda\x55\x7a\x7e\xcf\xe7\x52\x54\xaa\x78\xe4\xfd\x1a\x2f\xd4\x3e\xa0\x25\x4f\xb4\xd5\xb9\x96\x51\xe8\xc0\xb5\x0b\x1b\x95\xf6\xb4\x0b\x8c\xbe\xfb\x90\x95\x32\xfc\x78\x61\x4d\xf2\x9a\x1d\xd4\xb6\xed\x62\xd2\x8c\x94\x42\xce\x28\x44\xbb\x7c\x53\xfd\x73\xb0\x75\xf9\x03\x26\x1e\x74\xb2\x38\x05\x3e\x2b\x7e\xf2\x7e\xf9\x1c\x2b\xda\x75\xcb\x0c\xbf\xf9\xdf\x7f\xfd\x76\x1d\x56\xcc\x2c\xb6\x60\xad\x2e\x8d\x24\x76\x2f\x64\x7c\x7c\x6f\xf6\xec\x5e\x70\x97\x37\x66\x9d\xeb\xd9\x36\x59\x53\x92\xe0\x96\x50\x3d\xf6\xc7\xe2\x1b\xff\x8d\xa1\xa0\xcc\xc3\xed\x29\xc6\x55\xea\xe7\x8f\xeb\x28\x54\x21\x89\xbc\x0a\x50\xee\xaf\x2e\xdc\x15\x8e\xb3\x24\x8a\xe1\x57\x2f\x4c\xab\x30\xcc\x17\x03\xbc\xcf\xb5\x17\x66\x35\xaa\x80\xf3\x51\x5e\xc9\x85\x1b\x81\xda\x9f\xac\x65\x4c\x40\xa5\xee\xd7\x12\x79\x98\x9c\xbd\xb7\xbd\xed\x9f\xd5\xa6\x23\xd7\x11\x18\x2e\x67\xdf\xab\x72\x9a\x7f\xd8\x53\x9e\xe0\x47\xc0\xdc\x98\x19\xfd\x30\x80\x47\x5c\x53\x38\x25\x63\x5f\x00\xa7\xee\xf6\xb2\xbf\x3a\x0a\x65\xcd\x85\x0b\x20\x6f\xa0\x5b\x0d\xb6\x4b\x04\xc6\x2d\x28\x92\x37\x45\x1b\xed\x0f\x2e\x79\xd8\x5d\x5b\x4c\x81\x18\x8a\x7a\x35\xf9\xee\x00\x95\xf9\x04\xa0\x60\xd4\x46\xb6\xdc\xa1\x0d\x16\xa5\x03\xce\xda\xa8\xd0\xa4\x2a\x18\x81\x0f\xf1\x8c\x3d\x30\xa0\x2f\x54\xcf\xd9\xb1\xb6\x8e\xeb\xf6\x93\xd9\xd0\x4f\x13\x9b\x15\xfe\x69\x80\x62\x48\x94\xb5\xe7\xc2\x1c\x8f\x88\xfb\x85\xae\xb6\x64\xdf\xb1\xc3\xb4\x3d\x6c\x43\x5f\x58\xf4\x76\x95\x81\x4b\x3f\x77\x08\xc3\x81\x5a\xb1\x40\x32\x23\xe3\xa6\x62\x82\x04\xd3\x69\xc7\x9a\x73\x6d\x33\xf9\xf6\x8d\x29\x51\x10\x88\x17\x2c\x39\xe6\xcd\x9f\x31\x63\xd9\xac\x6e\x6f\xd1\x00\xb9\x31\x8f\x51\x71\x06\x61\xd4\xce\x14\xa0\xac\xbe\xec\xf3\x7a\xc7\xcc\x4b\xfa\x2f\xe6\x7a\xfe\x24\xaf\x9c\x30\x32\xd2\xc3\xb6\x68\x47\x9b\xca\xf0\x59\xbe\xae\x18\xc7\x0a\xfc\xcb\xa9\x09\x99\x73\x96\x36\x39\xd8\xb2\xf3\x57\x35\x40\x22\xad\xf5\xf4\xab\x9d\x2f\x20\xad\x82\x69\xc6\xd5\xbc\x43\x71\x69\x54\xe3\x9c\xf8\x66\x37\x5c\xb4\x43\xca\xfc\xd7\x78\x53\x7e\x25\x79\xe6\xea\x9d\x8d\x47\x30\x33\x36\xd8\x5f\xb4\xad\xe6\xf8\xb4\xbd\x5f\xb9\x37\xa4\x0e\xcc\x04\x07\x6a\x13\x16\x72\x73\xda\x3b\xb5\x5c\x25\x29\x8f\xee\x0f\x54\x10\x1e\x89\xda\x7e\xb4\x60\xf9\x43\xc6\x07\xb6\x6c\x58\xba\xbf\x59\xb9\x38\xec\xf9\xee\xff\x6a\xea\x47\xa6\x6b\x45\xa7\xf0\x59\xf6\xe6\xb6\x48\x45\x68\x5f\x72\xc7\x89\x2a\xc7\xad\xd5\x85\x3d\x98\x63\x7b\xe3\x92\x4f\x97\x4e\xb2\x10\x84\xdd\x2b\x4b\x08\x80\x4e\x10\xaa\x11\xeb\x4f\x80\xa0\x15\x10\x15\x3c\xf7\xab\x39\x05\x7a\x8a\xc0\x7d\x9d\x77\xe4\x8f\xbc\x1e\x12\x71\xf3\x31\xf3\x71\x32\xcd\xdd\xf0\xc1\x86\x3a\xac\x15\x78\x8a\xad\x78\x12\x57\x41\xb7\x7e\xa5\xe5\x75\x9d\xe2\x63\x1d\xe1\x91\x9c\x94\xec\x8b\x01\xca\xca\x0c\x0d\x0c\x62\x21\x43\xca\xa6\x9d\xaa\xcd\x3e\x90\xf5\x2e\x03\x04\x6c\x8a\xed\x6e\x00\xdb\x91\xaf\xf2\xaf\x4e\x7e\x17\x18\x5f\x6a\xec\xa2\x86\x5b\x08\x0b\x53\xff\xbe\x44\x20\xaf\x7a\xd9\xfe\x36\xf0\xa1\x35\x97\x25\x93\xb5\xa6\xac\x78\x77\xc0\xbe\xf9\x8a\x36\x4a\xdf\x7a\x20\x6f\x0a\x8a\xc4\xa7\xb9\x2f\xd0\xf0\x3a\xd9\xab\x9b\xf6\x09\xdd\x93\x63\x84\xd9\xcd\xeb\x89\x79\xe6\x53\xfe\xfb\xf7\xd0\x83\x9b\x58\x29\xad\x69\x5b\xa7\x65\x49\xca\x9a\xd7\x8a\xfd\xba\x93\xab\xa0\xc8\xc3\x29\xab\x1d\xec\x04\x29\x8f\x78\x03\x45\xe9\x43\x82\x86\x46\x69\xa8\x29\xe6\xf1\x6c\xb5\x71\xcd\x38\xdd\x71\xc5\xe2\xc8\xb9\x9f\x43\xd3\xfd\xba\x2a\x4e\xa9\xcf\x95\x15\xa9\x08\x56\x14\x7e\xfc\x4f\x5c\xbe\xb7\xa4\x27\x95\xf1\x8c\x04\x95\xae\x9f\x35\x15\x95\x7a\x7b\xb3\xd7\x05\x67\xd6\xd1\x9b\xbe\x62\xe0\xd4\x67\xca\xd6\xec\xb3\xd2\x96\x8f\x15\xc0\xaf\x29\xa1\xb3\x2a\x56\xc6\xdf\xf7\x64\x58\x40\xaf\x58\x9e\x09\x01\xba\x41\x44\x3c\xca\xb1\x16\x7c\xf7\xbe\x20\x4e\xf1\x69\x3b\xfe\xd5\xdf\x1d\x75\x97\xd6\x98\xf9\xae\x92\x59\xcf\x83\xe9\x5e\x00\x05\x64\xfe\x2a\x19\x0e\x44\x5e\x3d\x88\xf7\xd5\x3b\xb7\xce\xe3\x5d\x48\xca\xc1\x80\x5c\xd8\x58\x9e\xeb\x7e\xea\xd7\x0f\x3b\x24\x8b\xbc\xac\x9a\xe4\xc4\x59\x43\xd4\xd4\x10\x93\x09\x64\x97\x33\xfb\x57\xb4\xaf\x68\x15\x4b\x7c\x05\xd4\x19\xa4\xf8\xb9\x11\x6c\x51\x92\xee\xc9\x98\xbe\xb6\xf5\x3a\x46\x0c\xef\x8c\x48\x1c\x3e\xaa\x41\x5b\x49\x1c\x7d\xe4\x77\xf9\x65\x10\x84\x6f\xf1\x8f\x2b\xdf\xa8\x55\xb0\x27\x80\xf3\xe8\x27\x68\x08\xa1\xde\xeb\xf8\x23\x0f\xeb\x73\xd0\x3c\xa5\x83\x94\x0d\x74\xd6\x7c\x55\x5e\xf1\x3a\xfc\xb6\xe2\xdc\x37\x69\x16\x49\x07\x49\x44\xb9\x82\x2f\xe3\x70\x4b\xda\xf6\xac\xca\x25\xb7\x32\x52\x7e\xc6\x6a\xe3\x6b\x11\x69\x3d\x0d\x69\x58\xe7\x74\x0e\x90\x08\x03\x5b\x0e\xac\xdc\xaf\xf7\xd8\xd3\x79\xf6\x4c\x1e\x71\xf8\x9b\x5a\x16\xb8\x5a\x10\x8c\xfc\xca\x33\x75\xc2\x4a\x48\x18\xc6\x33\x18\x51\xaa\x74\xcb\x32\x98\x74\x3d\x74\x4d\x27\xca\x0d\x29\x5b\x54\xbf\xa1\xfc\xfb\xe6\xd1\xe9\x8b\x90\xff\xf8\x9f\xdd\xe0\x56\xad\xa5\x40\x33\x7c\x6a\x9f\xc9\x88\xfd\x87\x04\x80\x78\x5f\x32\x7b\x9c\x55\x75\x77\x3f\x03\xa4\x2a\x28\xe6\xdd\x0d\xdd\x3d\x50\xd4\xd4\x12\x37\xf3\x31\x9e\xd5\x86\xba\x1c\x6f\xc9\x45\xb0\x0a\xad\x11\x39\xaf\xf6\xf3\x2c\xc2\xdf\x33\x76\x14\x22\x51\x31\x71\x85\xf5\xfa\x7c\xbf\x6c\x3a\xcf\x83\x99\x42\x7f\x35\xa2\x2e\x27\xb0\x96\x7e\x0f\x58\xc4\xf8\xb5\x25\x85\x68\xda\x28\x3e\x28\x0c\x75\x04\xd4\xe1\xcb\x80\xe8\x54\xd6\xe4\x21\x02\x80\x27\xaf\x85\x71\x92\xc1\xeb\x5b\x83\xe7\xe4\xbf\xbc\xf0\xf7\x53\xcc\x1e\x14\x9f\x42\xcc\x29\xa3\xf7\x6b\x79\x66\x0c\xb8\x4c\xe3\xe8\xa7\x4a\x3f\xd8\xad\x98\x3d\xa0\xea\x60\xf7\x9d\x70\xe7\x6a\x52\x51\x8b\xe4\x57\x00\x53\x66\x6d\x33\xe1\x54\x24\x3f\xb4\x3f\x94\xf5\xae\x58\x46\x89\xab\xbb\x32\xa2\xf5\x9b\xc1\x65\xf4\xa6\x28\xd1\x2c\x67\xc9\xf7\x31\xd5\x94\x5e\x9b\x9b\xcf\xd4\x6c\x7b\xdf\x4b\xc6\xc9\x3f\xaf\xc5\xe9\x8f\xa1\x71\x5f\x96\x35\x33\xf3\x2b\x46\xb6\x40\xd1\x45\x85\x6d\x8c\xd8\xc8\x18\xa3\x93\xaf\x48\x81\x55\x0e\xd6\xbb\xff\xa9\xed\x81\xc6\xec\x9d\x8f\x1d\x4e\xf3\x5f\x92\x3b\xe4\xb3\xd1\xa6\x44\x20\xd0\xda\xd9\x9a\x08\x92\xc9\x8a\xe2\x91\x8c\x64\x61\xd3\x20\x7d\x1f\x89\x62\xdf\x0d\xf0\xbf\x2d\x72\x3d\xc5\x85\xf6\x18\x5c\xc4\x9b\xff\xe1\xd0\xba\x18\x40\xe8\x12\xf0\xc8\xbd\x48\x58\x2b\xbe\x5d\xdb\x4a\xe0\xd5\xe4\xcc\x63\xaf\x11\x83\xe8\x48\x7f\xb9\xaa\x43\xa4\xf2\xae\xa5\x32\x9b\x7b\x74\xa8\xf2\xea\xe8\x99\x75\x93\x8c\xc3\xf2\xe2\x21\x7a\x30\x00\x68\xf5\xdb\xf3\x14\x12\xf2\x02\xdb\x61\x1c\x51\x91\x4f\x91\x32\x50\xf1\x7a\xb1\x81\xa2\x7b\x8e\xad\x3b\x88\xf3\xe4\x04\xb6\x64\x23\xc4\xc4\xa9\x6a\x4a\x23\xef\x47\xa7\x93\xe3\x18\x39\x4e\x90\x64\x7f\x27\xde\x0d\xfa\xb1\x8d\x20\x63\xec\x12\xe6\x87\x57\xf0\x57\x2e\xcb\x74\xdc\xe7\xe4\xbe\xf9\xbd\x57\xba\x1c\xaf\x15\xf7\x01\x68\x5d\x2d\x74\xa5\x52\x7f\x6a\xe3\x72\xe5\xcb\xfa\x7e\xd3\xa6\x5f\xc1\x56\x0f\x6c\x90\x73\x7a\xdd\x2f\x77\x37\x70\x48\x79\xb4\xb9\xf1\xf4\x89\x00\xb2\x8c\x7a\xaf\x2f\x36\xd2\x3e\x77\x04\x45\x10\x5e\xa7\x7c\x22\x5d\x97\xda\xcf\x9a\x03\xd1\x91\xdb\x28\x1c\x78\x0e\xc4\x7a\xdb\x5a\x32\x80\xa1\xc4\xbc\xa2\xfa\xe7\x17\xc8\xdf\x1c\xa2\x82\x7e\x3e\x34\xc2\xb2\x8a\xd0\x27\x3c\xad\x4c\xf3\x05\x85\x6b\xfb\xf1\xd5\x9e\xa9\x16\xce\xc6\xc7\x10\x28\xef\xcd\xd8\xdb\xa3\x96\x2f\x0c\x51\xa0\x30\x28\xe7\xaf\x26\x86\x3f\x67\x38\xe9\x65\x3c\x6b\xaf\x8f\x02\x0b\x51\xfe\xb4\x8b\x60\xe1\x40\xf6\x6b\xa6\x01\x16\x15\xad\x17\x28\x99\xfd\xe5\x83\x6a\x72\x7a\x48\x02\x8a\x5e\x54\x8c\xfa\x63\x5d\xa6\xc7\x2a\x93\x23\x41\xa6\x8c\x2c\xfd\x4b\x98\x09\xbd\x32\xa6\x8b\x8a\x88\x6a\xc7\x93\x21\xd5\x6b\xd4\x82\xa7\x7c\xa1\x94\x8b\xc0\xe1\x29\x22\x0c\x09\x71\xf9\x13\xdd\xcb\xba\x48\x1f\xd3\x66\x34\x7b\xf8\x44\x83\xe5\xa0\xa0\xcd\xe5\xb4\xb4\x9a\xd7\x8f\x75\x86\x70\xeb\x2e\x1e\x70\xdf\x74\x20\x5c\x73\x32\xdf\x95\xf3\x34\xd7\x69\x12\x62\x84\xe5\x6a\x14\x71\xc8\xfa\x02\x3a\x12\x46\x1e\x93\xbb\x4c\xa0\x73\x5f\x44\x96\x61\xfc\xd8\x0f\x05\xa4\xc1\xd1\x3b\x38\x01\x7d\x48\xdb\x34\x51\x98\x94\xb6\x62\x56\x12\xea\x49\x08\xa3\x2c\x56\xb3\x9f\x48\x91\x82\xbb\x23\x32\xf3\xd2\x68\xf6\xe8\x43\x9d\xa7\xc3\x02\xfc\x03\x1c\x76\x44\xfb\x0f\x3e\x41\x87\x18\x7f\x18\x86\x66\x06\x27\x1b\x6c\x75\xe0\xcd\x41\x75\xe7\x79\x21\x03\xe7\xe0\xb9\x64\x5b\x67\x8e\xab\x57\xea\xf5\x87\xa4\x77\x2f\xc3\x36\xd2\x07\x2d\x57\xb2\x6c\x6d\x1f\x9d\x83\x2c\x4b\x57\x9a\x87\x13\xdf\xa8\x6d\x78\x3f\xf4\x7d\xc1\xd1\xd9\x8a\xb5\x58\x1c\xef\x3b\xbb\x74\x70\x72\xb3\x0f\x18\x04\x7e\x17\x1f\x04\x96\x6e\x8b\xd4\xf2\x00\x1c\x81\x52\x12\x3f\xac\xda\x23\x1c\x40\xf2\x3a\xa0\x04\x69\x35\xf0\x29\xf6\x88\x62\x11\x41\x8b\x13\xb0\x54\x14\x25\x80\xd4\x61\x8c\x9c\xe6\x5d\x15\x7e\xee\x7f\xff\xf6\x4b\x7d\x29\xc6\xdd\xbc\x55\xf4\x80\x90\x6b\xb2\x98\x89\x07\xbc\x81\x68\x67\x12\xc5\xd6\xac\x6e\x56\x66\x05\x98\x66\x2e\x2b\x20\x49\x34\x8b\x1d\x30\xfe\x90\x65\x57\xd5\x77\xfd\x64\x37\xdd\xf1\xbf\x51\xa9\x95\x16\xe3\x29\x0e\x3b\x41\x70\x20\xc4\x21\x9a\x28\xaf\xa2\x18\x7e\xdf\x44\x30\x21\xea\xd2\xf3\x7d\x06\x06\x60\x16\x65\xca\xea\x92\x09\xb0\x71\x91\x38\x3d\x28\xff\x60\x41\x41\xf7\xea\xd4\xf3\xd6\xe8\x9a\x87\xe6\xfd\xda\xce\xa7\x9b\xfb\x3e\xea\x04\x61\x32\xe8\x07\x5b\x1f\xf5\xab\xdb\x94\xb5\xb0\xc3\xfc\xc1\x0f\x0f\x0a\x18\x7b\xae\xa5\xd1\x35\xb8\xa8\x80\x90\x45\x46\x9b\xba\xd2\x9d\x25\x2b\xb8\x99\x98\x80\x46\x3e\x00\x84\x24\xfd\xd8\xf6\x8b\x9f\xc5\xce\xcf\xe0\x0e\x2f\x40\xc4\xef\x18\xfd\xed\x31\x77\x6d\xef\xd4\xcd\xe0\xc1\xf2\xfd\x24\x29\x89\xe2\x57\x04\x1d\x15\xb4\x3a\x45\xfb\xfe\x32\xbc\x00\xcb\x5a\x2e\xdc\x65\x0b\xd0\x15\x6f\xf2\xe8\x32\x5d\x1f\x26\x39\xb1\x62\x7b\xcc\x2b\x49\x5a\x95\x3d\x1c\xd4\xc8\x32\xc8\x04\x02\x44\x4e\xcf\x00\x47\x04\xc0\x8a\x7d\x75\x0f\x98\x9e\x91\xe1\xeb\x9b\xe7\x77\xc4\xda\x1d\x65\x29\x0c\xb4\x2e\x8e\x43\xb3\x5b\xd8\x05\xd5\xa0\x27\x80\xb9\xca\x50\x66\x9f\xf3\x93\x31\x7b\xb1\xcc\x38\x58\xde\xcb\x06\x39\xcc\xdf\xfb\x76\x86\x01\x4d\x18\xd0\x14\x14\x0b\x68\x43\x10\x68\x80\x83\xca\x43\x5c\x5d\xf8\x93\xa6\xee\x3b\xf1\x91\x6e\xc1\x3b\x5f\x98\xd7\x2e\x81\x3c\xa2\x63\xa3\xaa\x40\x45\x18\xce\x27\xf9\xa9\x38\x2a\xdb\x30\xba\x93\x62\x79\xab\x1f\x82\x07\x65\x4e\x82\x82\x6b\xe9\x2a\x63\x39\x0b\x0a\x8b\x38\x9f\xa9\x50\x5b\xf1\x9d\x56\x5a\xeb\x16\x4a\x9e\x3b\x76\x9d\x31\xb6\x50\xcd\x06\x88\x59\x26\x97\x9d\xe3\x93\x89\xb2\x56\xb4\xf6\xef\x15\xb4\x2c\x9f\x0f\xde\x7c\xe1\x0b\x3a\x8f\x3e\x9c\x80\xf1\x6a\xe5\x3f\xa3\x70\xd5\x90\xde\xde\x6d\x0c\xe5\x3f\xbf\x95\xfa\xe9\x94\xf0\x81\x9e\x92\xa9\x96\xd4\xcc\xc0\xb2\x3d\x87\x88\xa9\x3a\x35\xf4\x56\xb4\x18\xac\x35\x54\xda\x24\x2c\xa5\xef\x83\x59\xc3\xd2\x40\xce\x97\xa8\x5c\xbe\xe7\x38\x59\xc4\x74\xf3\xc4\x33\xde\x30\xf9\x02\x55\xdc\xcd\x56\x9d\x5d\x65\x55\xc6\x5a\x6e\x55\xf4\xb7\xd7\x2b\xc2\x0d\xbf\x79\x81\xb2\xc7\xe9\x9b\x63\x5a\xfa\x3b\xfe\xbe\x26\xef\x96\xc7\x8c\xdc\x6a\x63\x92\xcb\x43\x68\xb6\x18\x7b\xa7\xa0\x9f\x0d\xab\x53\x5c\x1f\x57\x1a\x46\xee\x1f\x61\xc1\x46\xf7\xd7\x2b\xd4\x67\x14\x1c\x62\x95\x65\xf4\x0a\xef\x3e\xb3\xd0\x52\xad\xa1\xf3\xa0\x55\x3d\x61\xcd\xd2\x6f\xfc\x81\x5d\xab\x17\xe3\x47\xb0\xd9\xd9\x8a\xb1\xea\x4d\xc3\x65\x84\xc7\xa7\x4f\x5a\xea\xe8\xd0\x15\xfb\x84\x58\x19\x23\xaf\x15\x8e\x2a\xc7\x56\x79\x2d\xba\x14\xdd\xcf\xfb\x08\x0c\x6d\x49\x5f\xbe\x67\x30\xc8\x2f\xce\x55\x81\x27\x3c\x19\x57\xe8\xe5\x3c\xc2\x23\xbe\x6b\xe5\x6c\xb4\xdf\x18\x0d\xe8\x6c\x21\xe7\xb6\x7f\x6b\xa2\x97\x31\xae\xd4\xbb\x0b\xcb\x4b\x5b\xfe\x01\x9c\x6c\xbf\x0c\x7c\xc4\x44\xc8\x2b\xc0\xa4\x95\x1d\xea\x81\x1c\x28\x36\x40\xed\x93\xb4\xf0\x49\x75\x0a\x22\x61\xa0\x52\x53\xb0\xf6\xa4\x48\x5b\x27\xf5\x62\xd5\xc5\xeb\xe2\x0d\x65\x2d\xd0\xfe\x66\x5c\x2f\x11\xbc\xc1\x5d\xe6\xab\x06\x2f\x96\x01\x05\xfd\x00\x22\x50\x3c\x2f\x67\xfc\xaa\x6c\xcd\x71\xaa\x0e\x5e\x08\x2d\xfe\x7a\x56\x54\x43\x3f\x8d\x66\x4d\x06\x98\x92\x9f\x20\x7a\xf2\xa1\x4b\x18\x64\x91\x98\xc1\x07\x6e\x7f\xd6\x84\x20\x71\x3d\x6d\x94\xae\x5e\xc0\x05\x06\xff\xd4\xaa\xae\x13\xe8\xfa\xf1\xca\xf2\x9d\x01\x2e\x4c\x50\x6f\x19\x9f\xac\xda\x78\xb7\xe4\x8d\x9c\x06\xa5\xc4\x50\x6b\x26\x2a\xc9\x87\x23\x88\x1c\x18\x23\xaa\x0d\x7a\x82\xe2\x84\x81\x5a\xb0\xa9\xc8\x68\x47\xcb\x21\xa4\xb6\x75\x42\xe4\xd2\x2f\x6b\x00\x71\xe3\xc5\xe5\x0c\x75\x08\xe7\x24\xeb\x97\xc7\xec\x9f\x5d\x1a\x31\x39\x8a\xd1\xd1\x78\x48\x5d\x41\x54\x6c\x15\x62\x25\xc9\x83\x7a\x9e\xa9\xee\xfb\x64\x9c\x1c\x1f\x51\xa8\x19\xde\xed\xaf\x8d\x08\x2a\xd0\x47\xac\x63\x36\x28\x73\x8e\x0d\x1a\xe9\x57\x05\xc1\x3f\xd7\xc9\x34\x4e\x82\x52\x6f\xdc\x9a\x39\xc6\x83\x63\x02\x95\xad\x6c\xc8\xf2\x72\x68\x24\xfa\xdd\xcc\xf1\xf9\x1d\x54\x49\xa8\x57\xf6\x5a\x04\xaa\xc8\x43\xd6\xa1\x69\xcd\xad\xa5\x94\xb5\x31\x5a\x86\xe6\x9a\x16\x2e\xeb\x1b\x7e\x99\xb3\x22\x2c\x96\x48\xbb\xb4\x27\x46\x05\xef\xd0\xdb\x98\xae\x98\x5f\x0e\x27\x2e\x76\x86\x47\x81\xf1\x1d\x1b\x8f\xd3\xb3\x3a\xd4\x51\xa0\x80\x45\xef\x97\xba\xf2\xd0\x24\xc0\xb1\x61\x60\xc6\xed\x5a\x47\xeb\x14\xe9\x68\x82\xf3\xd7\x75\x26\x03\x4f\xe1\xcb\x98\xc6\xf0\xc5\xd3\x54\x14\x3b\x91\x63\x8f\xce\x38\x65\xc5\xea\x07\x6a\x2a\xc3\x10\x12\x64\xda\xc5\x18\xab\xaf\x41\x6c\x43\xd7\x0f\x09\xf7\x55\xf8\xbd\x39\x16\x88\xee\x46\x2b\x57\xb6\x33\xb9\x53\xeb\xce\xe3\x4a\x5b\xc2\x4e\x20\x40\x85\x4e\xf4\x00\x8f\xc6\x13\xf7\xe8\xe2\xc2\xb4\xf4\x8d\xf7\x82\x8f\xd8\x79\xbe\x20\x2b\x9b\xe4\x05\x24\x45\xad\x91\x04\x42\x21\x80\xc0\xaf\x24\x78\xdf\xd4\x02\x39\x32\xf1\xe2\xe6\xcf\xac\xd6\x43\x6b\x09\xc2\x11\xce\x0f\x25\xa8\x16\x01\xd4\x4c\xd5\x47\x15\xb9\x0e\xc3\x1c\xb8\xb5\x8a\xf1\x84\x7a\x7e\x10\xbe\x3a\x23\xb9\x32\x43\x2c\x8a\xa3\x15\xcd\xd8\x7c\x85\x68\x85\x3b\x6a\x83\x06\xbb\x4d\xc0\x54\xba\xa1\x40\x11\x0d\x1a\xd7\x60\xa4\x4c\x4c\x0a\xe7\xf3\x4f\x31\xac\xc0\xa7\x9c\xf9\x48\xbd\x07\xf0\x0d\x08\x81\x10\x1d\xf3\x72\xd1\xbe\xac\xc5\x56\x8e\xc1\xe1\x13\x55\x6d\x6e\x5d\x8f\xc0\x1c\x97\xfc\xae\xfb\x7a\x5a\xa4\xe8\x99\x66\x6c\x65\x92\xf2\x2e\x71\x3f\x08\xe4\xbb\x55\xc7\x23\x45\x7b\x80\xd6\x02\x9e\xbd\x6b\x0b\x80\x49\xf8\xa0\x2c\xc4\xf3\xaf\x3f\x8a\xb0\x7e\x5e\xc6\x78\x76\xe0\x29\xd9\x1a\xf8\x01\xd1\x30\x17\x86\x26\x30\x72\xae\x02\x2f\xbd\xad\x21\x66\xc6\xaa\xad\xdb\xfe\xc4\x2a\x80\xe4\x3b\x1d\x11\x3a\xcc\x6e\x5b\x34\xc0\x5f\xb2\xd2\x04\x61\x84\x45\xe7\x0d\x77\x85\xcd\x34\x6f\x0a\x35\x74\x95\x02\x40\x62\xa9\xda\xcb\x43\xf1\x6e\xb2\x08\xc5\x66\x63\x07\x63\xbd\xd2\xf0\x78\x09\xd8\x75\x3e\x7a\x75\x16\x6b\x95\xb3\x3a\x28\xaa\x5d\x47\xe8\xd3\x81\x35\xc7\x09\x22\x96\x08\xa7\x73\xf1\x21\x86\x50\x20\xe1\x44\x08\x1e\xee\xa7\xfd\xaa\x75\xfe\x58\xb0\x0e\x30\xc3\x28\x71\x53\xdc\xab\x35\xc3\x6d\x3b\x18\x64\xd4\x5f\x95\x8e\x1e\x7c\xc0\x7f\xea\xdb\x78\x4e\x7b\x3d\x74\x15\x74\x83\x90\x50\x3f\xf8\xae\x3a\xef\x27\xd8\xb6\xde\x3d\x5a\xa2\xa8\xb6\x4f\x6a\x93\xd6\x96\x1d\x03\xf8\x76\x5d\x01\x46\xb5\xd3\x66\x81\x54\xb9\xfc\xa5\x38\x88\x83\x2e\x19\xb8\x2a\x91\x9b\xd1\x5c\xb3\xf0\x4f\x0f\xe5\x00\xa5\xbc\x43\xfa\xb7\x49\xd5\x86\x6b\x1b\xc2\x76\x1c\x64\xb3\xd5\x18\x8e\x0e\x58\x1e\xd8\x1e\xf0\x6b\xe5\x76\xa3\x4a\xa1\xcf\x57\xbe\x46\xd4\x3a\xca\x01\x2b\x11\x9a\x35\x59\xf3\x98\xfc\xd5\x3e\x9a\x43\x3e\x6a\x94\x06\x9a\x8e\x40\xc6\x19\xd4\x89\x44\x79\xe7\x55\x9f\xa2\x19\x1f\xb1\x88\x1d\x59\x78\x34\xcb\xd4\x0b\x15\x4e\xf7\xf3\xfa\xff\xce\x55\x30\x79\xdc\x44\xcc\x8f\xe4\xf2\xb4\xde\xe5\x33\x9e\xb1\x86\x41\xea\x56\x6f\x89\x0b\x1c\x4f\x1d\xe2\x56\x25\x41\x84\xd3\x6f\x84\xaa\x46\x89\x3e\x6c\xc4\x67\x7a\xb3\x2b\xb1\x36\x1d\x8d\x61\x0b\xd6\x71\xb5\x5b\xb3\xdf\xeb\x17\x90\x89\x1b\x66\x39\x27\x3a\xbe\xc2\xed\x8a\x85\xe2\xeb\xca\x81\x6b\x8f\x8d\x7f\x31\x15\x0a\x46\x2b\xe9\x70\xaf\xee\xe7\x81\xa8\x2c\x3e\xdb\xf0\xf7\x49\xb6\x2f\xaa\xcc\xbd\x4e\x9b\x10\x59\x2e\x35\x1a\xd2\xcc\x84\x0c\x8a\xa2\xeb\x59\x89\x33\xdd\x5f\x95\x75\x1e\x47\xe7\xda\x29\xea\xc9\xbe\x94\x7b\xd6\x7c\xfc\xa5\x54\xa0\xdf\x4f\xfe\x93\x4e\xb4\x95\xf9\x9e\xe3\x69\x22\x99\x38\x3e\x0c\x36\x12\xa3\x29\x87\xfa\xb7\x07\xa1\xf9\x1a\x8a\xf2\x94\x19\xa6\xd0\xec\xb2\x5a\x5b\xa3\x58\xf0\xef\x2a\x50\x4b\x40\x07\x83\xfe\x85\xda\xfc\xe3\x65\x79\x6c\xd8\xb0\x3c\xb1\x59\xd8\x92\xac\xe1\x4b\xa9\x4c\xb2\x38\x77\x2e\x66\xaf\x6f\x8e\x92\x2d\xa0\xe4\x10\x3d\x7f\xfd\x3c\x83\xd6\x56\xd7\x4a\xd2\x7d\x37\xc5\xe9\x99\xb1\x73\x74\x45\xc2\x42\x4d\xbf\x5f\x2a\xd3\xa6\x6e\x72\x2e\xa9\xda\x3b\x52\x3f\xab\x8f\x2e\xed\xf8\xab\x79\xab\x09\x50\x1c\xf0\x4f\xa3\x09\xc9\xd6\x99\xf4\x5d\xf7\x98\xb8\xd5\x15\x24\xd3\x5d\xb7\xba\x45\xfd\xb3\xb4\xce\x05\xb0\x90\xf3\x66\xb7\x06\x99\x65\x00\x7c\x46\xed\x66\x83\x9f\x3e\x3d\xb3\x74\xd6\x41\x97\x68\x57\xb4\x87\x20\xc5\x3a\x76\x9c\x34\x62\xee\x67\x1c\xaa\x1f\x3e\xed\x78\x18\x75\x7c\xec\x99\x74\xcc\x18\xc3\x71\x79\x73\xed\xa8\x52\xc7\x43\x62\xe4\x17\x2e\xec\x58\x09\x7e\xf0\x77\x2b\x6e\x66\xd7\x7f\x08\x5a\x99\x77\x76\xc9\xc5\x53\x17\x0d\x18\x8b\x97\xff\xae\x3f\x31\x12\x52\x1e\x44\x25\xba\x96\x85\x6f\x97\x7d\xc9\x1f\x61\x06\xa7\x99\x1b\x79\xa0\xcb\xa3\xa9\xaf\x5f\xb0\x3a\x4b\x7c\x2c\x95\xab\xed\xd7\x05\xf3\x9b\xf0\x57\x3b\x21\x5b\xac\x22\x72\xa2\x0b\x23\x4b\xbd\x4b\xed\xf8\xcc\x63\x08\x66\x59\x39\x87\x9b\xa4\xf7\x41\xd7\x7e\x34\x85\x95\xa7\x8f\xda\x1c\x2a\x0f\x29\x7f\x19\x28\xd2\x0b\x5d\x0f\x98\x41\x73\x9f\x4c\x69\xae\x96\x8f\x34\xab\xb8\x11\x0e\xd0\x1a\x95\x39\x16\x8c\x02\x00\x3a\x1f\xb5\x25\xe5\xbe\x4e\x10\x96\xb2\xf9\x1c\xe8\xf1\x53\x1d\xb4\x0c\x1f\xc4\xfe\xf3\xc6\x12\x57\xb1\x12\x29\xd9\x58\xa9\xa9\xe6\x9b\x4f\x4f\x00\xcc\x5f\x47\x67\x8a\x1f\x9b\xdd\xad\x1b\x28\xb4\xeb\x78\xef\xba\x33\xe9\x4b\xbe\x16\x74\x82\x20\xbf\x65\x1d\xf4\x7c\xd8\xc1\xbd\x45\x79\xf5\xab\xa5\xa9\x22\xd6\xd4\x27\x99\x2a\x7e\xb2\x35\xb0\xd5\x3f\x8f\xb8\x1d\x9e\xc1\x5f\xab\xf0\x3b\xab\xab\x8c\x4e\x05\x01\x8c\x46\xa4\xac\x92\x7d\x8d\x8a\x69\xd3\xdb\x8b\x24\x32\xf6\xf7\x42\xf4\x8a\x3f\xb6\x41\x97\xbf\xb9\x27\x9b\xe9\xd0\xe6\xa9\x26\x1f\x6c\x34\xf9\xef\xe2\xe8\x22\x8c\xf9\xdf\x5c\x75\xa1\x65\x8d\x49\xdd\xfa\x27\xee\x8e\xb5\xa7\x94\xb9\x1e\xaa\x96\x32\x55\xe3\x61\x88\xce\x59\x73\x3a\x2d\x7b\xfd\x6f\x6f\xfd\xf4\x8f\xcf\xb7\xbc\x84\xb9\xd8\xe5\xd5\x5b\xda\x32\xaf\x6c\x15\xe6\x18\xa6\x3e\xaf\xec\x57\x3c\xf2\x5a\x61\x97\xd0\x86\xe3\x05\x93\x71\x1b\x52\xdd\x4e\xea\xe5\x7b\x63\x90\x3e\x1e\xee\x06\x73\x3a\x4b\x68\x17\xec\x9d\x38\x72\xd3\x33\xea\x94\xff\xdb\x13\x88\x64\x39\x46\x60\x11\x06\x3c\x9d\xef\x5f\x4f\xc8\xae\x5e\x49\x4a\x64\x1a\x2d\x22\x80\x97\x02\x58\x1d\xfa\x04\x66\xf2\x58\x2f\x8f\x7f\xf2\x22\x67\x58\xd5\x91\x58\x51\xa6\x28\x71\x04\xa6\x3f\x3f\xf3\xbf\xfb\xd4\x13\xbb\x66\xda\xbf\x9a\x0a\x8e\x34\xc8\x45\x18\x18\xd3\x41\x38\xa3\xc7\x15\x9b\xdf\x3e\x29\xf4\x26\x0e\xe9\x3b\xfa\xf4\x76\x8e\xaa\xe6\xce\x1d\x59\x9f\xaa\x6b\xde\x83\x2c\x74\x54\x55\xaf\xc1\xbf\x7a\x26\x19\xab\xf2\xfe\xbd\xdf\xfb\x85\x24\xb6\xb0\x4a\x17\xf2\x7b\x12\xdb\x84\x91\x03\x0e\xcf\xdb\x4d\x38\xd5\x6b\xfd\x84\xd9\x8b\x6e\xd9\x7c\xf4\xac\x67\x91\x8d\xad\x47\x3a\x42\x68\xff\xb3\xaf\x7a\xfb\xaa\x0c\xa7\xda\x58\x22\x9b\xcd\xcb\xde\xdf\xa4\xb8\x3f\x6a\xca\xf6\xd7\x17\x85\x84\xa3\x12\x1a\x8c\xbf\x60\x63\x08\x8c\x9e\x64\xd2\xa4\x9f\xb1\xc4\x9e\xf1\x1a\x7d\xee\x71\xac\xc6\x08\x2c\x83\xdb\x58\x4f\x04\xb9\xbc\x34\x20\x5b\x68\x6f\xd4\xb1\xff\xbf\xbd\xf0\xb1\xfd\xde\xe9\x01\x98\xda\x01\x9a\x31\x59\x0d\x63\x45\x64\x56\x96\x3a\xaf\xc5\x48\x19\xff\x4c\x91\xc3\x86\x1f\xed\x6f\x1b\xbd\xff\x2c\xc5\xc2\xf3\x64\xa1\x4e\x8f\xb0\x78\xc3\xfd\x2d\x8c\x11\xda\xdf\xef\x9d\x82\x06\xa4\x3c\xd1\xd7\xfe\xaf\x97\x56\x7c\x7e\x73\x26\x20\xd0\x5d\x6d\xe8\xf3\x29\x0a\xc5\x95\x81\xfe\xb7\xa9\xb5\x14\x07\xda\x33\x65\xd9\x5d\xef\x46\x3d\x0f\x1e\x51\xe8\x31\x9d\x17\x98\x03\xd7\x73\xa7\x19\xdf\x7f\x8f\x5b\xae\x60\xfc\xa0\x98\x32\x81\x96\xdd\x73\x18\x68\xbb\x0e\xa4\x37\x0b\x23\xfb\xb1\x81\x03\x6c\x0e\xeb\xa1\x6e\x24\x6d\xff\xbb\xcf\x5e\x39\x55\x5b\xc8\xd6\x72\xe9\x8c\x35\xc9\x9e\xfe\x75\x46\x8c\x4e\x9a\x04\x39\xce\x25\x48\xde\xf8\x27\xee\x76\x7f\x68\x9d\xab\xf5\x20\x79\xfb\x57\x2f\x84\xd4\xd5\x5e\x07\x43\x92\xf5\x58\x74\xf9\x25\x2a\xa0\x86\xc7\x72\x8d\x51\x41\xe0\x7f\x4e\xb5\x57\x88\x08\xfa\x57\x4f\x33\x29\xff\x28\xec\x14\xab\xa9\x51\x52\x1e\x7f\x7b\xc6\xfe\x6b\xe9\x6e\x82\x7a\xa6\x5c\xda\x79\x6e\xc7\x7f\xf5\xa8\xe2\x61\xf6\x43\xde\xb9\x92\xe9\xf8\x83\xca\x36\x88\xf4\x41\xe2\x33\x1e\x3b\xa5\x86\x7b\xfe\xbb\x97\x95\xc8\xf2\x59\x27\x9b\x1a\xcc\x10\x9e\xd9\xd7\xbc\xfe\x1a\x57\x66\xba\x99\x7f\x1d\xc3\x66\xec\x6b\xdd\xf6\x09\x2a\xbd\x6e\xe8\xc7\x90\x74\xe7\xdf\x28\xfc\xc5\x21\x9c\xfd\xa7\xb1\xcc\xab\x2f\x4c\x28\x98\x1d\x6b\x16\x7c\xab\x65\xd1\x15\xf4\xd7\xcf\xb2\x07\x26\x31\x3e\x01\x72\xff\xab\x27\xcc\xf4\x35\xdf\xc0\x62\x53\x4b\x0a\xd3\x4a\x0c\x3d\x29\xa6\xff\x7a\xd6\x41\xdf\x28\xfb\x77\x4f\xa0\x9f\x8f\xd7\x2c\x97\xdb\x14\xca\xb8\x8b\x09\x90\x4a\x2d\x8f\x3b\xb4\xb7\xfc\xf8\xfb\xef\x41\xd6\xdf\x92\xf1\xdd\xd7\x14\x8b\x21\x98\x09\xe1\x23\x25\xb4\x6b\x33\xff\x7c\x59\xbc\xff\xf7\x38\xc9\x26\x6a\x57\xcd\x19\x0e\x2d\xcf\xde\xef\x8f\x76\x75\x6b\x8d\x20\x3f\xe6\xfc\xaf\xc6\x5e\x2d\xd3\x9d\xb5\x00\x7d\x8a\x96\x07\x91\x8f\x81\xb9\x7a\x03\x19\x66\x14\xf8\x5c\x2e\x4d\x12\xd2\xff\xb7\xaf\xe1\xeb\x3d\x50\x2e\xf0\x6a\x58\x83\x7b\x0a\xe4\xee\xba\x6b\x26\xf8\x85\xc1\x1e\x4b\x42\xfe\xff\x3d\x2f\x5b\xbb\x73\xce\x40\x41\x6d\x29\xe1\xd2\xa0\x95\xe8\x3f\x72\x52\xfc\x73\x8d\x70\x4c\xfe\xbf\xc7\xde\x75\x31\x8c\xd6\x3f\xef\x64\xbf\xc5\x6c\xd8\xae\x47\x05\x3d\x8c\x0b\x8c\xc7\x27\xcd\xe6\x52\xff\x9e\xdf\xcf\x3f\xf5\x19\x95\xa5\x2b\x86\xd6\x8c\xd1\x3b\x27\xfd\xc8\x7c\x6a\x64\x93\x9a\x98\xfd\x6f\xcf\x94\x9a\x63\x7e\x20\x92\x33\xc4\x36\x1b\xc7\xf2\xff\x7f\x5c\xd3\x33\xb3\xd9\x8d\xd0\xd3\xd1\x75\x6c\xe1\x24\x73\x69\x37\x75\x10\xb3\x08\x40\xf3\xf4\xe6\x80\xd8\x58\x9c\x31\xfc\x6f\x7f\x2e\x59\xc9\x99\xcf\x61\x57\x94\x45\xc9\x8c\xe9\xa1\xf3\xf8\xa3\xdd\x7f\xd6\xc8\x95\x1e\xd2\xff\xdb\xb3\xb1\x63\xda\xb2\x21\x0f\xf1\xfb\x5a\x02\x1e\x35\x33\xa0\xdc\x4f\xb7\x7e\xf3\xa2\x0e\xb4\xfe\xbf\x63\x11\x61\xa6\x0e\x18\xe2\x1f\x5f\x51\x0e\xcf\x5e\x1d\x90\x64\xe6\xbf\xd6\xef\x35\xff\xab\x41\xd8\x6b\x61\xf5\x4f\xc5\x3c\xf4\xb0\x03\x0d\x09\x81\xec\x5f\x9f\x27\x15\x63\x71\xef\xd5\x35\x79\x26\xd6\x79\x9c\xff\x3d\xb6\x15\x13\x3f\x80\x97\x17\xc9\x50\x82\xd7\x63\x91\xbf\x35\xc2\xdd\x19\x5a\x99\x4f\x13\x1e\x52\x93\xff\xa7\xef\xcb\xc3\x31\x0c\x94\xe8\x72\x7f\x63\xf1\x0e\x5f\xd5\xb5\x27\x36\x0f\x17\xfb\xe7\x05\x16\xec\x9f\xf1\x38\x66\xbb\xcd\x8b\xd5\xc6\xfe\x1b\xd3\xcd\x97\xc5\x58\x1e\xa2\x76\xea\xcd\x41\x5b\x83\x3b\x83\x83\xdd\x0e\x24\xec\x15\x20\x77\xde\x52\x54\xce\x21\xa5\x09\xf8\xc3\x2d\xfb\x88\x6d\x58\xb5\x5f\x47\xf1\x71\x66\xf7\x3f\xf7\x63\xdf\xbf\x95\x27\xa1\x6a\xb5\xfe\xea\x8c\xcb\xcb\xe1\x08\xe6\x13\x47\x7e\x5a\xf5\xf9\x61\x62\xe8\x33\x8c\xf9\x08\xeb\x1c\xc5\xfd\xd4\x0d\xcc\xb0\x0c\xdf\xfb\x9a\x01\x8e\xe5\x9e\x65\x13\x77\x5b\xde\xc2\x06\x6a\xfe\xdf\x71\x4c\x99\x86\x01\x2e\xaf\x73\x9d\x3b\xcf\xc9\x18\xc5\x80\x7b\x89\x31\xb4\x3b\xb8\xad\xc9\xe2\x4a\x92\x6c\x7c\xb0\x17\x19\x04\x2f\x6b\x08\x46\xb4\x44\xcf\x57\x52\x11\x7d\x97\x7d\x19\x10\xaf\x27\x75\xa3\x0e\x9d\x98\x8e\x41\x0c\xc9\x35\x78\x86\x1a\x40\xdf\x81\x18\x56\x56\x3e\xb5\x7a\x8d\x19\x03\x0b\xe3\xf6\x85\xee\x61\x8a\xd8\x5f\x44\x5f\x3d\xc3\xb0\xb7\xf4\x4f\x57\xfc\x7f\x7a\x87\x5d\x46\xad\x68\x47\x2b\xfc\x88\x9d\xe5\x8e\xea\xf5\xf4\xaf\x6f\xa8\x04\x61\x18\x4e\xb6\x2e\x3f\xe9\xaa\x3e\xfb\x97\x19\x8c\xbf\x1d\x67\x05\x66\xf1\x22\x90\x07\x60\x07\x03\x67\x25\x50\xc0\x96\x9b\x0a\xd8\x1f\x32\x4a\xe9\x1c\x03\x67\xf8\x55\x5f\x61\x6a\x16\xba\x0a\xf7\xc4\xb3\xf0\xb7\x91\xde\x2e\xbf\xd2\x8b\xce\xd7\x6a\x07\x85\xe2\x12\xeb\x54\x39\x93\x00\x0c\xc7\xcb\xd4\xc0\x06\x12\x89\x6e\xd1\xd8\xcd\x3f\xbf\x23\x3b\xe2\x07\x0b\x46\x6e\x40\x5e\x10\x9a\x75\x6b\xfd\xe3\x3b\x95\xb6\xc1\x98\x1b\xeb\x02\x5f\x0e\x03\x51\x3e\x0f\x16\x7d\x0b\xee\x59\x31\xc6\x2d\x2e\x8b\xfe\xdb\x38\xa1\xbd\x03\x30\x7a\xae\x53\x32\x96\x62\x8d\x98\xca\x07\x5b\x88\x64\x62\x49\xae\x9b\xfe\x57\xe3\xec\xab\x35\xb8\x93\x17\xfe\x01\x9e\x6e\xfe\xcb\x41\xae\xad\xba\xc3\x05\x2c\xc9\x56\x58\x6d\x77\xc7\xff\x0c\x83\xe0\xd7\x01\x63\xd7\x35\xb0\xec\x05\x6e\x7e\xb0\x4e\x81\x51\x72\x69\x36\x07\x45\x5e\xef\xa2\xe8\xf9\xb6\x0a\xb6\xd7\xaa\x4b\x49\x69\x40\x07\xf1\x43\x75\xaa\xa4\x6c\x9e\x3e\xa3\x10\xcf\xa7\x01\x51\x2a\xc3\x9d\x4b\xb3\x5f\xfc\xcf\x5c\xa5\x67\x0a\x1d\x6b\x51\x23\xf8\x5a\xf4\xa3\xe2\xd4\x99\x48\x27\x0c\xa1\x1b\x1b\xb2\xb2\x9a\x9f\x12\xdb\x8d\x74\xe4\xa1\xaa\x33\xa1\xd7\x0d\xf2\xa8\x47\xbc\x71\xf0\x63\xfa\xaa\xdb\x95\x4a\x2e\xcb\x53\x9c\x85\x22\xe3\xa5\x9d\x5b\x62\xe9\x2c\xeb\x27\x13\x33\x26\x52\x94\x2c\x5d\xad\x20\x78\xd7\x71\x8c\x01\x14\x24\x29\x61\x15\xa0\x00\xd8\x96\x15\x22\xe0\xcf\x91\x5f\xc6\xbb\xc2\xad\x4a\x75\x42\x86\x65\x25\xcd\x79\x67\x8e\x2c\xf6\x0d\x2a\x5a\x25\xf0\x5e\xf3\xf9\xdd\xc4\xf7\xfc\xf1\x69\xb6\xaf\x16\x14\xe4\x97\x0e\xe5\x34\xa0\x29\xb5\xf4\x4f\xee\x83\x10\x7f\xfb\xfa\xa5\xba\x3f\xb1\xd7\x12\xd2\x0c\x13\xf9\x05\x6b\xd6\x96\xa0\x75\x81\x25\x6a\x08\x4f\x5a\xca\x93\x81\x14\x83\xd9\x5b\x6b\x64\xfe\xa8\x01\xab\x34\x5d\x4f\xc5\xfe\x8c\xd9\x28\x1a\x66\xed\x43\x3f\x91\x96\x95\xe8\x6d\xe8\xb7\x13\xb0\x10\xc9\x7c\x54\x39\x67\x21\x65\xd2\xb0\x0f\x8c\x17\xea\xf2\x24\x9a\xca\xdf\xde\x4b\xf2\xfd\x66\x03\xa1\x2b\x5b\xb1\xa4\xa8\xc4\x8b\x32\xb9\x4f\xee\x0d\x42\x26\xce\xed\x53\x03\x68\xc3\x1e\x2b\x4c\xcc\x8a\x1a\xe5\x31\x08\x1d\xb0\x6a\x5b\xb6\xf3\x11\xd0\x38\x1b\xbd\xad\x96\xa0\x0c\xa5\x55\x6b\x65\xa9\xb0\xe9\xb2\x73\x63\x14\x93\x8c\x4c\x36\xbd\x43\x7d\x84\x4e\xbf\x0a\x9e\x38\x77\x5d\x9d\x83\x11\x0c\xe9\x06\x27\x71\xd7\xd4\x62\x40\x1a\x3f\xbe\x5f\x4f\xf8\x69\x0c\x67\x17\xed\xca\xef\x83\xb7\xaf\x75\xcf\x5d\x41\xf0\xb0\x04\x61\xb7\xcc\xf2\x15\x15\xe3\x09\xd9\x43\x8e\x96\x8f\x04\xa2\xda\xb2\x9a\xdf\xd5\xa1\xd8\x9d\x75\xca\xce\x06\x59\x07\x6e\x07\x1c\x19\xa4\xa5\x2c\xc9\x09\x17\x54\xfa\x9a\xa7\x2e\x64\x6d\x39\x6a\x42\x88\xec\x54\xd5\x46\x1e\x76\xe5\x84\xac\x32\x7f\xf8\x24\x2a\x5f\x71\x65\x3f\x31\x23\xc6\x22\x86\xd5\x6e\x70\x77\x56\xe3\x49\x25\x7a\x9f\x71\x87\x0f\x3a\x34\x0e\xd2\x1b\xd0\x58\xf9\xea\x20\x0b\x7d\x25\x04\xe9\xbd\x9a\xbf\x93\x35\x91\x99\x28\xc8\x5e\x54\x09\xc2\xea\x27\xa6\x3b\xae\xe8\xdb\x6f\xf2\x58\x2b\x79\x2d\x45\x6c\x86\x34\x12\x03\x0d\xca\xf2\xb7\xf0\x7f\xb1\x2b\xbf\xb1\xbb\x75\x9b\x5a\xf4\x23\xde\x91\x68\xa0\x7b\x2b\xed\x6e\x86\x70\xcf\x11\x2f\x90\xe7\xfa\x86\xcc\xfa\xcc\xc5\xab\x8f\xb8\x5e\xb2\x34\x83\x43\x1a\x81\x44\x96\x1c\x77\xb0\x60\x8b\xaf\x3e\x48\xb6\x76\xd2\xe5\xb6\x21\x2a\x74\x6a\xcf\xf7\x39\xa3\xb0\x55\x76\x36\x63\xbe\x27\x93\x67\xd7\x51\x02\xd5\xd1\x0d\xb2\x48\xc2\xbd\x5d\xa1\x20\x54\x3c\x15\x8a\x66\xfb\x8b\xe5\x2c\x92\xbd\x34\x72\xd9\x58\xb0\x02\xf4\x4e\x34\xd0\x3f\x3d\x59\xfa\x0c\x2f\xcd\xdb\x71\xe9\xe9\x76\x9e\xde\xfe\x4b\xd5\x3c\x17\x31\x33\x1d\xf7\x3a\x19\xad\xc4\x4e\x23\x42\x8d\x53\x26\x15\x15\x91\xd9\x1d\x0d\x5d\xb4\x80\xa0\x30\x1d\x94\xd6\x30\xf2\xc9\x9c\x77\xfe\xe8\xa8\x7e\x67\xf2\x16\x38\x2c\xc7\x76\xa0\x42\xbf\x90\x15\x85\x73\x3c\x33\xd1\xf9\x65\x4e\x99\xbf\xce\xfa\x6f\x9f\xa7\x42\x63\x74\x01\x00\xf3\x55\xbd\x7c\xfa\xd8\x19\x9b\x11\x8b\x01\xec\x25\x61\x6a\xa7\x5d\x97\x14\x14\xd7\x8e\xcb\xfd\xed\x1b\x7a\x6c\x07\xeb\x4e\x1a\x86\x48\x3a\x71\xfe\xe9\x57\xea\xb9\xde\xc9\x72\x49\xdd\x20\x1c\xba\xdc\xd9\xd6\x0c\xb1\x00\xfc\x0e\xb8\x04\x48\x46\x88\x86\xe0\xe4\x81\x8b\xc5\x92\x38\x88\xe9\x11\x62\x3a\x5d\x99\xac\x4d\xcb\x08\x6a\x5f\x96\x9e\xf8\xd8\xaa\xa2\x50\x2c\x39\x1c\x29\xf6\x3f\x1e\x7c\x78\xff\x8a\xb2\xc7\x67\xab\x53\xa5\x8a\x0d\x72\xdd\x4a\x3e\x9c\x94\x04\x42\xd2\xc2\x34\x26\x1d\x6d\xce\x13\xd6\x11\xc1\xb1\x8f\x9e\x0d\x03\x4d\x36\xf3\x28\x13\xe9\xb6\xe5\x25\x9f\x2a\x71\x81\x39\xef\x20\xc0\xe7\xaf\x17\xd4\x60\x33\x82\xd7\x81\xeb\x42\x32\xd6\xf8\x58\xf2\xab\x33\x4c\x78\x1e\xda\x95\x30\xa2\x28\xef\xd1\x59\x60\x86\x3c\xbc\x50\x0c\x47\x62\x40\x8a\xd1\xf8\x93\x24\xe6\xab\x38\x75\x79\x7e\x47\x79\xa5\x4a\x02\xd8\x9e\xfe\x14\xd6\xf7\xba\x1d\x06\x18\xcc\x89\x66\xf7\x4f\xbd\xac\x3c\x94\xd2\xad\x10\x07\x27\x54\x71\x96\x15\x30\xac\x46\xf4\x54\xc7\x95\xca\xcb\x80\x33\xc6\x32\xd8\x52\x2d\xdc\x0d\xbc\xde\x40\x17\x9e\x3c\x6d\xe1\x2f\xce\xda\x41\xc2\x05\xeb\xf0\x2d\x56\xa1\x94\xc0\x47\xfe\xfe\x4f\x8f\x8a\xda\x3f\xdb\x57\x67\xfc\x1a\xc4\x6f\x28\x37\xdc\x56\x17\xd2\xcc\xa0\xd4\x98\x9c\x51\x9a\x62\xcc\xa6\xf5\x49\x84\x57\xcc\x3e\x51\x6f\xd9\x2f\x71\xbe\x8a\xc9\x41\xb6\x24\x36\x4a\x10\xf7\xae\x9b\x57\xb3\x8e\xe1\xa1\x04\x49\x00\xdc\xb9\x7a\xd9\x4a\x77\xe4\x93\x7a\x49\x1c\x70\x1b\x31\xf0\x03\x26\xa0\x5c\x4c\x7b\x65\xed\x39\xd9\x2a\xdf\x9f\xab\xc3\x1f\xa9\x82\x1d\x35\x1e\x3d\xd6\x3c\xae\xfb\x4e\x83\x4f\x41\x00\xd4\xb8\x5e\x3f\xa8\xf8\xf8\x43\x6f\xd0\x1d\x11\x7e\x61\x81\x8b\x12\x93\x4e\xb3\x05\x33\x30\xdb\x99\x20\xbf\x9a\x4c\x81\xec\x82\x1e\xfb\x19\x21\x9f\x16\x59\xc9\xa6\xfa\x39\x30\x70\x17\xff\x89\x95\x7d\xb1\x6b\xfd\x20\x01\xdd\xdc\x83\x08\x9a\x6f\x44\x98\xef\x8d\xad\x28\xd9\x00\x9b\x89\x1b\x19\x05\x65\x52\x1e\x2b\x51\xaf\x94\xf9\xe7\x7b\xa3\x44\x5b\xbd\x39\x2c\x65\x8e\xe6\x5e\x0f\x55\x6b\x67\x88\x7e\x08\xa4\xe9\x9b\x4b\x21\x0b\xc1\x2b\x3a\x0f\x01\xa3\x3e\x0e\xe8\xc8\xf1\x98\xb5\x42\xb5\xad\x39\x89\x57\x67\x16\xa6\x7a\x69\x1c\xea\x38\xbd\xce\x8e\x49\xed\xfd\xb9\x52\x5d\x14\xff\xab\xc6\xfc\x7e\xe0\xb1\xa7\x2b\xd1\x2b\x6b\x05\xc7\x72\x9e\xfd\x2b\xb0\xaa\xbb\x1a\xc0\xea\x05\x99\xb0\x62\x03\x8e\x13\x94\x8a\x12\x86\x8c\x26\xbb\x08\xd4\x40\x38\x52\x10\x63\x83\xa3\x78\xca\xd7\xda\x33\xf1\x76\x9d\xb2\xa9\xaa\xf1\x10\x8e\xa2\x5b\x35\xcb\x89\xe0\x6f\x80\xb7\x21\x58\xd0\x2b\x5d\x7f\x51\x32\xe0\xb1\x22\x52\x50\x7e\x3e\x9e\x7f\xcb\x26\x88\xae\xa0\x85\x3f\xda\xb0\x8c\xac\x65\xbe\xeb\xff\x42\x01\x0b\x77\xfc\x20\xb4\xb6\xb3\x43\xba\x62\x27\x8c\xee\xa2\x04\x63\x60\xe8\xdf\xcf\xd3\xdd\xbf\xea\x7d\xc0\x4e\x6e\xa3\x81\x82\x5c\x17\x19\x50\xc8\xcd\xd1\xd9\x9b\x53\xe3\xb1\xd5\x1b\x81\x61\x21\x86\x75\xa2\xe5\x4f\x62\x88\x66\x2c\xaf\xfc\xb2\xfe\x0e\xec\xe9\xbf\x51\x52\x60\xf3\x67\x08\x98\x62\xdb\x49\xbd\x3f\xf5\x21\x1e\xa5\xe0\xec\xba\xcd\x0b\x4f\x20\xe3\xc4\x55\x8d\xcb\xd0\x03\x76\x5e\xb4\x34\xd0\x42\xf3\xc5\x3d\xc5\x4f\x36\xf6\xc1\x00\xc4\x92\x31\x0a\xd5\xf2\x64\xb1\xb4\x35\x04\xc2\x5b\xb6\x95\xd5\x1a\x79\xaf\x38\xea\x7d\x52\xec\xc0\x64\xc8\x29\x81\xdc\xaa\x06\xa7\x71\xfd\xe8\x4b\x63\x2a\xbc\x85\xa1\x5e\x00\x31\xb2\x7c\x69\x36\xcf\x04\x98\xf4\xc2\xe3\xb2\x1b\xf9\xe0\xf5\x1d\x00\xa6\x84\x5c\xb0\x92\x28\xf7\xc7\xec\xd2\x75\x40\x9d\xde\xd8\x5e\x8f\xbb\xe6\xe7\xe5\x7a\x78\x6a\xc9\xcd\x2a\x8d\xa6\x49\x9d\x33\x3f\x45\x83\xb6\x9c\xc2\xf0\x7c\x41\xc9\x94\xe5\xfe\xb7\x82\xb6\x99\x7d\xa6\x56\xec\xbc\xd9\xd9\x30\x2c\x59\x06\xf0\x08\xb4\x9b\xd6\x73\xa3\xf2\x87\xff\xed\xe3\xf6\xf7\xfe\x4c\xf1\x73\xcd\xf4\x3e\x8a\xe0\xb7\xe0\xb9\x4d\x17\x86\xac\x81\x6e\x8d\x81\xdf\xd4\x04\x33\xc9\x5c\xad\xa8\xae\xb3\x08\x98\x21\x61\x79\x93\x3a\x8f\x51\x59\xf7\xb6\x5a\xc8\xc9\xb7\x06\x01\x3e\x85\xc9\xe1\x3a\x95\x68\xaa\xc3\x28\x1f\x31\x66\xd7\x52\x1f\x66\x38\x8d\x16\x12\xa6\x89\x2a\x6c\x7e\x60\xf8\x15\x93\xd0\x17\xe9\x55\x35\x5d\xdb\x93\x2e\x01\xd7\x41\x6d\x83\x80\xd7\x2a\x1a\xd2\x8e\x38\x9e\x34\x62\x13\xc2\xb0\x2a\x8b\x36\xe3\x77\xac\x13\x80\x3f\xd6\xbf\x3e\x75\x7c\x60\x99\xba\x01\x56\x0f\x66\xe6\x0c\x2c\x4b\x70\x76\xa0\x09\x3e\xa9\x28\xdc\xdf\x60\x9e\xf7\x9b\xa6\x1c\x73\x67\xf4\xff\x0b\x1b\xb2\x9a\xd9\xba\x22\x8e\x8a\xf5\xf4\x20\x08\xd0\xf4\x23\x89\xee\x71\xa8\x13\x45\x82\x58\x47\x81\x2f\x78\x48\x6a\x29\xf2\x15\xc4\x73\xa7\xc2\xaa\x8f\xf3\x4c\x4d\x98\x1b\x1b\x7c\x15\x43\x99\xc2\x45\xfc\x9f\xf7\xaa\x87\xb5\x8e\xd5\x71\x9e\x4f\x28\x56\x39\xa9\x07\x12\xa0\x58\xc1\x68\x72\x50\x91\x73\x9d\xa2\x5a\x97\x10\x9f\xe5\xb2\xed\x04\xc4\x0e\x00\x32\x58\x11\x5a\x16\x56\x1a\xb4\xbe\xb8\x93\xff\xe1\x56\x9d\xf3\xd9\x8f\xf2\x63\x3e\x50\xa7\x81\x0b\x8b\x3b\xa0\xa2\x9d\x43\x1e\x88\x31\x98\x62\xc9\xeb\x4f\xf8\xd6\xd7\x6b\x15\xce\x81\x9b\xf9\xfa\xb9\xea\xe3\x44\x80\x43\x7b\x42\x07\x21\xd3\xb1\xc5\x17\xb5\x7c\x69\x5f\xe6\xed\x98\x53\xa9\xfb\x71\x09\x7d\x7e\x2e\x0a\x38\x12\xa0\x34\xd1\xf5\x9d\x0e\x92\x44\x40\x1d\x42\xa3\xb0\xc8\x43\x6f\xff\x4f\x3f\xb7\x9a\x13\xd8\x67\xf6\x87\x2d\x7c\x40\x18\x32\x33\x70\x38\x26\xc8\x03\xb3\x5c\xa0\x0f\x4d\x05\x6a\xca\x6c\xdb\xd7\x69\x9e\xfc\x6d\xb2\x3f\x0b\xa8\xa8\xa5\xcd\x8d\x7a\x43\x8b\x6f\xe7\x9c\xbe\xb4\x28\x22\xb7\xa6\xa5\x30\xb5\x19\x4c\xb0\x8f\x92\x67\xaa\x6e\x91\xea\xaa\x81\xd5\x15\x7f\x96\x35\xac\x38\x0a\xcb\x9c\x39\x06\x90\xa8\x81\xe7\xe3\xaf\x63\x7e\xd4\x1c\x07\xb9\x18\x78\xb1\x03\xe7\x14\x81\x0a\xaa\x06\x4f\xde\xc7\x80\x43\x12\xef\x1d\xfc\x3a\x4d\x87\xc0\x4a\x64\x6a\xaa\xd8\x4f\x2f\x00\xe1\x97\x0d\xb0\x1f\xa5\xb9\xd6\xff\x71\x54\x7a\x2a\x93\x1c\xc4\x24\x41\x7f\x87\xf9\xc9\xaa\x12\xa4\x0f\xf5\x97\x59\x80\xf7\x8a\x9b\x47\x14\x58\xf7\xc6\xd0\x57\xc3\x6a\xd5\x01\x63\x71\x28\x3c\x6e\xab\xc0\x51\xf8\xdd\xe6\x45\x1b\xcc\x67\xec\xf4\xe5\xe9\x5a\xdd\x2e\x41\x98\xfc\xfd\x20\xd0\xc2\x70\xe4\x77\x79\x04\x10\xc9\xbe\xf6\x8c\xbe\x14\xc2\xd9\x5f\xdf\x24\xe3\x95\x62\x64\x31\x41\xd0\xf4\xcd\x9e\x5c\x52\xe7\xfc\x4f\xf3\xdc\xf6\x93\xb0\x2e\x53\x36\x10\xd5\x9a\x28\x08\x44\xe7\xb2\xa1\x19\xf8\xfd\x94\x16\xb8\x9f\xef\x74\x0b\xc8\x4f\xec\x78\x21\x16\xc2\x8c\xb2\xc7\xdb\xf9\x52\x35\x0d\xf9\x02\x81\x7f\x6d\x2e\x17\x26\x79\x90\x9d\x13\x3c\x50\x12\x03\xfc\xa0\x00\xac\x21\x81\x48\x28\x4e\x4b\x34\x7f\x07\x60\xca\xd0\xca\xc3\x7e\xe5\xf1\x7e\x2f\x3f\x74\x7b\xc7\xf6\xff\xed\x85\x57\x0b\x6c\xca\xaa\xbc\xb7\xa0\x15\x0d\x70\xc5\x20\x32\x89\x56\xc1\x02\xb1\x64\xc9\xef\xa3\x2c\x58\x1d\x48\x12\xfb\xe8\x0b\x2f\x50\xee\xaf\x8e\xc0\x53\x58\x08\x96\x58\xd6\x16\x63\x04\x4c\x23\x95\x8f\x99\xc1\x63\xf8\x9e\xd7\x8b\xdc\x07\x03\x9b\x69\xbd\xd4\xaa\x95\x5b\xc0\xf1\xc3\x5c\x92\x15\xdb\xa8\x7e\x5e\x92\xd3\x39\x00\xc1\x01\xde\x05\xb0\xf8\xaf\x9e\xaf\x6c\xcd\xa5\xaf\x07\xb7\x98\x50\xe8\xdc\x21\xdd\x2c\x8f\x95\x71\x4f\x9f\xb5\x95\x89\x43\x53\x35\x9d\x65\x4c\x8e\x79\x9d\xb4\x25\x8d\xda\xc6\x95\x24\x77\x54\x3e\x21\xfd\x4f\x2d\x6a\x0f\xf5\x7a\x05\xfc\xa2\x7b\x8c\x4a\xc5\x26\x4d\x18\xc1\xf5\x88\xf7\x35\x5a\xcb\x24\x60\x8b\x0f\x80\x44\x10\x81\xaf\xfa\xb9\x38\x76\x4e\xf4\x56\xb4\xef\xd9\xd9\x49\x85\xff\xed\x87\xe7\x32\x3b\xbb\x31\x1b\x83\x13\x67\xc3\xaa\x25\x0d\x18\xdc\xfd\x83\xcc\x87\xd8\x00\xeb\x72\xd5\x1d\x64\xf4\x44\x1f\x2d\xa0\x4e\xfd\x87\x68\x1c\x01\xa9\x05\xe2\x98\x7c\x06\x65\x76\xe5\x42\xc1\x9b\xa5\x26\xe6\xbe\xc8\xec\xa1\x28\x33\x0b\x3d\x7b\xd8\xad\xc1\xb8\x54\xad\x1b\xd2\x35\x02\x01\x88\xf5\x00\xf0\x7a\x25\x37\x5f\x0c\x36\x28\xa1\xff\x3e\x7b\xec\xfb\x06\x63\x57\x96\xf8\x3c\x05\x8b\x00\x38\x18\xa3\xf4\x63\xc3\xa0\x36\x51\x30\x70\xf1\x99\x45\xb3\xa7\xe8\x46\x8b\x88\xa7\xbe\x22\xe3\xf5\x11\x04\x6e\x48\x1c\x58\x5d\xbe\x91\x66\x73\x85\x49\x1d\xd9\x77\xc0\x01\xac\x4a\x10\xcf\x58\x91\xb0\x10\x4e\x86\x02\x32\xd1\xc5\x63\xbb\x69\xf4\x19\xb5\x34\x7c\xcf\x00\x7c\x4a\x9f\x81\x1c\x10\x11\xa4\xa0\x99\xf8\xfd\xab\xcf\x23\x8b\xb3\xaf\x4b\x62\x3e\xb0\x57\x55\xa5\x55\x91\x2a\xb6\x6a\x1e\x84\xdf\x91\x37\x7d\xa3\x0c\xa2\x3e\x8a\x16\x16\x29\xb3\xfb\x41\xb0\xfd\xd6\xb2\xdd\x6c\xa5\xa2\x19\xce\xe7\xbd\x1d\xc9\x2a\xe0\x44\xe1\x42\x3c\xe8\xce\x47\x43\x5f\x02\xad\x63\xa8\x52\xe2\x82\xc8\xca\xd9\xa4\xe9\xd8\xb3\x5f\x9d\x35\x38\x58\x15\x13\x01\xbc\x1c\xa1\xd2\xc5\x26\x80\xff\xed\x01\xcb\x6e\xef\xb9\x17\x46\x18\xfa\x45\x8a\x34\x77\x88\x29\xf0\xc5\x53\xcc\x2c\xd1\x89\xab\x63\x4d\x52\xe3\x2e\x1f\x6e\xb8\xc7\xa5\x8e\x36\xf6\x5b\xe8\x75\x90\x62\x89\x8b\x65\xd6\x5f\x78\x47\x83\xdc\x18\x4c\xc7\x03\xf7\x75\x66\xdc\x6a\x59\x0b\xd5\xa7\x97\xde\x56\x79\x56\xb7\xcd\x10\xb1\xb3\x41\x9f\x15\x48\x2b\x17\x91\x18\xc5\x55\xa4\xab\x4f\xd1\xc5\xea\x07\x78\xbb\x09\xaf\x33\xde\xd3\x7f\x3d\x3b\xd1\x6d\xb1\xe2\x5e\x3c\xd5\x78\x82\x4e\xfa\x37\xfd\x1c\xef\xf2\x70\x8b\x5e\x78\x7a\x44\x23\xa9\x3a\xbb\x5d\x0e\x50\xa4\x2e\x39\x04\xfe\xe7\xef\x41\x1c\x1a\x6f\x2a\x07\x95\x3b\xfc\x36\xef\x58\x0c\x5a\xa7\x55\xfe\x7e\x1a\x9b\x96\xc4\xbc\xb9\xfd\x55\x4a\xe0\x1a\x06\x72\x0b\x55\xa7\x9d\x1f\xbe\x9e\x12\x3a\x96\x31\xc0\x5e\x6c\x0b\xc4\xff\x8f\xb9\xf7\x5a\x92\x5c\x49\x96\x04\x3f\x67\x76\x05\x3b\x0b\x1e\x00\xee\xca\x3c\x80\xb3\x00\xe7\xf1\x06\xce\x39\xc7\xca\xfe\xfb\xa2\x7a\xe6\xf6\x39\x33\xaf\xd3\x0f\x5d\x22\x29\x95\x25\x89\x8a\x84\x3b\x4c\xd5\x54\xcd\x1d\x6e\x7e\xbe\xea\x0f\x30\x53\x95\xf5\xcf\x7a\x0c\xcc\x48\x32\x4b\x4b\x23\x26\x35\x60\xe3\xe6\x40\xf4\xa9\x07\x8a\x8a\xef\xfd\x58\xf0\x82\x6f\x5e\x94\x1b\x99\xfe\x29\x22\x6d\x86\xd2\x12\xaa\x1e\x38\xa1\xe1\xa1\x14\x9a\xba\x9e\x7e\x64\x12\xf1\xa3\x7c\x7c\x6b\xa1\xf6\x42\x3d\xfc\x46\x1a\xd1\xfe\xba\x19\x5b\xa7\xb4\x79\x1a\x88\xd8\x88\x02\xd5\x9f\x38\xdd\xc0\x65\x9e\x81\xff\xf5\x9c\x4f\x3a\x63\x38\x45\xa5\xb9\x99\x6e\x16\x94\xac\x6b\x92\x9f\x3f\xe3\xce\xc1\xb6\x5f\x01\xd7\x14\xd9\x05\xf0\x3a\x30\xdf\x0f\x5f\x25\xa1\x3a\x6d\x8a\x2f\xdf\xcf\x0c\xf1\xa3\x3e\x74\x52\x94\x94\x2b\x69\x7c\x32\x29\xf8\xf9\x29\x93\x6f\x13\x59\xd8\x53\xf3\xe7\x1c\x76\x1b\x2e\xd1\xa5\x00\x93\x55\x4f\x00\x0c\xf9\xf3\x26\xec\xdf\xc6\xaa\x33\x5f\xd9\xa1\x05\x39\xdc\xf3\x35\x10\x44\x80\x48\xfb\x61\x29\x89\x00\x56\x70\x3b\x10\x2e\x47\x94\x57\xad\x2f\xb6\x49\x8e\xa1\x94\xa4\xae\xdb\xfe\xd2\x74\xef\x05\xf7\xfe\x07\xe7\x07\x38\x5f\x13\x8b\x97\xb7\x0e\x3c\x4a\xb1\x2a\x07\xd6\xa6\xb7\xd5\x24\xd9\x2a\xfb\x47\x0a\xf8\x5c\xe9\x18\xa0\x69\x4b\x20\x32\x66\x05\x25\x41\xcb\x5f\xe7\xcd\x63\x0c\xc9\x8a\xce\x4a\x4b\x91\x3d\x2c\x70\xfb\x64\xe0\x41\x71\xd4\xf5\xd3\xfb\x03\x01\xc9\xfd\xb1\xdf\x44\x8b\x88\xb7\x18\xe7\xfe\x7d\xba\x94\x45\x6f\x2c\xee\xc1\x1f\xbc\x49\xff\x74\xa6\xc0\xb8\x86\x1b\x5e\xb7\xbf\x3d\x24\xb6\x27\x36\x14\xd7\xd3\x43\x00\x47\x4e\x52\x00\x39\x07\x94\xf3\xd7\x39\xb1\x24\x07\x30\x0d\x93\xd3\xdc\x2e\xbb\xcb\x82\x22\x60\x16\xcb\x99\x6f\x9c\xd9\xb1\x68\xa2\xf0\x55\xf7\xc2\x33\x39\x0d\x89\x87\x9f\x3f\xe1\x8e\x45\x0f\xee\xd1\xe2\x39\x1c\x01\x4b\x24\xc2\x28\x0e\x30\x5e\x53\xc1\x5b\xe1\x41\xba\x41\xa5\xe0\x2e\xcc\x1f\xe0\xf3\x99\xe3\x30\xa0\xec\xe2\x6f\xf5\xea\x1f\xf3\x55\xc4\xd7\x87\x10\x3b\x1e\x6d\xea\x7d\x91\xc5\x0a\xa1\x75\x3b\x71\xdd\xe5\xf8\x15\xa6\x22\x05\x42\xaf\xbf\xc1\x16\x5e\x9b\xf2\xf1\x60\xc5\x8d\xb0\x5c\xb7\x42\xbc\x1e\x9f\xf9\xb7\x2a\x1a\x8f\x69\x5b\xa8\xba\x23\x16\xc9\x19\xfc\x67\x2f\x54\xc4\x58\xe6\x89\x96\xa2\x62\x83\x3b\x5d\x3e\x8b\xb6\xb9\xbe\x90\xa3\xf9\x76\x84\x62\x7b\xbc\x59\x10\x7c\xba\xfa\x8a\x7f\xcc\xc7\x83\x8c\x3f\x4b\xb5\xd6\x7f\xd6\xec\x05\x8f\x27\xcb\x99\xce\x1b\xda\x40\x8b\x0d\x99\xc6\x8d\xba\x11\xca\x6c\xec\xe6\x6a\x8b\x87\xdd\x90\xae\x8b\xf5\xc6\x84\x4c\xb1\xd3\xfd\xce\x86\x47\xfc\xc5\xcc\x57\x2a\xd1\x1d\x25\x4d\x28\x71\xd0\xa0\x36\x44\x76\xfc\x15\xce\x06\x05\xf6\xd1\xa3\xcd\x55\x05\xcc\xbd\x71\xd3\x4d\xbc\xda\x29\x43\x0d\x33\x8e\xb7\xff\xa9\x86\x1c\x9f\x5e\xa9\x61\x82\x11\xa2\xfe\x3c\x1a\x89\x3f\xdf\x24\x8f\x0c\x1d\xba\xd2\x34\x74\xc4\xd0\x6a\x48\x32\x47\x99\xaa\x16\xe2\xe5\x2a\xfd\x62\x7b\x4d\xcd\x47\xf4\x18\x87\x03\xc0\xb3\x88\x10\x03\xff\x14\x69\x31\x22\x0d\x6d\x3d\x0b\xbf\x02\xe7\x32\x41\x89\x84\x5f\x3f\xd4\xc2\xd2\x63\x9e\x09\x14\x3d\xb4\x3c\xcf\xa1\x2e\xfa\x67\x8d\x77\x1d\x85\x3f\x5b\x5a\x68\xfc\x54\xb3\x54\x43\x0e\xf3\x25\xc8\xf7\x49\x7e\x2d\xc1\x7f\x15\x46\x75\x0a\xaa\x4e\x9f\x24\x9e\x78\xc8\xe7\x4f\x59\x79\x5b\x39\x91\xb9\xd7\x4b\x53\xf1\x7c\xa1\x37\x0e\x21\x91\x6d\xde\x58\x95\xc2\xdb\x16\x23\x77\xa9\xe9\x60\xca\x8b\x69\x04\xd9\x8e\x1f\x4a\x50\x0d\x40\x38\x19\x08\x0c\x54\xe8\x0e\x3d\x17\xd3\xe9\x5f\xe7\x0e\xf3\x34\x6b\x38\x3c\x9d\xfb\xa8\x81\x12\xd6\xb4\x1a\xc4\x0e\x10\x58\x32\xab\x04\x99\xf6\x82\xb2\x2a\xe5\x4f\x6a\x2a\xf6\x27\x7c\x47\x89\xac\xed\x3f\x87\x6d\x08\x65\xcc\x29\x9d\x4f\xae\x10\xb0\xb3\x29\x37\x62\x2a\x4d\x61\x6b\x6e\x9b\xb0\x06\x9a\x10\x81\xa6\x6d\x88\x91\x3c\x03\x2e\xa6\x29\xb8\x49\x5a\x50\x07\xe6\x9a\x03\xfa\xbc\xe6\xa1\x42\xe1\x8b\xcf\x3c\xeb\x9f\xe3\xe6\xdb\xb3\xfc\x9d\x4e\x79\x22\xe8\x45\x92\xa0\x89\x98\x28\x7d\x12\xe4\x32\x7f\x28\xf0\x8b\x59\x19\xb4\x4e\x0c\xcb\x4c\x06\xfb\x68\xbc\x63\xf4\x9a\xb1\x95\x9a\x71\xd6\x27\xf4\x89\x8b\x8d\x68\x6b\xda\x18\x12\x9d\xe2\x1c\x7a\xdb\x1a\x24\x16\x69\x8e\xc5\xd4\xca\x85\xc2\xdc\xe0\x3e\x0d\xfa\xdb\x51\xb3\xd1\x62\x49\x62\x14\xf8\x07\x00\xd4\x96\x70\xea\x34\x0b\xd7\x5f\x35\xf8\x98\x96\x71\xe5\x64\xb3\x19\x0d\x55\xb3\xc8\xc9\x25\x3c\x24\x62\xdb\xd4\xb8\x5f\xcd\x49\xaa\x4e\xfe\x89\xfb\xf9\x6b\x79\x2f\x79\xc2\x01\xe7\xa7\x4d\xc6\x9c\x80\xf7\x71\x8f\xed\xea\xf7\x93\x69\xdd\xd7\x6b\x31\x94\x8a\xea\x6e\x56\x31\x22\x48\x03\xcc\x12\x15\xaf\xab\x23\x75\x33\x5c\xbf\x6b\x07\x6d\xd9\x43\x8c\xd3\xf7\x4b\xa0\xe1\xfe\x55\x10\xe1\x9f\x6b\x51\xbc\xa6\xe0\xec\xf3\x87\x07\x92\x3a\x4b\x73\x10\x72\x2a\x73\xc6\xc6\xa5\xae\xd7\x41\x69\x0d\x51\x25\x9d\xc9\x85\xe9\x2b\xf0\xfd\xbe\x09\xbe\xf2\x06\x94\x03\xb6\x21\xce\x49\x7f\x32\x30\x84\xa9\x6a\xe4\x0a\x1f\x12\x01\x03\x85\x88\xd4\x97\x43\x38\xb1\xd1\x1d\xfb\x51\x85\x71\xc3\x59\x06\xae\xe6\xb7\x61\xb7\xc5\xfe\xdb\x58\xf9\x3f\xef\x22\x9d\x0c\x2e\xfd\xf2\x35\xa9\x5f\x8a\xd2\xca\xcc\x3c\xe1\x37\x69\xc1\x89\xc0\x96\x53\xba\x7b\x05\x6b\x02\x17\xd4\x0f\xaf\xb5\x54\xa2\x8a\xb0\x6f\x25\xf9\x10\xdf\x44\xb1\x69\x2d\x16\xcf\xaa\xb9\x2c\xb8\x0e\xb7\xee\xf7\xbc\xcf\x59\x7a\x10\xea\x8c\x54\x7b\xb9\xa9\x1f\x31\xf7\x51\x0e\xcc\x03\x71\xa0\x76\xdf\x00\xe4\xde\x73\xe3\x5f\x67\x4b\xd7\x6d\x29\xbf\x12\x8e\x7e\x9d\x75\x96\x1f\xf3\x76\x5f\x14\xa9\x01\x5d\x56\x84\x87\x25\x2a\x11\xf5\x62\xab\x15\x76\x2c\x33\x27\x88\xbd\x77\x43\x21\x9c\x18\x6d\x80\xc7\xb1\x58\x43\x9e\x1b\xd4\x7a\xa5\xf5\x90\xec\xa1\xbc\x21\xe3\xa9\x01\xe6\x31\xed\x32\x1e\xe8\x70\x92\xf5\x6f\xa0\xbc\xe1\x82\x98\xef\xfc\x76\x6d\x09\xff\xcf\x7d\x66\x02\x6f\xa2\xe9\x81\x75\x58\xf4\xb8\x97\x90\xfc\x24\x0b\xfb\x60\x9f\xa4\xdc\xc4\xee\xd9\x63\x8e\xee\x42\xaa\x0c\x7e\x6d\xa6\xcf\x71\x3b\x97\x73\x2d\x83\x30\xb6\x42\x32\x1d\x4d\x82\xb1\xbf\x3e\x20\x70\xcf\x78\xc9\x25\x00\x6c\xfb\x1d\x31\xac\x03\x4a\x01\x13\x9b\x81\x63\x8c\x79\x0e\x71\xdf\x70\xd2\xcb\x57\x93\xbc\x49\x54\x3a\x66\xff\x6f\xeb\x3a\x5f\xeb\x0b\x88\xf1\x72\xf3\x45\xae\x17\x44\xf7\x7c\x00\x15\x45\x8b\xe3\x91\x8a\xde\xa4\x6a\x96\xa6\x38\xe9\xf5\x89\x7e\xaa\xf6\x33\x14\x4c\x55\x9d\xc6\xf5\x03\x5d\x93\x88\x2c\x02\xeb\xd2\xed\x73\xf0\xe5\x87\x85\x0a\x95\xd9\x8e\x58\xd9\x21\xd6\x27\xc5\xdb\xfd\x46\x6a\xbf\x53\x0c\xad\x9a\x60\xe9\x0d\xcb\xb4\x4f\x02\xbb\x7c\xf2\x3f\xf5\xd2\x62\x2c\x41\x08\xe6\xc1\xa9\xa7\x1a\xd9\xa0\x64\x17\x02\xda\x18\x34\x7a\xf5\xca\x84\xef\x43\x54\xdd\x0e\x50\x00\xe6\x7b\x94\x3f\x48\xff\xe4\x55\x5a\x04\x79\xa8\x01\x79\x25\x34\x59\x07\xfe\x1d\xb1\x7f\xdf\xb7\x89\x86\xa3\xe9\x9f\x73\x56\xe9\xea\x40\x37\x21\x59\x56\x5e\x6d\x81\xa3\x20\xbf\xe6\xcd\x72\x64\xc0\xba\x37\xcb\x3e\xf5\xc6\xe2\xd5\xdf\x87\x82\x58\x72\xeb\x65\x04\x6d\xd8\x82\x24\xea\x22\x7c\x2c\x47\x7c\x39\x7d\xdd\x83\xaa\xde\x87\xe7\x59\x1a\xd0\x01\x72\x08\x38\xce\xc0\x03\xb3\xbf\xd6\x9d\x0c\x8f\x57\x68\x5a\x6f\x7f\xe0\x03\xd3\xab\x44\x08\x0b\xfc\x11\x50\x99\x07\x2f\xf1\xc7\x98\x4a\xfd\x88\x49\xfd\xe7\xdd\x5e\xf9\x64\xb5\xb1\x84\x04\x6c\x6f\x91\x86\x3c\xa1\x97\x27\x88\x3c\x95\xa0\xac\x28\x50\xf1\x24\x5f\xce\x99\xa8\x52\xe9\x49\xa7\x5c\xd7\xef\x84\x12\x5b\x1d\xe8\xb8\x82\xde\x8b\xfa\x3d\x40\x15\xc2\xe2\xd0\x4f\xfe\xd6\xab\x2d\xfd\xd3\xab\x4d\x80\x73\x72\xd2\x81\xfc\x25\x49\x19\x32\xab\x0f\xc0\x35\xfe\x95\x7f\x4f\x0e\xb4\x5c\x7f\xc6\xd7\x89\x15\x68\xdd\x0e\xd4\x03\xe2\x34\x28\xa2\x9b\xe3\xc0\x29\x37\x85\x83\x9f\x04\x98\xbb\xb1\x9c\x87\xbd\x31\xaf\xb3\xe5\xe8\x6f\x56\x5e\xf6\x1e\xc2\xe8\x75\x78\xdb\xa1\xa9\x9f\x55\xf2\xfe\xea\x17\x65\x9f\x03\x66\x60\xf3\xc5\xdd\xa0\x6a\x06\xe9\x01\x6e\x6b\x45\x22\x89\x79\xdd\xaa\xed\xdc\x12\x70\x8e\x21\xe3\xd5\xe7\xa9\xa7\xba\x1c\x65\x06\xfe\x4b\x4c\x7f\x0b\x83\xef\xfc\xe1\x04\x73\x75\x2c\x88\xc5\xae\x92\x33\x7e\xad\x8c\x4c\x00\xab\x3f\x6b\x9b\xc9\xbd\xd8\x24\xc0\x12\x86\x52\x98\x58\x67\xd5\x3a\xc5\xd9\x53\x5f\x71\xd2\xfe\xf3\x77\xd6\x67\x37\x32\x3a\xc7\x5d\x2e\x4c\x40\x9f\x64\xe2\xbc\xb5\xd8\xe7\xbe\xdd\xf7\x1f\xff\x55\x5d\x19\x47\xea\x32\x0e\xf1\x8a\xa9\xed\xfe\x56\xca\xbb\x92\x89\x2a\xde\xfc\x56\x70\x01\x93\x96\xbe\x8c\x6b\x02\xf9\xaf\x79\x03\x8e\xf8\xb3\x3f\xdd\x52\x4d\x8c\xf8\x44\xd9\x84\x6e\x62\xf1\x4e\x6d\xca\x52\xae\x6e\x14\x39\x9b\xa6\x68\xfc\x83\x75\x0f\xf8\xfb\x5a\x73\xf4\x67\x6e\x19\xfd\x54\xf0\x07\xff\x30\x7f\x7a\x5c\x0e\xd5\x9e\xed\xb6\xb8\x63\xe7\xf6\xe2\x7b\x17\xd4\xe8\x8d\xa3\x34\x0d\xd6\x4f\xbb\xd7\xfd\x8d\xfe\x39\xbb\xca\x55\x42\xa5\x7b\x4d\x37\x39\x96\xcd\x9c\x74\xd3\x9b\xa3\x1f\x0f\xd1\xbb\xb2\x19\x71\xf0\x03\xc3\xef\xbc\x29\xd9\x14\xe6\xd0\xf0\x17\x97\x7c\x4f\xad\xe4\x95\x53\xc9\x7e\xb9\x49\x2c\x34\x01\x00\x9f\xf4\xb6\xa1\x9f\x50\xfc\x09\x52\xdd\x4f\xbf\x3d\x86\xcf\x64\xca\x68\xca\x95\xb3\x05\xc2\x96\xfc\xab\xab\x79\xb8\x21\x95\x92\x95\xf6\x6a\x30\xb9\x57\x07\x39\x86\xde\x07\x4d\x15\xdf\x03\xfd\x71\x74\x12\x81\x74\xd0\x03\x45\x58\xa3\x0a\x42\xdb\x54\x02\xe1\xcf\xbf\x96\xaa\x73\xce\xfb\xd3\x13\x2e\xd4\x40\x09\xba\xa7\xaf\x3f\x43\x1b\x6a\x9a\x92\x97\x9d\x1b\x87\xfb\x00\x1b\xb3\x5d\x6d\x30\xa6\x1b\x07\x92\x88\xd6\xfb\x34\xbf\x6c\xaa\x01\xfb\x76\x6e\x8a\xe3\x82\xf0\xa9\x1e\xdb\x4b\xfb\xaf\x57\xa1\x8a\x10\xa5\x2d\x1b\x7e\x84\x09\xe5\xc5\xe1\xb8\x0c\x54\x62\x84\x0e\x60\xce\xb3\x31\xfd\x15\x45\x17\x02\xb3\x41\xf2\x54\xe4\xa4\x69\xaa\x3a\x32\x64\x00\xe8\xd6\xbf\x6e\x44\xa3\xa9\x3f\x75\x1b\x8a\xbc\xb5\x78\x78\xe6\x3d\xcf\x7a\xfd\x43\xa5\x99\xb7\x29\x7e\xf1\x1a\x63\x06\x88\x7e\x67\x7a\xe9\xe5\x99\x9c\x97\x78\xac\x55\x07\xfb\xf5\x50\x33\xa0\x72\x7c\xef\x30\xa3\x27\xb6\x19\x69\x43\x7e\xef\xa3\x58\xd7\x8c\xb4\x15\xbb\x0a\x5d\xae\x66\x20\x9e\x44\x16\x7d\xc1\xcf\x4d\xe8\xf2\x60\x30\xa5\x26\xcf\x7f\x3b\x99\x0a\x4c\xf6\xf7\xde\x6d\xdd\xd9\xc9\xf4\x97\xd0\xe4\xba\x03\x87\x70\x29\x68\x00\x1c\x91\x44\xda\x28\x4c\x9c\xfb\x52\x8b\x0c\x8a\xf1\x83\xa6\x5f\xd3\x25\xe4\xde\x00\xf2\x20\x6d\x88\xb1\x24\x76\x3f\xb6\x4a\x3f\x2c\xc1\x42\xee\x47\xcc\x02\x21\x10\x74\xbd\x9e\x10\x90\x93\x47\x10\xc0\xe7\x28\x68\xcc\x73\x0b\x8a\x58\x5f\x40\x22\xfb\x5f\xe7\x5e\x63\xb5\x92\x0e\x1a\xf1\xce\xb7\x57\x31\x01\x04\x99\xe4\x85\x75\x6b\xa0\x5a\x63\x5f\x3f\xba\xf8\x6a\xbc\x9f\x00\xe4\xde\x89\xd9\xdb\xe2\xe6\x28\x46\x7f\x6d\xea\x9f\xc5\xdb\xa3\xb0\x1f\x0c\x04\x8a\x39\x16\x02\xdf\xbc\xdb\x30\xda\x51\x1d\xf2\xa5\xad\x71\x41\x43\xf3\x41\xf0\xd8\x6e\x98\x41\xe1\xaf\x03\xfc\xd5\xaf\xea\x1f\x65\x8a\x41\xa6\x85\xf1\x59\x97\x67\x78\x3d\x04\x22\xb0\x00\x00\x0e\xdd\x3f\xf2\xe4\x0f\xc9\x3a\x3c\x2b\x44\x59\xb3\x74\x33\x32\x2b\x8e\x4a\x55\x3f\x52\xbb\x5b\x59\x39\xd3\x4c\xfb\xe9\xe7\xb1\x2b\x33\x1a\xf2\x33\x85\xa3\x0d\x9f\xd6\x54\xe8\x40\x21\x14\x9a\xd1\xac\x79\x25\xc3\xc4\x6f\xa3\x48\x8c\x24\x44\xa4\xd8\x77\xd3\xc3\x88\x4d\x7f\x20\x90\xa8\xa3\xbf\xf6\x80\xbc\x82\xaa\xa4\xf3\x3a\xef\xf3\x34\xc6\x89\x07\xc3\x4a\x5e\xe7\x40\x62\xe1\xee\x00\x86\xb0\x8f\xca\x79\xc5\x4d\xe8\x21\x5b\x1d\x1f\xe6\xe6\xb3\x15\xbf\xa2\xbd\x8a\xfe\xd8\xa8\x19\xf7\xdf\x71\xbf\x6a\x20\x29\xcc\x3b\xda\x9f\x5c\x44\x24\x5c\x64\x42\x1c\xa0\xf6\x29\x77\xcd\x02\x21\xf4\xf9\x92\xdd\x6a\xc1\xe7\x01\x1d\xc2\xa1\x17\x67\xe3\x83\xb4\xed\xdf\xe3\xcb\xfd\x13\x5f\x2e\x58\x93\x7e\x41\x60\x52\x98\x83\x07\x01\x0c\xe4\x53\x08\x63\xf2\xfd\x20\xcc\xcb\x2d\x55\xad\x2a\xd7\x8d\xb0\xea\xce\x5b\x60\xf0\x2c\xca\x77\x8e\xcb\x9f\x80\xf6\x99\x54\x1d\xc7\x67\x31\x23\xfd\x73\x67\x59\x9e\x1f\x98\x90\x29\x45\x02\x80\x2c\x85\x8c\x86\x41\x9d\x33\x37\x86\xef\xc0\x8f\xae\xa0\x5e\x3f\x4d\x68\x2e\x44\x20\xfa\xd2\xa8\x09\xf2\x6a\x38\x7a\x0d\x83\x48\xcc\x06\x9f\x70\x1a\x00\x3a\xc0\x7d\xb7\x96\x8d\x78\xd2\xd9\xc4\x9c\xbf\xf5\x34\x93\xf5\x51\xa0\x54\x2c\x6b\x25\x78\xd8\x90\xd3\x35\x82\x25\x5e\x27\xc9\x97\x4e\x90\xa2\x53\x09\x79\x64\x81\x4e\x71\xce\x99\x3f\x85\x35\x55\x36\x15\x88\xfe\xfe\x8f\x76\x6c\x96\x5b\xfe\xa0\x59\xdc\xbf\xf3\xeb\xb1\x3e\x3f\x85\xc9\x99\x16\x20\x40\x48\x2c\xc3\xd2\xb2\xb2\xa4\x57\x71\xe0\x13\x07\x4a\xf1\x30\x54\x00\x53\xcd\x78\x85\xfb\x67\x74\x06\x74\x5e\x05\x5c\xb3\xa9\x21\x01\x37\x4a\x44\xa2\xa3\xc2\x72\x91\x79\xc4\xd8\x10\x0f\xa1\xc2\xe1\x4b\xcf\x8e\x70\x71\x65\x93\x51\x21\x08\x67\x91\xbf\x73\xe2\xf0\xa7\x77\x9c\xb4\xb0\x1d\xd1\xa8\x2e\x7b\x84\x80\xc9\x82\x4b\x89\x7b\xbe\xf2\x61\xe5\x04\x26\xab\x11\xba\x84\x48\x4d\x9d\x12\x0d\xe0\x5a\x6d\x9c\x2f\x94\x99\x63\x40\x45\x1d\xa2\x1e\x0f\xd6\x3e\x76\x99\x4b\xf2\xbd\x8c\x50\xc8\xc8\x9a\xdb\x25\xc2\xca\x1b\x5d\xa7\x22\x2c\xb0\x42\x5f\x4f\x44\xa8\x5d\x3f\x89\x25\x22\x8b\xd3\x82\x7b\xcf\x73\x16\x61\xb8\xc0\x5f\xb7\x05\x14\x00\xe1\x1d\x98\x67\x20\xd1\xbf\x23\xee\xd8\x59\xf9\xdb\x46\x0b\x4c\xa9\x69\xe6\xc3\x74\x55\x54\xad\x18\x80\x77\x43\xb8\x19\xe7\x67\xee\x92\x92\xd2\x67\x14\xab\x26\xcc\x3d\xd1\x28\xd0\x22\xfd\xdb\x6a\xab\xa5\x7d\xd7\x1c\x3e\xe2\x94\x9f\x51\xcd\x59\x27\xab\xf2\xfc\x5a\xde\x31\xeb\x6e\x13\x7b\x8d\x5f\xc9\xce\xc1\x8b\x1b\x45\x3e\xa2\x7e\xf2\x1c\x8d\xe1\x4d\xf9\x15\xc3\x44\xa1\x60\xaf\x7d\x36\xed\x83\x8c\xe0\xff\xdc\xff\xf1\x1f\xfd\xe0\x0c\x9f\x04\xb6\xe1\x95\x44\x26\x4a\xb6\xd0\xb6\xa1\x61\x28\x74\x78\x74\x46\xe4\x1e\x7c\x1c\x67\x93\x40\x02\x55\x40\x73\x26\x33\x6d\xda\x7a\x30\x62\x39\xc0\xc2\xb9\x25\x87\xa9\x9e\x8d\x01\xf5\x00\xc1\x3d\x11\x74\xb4\xc4\x8f\x7d\xfe\xab\x1e\x4c\x97\xed\x4b\x70\x7c\x64\x28\x91\xb2\xdc\x9d\x07\xc7\x3b\x47\xe3\xaf\x07\xb0\xb2\xc7\xd8\xf2\x5e\xa0\x3c\x51\xa5\x2d\x5d\x81\x02\x77\xa8\x06\x8e\x59\x53\x87\xa2\x82\xe8\x0f\x12\xb8\xc5\x82\x4d\x8e\x88\xd3\x34\x27\x49\x8f\x40\x0f\x02\x01\x8c\xb8\xe4\x22\x60\x42\xff\xd9\xcf\xe3\xcf\xfb\x30\xe7\x9f\xde\x63\xd0\x75\xaf\x5b\x8e\x03\x70\xb6\xa3\xda\xd5\x66\x21\x58\xff\x22\xaf\x10\x81\xf6\x27\xbd\x46\xda\x79\x19\x06\xc7\xda\x44\x36\x22\xca\xb9\x46\xba\x79\xe9\x4e\xfd\xe3\x3d\xd1\xa5\x2e\xbe\x02\x79\x12\xd4\x0f\x77\x5d\x0a\xf9\xad\xbb\xb9\x58\xec\xdf\xf6\x76\xad\xd1\x9f\x3e\x8d\xba\x17\xfb\xe0\x45\x69\x45\xf1\xa6\x44\xe2\xcf\x31\xb9\xd5\xbc\x2e\xdc\xc7\x03\xdf\xb4\x0a\xd2\x86\x8b\x84\xc7\x07\xc8\xa4\x63\x95\x32\xf3\x35\xf3\xc0\x70\x84\x05\x87\x12\x57\x7d\x92\x03\xf9\x5e\x1c\x9c\x7f\xef\x13\xba\xfe\xe9\x9b\x7a\x80\x82\x52\xe4\xa8\x05\x11\x1f\x0b\x44\x93\xae\xfd\x73\xe0\xcb\xc1\xd2\xd1\x22\x8f\x55\xa2\x5d\xf2\xf7\x15\xfb\xc5\xce\xca\xae\x4e\x05\xe7\x97\xde\x8f\x04\x7a\x89\x15\xce\x4c\xf8\x02\xa0\x5f\x06\xd6\x3a\x0d\xfd\x1a\xa1\x82\x36\xa5\x91\xfc\xbf\x3c\x9c\xfd\x8a\xfb\x57\xcf\x3d\x14\x87\x61\x11\x15\xcc\xf5\x4a\xc0\x22\x0a\xf0\x9b\xc4\x7c\xc9\x36\x79\xd8\x59\xdb\x5f\x1f\x87\x9b\x81\x47\x0c\x15\xe8\x6e\x18\x2a\xda\xc3\x18\x8b\x20\x76\x03\x38\x2a\x0d\xd2\xb1\x78\x5e\x01\xe7\x22\x1c\x52\xff\xd4\x50\xef\x97\xa0\x70\x16\x2d\x9f\xfa\xef\x76\xb3\xc9\x79\x86\x0e\x46\x53\x53\x73\x1b\x10\xda\x3d\x01\xa6\x04\x35\xe6\x49\xe0\xf2\x12\x39\x66\x7a\x1f\x46\xef\x23\x79\x54\x42\x71\x5f\x6a\x85\x09\x01\x2f\xfc\x06\x44\x5b\x34\x2f\xc0\x83\x0f\xc3\x6f\xde\xb0\x7f\xef\xab\xd6\xb7\x6f\x32\x13\x23\xff\xb8\x15\x03\xac\x27\x8a\x22\x40\x74\xfb\xf4\x55\xb5\x7f\xd5\xcf\x31\x5c\xca\xb7\x94\x90\x46\x22\x31\xa3\x40\x11\xee\x3b\x8c\x1f\xfb\x36\x39\x9c\x83\x61\xe8\x0c\x10\xe5\x43\xc0\x31\xff\xf7\xbe\xb1\xec\x3f\x34\x82\x07\xb5\xc4\xbd\xfe\xe2\x35\xec\x50\x0f\x50\x48\x5d\xc0\x3e\x46\x33\xdc\x83\x26\x54\xb1\x50\x42\x66\x5e\x62\x49\x04\x81\x68\x57\x3a\x1b\x96\x10\xf9\xcd\x33\x09\x72\x93\x86\x11\x10\xb6\xe2\xfe\xe9\x39\x67\xe3\xd0\x2e\xb7\x03\x61\xc4\xc2\xdf\xf2\x60\xca\x53\x34\xbd\xd1\x94\x46\x81\xa1\x21\xfd\xa9\x83\x2c\xe2\x85\x13\xe4\x2f\x14\x04\xc0\xe9\x9b\xd5\xaf\x98\xc6\xfc\x30\xdf\x39\x17\xc4\xa2\xc6\xff\xf4\xc1\xf3\x07\x02\x88\x0d\x60\x0f\x2d\xd0\x04\x25\xea\x30\x77\xc4\xac\xaf\x18\x06\x0a\xa2\x85\x3d\xfe\x6f\xfb\x22\xf9\xe9\x1f\xfd\xb2\xc6\x76\x4c\xd1\x2c\x02\xcd\x57\xba\x23\x1b\x8e\xbd\x9c\xe0\xd4\xf3\x82\x42\x96\x1d\x33\x23\xf2\xab\x2f\x2a\x20\xb9\x39\x46\x96\x34\x68\xee\x0d\x18\x1f\xe2\x79\x2e\xf7\x93\xad\x37\xf5\xfc\xac\xe7\xaf\xde\x20\xa5\xff\xa7\x5f\xaa\x00\x08\x8a\xb9\xe5\x80\x51\x65\xc5\xb3\x51\xf3\xb7\x40\x05\x2c\x64\x22\x15\xdd\xa4\xab\xd6\xdd\x61\x76\x22\xe0\xe5\xfe\xed\x67\xb9\x10\x55\x6c\xea\xfb\xbc\xc6\x74\x3f\x0c\x6e\x76\xc2\xc2\xab\xff\xbe\xe7\xb1\xea\xf4\x3f\xb1\x30\xde\x40\x91\xe7\x99\x19\x24\x11\x22\xe8\x04\xfe\x7c\xf3\x15\x54\xe5\x03\xbf\xd2\x80\x51\x5f\xeb\xb3\x2e\x2f\xfd\x10\x32\x82\xad\xfe\xa5\x4b\x4c\x37\x27\x05\x4c\x3c\x70\x61\x17\xe9\x9a\xd7\x70\x91\xc7\x5b\x0c\xfd\xbd\xcf\xad\xf0\xea\x19\x9a\xe9\xf9\x2e\x54\x4f\x81\x0a\x13\x65\x0f\x0b\xd4\xb8\xae\x0f\xa8\xfa\x4c\x9e\x5b\x0a\x1f\x71\x9f\xe4\x4b\xd7\x5e\x63\x46\x30\x31\x7c\x6e\x6a\xa7\x39\x97\x40\x9b\x49\x31\xbc\xa3\x86\x98\x41\x0f\xf8\xbf\xed\xa7\xfc\xfd\x79\xaf\x7c\xf8\x50\x06\x7f\x6c\x08\x30\x1b\xa6\xf4\x14\x16\x2a\x95\xa8\x30\xb6\xde\x35\xab\x97\x4b\x5d\x04\xde\x77\xa0\x9b\x7f\x2b\xf8\xaf\x06\x5b\xef\x17\x6b\xb2\xe6\x05\x52\xdf\xdf\x0f\xf2\x7e\xf7\xb7\xb6\xe8\x3f\x7f\x1c\xcf\x37\x6c\x15\x67\x23\x59\xfe\x6f\xff\xe5\xff\xfc\x7f\x92\x38\x6d\xcb\x65\xdc\x87\xec\xbf\x2e\xf9\xf4\x8e\xe7\x3f\x86\xf1\x7f\x7c\xf7\xf7\x9f\xad\xf5\x93\xff\x47\x3a\x0e\x5b\x5c\x0f\xff\xdf\xff\x5d\x4f\xc5\xfa\x5f\xfb\x3a\xfb\x7f\xff\x76\x45\xdd\xc7\x65\xfe\x1f\xfb\xd2\xfd\x1f\xff\x25\x7b\x67\xe5\x3f\xfe\xf1\x6f\x70\x1a\xca\xf7\x63\xd6\xfc\x83\xfd\x5f\xb5\xcf\x18\xf6\x09\xa9\x62\x39\xfe\xb9\x0f\xdd\xf1\x2a\xfe\x8d\x13\x9a\x66\xfe\x71\x5f\xd6\xcb\x1d\x7f\xfe\x2e\x48\x90\xaa\xfe\x7c\x23\x86\xb6\x10\x48\xb6\x9b\x20\x3f\x28\x43\x84\xfb\x67\x31\x7f\x7a\xf7\xd5\x3f\x87\x51\x92\x40\x18\x7e\xbe\xd2\x45\x81\x8d\xa7\x69\xd7\x99\x7f\xfe\xc3\x50\xfd\xf7\xc6\xe2\xfa\xb4\x7a\x10\x6c\xf2\x30\xef\x99\x99\xf1\x53\x03\x6f\xdc\x54\x4f\x15\xbe\x7c\xd0\x5a\x30\x4f\x3b\xa2\x78\x89\x4c\x8d\xad\xb2\xbf\x6c\x08\x21\xd7\x97\x0e\xb2\x03\xc0\x5e\x85\x3f\x58\x6b\xf8\x3a\x46\x52\xa6\xc7\xd9\x66\x44\x3e\xd6\x3c\x2f\x78\xc6\x62\x08\xdb\x87\x20\x61\x0c\xfd\x58\x5c\xfb\xe6\xdf\x3b\xdc\x7f\x39\xf5\xc1\x29\xfc\x42\x97\x4b\x11\xf8\xdc\x7d\x62\x35\xf8\x64\x7d\x36\x15\xf2\x78\x35\xbb\x6d\x0b\x3c\x2b\x0e\x04\x88\xbd\x7e\x2e\x7e\xbd\xeb\x7a\x07\x65\xd1\x79\xf8\x4a\x52\xf0\x7c\x88\xa3\x92\x9b\x4a\xbc\x16\x4c\x99\x3b\xd3\x10\x00\x37\x2f\x2b\xbe\x83\x71\x3c\x73\xa1\xc2\x23\xba\xc8\x5c\x1c\x7f\xb8\xf1\x32\x78\xac\x67\x3d\xe8\xbe\xc9\x10\x65\xbe\x99\xc1\xd9\x96\xab\xfc\xf0\x51\x7b\xa2\x98\x83\xbc\x9d\xcb\xcd\xa7\x4d\x86\xe1\x82\xfb\xc6\xa7\xc0\x4e\x9b\x80\xfa\x61\x54\x5e\x3e\x2d\xcd\x78\x8e\x41\x11\xc7\xa8\xaa\x18\xae\x14\xd4\x4d\x6b\x7a\xa6\xa3\xc2\x1f\x8e\x05\xf1\xe4\x34\xdf\xa1\x1d\xb4\x81\x6f\xad\xe8\xbd\x33\xd6\xaf\xd4\xf0\xbb\x01\x60\x66\x6b\x37\x92\xe1\x86\x94\xf9\x83\x39\x5e\xb9\xe4\x6d\x5f\x9f\xdc\x91\x13\x07\x1d\x82\xf4\x1e\x46\x39\xbf\x1a\x4b\xbe\xc9\x65\x5c\xe5\xf9\xa6\xc0\x32\x46\x89\x40\xef\x8f\x52\xf5\xd5\x9e\x6f\x13\x42\x71\x4e\x19\x9d\xe4\xcc\x9e\xe1\x96\x09\x99\x9f\x42\x7b\x6a\x9b\x7c\xce\x0c\xa3\x0a\xf9\x49\x94\xad\xca\x86\x4f\x2a\x57\x3c\x14\x7a\x41\x72\x9b\x58\xda\xaf\x55\x9f\xfd\x68\x1e\xca\x3d\x86\xfb\x94\xac\x0e\x71\xdb\xef\xd4\x88\xf0\x04\x6c\xaf\xf3\x79\x85\xdd\x6b\x82\xed\x6f\x33\x76\x28\xec\x61\xa1\xcf\x4f\xcb\x33\x9b\xe7\x6d\x9d\xfe\x64\x0d\x13\x48\xab\x43\x01\x54\x61\x3b\xe8\x44\xd1\x4b\x6c\x84\x49\xc5\x7f\xb5\xce\xb3\x9a\x1d\x11\x11\x83\x50\x68\x8b\xd7\x5b\x28\xac\xa6\xd7\x54\x69\xb2\xdf\xf1\xbf\x27\xe6\x92\xcc\x50\x0d\xb2\x09\x6e\xd0\x5d\x62\x71\xd5\xfa\x2f\xf6\x25\x55\xb7\x1c\xe4\x22\x2d\x6e\x4c\x61\x82\xdc\xd2\x27\xa8\x81\x21\xcd\xda\x18\x8c\x57\x8c\xf2\x33\xbf\x08\x07\x13\x5e\x42\x27\x51\xdf\x04\xec\x6b\xd0\x8d\xa4\x5d\x06\x9c\x89\x47\x80\x35\xe1\xc3\xd5\x84\x7c\x7e\x20\x97\x81\x1a\xc5\xbe\x0d\xbd\x3a\x30\x2d\xdb\x87\x84\x1b\x72\x8d\xfe\x30\x9a\xc9\xd1\x9f\x5a\x13\x1e\xfe\x4a\x2d\x89\xa2\xaf\xf6\xf5\xed\xc9\x0d\x58\x4f\x7e\x6c\x83\x80\x18\x1e\xd9\x31\x2e\x5c\x93\x85\x11\x23\x9e\x01\x83\xc2\x99\x89\xae\xc4\x23\x8e\x2b\x69\x20\x8c\x01\x47\x67\x1a\x0f\x64\x5f\x75\xa2\x9a\xc5\x40\xd1\x52\xf8\x93\x98\x27\xba\xc8\xcf\xe7\x97\xb9\x6a\xbb\xfb\x43\x56\x49\xfb\x9c\x0f\x0e\x5c\x81\x07\x56\x14\xc7\x35\xbf\xb9\xcc\xdb\xe9\xcd\xac\x9f\x1f\xc4\x4d\x44\x08\x17\xee\x0a\x08\x34\x95\x6a\x43\x90\x61\x2f\xa8\x20\xdd\x68\xc6\xde\x17\x9e\xdc\x4d\xcd\x93\x17\x69\x96\x13\xf3\x28\xcb\x7e\x21\xf9\xf9\x07\x3a\xf9\x4e\x70\x5b\x67\xb7\x7a\x96\xfd\xdf\x23\x8d\x09\xfd\x37\x23\x8d\xeb\x3f\x49\x03\xf2\x48\xca\xe4\x21\xde\x03\x97\x98\x08\x83\x6b\x72\x56\x21\x92\x13\xdf\x2a\xa8\xf7\x0a\x46\x6a\x23\xc6\x96\x4d\xa5\xc2\xce\x45\x47\xb9\xf5\x87\x69\xa6\x68\x92\x09\x78\x7a\xac\x2c\xf1\x27\xce\x47\xd0\x09\x61\x23\x25\x7f\x53\x9b\x17\xfb\xd3\x2f\xe3\x30\x49\xc2\x6c\xc8\x90\x24\xd4\x2f\x33\xae\xc4\x3b\x7d\xf2\x64\x7b\x96\x07\x3d\xf2\x57\x23\x60\x50\x60\xdb\x52\x1b\x0c\x78\x9a\x27\x3b\x96\x4a\x1f\x47\x8b\x88\x1c\x6a\xec\x57\xe8\x53\x29\x1a\x5c\xab\x7f\xa4\x79\xdf\x27\xf7\x4a\xdd\xee\x07\xd8\x25\x85\x1c\x63\x33\x2f\xee\x29\x33\x39\x0e\x8f\xfa\x0a\x3f\x91\xcf\xa9\xb5\xaf\x75\x25\x95\x8d\xdb\x76\xde\xf8\x09\xec\x72\xe8\x20\xb6\x2b\x3f\x5d\x94\x20\x17\xa7\x38\x9c\x0c\x41\x16\x21\x62\x41\xa7\xbf\x68\xb8\x55\xfd\x7a\x9e\xb8\xc8\xde\x3a\x06\xec\xcf\x0e\x18\xdf\xcd\x5a\x3d\xf3\x32\xad\xeb\x05\x16\x3d\x5a\x0d\x43\x9f\x84\x01\x24\xbc\xcf\x6a\x9a\x55\xce\x77\xb9\x02\x5f\x61\x85\x2b\x20\x13\x33\x32\x19\x62\xc8\xea\x43\x83\x32\xbe\x1f\x25\x2a\x4f\xfa\x30\x84\x9f\xaa\x8e\x37\xea\x63\x18\x5b\xea\xc5\x41\xbc\xf1\x38\x7e\x25\xa0\xf3\xf8\xf2\x78\x04\x32\xca\xaa\x07\x18\x30\x78\x1d\x68\xd1\x16\xf1\xd2\xe3\x4f\xb6\x7b\x2a\x29\x3b\xb6\x4d\xb8\x0a\xfd\x8e\x54\x88\x1f\x94\xf1\x51\x55\x3c\x17\xd3\x02\x9e\xa5\x11\x16\x8d\x6b\x6b\x4e\x19\x98\x8a\xa3\xc7\x4a\x30\x80\xe7\x4d\x29\xf3\x25\x7a\xb1\x10\x2a\x8e\xfc\xa5\x80\x00\x6a\x5a\xa8\x67\x9d\x8a\xbe\xb8\xd7\x78\xa9\x65\xa2\x87\xd7\x6f\x46\xf1\xc3\x52\xd9\x5a\xb5\x5a\xc7\x0b\xb3\x14\x06\xca\x34\xad\x60\x41\x12\xcd\x5e\xdd\xe5\x92\x61\xb3\xf4\xf3\x99\x15\x4b\xd2\x44\x0a\x80\x75\x79\xf2\xee\xee\xba\xa4\xed\x35\x0b\x25\x5f\xbb\xf2\x0e\xe8\xd8\xdd\x64\x41\xb9\xdd\xd0\xed\xf6\x99\xde\x08\x4f\x7a\xaa\x4d\x04\x69\x1e\xa1\xbd\x14\x29\x37\xa3\x13\x67\xf0\x47\x18\xe5\x0b\x4d\x40\x1a\xe2\x7e\x84\x10\x85\xc3\x9a\x4d\x74\xe3\x69\x9c\xd1\xc8\xf8\x63\x96\xc5\xc8\xac\xf6\x98\x55\x95\x58\xfd\xa4\x82\x61\x5e\xb9\x2d\xbe\xe3\xdf\xae\x40\x36\x4a\xbc\x73\x11\x7d\x5b\x64\x3c\xfb\x42\x0e\x83\xab\xca\xf3\x1d\xb3\x6e\x9c\xc3\xd8\xe0\xe3\xcd\x14\x9f\x4d\x9d\xf1\x52\x44\x1d\x9c\xd3\x9b\x9d\x56\x71\xbd\x51\x95\xc2\x8c\x54\x19\x1a\x5b\xa6\x75\xb2\xbc\x67\x6b\xa7\x74\xc0\xa5\xb5\xf4\xaf\x93\x9b\xdb\xc5\x34\xd8\xd7\x61\xcf\x42\xd8\x78\xa8\x5d\xe6\x39\x24\x7e\xbe\x36\xec\x54\xd8\x69\xe1\x8d\x74\x84\xc6\x43\xd9\x43\xa3\x35\xad\x5b\x77\xf4\x39\x82\x8b\xfd\xc0\x5e\x84\xc1\x8f\x7b\xeb\x69\x54\x7d\x40\x8b\xd5\x43\xeb\x78\x6a\x3c\xb3\x51\x6a\x90\xfc\x19\x66\xbc\x7b\xd7\x44\x9a\xb6\x57\xe1\xb3\x25\x49\x8e\xd5\xea\x6b\xd0\x08\xa3\xc7\x6b\x81\xc9\xfa\x00\x76\x56\xae\xe8\xbe\xf5\xce\x7c\xd6\x90\x82\x80\x17\x91\x9c\x44\x07\x44\x16\x40\xd1\xf0\xfc\xc1\x29\xcd\x28\xb6\x87\xf3\x4b\xab\x94\x65\xf9\xdf\xfe\x37\x15\xc7\x84\xfd\x9b\x91\x07\xf3\x4f\xc5\xd1\x52\xd4\x02\xc1\xb4\x77\x11\xd4\xb4\x2e\xee\xb2\x37\xfe\x96\xa4\x79\x9c\x0c\xbf\x15\xe3\x22\x5b\x72\x46\xad\xfa\x70\xe5\x4c\x10\xcb\x00\xd0\xc5\x48\x83\x80\x5c\x60\xcc\xd8\x02\x2c\xc4\x16\x97\x6f\x73\x4c\x05\xc8\x88\x3e\xff\x9e\x65\x72\x60\xce\x3f\x92\xcd\x43\x1d\xc5\x4c\x7b\xd2\x71\xba\x55\xe9\xf2\x74\x1d\xa8\xec\xd5\x8a\x83\x41\xe2\x3e\xc8\x50\x12\x24\x11\xa4\xf1\xcd\x36\xf0\xcb\x75\x16\xbc\x6b\xd1\x9d\xc8\x74\x29\x53\x03\x45\x19\xc1\x35\xe6\x24\x3a\xb4\xf5\x43\x55\x1c\x17\xe7\x28\x0a\x29\xc7\xf5\xc5\x48\x03\x87\xc3\xab\x07\xf3\x41\xdf\x54\x33\xf1\x4f\xba\x82\xf8\xf4\xd3\x46\x53\x18\x6f\x94\x39\x74\x88\xc8\xb0\x10\x22\x2d\x8d\x86\x47\x44\x85\x99\x24\x40\x08\x9b\x2c\xf8\x0a\x46\x19\xcb\xe5\x0d\x1d\xb1\xa7\x72\x56\x54\x07\xc0\xc8\xdc\x01\x14\x4c\xda\x10\x13\xb8\x85\x9a\xb9\xac\x88\x1d\xfa\xee\x89\x19\x21\xe2\x0d\x82\xbb\x84\x6c\x92\x44\xc4\x36\x69\x2b\x0d\xa3\x02\xe7\x70\xb4\x61\x61\xa5\xc2\x8b\x9e\xf2\x5d\x21\xd3\xac\x28\xb6\xe5\x9d\xd5\x5c\xfa\xeb\xe2\x1f\xa4\x5b\x62\x74\x19\x26\x7a\x43\xc5\x4f\x9c\x95\x99\x55\x31\x53\x9f\x7f\x5b\x61\xcc\xf4\x7e\x6d\x26\xc3\x1c\x43\x66\xb1\x5c\x2e\xed\x05\x41\x77\x88\x1a\xd5\x6c\xee\x79\x4c\xf6\x04\x8f\x23\x26\xeb\xd6\x03\x58\xc3\xba\x7a\x18\x63\x98\xea\x50\x52\xe9\x2b\x4e\xa2\xe9\x1a\xb4\x72\x5d\xf9\x37\x59\xec\x92\x6e\xa3\xbd\x61\x32\x09\x13\xf1\x31\x50\x82\x57\x7e\xc0\x54\xf4\xc5\xc2\x79\x1f\xe8\xd7\x54\x0d\x6f\xc4\xcf\x41\x54\xc6\x11\xac\xd1\x12\xed\x58\x46\xed\x51\x9f\x47\x7d\x5a\x8f\xf8\xf4\x67\x5e\xc5\xc6\x71\x7d\x2a\x6a\xd7\x0e\x42\x15\x74\xd4\x1e\xa7\x2f\x36\xba\xb4\x8c\x49\xf6\x9b\x17\x9c\x0e\xa5\xa8\xab\x8b\x01\x08\x8a\x12\x1b\xbd\x17\xf9\xd1\x28\xe7\x71\xe6\x52\x51\x86\x51\x4d\xdb\x4e\x5c\x24\x88\x64\x53\xa5\x19\x98\xed\xfb\x65\x57\x87\x17\xc5\xc9\x09\x94\x43\xda\x38\x55\x1f\x85\x7a\x9a\x56\x05\xda\x3b\x79\xe5\x38\x8f\x65\x69\x57\xeb\x18\xab\xd5\xf7\xb8\xe2\xef\xfa\xe7\xa6\xdf\xb5\x17\x18\x35\xb6\xae\xd8\x68\x71\xd4\xed\xa4\x5c\x6d\x35\x02\xa2\x1b\x7a\x1c\xd9\xb2\x62\x8c\x96\x5a\xd5\x4d\x3e\xe6\xcd\x9c\x1d\x4c\x60\x62\x7b\x50\x1c\x3b\x24\xb7\x06\xe1\x8c\x0a\xed\x04\x06\xb7\x4e\xe4\xa7\x0b\x2a\xfe\xb5\xc7\xcf\x89\xbd\x57\x23\x65\x49\x3f\xf7\x59\x67\xb4\xa6\x83\xd4\x2e\x9b\xce\xaf\xec\x98\x2f\x67\xe0\x82\xf0\xa7\xbb\x1e\x7a\x4a\x3c\x35\xfb\xf6\x31\x4a\x8b\x37\x15\x03\xe0\xfd\x69\xc9\x08\x12\x4e\xb5\x9d\xc9\x28\x29\x9f\x26\x93\x48\xfb\x77\x39\x2c\x4e\x54\x8e\xcf\xbb\xaf\x11\xc5\x41\x64\x87\x84\x6b\x36\x25\x65\x3c\x01\xb2\xfe\x61\x5d\x14\x21\x96\xb8\xa3\x75\x56\xc9\x97\x41\x44\xc4\x44\xd2\xf4\x49\x7f\x59\xf1\xa7\xf2\x26\x23\xb2\xff\x7a\xb2\x28\xff\x5d\x95\xc6\x6b\x4f\xb6\x7f\xd8\x13\x70\x4f\xec\xac\x9b\xfb\xc1\x63\x92\x31\x86\xbc\xdc\xb3\xb8\xda\x13\x59\x16\x06\x94\x7e\xa9\xae\x31\xdc\xc0\x92\x39\x5e\x4b\x42\x60\x2f\x59\xd0\x3e\x14\xa1\xab\xae\x0a\x50\xe4\xc5\x32\x3b\x8f\x72\xd1\x94\xbe\x53\xf1\xb5\x28\x60\x1b\x67\x64\x06\x58\x18\xb9\x11\x3b\xea\x56\xe7\xb8\xf8\xdb\x89\xe9\x79\xd0\x1c\x4c\x89\x37\x9b\x88\x72\x2c\x63\xc7\x40\x4d\x00\x68\xa2\x15\x96\x63\xf1\xc7\x3c\x3e\xfc\x68\x31\x5c\x7d\x13\x26\xbe\xa4\xb0\x09\x87\xdb\x27\x34\x49\x0d\xcf\x9b\x70\xfc\x78\x0a\x90\x05\xc9\x9e\xf7\xb9\x2b\x66\xd3\xa1\x67\xb5\x9e\x53\xd1\x61\x7d\x73\xec\x75\x4f\x66\xef\x4e\x76\x7f\xcf\x64\x4f\xc0\xcb\x44\x60\xcc\xa5\x0a\xc8\x76\xca\xd2\x41\x8f\x40\x01\x76\xb0\x8b\x44\x13\xf4\xa4\xb9\x54\x3f\x85\xf1\x8b\xc1\xcb\x1e\x0a\xc6\xae\x44\x8c\xb5\x66\x3a\xaa\xc7\x57\x5d\x87\xdc\x4d\x93\x5c\xfd\x79\xad\x74\x9a\x87\x2c\x1c\x32\xe7\xe2\x86\x92\x29\x21\x47\xf0\x49\x53\x86\x61\x34\xf9\xc3\xac\xce\x1b\x71\x4f\xdc\x8a\xdf\xcf\xc5\x40\xdb\xd1\xdc\xcc\x65\xeb\x57\xc6\x6e\x29\x12\x16\xa4\x4d\xb9\x77\x1c\xb8\x9c\x51\x22\x6e\x53\xf8\xd9\xf9\xa9\x66\x79\xe4\x29\x06\x11\xaf\x96\xe7\x82\xee\x2b\x11\x2b\x96\x6f\x5b\x8e\xb4\xce\x04\x8d\x9c\x9d\x3f\x8b\x75\xb2\xb2\x4c\xa3\xa3\x28\x2d\x10\xd8\xab\x5a\xcf\xe3\xe8\x3c\x22\x68\x80\x01\x27\xee\x00\x16\x3e\x56\x63\x42\x85\xe8\x56\x4a\xa5\x41\x7a\x5f\x8b\x66\x59\xa2\xd4\x2e\xaa\x57\x2d\x13\x85\xf8\xe0\xd3\xf3\xd2\x14\xd5\xe4\x95\x2a\x7c\xf6\x68\x7b\xda\x14\xad\x9a\x2e\x6e\xd1\xcf\x7d\x2d\x44\xcc\xc5\x0a\xa0\x9c\x01\x65\x7c\xab\xca\xf9\x2a\x2a\x29\x5b\x68\xfc\xc0\x24\x95\x60\x67\x0c\x53\x9b\x9f\x1e\x4c\x71\x19\xcf\x13\xf1\x7e\x74\xbb\x42\x12\xf5\x0c\x01\xfa\xc1\xeb\x18\xc1\xb8\x67\xe4\xb9\xf1\x31\x94\x44\x22\x73\x7f\x13\x9d\x2a\x94\xdc\x53\x5f\xe2\x74\x58\x45\x62\xfe\x7c\x9f\x95\xdf\x63\xba\xd3\xcd\x9e\xaa\x08\xce\x71\xfc\xe6\x7b\xb3\x5a\x3b\x4d\x9c\x0c\x88\x0e\xe2\x6c\x56\x40\xc3\x76\xa7\x8e\x50\x88\x7f\x06\x24\x04\x3e\x96\xd4\xf6\x2d\x17\xb7\x97\x40\x05\x9a\x3f\x07\xb7\x0a\xac\x20\xb3\x6c\x55\x54\x1c\xc1\x03\x7b\x25\xf0\x78\x5c\xda\x68\xa6\xd6\x43\x15\x3a\x5d\x7c\x23\xf4\x31\xca\x26\xf2\xce\xee\x19\x31\x6a\x29\xf4\xfd\xc2\xbf\xb2\xe7\xbb\x49\x54\xb6\x00\x65\x00\x18\x97\x64\x51\x97\xdd\x45\x8b\xe4\xce\x90\x88\x1a\xf2\x30\x58\x5f\xe4\xc1\xe6\xef\x2e\x7a\xe5\xac\x8d\xcf\x56\x62\x31\x31\x5d\x51\x78\xe1\xab\x3f\x76\x98\x2c\x76\x48\x28\x6e\x09\x7e\xf7\x62\xf3\xb6\xf3\xd1\xb9\xdc\x3b\x81\x5b\x8a\xc3\x8a\x58\x36\x8c\xc5\x18\xf2\x06\xa8\xa3\x3d\x07\xa2\x3d\x55\xdd\x9e\xb8\x31\xa6\xe2\x5a\x50\x99\x68\x10\x00\xfb\xd5\xd5\xb3\x85\xe4\x70\x8f\xda\x24\xc3\x94\xf4\x4d\xb5\x0f\x75\xaf\x2c\xff\xb1\xfe\xc5\xe4\x31\x66\xc5\xbf\x17\x79\xd4\xf8\x5f\x36\xa5\xdd\x16\xef\x8f\x4d\xe9\x93\x1d\x15\x0a\x1e\x56\x6d\xdb\x9b\x82\xa8\xca\xe9\xaf\xce\x78\xb6\xe7\xdf\x44\x7a\x53\x5e\x6b\x7c\xd4\x00\x04\xbe\xc9\x06\xc9\xe6\x2a\xec\x35\x56\x7e\x99\xc4\x9b\x85\xd8\xdb\x43\xde\xf5\x54\x36\x8a\xa1\xc6\xc1\xef\x1f\xf2\x33\x70\x9c\x48\x70\xad\x77\xc3\xa9\xec\x39\x73\x28\x8c\x94\x68\x9e\x41\x13\x65\x3d\x32\x6b\x28\xcb\x71\xef\xe8\x27\xc3\xbe\xa6\x86\x07\xdb\x44\x9f\x03\x08\xc2\x39\x7f\x27\xdd\xe0\x84\x25\x02\x27\x7f\x45\x2b\x94\x7a\xa9\xf4\xdb\x7d\x39\xa6\xdd\x28\x72\x03\x59\x6e\x00\xda\x5c\x1d\x89\xa1\x98\x10\x5c\x87\x22\x60\x1e\x2e\xfe\x9a\x41\xa5\xc3\xbe\x40\xaa\x67\x16\x7a\xb3\x56\x36\x7d\xa8\x12\xb7\xc0\xea\x63\x7c\x75\x68\xbb\xf1\x7b\x90\xdb\xf3\xd1\x4e\xef\xac\x10\xe1\xd4\xb2\x34\xe1\x6a\xf6\x67\x85\x5d\x3b\x0b\xec\xc4\x8f\xa6\x0b\x9e\x7f\x5a\x54\x34\x5f\x79\xa0\xf5\x94\x03\x40\x2f\xa6\xde\xa9\x38\x95\x4e\x3c\x16\xe6\xc7\xf6\xf9\x86\x00\xce\x47\xe3\xf1\x12\x1b\xc5\xee\x47\xd1\xa0\x4c\x40\x21\xcb\x55\x15\x97\x5f\xa6\xee\x0a\xaf\xff\x51\x98\x33\x1d\xf6\xb0\x15\xc8\x8f\xef\xe8\xbf\xcb\xdf\xa1\xbd\xfd\x3a\xe2\xc0\xa4\x73\x37\xe8\x98\x34\xb2\xd6\xcb\x98\xbb\x7c\x10\x5f\x6f\x1c\x35\xa3\xb4\x88\x99\xc0\xbf\x34\x9d\xe0\x14\x43\x95\x2c\x23\x39\x6a\x39\xe7\xbd\xbd\x50\x58\xaf\xa9\x3d\x87\x60\x6c\xa1\x91\x43\x42\xb8\x52\x24\xbe\x9a\x7c\xa2\x5b\xaf\x4d\x93\xcf\x47\xb9\x45\x46\x10\xf8\xc0\xf6\xbf\x38\x51\x0a\xbc\x84\xcf\x0d\xf9\xbd\xce\x34\x46\x66\x07\xe5\x7f\xf1\x5e\xf6\x0f\x7d\x22\x58\x66\x83\xa1\x55\x9e\xdb\xca\x3a\x4c\x63\xca\x2f\xf9\x7a\x23\x8f\xbc\x5a\x89\x19\x7c\x92\x4e\xc1\xb4\x0e\xa4\x55\xa5\xc0\xb2\x0b\xbe\x03\xf7\x51\x91\xa3\x34\xd7\x67\x7d\x79\xdf\xca\x8a\xdc\x82\x53\xd0\xad\xd4\xd1\x2d\xb9\x81\xa2\x51\xd5\xe3\x39\x10\x47\x32\x0c\xbf\xd2\xea\xb7\x1e\xe3\xc2\x7d\x98\x02\xfc\x7d\xa0\xa5\xf6\x51\x8b\xfa\x81\x50\x92\x88\x1e\xbc\x8f\x53\x24\xb6\xc5\x0b\x47\x0b\xf5\x93\x55\x3b\x3b\x68\xee\xde\xd4\x42\xdb\xbd\x33\x9a\x51\x18\xdc\x50\x36\x2e\x04\xf5\x2d\x18\x8d\xe7\x33\xb2\x11\x57\x70\xb7\xd0\xa8\x23\x4c\x45\xd0\x85\x64\xe7\xc3\xda\x47\x96\x9a\x2d\x48\xb2\x18\x8f\xb0\x7a\x53\x19\x78\xcc\x26\xba\x41\x08\xf8\x8b\x7a\x45\x98\xdd\xf6\x89\xe0\x0f\x24\x8e\xd4\xce\x6b\x89\xa0\x92\x95\x0d\xea\x4b\x16\x6e\xc2\xee\xf9\xfd\xd0\xdf\x8e\x2f\x96\x06\x76\x60\x5f\x8d\xa6\x1b\xbb\xec\x0d\xc0\x51\x94\xfa\x5f\x0f\xea\xf5\xdf\x0b\xd4\xf9\xf6\x3f\x40\x3d\x6b\x5e\x03\xcb\x12\xff\x82\xba\x26\x76\xe2\x87\xa0\x39\xb9\x89\xe5\x90\x8b\x7f\xf6\x39\x56\x3f\xae\x6d\x2b\xab\x76\xf6\x53\xb3\x6c\x3e\x89\xb1\xdd\x2b\x27\xbf\x7b\x6d\x3e\x6f\x55\xfb\xd2\xb2\x63\x30\xa7\x96\x05\xbd\x4a\x34\x8e\x54\x7d\x56\xee\x52\xe4\xcf\x52\x7a\xc3\xe8\xa3\x0e\x03\x91\x87\x69\x16\x82\x62\x9f\x49\xbe\x19\x44\x82\x89\x96\x27\x41\xc2\x04\x0a\x1e\xfd\x11\xb5\x96\xb5\xd2\xa7\x7e\xa4\xed\x4c\x7d\xbc\xe1\x96\xf9\x8a\x00\x33\x6c\x23\x76\x2f\x03\x5f\x3a\x26\x71\x13\x48\x42\x27\xc4\xca\xa5\xad\xe4\xcf\xa0\x44\x66\x40\x7c\x97\x70\x62\x54\x24\xba\x6a\x67\x74\xaa\x6a\xc7\x98\x7d\x86\x75\x73\x2c\xb8\x7c\xce\x96\x1f\xfa\xc3\xd2\x8e\xe0\x30\x05\xf5\x36\x1a\xf7\x6f\x9f\xee\x7a\x65\x5c\x76\x8a\x2e\x90\x1f\x3d\xc1\x5d\xca\x5a\xe8\x30\x97\xf5\xae\xf6\x49\x84\xfe\x39\xdf\x09\xd0\x06\x49\xa3\x44\xff\x6b\x42\xc1\x89\xaa\x3a\x8d\xa2\xac\x35\x5a\xd6\xf0\x25\x51\x4c\x68\x08\xcc\xf4\xc4\x96\xd6\x79\xb9\x65\x1d\x67\x8d\xc9\xd2\x9a\xcb\xc8\x95\x53\x06\x32\xfd\x35\x64\x50\x98\x14\xdf\xd4\x53\xb3\x7e\xc0\xb6\x93\x2d\xd0\x2b\xd0\xe0\xa5\xda\xd1\xd8\x86\x50\x62\x59\x61\x19\xb4\x41\x98\xa0\xbe\x0e\xc7\x9a\x05\xcc\x13\xca\xbd\x77\x78\x9a\xa4\xdf\xb8\xe1\xbb\xba\x0b\x92\xc8\xf6\x6f\xb0\x5b\x87\x15\xd4\x61\x8b\x69\xaf\x8d\xd7\x05\xac\xb9\xcc\x2b\x2d\x53\x06\x66\x7f\xbe\xb1\x1c\xda\x96\xab\xff\xfd\xe4\x0f\x45\x16\x2b\x00\x30\xd3\x90\xaa\xa3\x33\x68\x42\x15\x25\x80\xe3\xbd\xf5\x2c\x6a\x21\x69\xa3\xa8\x66\x0b\x49\xe5\x3e\x03\xa1\xb5\xd8\xea\xc4\xb6\x14\x48\x7f\xe9\xd1\x09\xc4\xa2\x3d\x39\xb0\x27\xc9\xa5\x63\x50\xa1\xc5\x9d\xad\x71\x26\xf8\xd9\xce\xb8\xf6\x72\x95\x38\xbb\x1f\xe4\x72\x50\x8c\x57\x5f\xf7\x35\x70\x7f\xd0\xab\xaa\xd3\x32\x4b\xaa\xb2\x76\xee\x7a\x23\x10\xb5\x22\xe7\xa0\x09\x8c\x1b\xe9\xbf\x26\x05\xc7\xef\x46\x95\xe6\xf1\x35\x2e\xa0\xba\x7c\x45\x56\x1d\x24\x0a\xf0\x89\x4d\x9c\x5b\x71\x45\x4d\x25\x08\xdc\x20\xfd\x9b\x39\x39\x25\x43\xbe\x2e\xd7\x81\x21\x5e\x93\x07\xea\x10\xba\x7f\x52\x87\x92\xe0\xf0\x26\xbb\x1c\xef\xfd\x29\xfa\xe9\xec\x85\x80\x4b\xc8\xf3\x82\xf0\x2f\x2d\xfa\x8d\xd9\xf6\x6f\x06\xbc\xe9\x9f\xc0\x6b\x1f\x58\x96\x61\xde\xba\x40\x80\x60\xe3\x8f\xd2\xce\x77\x54\x2b\x64\xaa\xf2\x13\x6d\x25\x8c\x25\x70\x3d\xb4\xfa\xc6\x2a\x30\x0e\x75\x6e\xb8\x6a\xb8\x25\x2f\xe2\xdb\x79\xf9\xdd\x0f\x08\xf9\x41\xd5\x6b\xb9\xac\x22\xf5\xba\xf0\xe1\xe4\x27\x59\x0e\xe3\xda\xa1\x4b\x55\xec\xb5\x37\x6f\x22\xe8\x4e\x02\xa8\x4b\x18\xf1\x4f\xe1\xb5\xaf\x48\xf5\xbd\x39\xe8\xe0\x09\x1f\x8c\xf7\x7a\x47\x53\xd7\x2b\x3b\x08\x25\xe3\xe6\x4a\x68\xb8\x48\xc4\xad\x95\xed\x67\x76\xa6\x3f\xea\x53\xf1\xca\xdb\xd4\x1d\xb7\x54\xda\xae\x0b\xfa\xb4\x59\x38\x39\x16\xc8\x1c\x8f\xca\x3b\xbf\x47\xe6\x7a\xfa\xf9\xdc\x80\x3e\x36\x46\x33\x71\xda\xaf\x9e\x6d\x53\x2e\x5f\x0f\x79\x07\x1a\xc9\x2d\xaa\xe7\x13\xf1\x65\x00\x65\x3a\x61\x25\x41\x3f\xfd\xbc\xd3\x99\xaf\xcf\x46\xf9\x45\x1e\x44\xc6\xea\x0c\x66\xa0\xb3\xcc\xef\xd2\xb8\x37\x70\xf9\x7e\xf1\x64\xff\x9e\xb4\xcc\x95\x34\x95\x45\xc0\x17\xe0\xda\x9d\xb3\x0c\x76\x69\xb5\x92\x59\xed\xb2\x4b\x35\x63\xa6\xc5\x53\x5e\x37\xad\x24\xe9\x56\x5c\xf7\x9f\xa6\x7b\x9a\xad\xb5\xdf\x99\x07\x2c\xb1\x1b\xcb\xee\xe8\x68\x62\x97\x0d\x5a\x3d\x7e\x71\x63\x43\xb3\xd2\x7a\x0d\x18\x4a\x39\xc4\xbc\x48\xed\x7c\x55\xed\x96\x42\x5d\x1b\x6a\xa6\xc9\x92\xfe\x9c\xb3\xa3\x3c\x66\x1c\x76\x0b\xd1\xde\x20\xb8\x65\x5f\xde\x2b\xe2\x73\x65\x1a\xfe\xf2\x04\xd1\x0f\x12\x97\xa0\x2f\x27\xc6\x10\x09\xf9\x6a\x85\xc1\xf5\x47\x86\x9e\x6a\x42\xd8\xb4\xf6\x8d\xce\x37\xd7\x6e\x2b\x79\x29\xe0\xc4\xe7\x04\x88\x9a\xbd\x89\xe4\xe2\x81\x72\x00\xb5\xaf\x09\x8a\x07\x50\x22\xeb\x23\x4d\xd8\x7d\x13\x3a\xd2\x2a\x41\x05\xfe\x03\x7b\xcf\xf9\x41\xf2\x82\x28\xfd\xca\x6c\x1c\x09\xad\x24\xb8\x88\xf7\x13\xac\x5f\xc1\xe5\xf0\xdb\xb3\xd8\xa4\xf0\x18\xa9\xa3\x32\x94\xfc\x1e\x6d\xc6\xb9\xa1\xbc\x4b\x3e\x33\x42\x2d\x90\xbc\x42\xea\x33\x1b\xc0\x4d\x9b\x62\x78\xbe\xae\x8a\xc6\x4e\x83\x32\x75\x0c\x6b\x54\x4e\x24\xde\xf4\xb6\x97\xf2\x53\x23\x9b\x05\x41\xfd\xfa\xde\x51\x37\x9d\xc3\x2b\x53\x9b\x1f\x4a\xa8\x6d\x06\xbf\x3c\xb9\xe1\xe1\xc3\xc8\x42\x07\xee\x85\xb0\x0d\xb5\x0b\x76\x42\x49\xf3\xb4\x07\x82\xe5\xa7\x3d\x32\x91\xd1\xfe\xb5\x4b\x74\xe3\x36\xfd\x7b\x01\x2f\x55\xfe\x02\xde\xba\x1e\xf4\xeb\x81\xaf\x05\xf8\x35\x6d\x96\x2c\x85\xa0\xfa\x1c\x8c\xf0\x4b\xe4\xd8\xfc\x37\x42\x8a\x56\x02\x18\x6d\xfd\xa6\x56\xec\x9c\xeb\xe2\x3c\xbf\x1a\x23\xf8\x46\xe1\xad\x96\xaf\xa2\x56\xe8\xaa\xc8\xf1\x37\x61\xca\x66\x63\x9e\x5a\x02\x26\x3a\xb4\x51\x7f\x7d\xcd\x02\x78\xff\xeb\x9e\x03\x5c\x09\x0a\x3f\x1f\x76\x4f\x53\x6b\x48\x1f\x34\xca\x6d\xa6\xc1\x54\xe9\x81\x40\x7b\x31\x7e\x39\xa2\xaf\x3f\xd3\x44\xa0\x11\xc1\xf1\xc7\x42\x79\xfa\x7a\x78\x9a\x05\x3e\xfb\xe3\x9e\x30\x2a\x62\xae\x60\xd1\x71\xfb\xe7\x80\xef\x4d\x8e\x92\x08\x52\x19\x63\xb9\x08\xf9\x38\x39\x6b\xee\x24\x1f\x1e\xd1\xe8\x52\x5d\xe3\xb8\x69\xef\x28\x2c\xb6\x98\x7b\x1a\xcd\xb1\xba\xbd\x1d\xf0\xb1\x5f\x08\x18\xe3\x46\xe4\xa3\x23\xf1\xe5\x4f\x96\x16\xb6\xb0\xe8\x82\x99\x99\x3f\x1d\xb2\x39\xd5\x49\x2d\x9a\xbf\x32\x32\xef\x19\x5a\x2f\x01\xc4\x43\xd8\x43\xd7\xda\xb6\x74\x66\xa6\x5f\xd8\x4b\x60\xc8\x7e\x6f\xf2\x51\x61\x82\x93\x9f\x69\x3b\x5d\x57\xd5\xa5\x15\x5e\x5c\xfa\x58\x5c\x9a\x59\x85\x9f\x43\x6f\x07\x09\x63\x5e\xbd\xad\x76\x88\xef\x4d\xf6\xd5\x37\x78\x00\x5c\x04\x3a\x53\x34\x1f\xff\x96\x57\x63\x71\x30\xaf\x74\xbb\x3c\x39\x68\x4d\x29\x56\x4d\x03\x31\x50\x39\x33\x29\x9d\x4a\xe3\xae\xdf\xc5\x8e\x83\x7a\x33\x5d\xd6\xe8\x2a\xfe\x37\xe0\x3a\x81\xaf\x1a\x3c\xd7\x65\xdf\xe9\x40\xc6\xa0\x3b\x00\x58\xf1\x88\x07\x98\xbb\x25\x7c\x18\xca\x6b\xbd\x3e\xe2\x17\x33\x69\x08\xaa\x37\x77\xdd\x3f\xe1\x60\x05\x23\xe4\x36\xda\x7b\x6b\x73\xa1\x71\x17\xb8\xdc\x8c\x92\xc6\xfe\xe3\xe8\x8e\x84\x15\x01\x64\x01\x4b\x2b\x17\xf8\xb2\x36\x76\x5d\x67\xc4\x7d\xeb\xc4\x3e\x86\x0e\xfa\x1b\xa0\xbc\xe4\x21\xbd\x36\x6c\x5a\xa7\x12\x0b\x82\x09\x0e\xfe\x2e\x35\xc1\x7e\x3b\x13\xe1\xb9\x05\xb5\x0b\x82\x05\xeb\xed\xf1\x91\xa4\x08\x69\xb0\x2e\xbf\x5c\x6c\xd7\x57\x31\x94\x42\xe5\xb0\xe3\x28\x21\x97\x6f\xb2\x01\xd3\x9d\x6a\x4a\xd3\x27\x13\xc2\xeb\x4e\x7f\xb6\x1c\xf8\x47\x60\xfe\x4b\x65\xe3\xf6\x6f\x26\x1b\x8b\xdf\x5f\xb2\xb1\x85\x97\x7f\xac\x73\x3b\xe4\xd3\xc7\x89\x92\x71\x2a\x83\x94\x1d\x7f\x46\x14\x7f\x29\x51\xcd\x78\xbc\x35\xf9\x08\x3e\x97\x1b\x13\x16\x65\x32\xf1\x5b\xb8\xef\x8d\x5a\x00\x74\xc1\xab\x76\x96\xbd\xde\x41\x94\xd8\x2e\x89\x7c\xf6\xb8\xd5\x20\xae\x1b\x17\xe9\xab\x66\xaa\x5a\xc2\xc9\x08\x2d\x27\x3e\x3f\xe3\xf5\x9c\xfc\x5a\x33\x51\x90\x18\x47\x91\x93\x24\xf0\xa1\x2a\xc9\x30\x68\x9e\x2a\xd0\xc7\xf5\x07\x4e\xd5\xa5\xa3\xea\xd6\x62\x36\x4f\x70\xd1\xa0\xf1\xb8\x83\xd5\x16\xb2\x50\xe1\x27\x4e\xbe\xed\xc1\xe9\xfc\x07\x52\x9d\xb6\x3f\xe2\x24\x56\xf2\xd0\x51\x18\xbe\x2a\x69\xe5\x71\x39\xfd\x4e\xfa\xcd\x1b\x1c\xdc\xec\x4b\xb0\x5b\x74\x88\x90\x70\x5d\x03\x0b\x18\x15\x46\xad\x9c\x42\xce\xd9\xab\x5a\xae\x55\xd4\xaa\x3f\x5f\x6f\x2f\x43\x42\xa0\x93\xd8\x34\xd7\x37\xe0\x64\x49\x52\x59\x67\x9b\xed\x35\xf4\x05\xd3\xda\x7f\x25\x47\x31\xcc\xd5\xd4\xad\x53\xdb\x33\x06\xd3\x74\x5b\xb2\x16\xf3\xf8\x05\x04\x30\x01\xcf\x84\x84\xc8\xf4\xd0\x55\x4a\x92\x37\xb7\xb0\x97\x30\x3b\x9f\xca\x77\xf6\xdc\xec\xaf\x33\x64\x2a\xe2\xc5\x9d\xbb\xce\x6c\x67\xd9\x7a\x73\xd0\xb0\x42\x8f\xd7\x77\x62\xcd\x92\xce\x98\xa9\x7c\xb8\xaf\x94\x7d\x88\xad\xd9\x76\x9a\x0e\xeb\x04\xc7\x32\xd6\xf4\xf9\xc2\xc2\x43\x39\xad\x5b\x10\x02\x91\x9a\xcb\x8d\xe8\x41\xd3\x9c\xbd\x96\x7a\xd5\x6a\x0a\xc2\x6e\x28\xac\xff\xbc\xb0\xd7\x32\x44\x57\x7e\x2e\x41\x3a\x85\x8b\x9f\x3d\xcd\x87\xe2\xc1\xd3\x99\x6f\xa0\xf6\xe4\x95\xa1\x35\xf6\x30\x81\xf1\xf4\xb4\x73\x41\xde\x5a\x07\x24\x0a\xf7\x34\x4e\x85\x4d\x0f\x54\x84\xc4\x1a\x77\x99\xed\xa1\x34\x2b\xf1\xf6\xd0\x84\x39\xe6\x37\x65\x0d\x61\xe3\xee\xa3\xcf\x81\x0d\xb8\xda\x69\xcf\x19\xc1\xb7\x9c\x87\x2f\x8b\xd3\xc8\x79\x3a\x2e\x60\x0e\xa4\xfb\x41\xa8\x2e\xfe\x83\x16\x84\x82\x93\x73\xa7\x42\x9a\x38\xd2\x1b\xed\x11\xa0\x74\x63\xcf\x86\xb1\xad\x5a\x5a\x8a\x09\xe3\x35\xc9\x6a\xa3\x71\x72\x74\xe8\xcd\xe9\xbc\x57\xe8\x72\x6a\x50\x9a\x12\x2e\x43\x13\xd9\x62\x3f\xd9\x05\xce\xe8\x2f\xb1\x13\xb0\x21\x19\xbe\x46\xcd\x11\x4f\x5d\x65\x92\x64\x8a\x65\x74\x8e\x8f\x01\x40\xbc\x39\x46\xa7\xc0\x3f\x55\x99\xb3\xe0\x3a\xd6\xf7\xac\x58\xa7\xff\xd5\xd9\xec\xdf\x4c\x46\x66\xc2\x5f\x40\x24\xa9\x05\x42\xe8\xa8\x98\x14\xa8\xf6\x7b\x82\x9f\x2b\xdf\xb8\x4e\x22\xe3\x18\x3e\x9b\x6a\x96\x87\xed\x91\xff\xbd\x80\x3b\xbc\x32\x91\xf3\x0b\xb4\x57\x22\xcf\xab\x69\xba\xff\xec\x21\x78\xac\x1d\xd3\xae\x33\x9a\x20\xa9\x74\x2a\x79\x3f\x4f\xcf\x77\x58\x7f\xeb\xa0\x15\x46\xe3\xa2\x03\x9c\xe7\x49\xa7\xfb\x75\xd3\xb7\x40\x60\xb9\x8e\x93\x28\xb8\x02\xa6\x01\x98\x0e\x2f\xcb\xb9\xf2\xcb\x96\xcd\xf2\x65\x98\x62\xcb\xbe\x05\xcd\x0a\xa5\x92\xa9\xeb\xc2\x99\x44\x4a\x62\x2d\xbf\x97\x9f\xde\xaa\xfe\x9b\xe4\x8b\xf4\x1a\x7c\xc7\x1f\xa7\x49\x29\x71\x3c\xc8\x5f\x5d\xb1\x36\x4b\x72\x00\x61\x0c\x98\x92\x3e\xf9\x21\xe6\xae\xf8\x7e\x26\x8b\xa5\x9b\xaa\x6c\x0c\xcc\xb9\x66\x58\xc3\x35\xcb\xf2\xad\xdc\xc3\xbc\xd0\xb1\x08\xdf\x43\xf5\x9f\x2d\xd6\xf5\x10\xb6\xf2\xd3\xd4\x06\xd4\x74\xcd\xc6\xb1\xb8\xbb\x15\x9d\x28\x8b\x9e\xa5\x31\x40\x5f\x34\x31\x38\x9a\x07\x62\x5f\x94\xa6\x35\x93\x96\x29\x97\x11\xde\xa1\x7b\xb6\xe9\x29\xb7\xf9\x59\xcc\x16\x6c\x6d\x96\xce\xa0\x24\xfe\xa4\x32\x8c\x09\xef\x10\xf2\x6b\x75\x92\x8f\x63\xdf\xeb\x0f\x13\x92\x02\x10\x3e\xb9\x74\xd2\x6e\x4a\x95\x8a\xa8\xd3\x3d\x5e\xb3\xee\x17\x1f\xc0\x2f\xeb\xdb\x22\xcd\xe9\x63\x1e\x8b\xa3\x34\xdf\x71\xf8\x7a\xbd\xd9\xea\x5c\x83\x1b\x8f\xf4\xd4\x5b\x9c\x6e\x3e\x67\x38\xfb\x64\xce\x8e\x04\xcf\x55\xda\x38\xf0\xd2\x14\x00\xd9\x8f\x25\x4a\x4f\xa4\x48\x0e\x2d\xf3\x91\x38\xda\x04\x82\x2b\x2a\xa5\x0f\x33\xdb\x88\x8f\x21\xde\xf0\x35\x6c\xde\x1b\xf4\x8d\x77\xd1\x2d\x0a\x74\xc8\x2a\x29\xa0\x50\x6e\x8b\x0b\x69\x9e\xa2\x17\x9c\xd3\x43\x49\x63\x81\x04\x22\x3d\xd7\x35\x02\xba\xfa\xe7\x98\x1e\x22\xb4\x75\x03\xf3\x96\xf3\xee\xad\x4d\x93\xdb\xcc\x65\x3c\xa8\x07\x56\xc6\x88\xef\xef\x3a\x90\x6a\x4c\x90\xbd\xc5\x35\x17\x98\x93\x84\x14\x9a\x6d\x02\x03\xde\x5a\x98\xaf\x67\xa4\xfb\x1f\x5c\x98\xe0\xba\xb7\x03\x93\x7c\x86\xbc\xf0\xad\xb0\xe9\xa0\x8f\x7b\x69\x9d\x52\x04\xaa\x1e\x2c\x80\x7a\x90\xee\x1b\xa9\xad\xb7\xe7\xf9\xa5\x08\x18\xf1\xaf\x2e\x8c\x4c\xff\x6e\xd5\xce\xbe\xfa\xab\xda\x09\xfd\xa3\xda\x19\x99\xae\x89\xe2\x73\x74\xc5\xc1\xc7\xed\xb8\x78\xd2\xd5\x40\x4c\xca\x11\x11\x5a\x81\x5c\x68\x9b\xf9\x2c\xc9\x18\xee\x6b\x01\x09\x60\x75\xcf\x88\x87\xb5\x8b\x30\xef\xe5\xec\x30\xf9\x4e\xdf\x5f\xcc\xe1\x6d\x66\xdd\xa4\x18\xaa\x6d\x4a\x51\x7f\x0a\xd1\x1f\x3b\xa0\x41\xdb\xfc\x13\x5b\x09\xbd\x48\x8a\x24\x9a\x87\x1b\x86\x1e\x11\x1c\x67\xae\xb0\x61\x85\x7c\x0f\xbe\x13\xe1\x69\x3e\x04\x16\xc4\xe8\x9f\xae\x47\x49\xb1\x0a\x56\x02\x34\x8b\xd8\x90\xe1\x91\xf4\x90\xd4\x1d\x91\x6f\x5f\x95\x32\x2d\x53\xef\x76\x62\xbf\xf7\x80\xa1\xf5\xf8\xae\x9b\x3f\x3d\xd9\x9a\x16\xed\x48\x24\xbf\x49\x00\x20\xc7\x00\x53\x0e\x50\x02\xcf\x8c\xc6\xf0\x4f\x89\xb4\x87\x44\x51\xc2\x2d\xe9\xf5\x4e\x3f\x48\x76\x16\x5d\xa2\x93\x51\x40\x3e\x9a\x8a\x8b\x23\xc8\x6a\x85\xd5\xd2\xd5\x24\xae\xb7\x55\xda\xec\xd6\x4d\x3c\xd6\xc8\xde\xf7\x66\xa9\x42\x7a\x76\x42\x33\x4d\xbe\x03\xf1\x3a\xf9\x04\x06\x0f\x29\x8a\x6e\xd7\x92\x54\x7e\x1a\xf2\xd3\x6b\xcd\x09\x22\xa5\x88\x25\x6d\x12\x61\x66\x80\x45\x0d\xd2\x7c\xb0\xb5\xa5\x71\xc1\x95\xb2\x98\xd7\xcc\x9a\x56\xd6\x67\x83\x57\x15\xca\xb0\x75\x57\xfa\x08\x6b\x21\x40\xe6\x38\xd1\x7e\x55\x6c\x05\xed\x78\x99\x37\x56\x0a\x14\x22\x99\x47\xac\x1c\x57\xb6\xc5\xf2\x45\x69\x0d\xf2\x5e\xf1\x6d\xb9\x19\x3a\x0f\x64\xe2\x15\x5d\x3c\xd7\xc0\x5c\x55\xf3\x77\xfa\x03\xe0\x28\xcc\xbc\x49\x62\x67\x57\x53\xe4\xe8\xe6\x79\x07\x8f\xc3\xd0\x5a\x6b\xa4\x8d\x3a\x91\xaf\x63\x5c\xdc\x24\x5f\x1d\x80\xb8\x37\x99\x9c\xdd\x37\xb3\x2c\xbe\xf4\xd7\xdc\xfd\x1d\xb8\x38\xdf\x3c\x11\xde\xba\x05\xbe\x91\xb8\xb2\x4a\xa6\x48\xef\xe1\x5b\xf5\xfd\x21\x6a\x5c\x23\x7f\xa3\x78\xc0\xf8\x4f\x68\xf5\x5f\xd1\x31\x5b\xb3\x35\xc2\x80\x76\x35\x87\x4b\x1b\x8b\x6f\xe9\x78\x36\xf6\x0f\x50\x83\x4b\x9a\xd2\xdf\x4d\xf8\xe2\xe8\x57\x46\x17\x48\x9f\xe1\x1f\x87\x81\x5a\xf8\x53\xe2\x20\x12\xf2\x05\x78\xb9\x4c\x49\x3f\x2b\x2a\xf3\x65\x84\x02\xbb\x19\xfd\x24\x97\x4e\x69\x49\x03\x38\x97\x27\xcb\x41\x83\x59\xe7\x8e\xd9\xbd\x3b\x00\x71\xb1\x6f\x72\x7f\xc7\xd1\xa5\x3b\x16\x16\xd5\xd7\x9f\xda\x49\xdf\x06\x90\x13\x69\xdc\xdb\xa1\x38\x11\x24\xf4\x13\x0c\xcf\xc3\xc6\x77\x40\xb7\x7d\xa4\x1c\x41\xce\xb6\x45\x42\x39\x67\x04\xe8\x5c\xe2\x8c\xc6\x85\x25\xd1\xac\xbc\x01\xfa\x9b\x7d\xdb\x0e\x7d\x50\xaa\x9e\x60\xf3\x9c\x3e\x38\xfb\xa0\x83\xec\x5e\xc7\x8e\x97\x77\xbc\x59\xa4\x9d\xa5\xd7\x8c\x76\xd8\x1c\x49\x20\x35\x63\xa5\x5e\x7d\xe1\x42\x64\x80\x60\xe4\xf9\x44\xab\xc2\x19\x7b\x63\x33\xef\x48\xe7\xba\x75\x27\x7b\x04\xe7\x39\x83\xc0\xec\xa0\x88\x14\xfd\xc6\x1b\x55\xd0\x56\x44\x63\x0f\x99\x84\xd4\xe0\xae\x09\xf9\xaf\xcd\xbc\x53\xf5\x6f\xe6\x23\x2b\xfd\x2f\x1f\xd9\x6d\xcb\x9f\x5d\x5b\x17\x08\xfe\x12\x2e\x0e\xf5\xe5\xf8\x3d\x99\xfc\xc6\xbe\x11\x8a\xe5\x15\x59\x1d\xab\x22\xb2\x1c\x7d\xf8\xbd\xb8\xc4\x8c\x21\xe9\x03\xc3\xe8\xcc\x26\x69\x13\xc3\x14\xc3\x64\x6c\x80\x2f\x47\xc8\x64\x3b\x4f\x75\xdd\xd0\x72\xfa\xd6\x8d\x36\xa3\xed\xf7\xc4\x51\x16\x0b\xce\x51\x09\xb9\x15\x87\x57\x74\x99\x5b\xff\x54\x19\x80\x82\xa4\xde\x5c\x9c\x66\x3d\xc2\xa5\x59\x70\x0c\xc4\xf7\x69\x5f\x69\xd7\xb3\xf2\x12\x13\x43\x4f\xed\x5d\x49\xde\xdf\x1f\x44\xdd\xa1\xa1\xe3\x38\x57\x36\x1b\x6c\x6a\x48\x6d\xae\x10\x10\x07\x60\xb1\x64\x15\x99\xbc\xc6\x06\x74\x0e\x8b\x19\xd8\x6f\xcd\xcf\xa9\x70\x7d\x55\xc7\x19\xb3\x6e\xf1\xa8\x2e\xaf\x45\xb3\xae\x82\xdc\x64\xda\x32\xe7\x7f\xc6\xc7\xa9\x58\x24\x63\xe9\x9f\xbe\x17\x1f\x9a\x4b\x80\x2e\xb3\x70\xd3\x33\x2f\x8c\x61\x85\xb4\x0c\x8b\xec\x7c\x25\x6a\x05\x73\x5b\x86\xb3\xaf\x4b\xd6\x4f\xc2\xb1\x6a\x2d\x63\xc3\xb6\xfb\xdc\xbe\x7c\x83\x16\x13\x94\xa9\x07\xdc\x17\x18\xae\x5f\x15\x49\x33\x69\xc8\xe6\x4e\x05\xbb\xdf\x25\x81\xc6\xa4\x18\x44\x31\xac\x41\x84\x3e\x60\x44\xcf\x40\x09\xb9\xbd\x34\x8d\x9e\x84\x1c\x0c\xfe\x62\x86\xb4\x39\x4a\x18\x61\x28\xa6\x7b\x4e\x71\xcb\xe0\x96\x77\x63\x9f\x7a\x15\x25\x97\xd5\x4c\x8d\x63\x56\x0c\x14\x0d\x03\xfe\x38\xbf\xca\xda\xab\x97\x64\xfb\xfc\x59\x80\x9a\x1a\x74\xe0\xed\x69\x05\xd9\xcb\xdd\x18\xe8\xe4\x72\x02\xab\x09\x71\x1f\x53\xb2\xfa\x68\x7f\x44\xbd\x64\xed\x5a\xfb\xf9\x34\x4c\xc8\x96\x8f\x19\x72\xec\xca\x64\xb2\x4b\x20\xad\x68\x42\x06\x21\x6e\x3b\x41\xb8\x69\x60\xcd\x10\x1c\x29\xfe\x26\x72\x5a\xd6\x37\x62\x87\x43\xd2\x4c\x28\xb5\x94\x81\xef\x95\x1c\x68\x2a\xd8\xe4\xd5\x5c\x1f\xda\x02\x12\xc0\xbd\x56\x70\xfe\x52\x6a\x6e\x76\x75\x85\xb0\x1a\xc3\xce\x14\x52\x95\xc8\xfc\xd2\x1d\x3d\xfa\xaf\xbb\x88\xae\xac\xca\xe6\x4a\x7a\xec\xa4\x4c\x27\x21\x9f\x5f\xd6\xea\xa6\xc3\x0a\x30\xa1\xea\xe9\xfa\xfa\x81\xeb\xb9\x62\xcc\x18\xb0\x1a\x38\xf7\x2b\x8f\x87\x33\x8f\x66\x9c\xc5\x2e\x2f\x4a\xa3\x20\xcb\x9b\xf9\x57\xaa\x1f\xd1\xe3\x48\x40\xf6\x29\xbf\xac\x18\x41\x87\xed\x9f\x72\xa0\xab\x8b\x67\x35\x73\xb2\x30\x5e\x0e\x69\x59\xb2\xa6\xa2\x0a\x50\xc0\x9a\x3a\x99\xed\x03\x8e\x37\x56\xe6\x4f\x0f\x1a\x0d\xdd\xfb\x88\x5f\x14\xd0\x4a\x55\x8b\xf1\xc6\x51\xb4\xf1\xaf\xde\xfc\xf0\x62\xf1\xdf\x2c\xa3\x6f\x7f\x6d\x7e\xa0\x36\xe3\x8f\x67\x0d\xc3\x3f\x6f\x68\xd3\x5e\xd0\x2b\x09\x0b\x61\x17\x43\xeb\xba\x2b\x00\xad\x5a\x89\x64\x21\x73\x9f\x78\xfe\x82\xa5\xc9\x37\x14\x0f\x75\x72\xf1\xe1\x1a\x14\x92\xbe\x10\x7a\x5e\xd6\xe6\xcb\x2c\x64\xa9\x4b\x70\xe9\x22\xcc\xb5\xd4\x0f\x31\x1a\xf8\xb9\x06\xb8\x87\x46\xf9\xe7\x0e\xf2\xfd\x23\xc1\x3c\x07\x10\x18\x7e\x70\x69\x7d\x25\x5b\x54\x22\x54\xf1\x15\xa7\x2b\x90\x95\x80\x89\x44\x07\x97\x69\x72\x48\xd5\xca\xa5\x82\xa3\xc0\xa2\x79\x5a\xb6\x1d\x38\xd1\x99\xc4\x43\xa2\xa0\xac\x6b\xcd\x39\x70\xea\x7f\xda\x6f\xbd\x74\xaa\x18\x52\x5c\xd1\x4f\x88\x66\x45\xf1\xf8\x6c\xa6\x73\xdc\x07\xcf\xa9\xfc\x18\xfc\xf0\x88\x34\x24\xc9\x48\xd4\x69\x4f\x4f\x8c\x81\x36\x34\x2e\x87\x0e\x63\x15\x73\x45\xa2\xd6\xf2\x77\x6c\xfd\xc0\x86\x1d\x33\xb3\xa6\xa4\x24\xcb\x31\xb5\x6c\x57\x95\xaf\x51\x49\x18\x65\xfe\x1c\xd1\xa9\x44\x6a\xa8\x5e\x3a\xd0\x66\x59\xab\x90\x16\x99\x2a\xf1\xc9\xd1\x2d\x9f\xe0\x8e\x6d\xd0\xff\xec\xa6\x6a\xde\x04\x83\x71\x2e\xd1\x79\x33\xa6\x79\xf5\xf4\x2a\x43\x44\x33\x39\x4f\xdd\xfc\x42\xfd\x21\xc3\x00\xe2\xcc\xa8\x27\xad\xa1\x5f\x61\x9e\x90\xdf\x28\xcc\x41\x9a\xa2\x95\xaf\x1e\xb0\x57\x99\x55\x6f\xfa\x2b\x39\x99\xe2\x7e\x25\x35\xcb\x2d\x7f\x6f\xb6\xd3\x66\xb3\xeb\x08\xd6\x26\xca\x5f\xe7\x85\x01\xf9\xf5\x6b\x0d\xd8\x52\xe8\x36\x8b\xbd\x7d\xe4\xdb\x50\x50\x9c\x6a\xa9\xc8\x86\x72\x2b\x63\x10\xb5\x67\x92\xe9\x0c\x47\x0d\xe5\xc6\x0c\x76\x5a\x0e\x58\xd3\x89\x8f\x27\x56\xc6\xb9\x6c\x5a\x95\x4a\x56\xd2\x26\x6e\xac\x5f\x5c\xa6\x8d\x0c\xe0\xe3\x37\xc7\xfc\x36\xb7\x86\xbb\xfe\x95\x99\x2c\x21\xe1\x87\x51\x88\xcc\x99\xad\x6f\xb1\x94\x65\xf3\x4e\xa5\x75\x9a\x00\x28\xe6\x25\x2b\x4b\xd3\xa4\x8f\x02\x5d\xf2\x9a\xf1\xc2\x48\x1d\x09\xe6\x15\x3c\x35\x7a\xeb\x10\x9e\x0e\xaf\x5a\x82\x18\x4b\xa3\x4b\x01\x65\x3f\xd3\x90\xec\x7a\x49\x21\x1a\x45\x0b\x2e\x9c\xd5\x0e\x5b\x7d\xda\x04\x10\xc4\x63\x44\xb4\x6b\x60\x18\x6a\x4c\x7f\xd7\x84\x69\xc3\x0a\xa7\x59\x8e\xc0\x28\x43\xc2\x39\x71\x9d\x74\xda\x61\x4d\x1b\xc7\x90\xec\x39\x8d\x97\x7d\x95\xb3\xfd\x42\x7c\xf3\xf1\x9d\x83\x5e\xd8\x8d\xe8\x48\xb8\x6e\xf7\x8f\xb8\xa8\x43\xc4\xbf\x56\xee\x8d\x5e\x47\xb1\x7b\x1b\xe2\xe2\x0b\x28\x64\x6a\xdd\xa3\xcb\x19\x31\xa9\x0b\xe4\xf3\xa3\xa1\x02\xd3\x0d\x1c\x15\x55\x75\x18\x27\xb7\xb1\x1a\xb9\x3c\x8b\x84\xfb\x70\xb9\x1c\x8d\x1f\x9e\x13\x27\xe0\x67\x26\x48\xab\xaa\xb3\x44\x56\x40\xdf\x5e\xb5\x8c\x83\x1c\x0c\xc2\x94\x82\x26\x67\x64\x1e\x66\x10\xce\xc8\xda\xb6\xb5\xe2\x5f\xf9\x3a\x6f\x50\xf8\x94\xd5\xa4\x6a\xe4\x60\xfb\x3e\xf2\x3d\x90\x99\xca\xbf\xf2\xe1\x14\x34\xfd\xa7\x4f\x42\x3a\x74\x5d\x2a\x30\x7d\xf4\x2f\xce\xea\xd3\xbf\x99\x9f\x6e\xff\xf6\x02\x07\x0c\xff\x23\xab\x9b\xae\x46\xdc\xce\x6f\xea\x1f\x97\xe2\xf4\xb8\x0f\xe8\x4d\x28\x9c\x9a\xad\xcb\x05\xa0\x2f\x81\x7a\xd4\xed\xcf\x11\xf0\x05\x48\xb7\x35\x20\xf0\xf5\x67\x3c\x30\x79\x0b\x24\x53\xac\xcf\x9a\xf1\xd4\xd1\x9b\x67\xd7\x53\xe4\xbe\xb7\x51\x8d\xd0\x1e\x74\x83\x5a\x3d\x87\x7b\xec\x2b\x9f\x29\xf0\xc1\x71\x0a\x7f\xba\xab\x6b\x78\xe5\xc9\x6c\x7c\x2f\xf0\xe1\xdb\xaf\xc7\xef\xfa\x94\xce\xb1\x65\x54\x40\x25\x4d\x25\x10\x4d\x89\x67\x12\x35\xd8\x29\x78\xce\x64\x14\xc6\xae\xa3\x4c\x5b\x02\x5d\x6d\x77\x11\xf2\x6f\x54\x3d\xfe\x81\x1f\x05\xe1\x3c\xef\x43\x81\x28\x71\x08\xd1\xc2\x5d\x79\xa9\x50\x1e\xd4\xb1\x00\x3d\xca\xb2\x2f\x96\x14\xfb\x05\x0e\xd9\x48\x4c\x8c\x54\x39\x6b\x20\x2e\x68\x0d\x6a\x7f\x50\x96\x81\xe7\x4a\x42\x15\xdb\x57\xc1\xd2\x29\x56\x7b\x1a\x68\x13\xcc\xda\x2f\x8e\x73\x6d\xa1\x56\xc4\x68\x48\xe4\x1d\x09\xa8\x4d\x81\xaa\x85\xa7\x00\xb5\xad\x58\x27\x76\x42\x6e\x4a\x32\xf6\x1d\x88\x36\xfd\x8b\xd0\x85\x79\xd9\xe2\xfd\x65\x21\xcf\x21\xf1\xcd\x55\xbf\x3b\xec\x20\x14\xa8\x74\x1a\x75\x2f\xdd\x5a\xab\x4e\xfc\xd2\x03\x8f\x61\x0f\x2b\x7f\xe1\x72\x0c\x7f\x67\xad\xe9\x07\x03\xd7\x9f\xe7\xa5\x5f\x75\xa7\x5f\xa6\x93\xdd\xe0\x2c\x75\x2d\x32\xf3\xaf\xaf\xd4\x02\x6f\x50\xf4\xd2\x48\x74\x7d\x2c\x74\xa3\x3f\xcc\x82\x15\xd8\x71\xca\x65\x26\x72\x58\xe4\xd1\xec\xfa\x63\x79\x92\xde\x22\x31\x70\xf1\x86\x5d\x45\xeb\xc4\x4f\x3e\x52\x1e\x13\x9c\x1a\xdc\x3e\x23\x97\x86\x13\x88\xc8\xad\xbe\xf8\x91\x26\xb4\x96\x59\xac\x21\x42\x17\x9d\x41\xab\x95\xda\xd4\x7d\x74\x8b\x7e\x85\x83\xa8\x6a\xd0\xb9\x5a\x41\xd1\xb5\x15\xbc\xcf\xa2\xdf\xfb\x41\xd2\xad\x35\xd7\x5a\xf7\x7c\x9d\x45\x5c\xa2\xb8\x33\xa7\xed\x16\xf3\x81\xeb\xb9\x52\xa4\x5f\x58\x2d\x7a\x20\x8b\x50\x00\x73\x84\xd8\x77\xda\x41\x96\x78\xa2\x52\x68\xf2\xd2\x89\x3e\x68\x70\x3b\xab\x74\x87\xe7\x14\x49\x1e\x04\xd5\xf9\x0e\x25\xb4\xb1\x2b\x09\xe3\xd2\x0b\xd3\xd6\xa1\x5a\xbf\x42\xc6\x7d\xd2\xc3\x56\x3c\x9f\xe2\xc9\xc5\x28\x67\xe6\x9c\xbb\x88\xa4\x9d\x15\xa4\xe3\x2c\xb1\xbf\x09\xc9\x3e\xb9\xdb\xf8\x6c\x37\x17\xdb\x47\xc8\x18\x98\xd8\xbe\xc4\x9d\xb3\x89\x60\x33\xd1\xf8\x5d\x3e\x87\x0d\x9a\xb5\xc3\x77\x38\xf3\xf2\x2b\xc3\x41\xa0\x8d\x0c\xad\xf1\x9b\x0b\x0b\x29\x56\x75\x03\x20\x87\xb7\x00\xf1\xeb\xc8\xbc\x77\x28\x3d\xf0\x69\x92\xb8\x42\x80\xc9\x41\xe2\x71\x00\xb0\x12\x8c\x8b\x0c\x73\x22\xc5\xdf\x69\xe2\x38\xc2\x8c\x05\x65\xc9\xda\x9c\xd1\x1d\x54\xe0\x7e\x71\x2f\xf3\xb1\x9c\xc1\x3d\x70\xec\xd9\xbf\x74\x2d\x76\x5a\xff\xcd\xde\xda\x9a\xff\xbe\x2d\x12\xd6\x5e\xd0\x47\x45\xb2\x65\xea\xeb\xef\xb7\x51\xde\xec\xa0\x54\x24\x7a\x62\x19\x1e\x91\xa1\x68\x1f\x6b\x27\x8e\x73\x4e\xc9\x0d\xa5\xc2\xe0\x48\x47\xdd\xc2\xad\xaf\xd4\x3e\xb5\x82\x6f\xc9\xf7\x07\x22\xfd\xca\x79\x28\x72\x5d\xdb\xd6\x4a\x08\x51\x55\x61\x1c\x59\x26\x71\xbb\x56\xf9\x3a\x44\x41\x98\xc0\x8e\xaa\x54\x38\x92\x75\xe0\xa2\xbf\x57\x95\xad\x7f\x36\x42\xec\xa2\x06\x41\xae\xdf\x9d\xbf\xe2\x51\x9e\x57\x92\x21\xb5\x6a\xe0\xe3\x7e\x58\xa2\x65\x4a\xa9\xc5\xe9\x28\x09\x1d\x1d\x67\x2e\x1f\xbc\xc8\xbe\x0d\x66\xc4\x18\x5c\x53\xab\xb0\x01\xf7\x56\xea\xc4\x48\x9c\xa3\x68\x2c\x73\x79\x1c\xd3\x97\x89\x05\xf2\x08\x1a\x58\xf7\xe8\x53\x74\x7d\xdd\xd6\xd0\xbe\xc0\x0f\x0f\x7c\x62\x0f\x08\x6f\xc7\xda\xdd\x59\x16\xc2\x87\xaa\xae\x5e\xfc\xb6\xa3\x43\x2c\x40\x74\x1d\xfe\xb0\x3c\xe2\xd3\x95\xa1\xc9\x19\x8f\xf7\x33\x5d\xac\x47\xb9\x0d\x54\x3b\x79\x97\xa8\xd1\x6b\xc2\x88\x97\x7e\x3f\xf0\x34\x4a\xd1\x7a\x45\xbe\xfb\x4b\x45\x19\xaf\xbe\x6f\xfe\x33\x00\xc0\x75\x0c\x47\x4d\xbf\x5b\xaa\xd1\x50\x26\x43\xbc\xb9\x4c\x18\x20\x6d\x43\x21\xe2\x6b\x5b\x7b\x79\xca\x89\xa7\xc5\x23\x5a\x43\x9f\xb5\x18\x74\x82\xaf\x73\x0f\xb0\xc9\x37\x3b\xb6\x4a\x65\xaa\x91\x13\x12\x62\xf6\xbc\x53\x0c\x57\x86\x92\x0d\x11\xb5\x28\x20\xe1\x9d\xa6\xe8\xf2\xf5\xdc\x6c\x49\xaf\x6c\x27\xa6\x45\x17\x41\x3a\xe0\x9f\x6a\x48\x85\x7b\xa3\x11\x9d\x66\x26\x1b\x13\x12\xd6\xe0\x06\x93\x4b\x27\x94\x29\x77\x7e\x46\xe0\xce\xea\xc0\xb7\x4f\xe3\x35\x37\xd3\x17\x1a\x0d\x5a\xeb\x75\xbe\xfa\xfe\xe4\xd2\xc9\xc7\xeb\x14\x9d\x20\xe5\xed\x71\x00\x35\x8a\x1b\x99\x76\x70\xe5\x4c\xb7\x74\x5a\x1b\x0c\xcc\x7c\x89\x72\x7a\xe7\x26\x3d\x87\xac\xa3\x11\x63\x13\x53\x4c\xa3\xaf\xbe\x43\x88\x09\xa3\xe5\xbd\x0b\x1c\x39\xa6\x2c\x3f\xce\xea\xc8\x19\x95\xab\x9a\x19\xa5\x3e\xd4\xb2\xc2\xe3\x0c\x6d\xa9\x32\x3e\x3f\x4a\xd6\x71\xaf\x4d\x26\x66\xfc\x94\xf5\x08\x13\x10\x44\x8a\xbf\x92\xc3\xfd\xac\x96\x09\x08\xc4\xa0\x52\x26\x12\xf8\xa3\x66\x64\xcf\xb6\x24\x22\x26\x34\x1d\xda\xb9\x7a\xf5\x02\x18\xf9\xfd\xd2\x58\xec\xfa\x6c\xc6\xb8\x93\x9d\x73\x77\x84\x1f\x7d\xfb\xed\x01\x51\xbd\x16\x5d\x3e\xdf\x4b\x67\xc7\x73\x10\xb9\xd7\x57\x95\x9f\x9c\x8c\x3f\x37\xbc\x13\xab\xc2\xca\xe8\xd6\x62\xf8\x71\x4f\x50\x5d\x5f\x38\xa5\xa0\xe5\x9c\xd9\xed\x25\x5d\x22\xfc\x12\x2d\xc4\x16\x14\xba\xe9\x20\x48\x26\x4d\x36\x6d\x15\x8f\x65\xa8\x19\xf6\x6c\x66\x68\x51\x32\xe4\x15\x7e\x6f\x31\x78\xeb\xc5\xf3\x47\x17\x9f\xcf\x0a\xfb\x46\x85\xb4\xf9\x7c\x8e\xde\x10\x4d\xc2\x25\x49\x58\xad\xca\xf5\xd5\xea\xa8\xf4\xf8\x96\x6a\xa2\xb5\x65\x83\xb8\xc8\xa9\xda\x1e\xf5\x3c\x5a\x01\xc5\x85\x6a\xd2\x5c\xc1\xab\x16\x17\xcf\x26\x7d\xf7\x9a\x2d\x21\x81\x6e\xa1\x5d\xc8\xbe\x73\x3a\x89\x11\x6e\xf1\x61\xe2\xb2\x60\x43\xf4\x72\xec\x37\x29\x4c\x72\xd7\x5e\x78\x1b\xa3\x80\xc7\x6b\x55\x56\x00\xad\x1b\xff\x01\x21\x27\xa2\x99\x55\x91\x4c\x6f\xdb\xc1\xf7\xf9\xfe\xab\x6d\xc9\xfd\xef\xc5\x3d\x9d\xff\x17\xf7\xb4\xdb\xc1\xf7\x7f\x04\x87\xf9\xb8\x9e\xd0\x97\x8a\x8a\x78\x73\x68\x09\x22\x3a\xd1\x1f\x83\xf9\x2e\xd6\x15\xf7\x4a\x1a\x47\xe7\xbe\xa3\xdc\x04\x75\x54\x1d\x7e\x0f\x7f\xb3\x77\x6e\x2a\x37\x54\x91\xa3\xd8\xf5\x9d\xf8\xda\x6c\x69\x55\x66\xe4\xe2\x15\x46\x2a\xc3\xbe\x2e\xf2\x44\xab\x78\x36\x7c\xd4\x5f\x9f\x03\xaf\x2b\x79\x45\x07\xfc\x79\x53\x01\xc2\x0e\x48\x9a\x20\xfe\x79\x4b\x1d\x8c\x80\xe0\x61\x22\xe0\x63\x58\xb1\x80\xed\x1c\x21\x06\xfa\x87\xca\xc1\xef\x58\x16\x7a\x4f\xb4\xde\x6a\x32\x42\x1c\x46\xa7\xa4\xe5\x2a\x64\x74\x93\xc5\x2b\xf4\x24\x29\xee\x7e\xb7\x8f\x4a\x91\x19\x4f\x91\x06\x94\x7c\x35\xd0\xd3\xcb\x05\x3b\x50\x84\x6b\xe0\xe2\x74\x2c\x7b\xa3\x32\x67\x2d\xfa\x5f\xaa\xc5\xd0\x22\x1f\x94\xf1\x82\x23\x5a\x4c\xff\xda\xcd\xe1\x0b\xb3\x3b\x1c\xf3\x89\xb2\x13\xc5\x21\xff\x3c\x79\xc4\x92\x39\xf7\x23\xfb\x49\xef\x03\x24\x53\xd4\x31\xa3\xe9\xe4\xd9\x0d\xcc\xfa\x1d\x33\x74\x94\xcf\x30\xf7\xee\x65\xa0\xb2\x52\xf8\x37\x8f\x3c\x20\x00\xbb\xf5\xe5\xd6\x41\xea\xce\x56\xd5\xd6\x54\x71\xcb\x0e\xcb\x15\xa3\xa6\x8a\xeb\xf7\xcd\x4d\xbd\x12\xaf\xcf\xcf\xb2\x6d\x61\x96\x30\xbf\xf9\xc5\xa2\x73\x40\xd1\x27\xb5\x52\xe5\x3b\x2d\x31\xf4\x2b\x77\x39\xc8\xcb\x99\xfb\xff\xdb\x7b\xaf\x5d\xe9\xb1\x2b\x4d\xf0\xbe\x9e\xe2\x47\x36\x30\x37\x84\x44\x1b\x34\x55\x2a\x0d\xe8\xbd\xf7\xbc\x19\xd0\x05\x7d\x90\x41\x32\xe8\x04\xbd\xfb\xf0\xfc\x99\xca\x4c\xcd\xa8\x5a\x6a\x74\x0e\x90\x03\xd4\xc5\x89\xc3\xd8\xdc\x66\x71\xd9\x6f\x31\xb6\x69\x76\xa2\x8f\x6f\x69\x05\xf8\xd1\x88\x1d\xc5\xd9\x9c\x76\xa9\x8f\x8c\x3f\x05\x4c\x6a\xa8\x40\xd3\x97\x31\x96\x09\x05\xe7\x97\x87\xd5\x96\x52\xfb\x32\xfb\xc7\x27\x7b\x4b\x69\xaa\x88\x0c\xdf\x5d\xd9\x31\xf5\x36\xad\xca\x15\x37\x30\x52\xd9\x71\x5e\x25\x03\xef\x1b\x80\xab\xda\x66\xd0\x53\x35\xf0\xd5\xf9\xfe\x68\x0b\xcc\x8e\xc2\x0b\x89\xb1\x5c\x2e\x35\x65\xea\xda\x91\xd5\xba\xf0\xe5\xbb\xb2\x80\x2a\x87\xec\xca\xfe\xc2\xb6\xf4\x78\xd4\xa1\xa1\x68\x8c\xac\x2c\x68\x5c\x46\x2d\x08\x91\x63\x9c\x74\xa6\x1a\xe3\x0f\xa9\xef\x79\x30\xeb\xf4\x21\x7e\x40\x37\x06\x2a\x30\x48\xf2\xdf\x88\xe2\xba\x67\x88\x37\x81\x35\x68\x4d\xc4\x83\x89\x1e\xdb\xac\x0f\xd4\x91\x8a\xd6\xf0\x3a\xbe\x0b\xc3\x80\x16\xde\xcc\x4e\x75\x7d\xaf\x9c\x8d\x77\x11\xb7\xe7\x69\xc1\xb9\x88\xc7\xcc\xe7\x84\xbd\x22\x44\x3a\xa2\x76\x51\x54\x3c\xe3\x5e\x89\x4c\x27\xe5\x80\x7b\xd3\x23\xd6\xf5\xa1\xc9\x5f\x0f\x93\xc5\xfa\x6b\x35\xc2\x75\x63\xa8\xbc\xba\xb9\x4f\x7f\x1d\xb5\xaa\x6a\x48\x2b\x81\x1e\x2a\x56\x4c\x1e\x73\x11\xcf\xe3\x43\xce\xe9\xb8\xdb\xa7\x0a\x2d\xea\x61\xd7\x6c\x1a\xc3\x3e\xb0\xa4\x7a\x35\x49\xca\x49\xae\x8c\xcd\x86\xfb\x7e\x11\xb7\xaf\xde\xaa\x4e\x54\xac\x3d\x89\x9e\x4f\xb7\x64\xdb\x0e\x69\xfc\xf0\xa2\x80\x97\xeb\xd9\x06\x59\xe2\xe5\x93\xfc\x5a\x02\xda\xfb\x0d\xf8\x1e\x1b\x33\x47\x7f\x6b\x3f\xf0\xfe\x9d\x25\x1e\xaf\x5f\xf9\x01\x72\xfd\x40\x10\xed\x5b\xfe\x63\x99\x30\xd8\xe8\x2e\xe7\x98\x9e\x6e\xf2\x6e\xa0\x40\x96\xed\x37\xcf\x2b\x7e\x12\x47\x3c\xbb\x65\x3b\x1b\x1e\xf1\xf2\x61\x38\x80\x66\x7c\x61\xe6\x66\xf6\x15\x32\xb7\x61\x16\x72\xd8\x54\x8c\x3b\xe3\xd3\xdb\x51\xe0\x32\xab\x8f\x99\xa7\x74\x22\x4d\x06\x77\xb6\xb1\xf1\x01\x3d\x9e\x92\xfe\x8a\x2c\xcb\x0a\x0b\xb1\x49\x65\x39\x9e\x0f\x92\x12\xd5\xc9\xe0\x8a\x63\x88\x3a\x1f\x4d\xf9\x91\x5d\x3c\xe0\x22\x43\xd8\x1d\x10\x18\x68\xd9\x49\xc9\x57\xdb\xdb\xd2\x72\x6e\x01\x2f\x22\xc2\xec\xa5\x00\x50\x3e\x3d\x3f\x11\x39\xd8\xeb\x8b\x14\x2b\x05\xa3\x93\x55\x16\x62\x44\xba\xd0\xe7\xc8\x05\x4e\x5e\x9d\x2b\x90\x3b\x52\xee\x42\x32\xed\xd4\x00\x07\x92\x22\x64\x4d\x43\xd0\x10\xcb\x50\x40\x85\xb6\x00\xb4\xdc\xee\x5e\xc0\x37\xb4\xc1\xc7\xb1\xbd\xb3\x52\xcf\x27\xa7\x52\xc9\xb6\xfa\x1c\x23\x12\xab\xa8\x8f\x95\x40\xb9\x94\x60\x71\x38\xf0\xd4\xeb\x73\x47\x0c\x68\xa7\x19\xd6\x75\xe5\x41\x5f\x42\xbe\x7a\xbb\x9f\xf1\x4d\x83\x28\x05\x2e\x7b\x22\x78\xa1\x76\x12\x9b\x3d\x12\x05\xf7\x7a\x5a\x5b\x18\xda\x29\x29\x7e\xcc\x00\x95\x63\x4d\x89\x86\xf3\xe2\x2e\x11\x01\xd9\x3a\x41\xa7\x3d\x93\x0a\x1b\xc1\x09\x46\x55\xc6\xf8\xd3\x12\xae\x80\xe8\x76\xdd\x08\xa4\xf6\xe1\x54\x50\x7c\x42\x9c\xea\x31\xaa\x6c\x5c\xb0\x9c\x79\x19\xaf\x5f\x3e\x4c\x1b\x5c\x48\x68\xf4\x93\xbf\xc9\x93\x2b\x66\x61\x84\x9e\x3e\x70\x2d\x36\x2f\x5c\xa7\xf3\x6d\xe4\xd8\x95\x0e\x44\xd1\x52\x7b\xba\x91\x3f\xa0\xf0\x78\x08\x28\x2a\x70\x34\x1e\x52\x28\x6f\xd2\xa6\x9e\xea\x66\x4f\x64\x8d\x6a\xed\xce\xca\x91\xc2\x58\xae\xed\x63\x89\xad\xa7\xc7\xad\x47\xdb\xc3\x4f\xd4\xcf\xc6\x47\xdb\xd9\x4d\x69\x63\x34\xeb\xfa\x1c\xd2\xf0\x58\xc4\x4d\x74\x91\xf9\x4d\x48\x71\x84\xbf\x34\x06\x15\x1a\x5d\x52\x1d\x69\xbb\x67\x05\xa4\x89\xd7\x8a\x76\x25\xad\x58\x7e\x0e\x1b\x0f\x37\x28\x79\xc3\x83\xe8\x11\x61\xd4\x00\xb7\xaf\xbd\x4f\x4a\x87\x71\x55\x3a\xae\x4b\x34\x8d\xa5\x3c\x09\x5f\x44\x0f\xf3\xcf\xf6\xb4\xce\x29\x57\x29\xcd\x16\x53\xf2\xcd\x6e\x21\xd5\x52\x80\xbe\xa5\xe9\x62\x9b\x52\xea\xd4\x62\x58\xd4\x8b\xa1\x39\x64\xbd\x8f\x45\x1c\x3e\x71\x06\x5b\xe3\x96\x6b\x5d\x73\xdc\x9c\xf7\x1b\x45\x66\x37\x7e\x83\xfd\x79\x63\x32\x9a\x20\x46\x38\x40\x78\xa7\xa1\xd7\x2b\x7e\x74\x9d\x3f\xcf\x8b\x04\xa0\xb6\x80\xa8\x31\x49\xd0\xb3\xb7\xda\x1f\x1b\x7f\xd1\x4f\xec\xc0\x05\x36\x80\x39\x08\x82\x81\x2d\x2e\xe7\x85\xd1\x91\x8d\xc6\x4e\xd8\x19\x10\x8e\x00\x9d\x34\x07\xbd\x44\xde\xb1\x02\xc4\xf5\x39\x17\x69\xeb\x49\xd5\xab\x01\x0d\x8d\x52\x13\xda\xf9\x19\xcf\x6d\x26\x67\xbb\xb4\xa8\x26\x50\xf2\xd9\x55\x2b\x05\xf0\x66\x21\x3f\xe3\x32\x8b\x3c\x0b\x74\x84\x8a\x16\xec\x31\x1b\x64\x93\x39\xb2\xe5\x37\x4d\x5a\xe6\x74\xfe\x9d\x39\x8c\xe9\xd7\xc0\xe1\xa7\xb5\x5c\x38\x69\x56\x67\x9f\x44\x0d\x54\x27\x34\x6b\xfb\xb0\xc6\xab\x2a\xab\xe7\xe9\x4a\xb4\x9e\xf3\xb5\xb5\xc4\xb1\xd8\xeb\x86\xf1\x20\xa6\x13\x98\x05\x37\x73\xe3\xa7\x06\xbb\xc3\x42\x75\x4c\x2d\xab\xd8\xaa\x4a\xfb\x38\x04\x0c\xe4\xa0\xbc\xe6\xc1\xed\x7b\x2c\x62\xef\x24\x1f\xa0\x1e\xd4\xd7\x3a\x2e\x92\x14\xcd\x3c\x27\xd3\x37\x55\x6e\x6c\x6d\x4a\x3d\xd8\x6f\xfa\x8d\x39\xb7\x30\x02\x2f\x0c\x60\x76\xaf\xcf\xb6\x9d\xc8\xa2\xa2\x22\x33\xa2\xc6\x35\x1e\xae\xc4\x10\x30\x49\x92\x75\xf4\x15\x8b\xbc\xde\xc1\xc1\x39\x3e\xf2\xf2\x11\x6d\x68\xdb\x4b\x12\x4c\x3d\xad\x8e\xf9\x00\x55\x78\x3e\x86\x3e\x17\x32\x34\x7c\x81\x9f\xa1\x54\x4d\x5e\x57\x84\x07\x4b\x1c\xac\x90\xad\x34\x55\x38\x51\x2b\x8c\xb1\x2a\x36\x9e\x43\xf7\x96\xe8\x07\x70\xaa\xfa\x94\x89\x06\xbb\x61\x13\x18\x4d\x7e\xed\xac\x93\x1c\x6f\xfa\x43\xd7\x8c\x25\xeb\x42\x3c\xd9\x01\x1d\x30\x18\x6b\xb9\x49\xcb\x5f\x1b\xbc\x97\xd2\x63\xcd\x38\xdb\x00\x14\x1b\xa1\xb3\x0f\x46\x92\xc3\xeb\x92\xb3\x9b\x97\xe8\x08\x06\x2a\x62\xed\x9c\x32\x12\xf3\x43\x38\x89\xc4\xf9\x6c\x0d\x66\x09\x2e\x0e\xbc\xe5\xa8\x08\x62\x8e\x54\x2a\x9c\xf1\xf3\x8f\xc9\x91\xcc\x78\xb9\x5a\xef\x84\x67\x93\x57\x81\xda\x79\xba\x1d\xee\x7e\x2f\x08\x90\x39\x96\x0f\x41\xb6\xba\x07\xc1\xdc\xf0\x09\x72\x9b\x73\x3f\x5b\x8e\xcc\x58\xee\x94\x16\xb3\x2b\xcb\xd2\x41\x5d\xff\xe0\x3b\xc7\x1c\x7c\x9c\x79\xda\xb1\xa0\xe8\xd2\xb3\x0e\xa3\x80\x2c\x9f\xb7\xd9\x64\x30\x1a\x58\xba\xb7\xcc\xb9\x67\xbb\xd7\x91\x80\xc0\x0b\x12\x03\x1a\xe7\xc5\xcc\x15\x8b\x4a\xa4\xb6\x11\x7e\x3c\x2b\xe4\x25\x54\x99\x49\x76\x04\xe4\x3a\x45\x01\x0c\x69\xcd\x79\x24\xbc\xf9\x87\x97\x38\xbd\xc2\x38\xfa\xd2\xbe\x49\x8a\x85\xf8\x88\xdd\xdc\x27\xe1\xc9\x4c\x02\xa3\x78\x9c\xf8\x0a\xdf\xf0\x84\x56\x29\x48\x61\x40\x30\x93\x7c\x78\xa8\xe8\xe4\xfa\xe0\x09\x35\xb7\x3d\x15\xb6\xfd\x16\xc8\x77\x9a\x3f\x67\x2b\x01\xbe\xa6\x25\x39\xe3\x99\xd6\xc0\xa0\x9d\x48\xb3\x64\x21\xb3\x97\xbc\xab\xf0\xaf\x3b\x73\x00\x60\x47\xa7\xfc\x8c\x12\x6d\x33\xec\x68\xa6\x22\xa5\xd6\x99\x91\x82\x8a\x6b\xa8\xf6\x7a\xa6\x77\x96\xe7\x0b\xe0\xb0\xad\x82\x98\xcd\x67\xa9\x39\x79\xf3\xac\xaf\x7c\x3a\xc2\xbb\x66\xf8\x4a\x6b\x36\xee\x19\x5c\x52\x68\x87\xb7\xf6\x67\x96\xe9\xcc\xa5\x31\xd7\x92\xf9\x6a\x20\x43\x6f\xa6\xb2\xbb\x1c\x70\x8f\x2a\xf4\xe3\x54\xa6\xdf\xe6\xa4\xee\x6a\xa4\xe0\x59\x25\xf6\x69\xca\x05\x60\xbc\x49\xa9\x60\xcc\x2b\xb7\xa8\xde\x33\x70\x67\xa5\x7c\xcb\x15\x98\x39\xc6\xde\xe6\xbd\x20\xd3\x37\xea\x43\x97\xf4\xcc\xea\x0f\xe9\xb2\x58\xf0\xb5\x65\x7b\xcd\x44\x91\xac\x8e\x79\xec\x44\x1c\x65\x5f\x4c\x83\x45\x55\x5e\x0f\x5d\x41\x01\x1a\xc0\x7a\x34\xad\xbf\xf7\x13\x48\xae\xfe\xc3\x54\xbf\xed\x2b\xce\x39\xfb\x9d\x2d\xe3\xfa\xdb\xcb\x8e\x37\xff\x3d\xe1\xe8\xbf\x96\x71\xa5\xd5\xe3\x89\x9c\x4a\x1d\x69\xfb\x21\xaf\x50\xd7\xbc\x97\x80\x8f\x3e\xad\x05\xd0\x14\xf2\xc8\x3f\xb0\x95\x97\x68\xb8\x46\xc1\x1a\x7f\x14\x56\x20\xd4\xd5\x8b\x21\x63\x0e\x6c\x79\x72\x02\xb5\x8c\xbc\x4e\xcf\x4d\x4a\xb8\xfd\x44\x12\x8c\xa3\xa2\x3c\xba\x7c\xc8\x86\xa7\x59\x12\x28\xfa\x34\xe1\x38\xe3\xe4\xd0\x25\x01\xb0\x82\x8b\x33\x0f\x6e\xf0\x00\x1e\xa7\x89\x6e\xc5\xf2\x09\xd4\xf7\xf6\xe2\x09\x29\x41\x50\x88\x20\xd1\xb9\x3f\x47\x22\x0b\x98\x26\x77\xc5\xf3\xd9\x2e\xc1\x3e\x5e\xe3\x10\x64\xb2\x55\x82\x39\x20\x0c\x8b\x25\x3d\x47\x47\x55\x0a\x58\xf2\x88\x3a\x92\x98\xa4\x0d\x32\x1a\x4b\x69\x18\x33\x5e\x1c\x95\xb1\x48\xe0\x90\xd5\xfe\x39\xac\xe6\xe1\x1e\x28\xc5\x4e\x80\x0e\x45\xbe\x05\xa5\x75\x6d\xc1\xad\x56\x87\x84\x24\x70\xd8\x75\x95\x68\x46\xa1\xb2\xb6\x46\xf1\x79\x06\x6a\xef\x90\xe0\xa3\x16\xb2\xd7\x66\x52\xd5\xda\xcf\xa2\xdb\x9a\x02\x60\x4e\x51\x17\x6f\x4d\x78\x8e\xe4\xc0\xba\x98\x83\x67\x6f\x13\x9b\x48\x7c\x1b\xd0\x0d\x7b\xb2\x18\x37\x5b\xcf\x1d\xfb\xbc\x5a\x38\x32\x70\x65\x63\x52\x7b\x6d\xd9\xfa\xf2\x23\x15\x43\x97\xc0\xc7\x76\xe3\x4e\xba\x31\xd4\xdb\x40\x86\x40\xcb\xc2\xde\xbd\xe1\x29\xd7\x74\xcb\x06\x1a\xee\x17\x16\xdd\xc2\x72\x20\xb8\x6a\xcd\x16\x74\x4e\xcb\xd4\xfc\x6a\x99\x86\x6e\x57\x26\xe6\x3f\xa8\xc8\x97\x77\x32\xad\x2a\x50\x1c\x39\x38\xb9\x04\xf2\xa7\xbd\xe4\xfa\xda\x63\x92\xe3\x19\xe9\xc6\x82\x9a\xa3\x76\x4d\x6e\x73\xcf\x8d\x66\x8e\xb4\xb1\xbd\x1c\x8b\x41\x16\x7f\x4c\xac\x19\xed\x79\x9b\x0f\xfd\xa6\x54\xd2\xda\xef\x0d\x56\x4e\x71\xf3\x6c\xec\xb0\x1a\x46\x2d\xd9\xad\x10\x97\x6c\x11\xba\xfd\x40\x6e\x56\xaa\x09\xf9\x55\x4f\xab\xa6\xb7\x82\x3c\x00\x51\xcf\x9d\xbe\x81\xb8\x4f\xd8\x34\x4f\x1d\xbe\x8a\xb6\xd9\x36\x0e\x4b\xb3\x4c\x49\x20\xe9\x01\xcf\xb9\x49\x21\x8d\xd4\xcc\x26\x20\x16\xc8\xba\x4a\x7f\xa0\x48\x6c\x27\x9f\x28\x10\x97\xac\x3b\x68\x62\x79\x4d\xe2\xaf\xb9\x55\x5f\xac\x6d\xc6\xca\xc8\x01\x77\x92\x0f\x3b\x67\xb3\xfb\xc3\x43\x4b\x75\x6e\xa4\x0c\x61\x45\xf2\x37\xa7\x6d\x31\x36\xda\xc2\x04\xda\xd3\xd3\x03\xf5\x79\x90\x1f\x42\x17\x96\xd9\x0a\x18\x53\xb8\x8e\x50\x8b\xd8\x83\xdd\x99\x6d\x42\xd5\xd3\x5e\xbf\xdb\x28\xb9\x74\x10\x20\x34\x1f\xc8\x73\xa2\x77\xe6\x36\xaa\x49\xb0\x0f\x6e\x36\x3c\xc6\x4d\x0b\xa3\xaa\x37\x97\xc6\x3c\x8a\x95\x04\xbf\x26\x22\x5c\x1b\xf9\xce\x86\x0f\xbe\x55\xfc\x6f\x6c\xcf\xeb\xef\x6c\xa6\x52\xf9\xf8\xc5\xa0\xbf\xf6\x9c\xfa\x7a\x7b\xf9\x4a\x86\xe2\x21\xb8\x5b\xa5\xc5\xc2\x67\x0f\x57\x8a\xaf\xa4\x93\x4f\xc7\x03\xff\x7a\x41\x59\x6e\xd2\xb6\x2b\x60\xed\x59\xd2\x06\x1b\xa0\x5d\x25\x88\xc1\x62\x15\xda\xf9\xb4\xad\x0a\xaa\x58\xb7\xb7\x55\x3a\xf3\x96\xbd\x9c\xfe\x55\xaf\x08\x8e\x08\xd2\xf3\x04\x4e\x92\x24\x08\xcb\xd2\xc9\x8b\x80\x27\x96\x55\x6b\x0c\xfc\x12\xd7\xac\x3c\x23\xfe\x42\xf0\xa0\x7d\x22\xc3\x6b\x31\x1e\xb3\xa5\xaa\x61\x16\x4c\x07\x01\x94\xea\x23\xfa\x20\xdd\xa9\xaf\x86\x57\x3c\x0a\x52\x2f\xea\x46\xa1\x18\xd3\x24\xd2\x55\x90\xa9\xb4\x3d\x61\xff\xa4\xdf\xae\x25\x3b\xba\x83\x27\xc3\x68\x94\xb5\x21\xe9\x3a\x43\x3c\x23\xd0\x0f\x37\x5e\x15\xf4\x2c\x56\x79\x92\x7b\xda\x2f\xba\xde\x9d\x61\x50\xba\xec\xc0\xef\x1c\xf3\x01\x84\x83\x8a\xf0\xdb\x67\x42\x72\xea\xf4\x3b\xe4\xf5\x52\x69\x28\x0a\x72\xb7\x11\x23\xba\x84\xbb\x47\x56\xde\x00\x07\xc0\x1c\x81\x8d\xde\x2f\xeb\xdc\x19\x90\x56\x83\x4b\x45\x31\xa7\x95\x8c\xb8\xbf\x63\x37\x2b\x3d\xa0\x9d\xe0\x19\x66\xac\x32\x2f\x05\xe9\x40\x7f\xb8\x15\x53\xbc\xa0\x2a\x47\x68\x9d\x6b\xad\x25\x13\xac\x0b\x39\xce\x92\x4a\x97\xbd\x74\xf1\x17\x60\x78\x99\x48\x32\x2c\xfb\xf4\x57\xad\x7d\x3e\x91\x70\x0c\x5e\xc1\x44\x4b\xf8\x85\xd7\xba\xbf\x2a\x3c\xca\x9f\x5d\xfc\x9c\x04\x6c\xf3\x1b\x0d\xb5\x0d\x97\x10\xf4\xd2\xa5\x6d\x87\x91\x42\x74\x86\xa5\x37\x05\x33\x40\x30\x32\xb6\xd3\x36\x18\xc0\x1f\xc2\xb2\x5d\xb8\x74\x11\xed\x40\xc4\xfb\xc3\x94\x28\x84\xce\x15\x35\xa4\x44\xcc\x1d\xa5\xfa\xaa\x4f\xb0\x8f\x32\x86\x22\xed\x61\x40\x46\x36\x32\x1e\x61\xba\x2f\x01\xe2\xd1\x2c\x3f\x2a\xd1\x7b\xf7\x54\x73\xc9\x50\x66\x75\x90\x4f\x3e\x24\x07\x6d\x4c\x16\x43\x2a\x7e\x7d\x5a\x2f\x29\xe4\x52\x6e\x7a\xde\x09\x60\x22\xd0\xda\xa5\x28\x98\xae\x9a\x0f\x9d\xbf\x01\xa0\xe7\x46\x0c\x11\x80\xd9\x4c\x36\xb6\xa6\x5f\x40\xda\x0f\x4e\x12\xde\xc6\x3e\x3e\xde\xd4\xdb\xe9\x8f\xbd\xdc\xd9\xaa\x8c\xb2\x12\x7d\xcf\x81\xf9\x48\x3a\x9f\x03\xa9\x56\x11\x1d\xcd\x4a\xcd\x01\x67\xcd\x86\x42\x3c\x1f\xe6\xb2\x23\x2e\xa9\xcf\xe0\x70\x10\x37\xcf\xab\x62\x02\xd1\x2e\xeb\x34\x13\x4e\xd4\xcb\x05\x84\x2a\x1d\x7f\x53\x14\xbe\xa4\xcb\xef\x6c\x26\xfc\xa9\x4c\x81\x50\x43\x21\x0f\xeb\xe6\x60\x6c\x99\xfb\xa8\x7f\xac\xff\xc0\xb2\xe8\xc7\xd9\xc7\x34\xb7\x83\xa5\xc4\xd4\x31\xb2\xf6\x05\xcb\x34\x49\x58\x4c\x59\x0b\x35\x04\xf1\x01\xe5\x86\x99\x12\x0e\x6a\x82\x2b\x30\x74\x1e\xde\x6d\x24\x18\x53\xbf\xc6\xf3\x21\xf0\xca\xee\xe1\xc7\xe8\x34\xc7\x57\xdf\xc9\x2d\x09\xc8\xdc\x81\x99\xb7\x3d\xe6\x22\xdc\x17\x22\x5f\x95\x22\xbc\x64\x2f\x1d\x2f\xef\xf6\x37\x4d\x5b\x7c\x83\xca\xaf\xef\x59\x18\x40\xb1\x4b\x36\xb2\x54\xe1\x77\x9d\xbd\x10\x17\x4a\xee\x84\x2e\x43\x94\x5e\x16\x6a\xc3\x67\x19\x2e\x43\xef\x6b\xce\xff\xe8\x27\xdc\xea\x1c\x7f\xc8\x5c\x8c\x68\x2d\x0f\x19\x5e\x8c\xe8\xee\x5e\xe9\x2d\x7d\xe8\x0d\xb9\x7f\xfd\x19\x0d\x74\x18\xdc\x08\x1b\xed\x78\x1a\x27\x5d\xc9\xec\x4f\x7f\x2d\x56\x59\x92\xd2\x25\xed\xe4\x3a\x7c\xfc\x33\x3d\xf9\xe0\x0c\x96\xab\x8c\x85\xe4\xec\x66\x43\x6e\x05\x5a\xa0\xda\x2b\xbf\xb4\x81\x3a\x93\x93\x3c\x4c\xaf\x7b\x68\x17\x7d\x6a\x97\x7c\x6a\xd1\xdd\xbe\x81\xaf\x32\x7c\xdc\x91\xae\x5a\xef\xf6\xed\xaf\xfa\xe5\x93\xc8\xf8\x0a\x65\xf7\x33\xf4\x5b\xd6\x30\x67\x22\xc6\x78\x1c\x2a\x5b\x11\xd9\x94\xdc\xc8\xbf\xf0\xe0\x7e\xce\x5f\x8f\x79\x97\x7d\x7e\xe2\xc9\x27\x46\xa8\x55\x43\xeb\x3a\x67\xc9\x43\x6b\xe9\xed\xe6\x39\x96\x85\xc7\x27\xbf\xa6\x5b\x46\x8c\xe1\x79\x50\x93\x4a\x0e\x94\x73\x77\x20\x41\x1e\x37\x9d\x3f\xf2\x4a\xfb\x2e\x4f\x0a\x8b\x23\x7a\xd3\x5d\x6c\xd7\x10\x78\xd5\xce\x5f\xc6\xcc\x51\xc7\x4d\xc2\x98\x92\x87\x1a\x2a\x24\x1a\xd7\x4e\xea\x93\x9f\x3f\xcb\xbf\xcd\x10\x68\x2b\x45\x61\xd7\x2e\xfe\xa3\xb3\xd4\x15\x48\xfd\x9e\xb8\x94\x7b\x3f\xd3\x4d\xbf\xd2\x7e\xe9\x52\xd2\xe8\xb7\x9c\x6e\x99\x72\x13\x77\xcb\xbc\x2e\x44\xea\x0c\x6e\x9c\x9f\xdd\x32\xb5\x7f\xa4\xbf\xf2\xc5\x7a\xbb\xcb\xaf\x54\xa4\x76\xf9\xce\xee\x8d\x86\x1e\x03\xa4\xff\xdc\xe3\xa0\xf9\xd9\xfd\xd8\x9e\x87\x0d\xb3\xeb\x3f\x37\x4d\x75\x36\x18\xbd\xeb\xdf\xfc\xf9\xd2\x15\xf6\x31\xa5\xa1\x8d\xeb\x9c\xc1\xe9\x1e\xf3\x75\xe6\xbc\xa8\x7b\xfc\x43\xf7\x04\x41\xf7\x2a\xd4\x80\x0c\xc1\xf4\xe4\x53\xf7\x6c\xc4\xbe\x6a\xc6\xe8\xec\x5f\xf5\x77\x8f\x8f\x06\x6b\x72\xcb\xe6\x57\xfd\x75\x7f\xdf\x9f\xf1\x4f\xfb\xb3\x1a\x9a\xfc\x92\x8f\xe7\x43\xb8\x23\x06\x67\x1a\x25\x7d\xc2\x27\xe7\xcd\x9f\xea\x27\x1e\xe2\x69\xf8\xb8\x0a\x51\xb8\x65\x15\x28\xb7\x2f\x69\xbe\xea\x6b\x43\x7f\xdb\xc6\xb8\xeb\x90\x7e\xe8\x3c\x7f\x99\x7e\x7c\xe8\x5e\x77\xe8\xbe\x7f\x98\x5c\x8e\xda\x90\xff\xb8\xb5\xe5\xd6\xcd\x84\x33\x79\x1e\x71\x58\xf9\xe7\xfe\x6e\xde\xb4\x45\x08\xf7\x77\x08\xfb\x55\x7f\xce\xdf\xf5\x67\xff\xf3\xfe\xb6\x2f\xda\x35\xf4\x1f\xe8\xe2\x97\x8e\xb2\xd4\x77\x7d\xf4\x3b\x47\xfc\xb1\xde\x8f\xf6\xf6\xdd\xfe\xbc\xfb\x3e\x47\x61\xb9\x28\xb4\x29\x12\x40\xb2\x18\x7c\xbe\xec\x3c\x6f\x64\xd0\x1a\x09\x24\xf2\xf3\xdb\x31\x28\xf9\xf7\x23\x92\xa5\x36\xad\x05\xa5\xf0\x0e\x83\x11\x38\x38\x3b\xbb\x1c\x5c\xd7\xad\x58\xd7\x35\x14\x2b\x19\xca\xe2\xd9\xaf\xb8\x64\x34\xe2\xb7\xa3\x40\x30\xd6\xe9\x4e\x74\x07\x70\x06\xac\x6d\x4f\x02\x77\xa1\xd6\x1d\x5e\x79\x0a\x8c\x7d\x30\xed\x7d\x2d\x0b\xbc\xed\xb2\x74\x6f\x1f\x6a\x2c\x56\x55\xe3\xa3\x25\x2a\x65\xaf\x67\x84\x64\xc2\x0a\xf3\x3e\xd5\x51\x03\x35\x64\xfd\xf5\x98\xb0\xab\xbf\x04\x83\x3f\xe2\x61\xfa\x94\x60\x3b\x78\x6e\xb2\x3f\xac\x84\xee\x61\x9d\xde\x39\x0f\xca\x09\x93\x40\xf6\xcd\x2a\x3e\x45\x69\xa4\x9d\x65\xac\x66\x02\xad\x8b\x4e\xe4\xdb\x55\x98\xf9\xe3\x34\x1e\x1a\x47\x2f\x9e\xe0\xd0\x4a\xb7\x80\x1d\x8b\x58\x87\xe0\x04\x25\x4a\xb9\x0d\xfe\xc4\xb2\xb3\xbc\x70\x84\x9f\x68\x5e\x02\x2b\xc3\x80\x49\xe1\x81\x3a\x0d\x68\xe6\xc7\x93\x30\xd7\x42\xd3\x27\xd5\xc3\x90\x05\x4c\xac\x0a\xce\x65\x57\x99\x40\x0b\x5a\x2b\xc8\xbb\xb3\x41\x76\x54\x49\x8e\x28\xa5\x1a\x73\x3a\x4d\x53\xb0\x9c\xbd\x60\x84\x6e\xf5\x68\xaf\xca\x8b\x3c\xeb\xf7\x1a\x8c\xa8\x91\xbd\x1d\xb5\xcf\x8f\xdc\xd5\x60\xbc\x4a\x76\x05\x71\x4e\x52\x0d\x72\xb2\x4b\x4c\x48\x2a\x3c\xb5\xf7\x9d\x17\x40\x8a\xb8\xc5\x21\x6e\x52\x6b\xba\xdd\x52\x78\x48\x37\x3a\x5d\x23\x4e\x24\xc4\xaa\x4c\x90\x7d\xae\xe4\x07\x15\xc6\x0c\x7b\xe6\x32\xfb\x9a\x8c\x6c\x6c\xfd\x70\xe2\x1e\x31\xc4\x62\x49\x8d\x70\xd0\x8e\xd7\x61\x4e\x40\x1f\x3b\x61\x01\x39\x7c\xda\xc9\x1c\x09\x90\xbc\x48\x5b\x0c\x0c\x25\x53\x40\x64\x9d\xd2\xf5\x18\xe8\xba\x2d\xdc\x71\x5b\x20\x2c\x23\xd1\x81\xae\xaf\x9d\x73\xac\xc4\x3a\x7b\x1f\xa6\x1c\xd1\xba\xb9\xf2\xbb\x48\xbf\xb9\x77\x90\x57\x59\xd0\x75\x3c\xf0\xf0\x17\x9e\x0e\xd3\xed\x09\xa5\x17\xb2\x42\xc9\x7b\x53\x91\x77\x9a\xd5\xb8\xec\xa3\xed\xd8\x5c\xdc\xee\x55\x7b\xd9\xc2\x5a\x11\x3c\x95\xa8\x2b\x32\x95\x1c\x56\xc4\xee\xd2\xd3\xa4\x28\x1a\x02\xa1\x75\xb6\x31\x5c\x86\xfb\x20\x8f\x00\xdf\xe1\xeb\xd7\x62\xa1\xd8\xa9\x4d\x30\xcd\xc1\x47\x93\x31\xfa\xe7\xb5\x4e\x97\x4f\x0e\xca\xd9\xa0\x5e\x0e\x9b\x18\xd0\x53\xb7\x0d\xbe\x88\x83\xb4\x41\xd2\x92\x2e\xf8\x8e\xd9\xb4\xb6\x92\x77\xc6\x03\xb1\x73\x97\x29\xba\xe4\x8d\x13\xe5\x76\x8f\x45\x4b\xe1\x8a\xd4\x00\xda\x51\x8a\x97\x5b\xab\x6c\x1f\xa3\xeb\xe7\x65\xe0\xd4\xfa\x68\x44\x2d\xf6\x7a\x76\x4d\xbb\x56\x88\x89\xea\x30\x68\xa6\x30\x50\x06\x25\x98\xf4\xfd\x1e\x63\x8f\x9c\xce\x08\x6a\x5e\x98\xe9\x64\x4e\x27\xd3\x18\x1e\xf7\x9c\x93\xf9\x5e\xc6\x24\x88\x92\x81\xd6\x8e\xbd\x1b\x55\x78\xa5\x53\x3e\x59\x23\x35\x15\xd9\x5c\x91\x5c\x4b\x9b\xcc\x22\x54\xbc\xa6\x0c\x8b\xb8\x10\x65\xd8\x75\xf9\x23\xfb\x80\xc7\x9d\x8f\x4b\x5d\xc8\xe2\xbe\x45\x73\x9e\xf9\xdb\xa2\x89\xfc\xbf\xd1\xc4\x7f\xa3\x89\xff\x46\x13\xff\xcb\x68\xc2\xe9\x74\xcc\x6e\xe3\xd3\xe0\x82\xaf\xe8\x8f\xfd\x18\xfd\x7b\xc6\xe1\x13\xd6\xe0\x0c\x5e\x87\xf8\xaf\xc8\x0a\xe9\xbe\xfc\x2f\xa1\x89\x5f\xfa\x4b\xfe\x69\x7f\xff\x7b\x68\x62\x12\x6f\x54\xc2\x1a\xad\x0c\xe9\xd0\x57\xf4\xaf\x7e\x8c\xfe\xbe\xc0\xdf\xae\xea\x2e\xb3\x2f\xdb\xe3\x6f\x9d\xb2\x8f\x1b\x07\xfd\x0b\x68\xe2\x97\xfe\x0c\xfe\x9f\xf6\xf7\xff\x0d\x9a\xe8\x9e\xf0\x59\x7e\xad\xda\x57\xb2\x5f\xd0\x84\xf1\x13\x9a\x80\x56\xcd\xd7\x26\x86\x82\x3f\x63\x11\x84\xd6\x30\xce\xbe\x7c\x8c\x50\xe0\x36\x86\x7b\xf3\x34\x8e\x25\x1e\x45\x7b\xc8\x2f\xc0\xe6\x05\xb0\x80\xe9\xb6\x71\xd5\x3a\x22\xc7\x38\xac\xaa\xc8\x7e\x27\xf3\x61\x03\x85\x6a\xdf\xa6\xd0\xa7\x47\x10\xe0\x81\xc0\x54\xaf\x20\x78\xa5\x95\x4d\x79\x7d\xa2\xed\x45\xcc\xaf\xd7\x6b\xee\x67\x5f\xf0\x83\x61\x41\xf5\xad\x40\xb2\x56\xbe\x23\xbf\x13\x68\x0c\x0d\x5d\x22\x7d\x8d\x78\x5b\xb7\x03\xbc\x96\x00\xc1\xfb\x28\x31\x0b\x26\xb0\x13\x4a\xaf\x67\xab\x08\x94\x96\xf2\x18\xcc\x34\x19\xf2\x06\xbc\x03\x58\x8f\x9b\x06\xb3\xee\x30\x19\x72\x76\x4e\xad\xc9\x1b\x5a\x26\x22\x6e\xbd\x66\x89\x02\xd0\x10\x54\x67\x72\xb6\x4b\x9a\xa4\xb1\xe0\xde\x8b\x00\xa1\x45\x7b\x7d\x36\xae\xc2\x37\x49\x09\x05\x7a\x9a\x05\x90\x9e\x8f\x12\x1c\x9b\xd7\x82\x03\xf1\x7c\x8e\x6f\xb6\xf1\x99\xcd\x30\x4d\x80\x10\xe6\x48\x6f\x5f\x61\x0e\x85\xa7\x4c\x9b\xb6\x05\x19\x98\xf1\x22\xe5\xf7\xdb\xbd\xac\x03\x59\xf6\xbe\x5f\xe2\x77\xd2\x23\x64\x75\x63\x0f\xee\x74\xb6\xfc\x50\x23\x7b\x63\xf0\x4a\x76\xe3\xa9\xd9\x2b\x09\x68\xf9\xa0\x21\x62\xfa\x19\x62\x2f\xfe\xdd\x41\x96\xb2\x73\xe3\xd3\x28\x5b\x3a\x22\xac\xb4\x44\x16\xab\xae\x25\x7b\x14\x70\xd4\x7f\x88\x69\x83\x30\x2f\xb8\x9c\x46\x2b\x1c\x86\x54\x1d\x3f\x15\xec\x33\xa3\x3e\x57\xbb\x94\x66\x72\x10\xb0\x07\xd2\x16\xb3\x2a\xec\x03\x4e\x78\x09\x32\xf5\x7d\x61\xd9\x62\x85\xe4\xea\x43\xaa\x4b\xd9\xd7\xb3\x34\x79\xab\xc1\x6d\x28\x78\xd5\x70\xec\x89\xea\xc6\xd7\x8f\xbe\x95\x2a\x23\xb7\xc3\x3c\x46\x9b\xe6\x17\x5b\x5e\x27\x83\x95\x42\xf5\x55\xe9\x23\x04\xa7\xb3\x17\x83\xad\x20\x5f\xd9\x67\x75\x6b\xe5\x13\xd5\xb0\x30\x50\x82\x3c\xc4\x8d\x4e\xac\xde\xaa\x9a\x6b\x66\x02\xeb\x68\x50\x46\x0e\x3c\xed\x87\x2b\xfa\xa6\x1c\xc4\x3d\x21\x5d\x96\x2c\x21\xb1\x93\x40\x27\xa2\x26\xc0\x12\x5c\x8c\xf4\x42\x36\x78\x98\x74\xf1\x80\x38\x2f\x75\xd0\xd7\xf5\xdc\x9f\x22\xaf\x14\x67\x26\x76\xc5\x08\xee\x7c\x53\x71\xe7\x76\x68\x57\x85\x9d\x0d\xef\x6e\x63\x9b\xd4\xcf\x02\xa3\x4c\xba\xd4\x14\xa4\x28\xab\xd4\xd5\x64\xcb\x34\xf1\x6d\xaf\x9a\x38\x4d\x1d\x7e\xf6\x1c\x87\xe7\x8b\x17\xa9\x97\xb7\xae\x06\xc9\x43\x27\x5e\x5a\x71\xe3\xcf\xb7\xa0\xa5\xf6\xce\x3f\x85\x27\xcd\xda\x2f\x18\x90\x98\x50\x75\xd5\x0c\xd5\x3d\xcc\xad\x96\xf5\x53\x53\x5b\xc0\xa5\x40\x66\x63\x9c\xe9\xf0\xf8\x88\x3b\xfd\x32\x5d\x7a\x47\x79\xa6\xf5\x9c\x81\x67\xa3\x29\xac\x26\xc4\x33\xea\x25\xae\x6a\xe4\x32\x01\xa4\xca\x1e\xda\x83\xa5\x03\x5e\xf7\x76\x2a\x8c\x5a\x3f\xf8\xe3\x09\x2a\x37\xe8\xd6\x77\x3d\x5d\xf0\xd0\x57\x8b\xdf\x78\x85\xd2\xf2\xee\x7f\x67\x2b\x94\x7e\xde\xb0\x8d\xec\xf2\xf5\xfb\x22\xfd\xaf\x9d\x2e\xce\x48\xef\xc6\x14\x36\x18\xc7\x4c\xdd\x6e\xf2\x18\x1e\x41\xad\x3a\x41\x1e\xf9\xbb\x47\xce\x47\xf1\xd9\x68\xcb\xbf\xbd\xa7\x0b\x0b\x87\xec\x38\xcc\xed\x5f\x05\x9b\x12\x83\x82\xe3\x0d\xb9\x16\x95\xa3\xe3\x96\x1d\x19\x5e\x65\xf2\xd2\x49\x74\xfe\x18\x49\x7f\x63\x95\x91\xab\xef\x64\xe2\x42\x0b\x90\xba\x36\xd4\x1d\xf7\x83\x64\x6b\xf0\xdd\xc7\x2a\x12\xe9\x68\xce\x2b\x4b\x26\x41\xc0\xbb\x0d\xc0\xb9\xc0\xc1\x0f\x9e\xde\xe9\x40\x46\x38\xba\xe0\x01\x54\x3a\x2e\x16\xc8\x1f\x00\x94\xde\x0c\xfa\xec\x44\x8b\xe2\x56\xaf\xf2\x3a\x7d\x7d\x2d\x01\x46\x53\x51\x48\x07\x5d\x6a\x03\xa8\x00\xf6\x5c\x6c\xd6\x4e\x9f\xa7\x30\x9c\xf0\x3a\x90\xf3\x86\xef\x10\x9d\x36\xf7\xcc\x0f\xdb\x71\x39\x20\xbf\x11\xc6\xf0\xad\x4c\xa2\x7e\x3c\x38\xe3\x32\xb9\xf7\x23\x95\xad\x31\x25\x11\x3a\x64\x2a\xb0\x3f\x55\x4d\x97\x60\x4b\x9f\xc0\x94\x8a\x36\x2d\xd2\x2e\x11\xce\x3f\x42\xcf\xa4\xe1\xd4\xa8\xc7\x5d\xd7\xd5\x62\x9c\x4d\x59\x0a\xad\x2a\xbb\x3d\x1b\x3b\x71\xba\xd2\x76\x2f\xcd\x19\x37\x31\xb5\xf2\x26\xd4\xd6\xc7\x33\x75\x43\x9e\x19\x6b\x5c\x65\x36\xb3\x6e\x52\xa3\x4a\x21\x20\xa6\xa6\x3d\x15\x8e\xed\x43\xcc\xcf\xe9\xce\x75\xa6\x90\x5b\x81\xb7\xa1\x4f\x88\xdc\xb4\x92\xb8\x17\x45\xc8\x6e\x06\x80\xa2\x20\x3b\xd2\xa6\xf5\x78\x05\x85\x47\xb9\xc5\x68\xc3\x4a\x77\xe7\x69\x36\x1e\x6f\x33\x11\xe0\x10\x40\x5d\x44\xda\xd1\x48\xf5\x94\xa8\xdc\x11\xc8\xda\x7c\x08\x8f\xa0\xc4\x7a\x9b\x16\xa8\xcf\x47\x05\xde\xc2\x72\xf6\xdd\xb5\xd8\xc7\x9c\xa7\xf6\x00\x61\x75\x11\x76\x0e\x89\xea\xd7\x73\xa2\x66\x1b\x7d\xd2\xc2\xf5\xa1\xbe\xce\x82\x0b\xf5\x67\xdb\xe3\xe0\x24\x32\x45\x86\xb4\x99\x3a\xdc\xc8\xbf\x77\xda\xf0\x7c\x6c\xdd\x59\xfb\x09\x63\x55\x66\x29\x1a\x92\x41\xd1\x19\xa7\x89\xfe\x85\x4b\x25\x52\x07\x2d\xaa\xf5\x14\x4b\x82\xe5\xb6\x14\x55\xaa\xab\x90\x14\x90\x59\x3e\x70\x77\xcc\xf5\x7d\x26\x8e\xf2\xb2\x70\x00\x43\x13\x5f\x12\x24\xa3\xed\xc6\x75\xb0\xb3\x9c\x18\x2e\x58\x18\x0b\xc2\xcc\x3a\x17\xaf\x95\x15\x25\xa3\x31\xe9\x2b\x4c\x2a\x5e\xb5\x1f\xf6\xfa\xe8\xe5\xb7\x36\xb6\x59\x1a\xb9\xb6\x97\x3b\x27\x62\xee\xb7\x75\x27\xb7\x17\x95\x63\xda\xac\xba\x20\xf4\x79\xa0\x3f\x22\xf0\xca\x20\x6e\x12\x8c\xa9\xf3\xae\x21\x19\x9e\xc2\x7a\x95\x7b\x2d\xc5\xf9\x73\xf7\x63\x5a\x4f\x86\x20\x4d\xec\x5e\x13\xa4\xdf\x34\x15\x58\xab\xf4\xff\x17\x9b\xc2\x23\x0f\x15\x41\x64\xcd\x11\x9b\x29\x43\x90\xdb\x3b\xe3\x4a\xee\xf2\xb6\x97\xf9\xf2\xfe\x1a\xaf\x63\x62\xf2\xed\x7e\x5e\xde\x82\x54\x6b\xac\x72\x0f\xdc\x7b\xc3\x83\x89\x4e\x56\x33\x7e\x60\x6b\xc1\x8f\x55\x31\x58\x35\xc8\x41\x2a\x6d\x35\xd8\x1e\x4d\xe0\x04\x05\x2e\x97\xee\xc7\xd7\x50\x5e\x66\x09\x9a\x00\x45\xa0\xdb\x96\xf0\xb7\x44\x95\xf8\xf5\x2e\x2c\xfd\x73\x03\x34\xd7\x8e\x6d\x84\x52\xf8\x8e\x01\xac\x24\x9f\x97\x7e\x5e\xad\xda\xee\x11\x34\x48\x72\x12\x5c\x67\x4a\x86\x4f\x5d\xaa\x20\x9e\x26\xa5\x99\xd1\x21\xac\x0f\x84\x3b\x2d\x8e\x51\xf2\xe9\xba\x9c\x1d\x18\xe8\xf4\x54\x03\x52\x24\xd4\xfc\x48\xa4\x1e\xdf\xe0\x23\xeb\xdf\x3e\x5a\x6a\x39\x3a\x21\x81\x90\xb4\x33\x0e\x54\x76\xb5\x2f\xaf\xfa\x12\xbd\xcf\x1b\x37\xea\x05\xef\xe2\xe4\x1d\xb5\xbb\x06\x89\x42\xcd\xf7\xa8\x8f\x43\xe6\xf3\xb5\x87\x0b\x2c\x8a\xeb\xec\xab\x86\xb3\xa8\xdd\x54\xca\x32\x33\xa9\xb2\xb3\xca\xb0\x42\xe4\x11\x51\x47\x4e\x9d\x83\xba\x59\x47\xfb\x83\xeb\xd8\x44\x14\x91\x2b\xa2\xd3\xf6\x12\xe4\x91\xd1\xb7\x57\x05\x9f\x93\xac\x9a\xef\xc1\x42\x21\x31\x44\x4d\x99\xcf\x9b\x61\xdc\xae\xfe\x70\xce\x87\x53\xd1\xc2\xce\xf1\xb4\x6c\xde\x4d\xf3\x41\xd6\x8b\xc6\xd6\x16\xa3\x08\x77\x3d\x29\x94\xbe\x22\xa7\x8b\xf7\x57\x38\x65\x6a\xf5\x8d\x46\xcd\xeb\x50\xe3\xb7\x40\x9d\x76\xf6\x76\xe4\xf7\xa2\x9f\x00\x24\x9e\x27\x46\x64\x6a\xe5\x87\x91\xab\xad\xb7\xd3\xe4\x1f\x7b\xb1\x7e\xde\x36\xed\x0d\x0f\x48\x80\x83\x97\x95\x39\x62\xc9\xef\xa4\x14\x25\x59\x13\x90\xcf\x85\xdb\x64\xaa\x2f\xda\x0f\xb5\xd1\x1c\x7d\x36\xb5\xc6\x67\x73\x48\x27\xb5\x39\x06\x13\x7a\xe1\xcd\x9b\xf1\x1b\x06\xa6\x6f\xd0\xf1\xdc\x59\x7e\xc9\x3a\x0d\xd4\x31\xaf\xe2\x1f\xaf\x47\xcb\x1d\xc0\xe8\xf1\xd7\x04\x17\xe4\x40\xad\x2c\x29\xe1\x7a\x4a\x33\x50\x33\x5c\x07\x64\xf8\x60\xa8\xfa\xf1\x6d\x57\x23\x7f\xa6\x3b\xc7\x8c\xc8\xa7\xc1\x3d\xf1\xe9\xe1\xb4\x41\x1f\xf3\x76\x90\x44\x15\xb3\x22\x7b\x44\xbc\x1a\x28\x1b\x1b\x0a\x8b\x88\x96\x35\xbc\x19\xa0\x82\x99\x1c\x6e\x16\x98\xcf\x92\x23\x24\xa2\x5e\x44\x78\xa2\x26\xed\x32\xd5\xf0\xf6\xb0\x09\x54\x84\x0b\x35\x21\x60\x60\x13\x9f\x80\x98\x6b\xa6\xee\xf6\x4f\xbe\x12\x17\x0b\x7a\x17\x86\x39\x44\x10\xd3\x88\xea\x14\x74\x01\x9c\xed\x20\xfc\x0a\x91\x0c\xac\xd8\xe9\x09\x5b\x5e\x2e\x03\x31\x51\x56\xf8\xb4\x00\x22\x96\x89\x98\xe5\x50\x94\xdb\xd2\xc1\x48\x49\x3c\xf6\x06\x9e\xaa\xc1\x43\x5b\xff\x48\xf7\x8f\x3b\xd6\x6d\xd9\xb4\x99\x53\x3e\x83\x96\x5e\xfd\x8a\xb7\x1d\x95\x88\xeb\x86\x32\xd5\x17\xf1\x52\xc3\xe4\x38\x7a\xd7\x0a\x7b\xd4\xde\xab\x57\x2a\xcf\x21\x69\xeb\x35\xf7\xc0\x90\xcd\xcf\x0e\x30\xc4\xa8\x81\x58\xde\xd8\x86\x7d\xfd\x24\x61\xbe\x1b\x94\x6f\x89\x8c\xe6\x7f\xeb\xed\x79\xd6\xea\xfa\xfd\x1e\x43\x03\x7f\x5f\x9b\x1c\x5b\x99\x7e\xb9\xde\xb3\x58\xe5\x97\xb0\x76\xdd\xd4\xa4\x51\x10\x8e\x1f\x44\x70\x7c\xfe\x05\x2e\x23\xae\x7c\x2a\x0d\x1c\x25\x90\x7e\x02\x0c\x4c\x91\x64\xf3\xc4\xa0\x36\xbf\xf3\x18\xdb\x77\x0c\xc6\xb5\x8b\xaf\x6d\x8c\x6d\x03\xe9\x97\x4f\x49\xde\xc1\x7f\x49\xb6\x9c\x10\x46\x73\xe6\x96\x4b\x27\x1e\xd7\xeb\x69\x0e\xc1\x30\xac\x7e\x7c\x6e\xf3\x09\x00\x28\x7e\x3e\xcd\xd2\x85\xdf\xef\x8b\x5b\x37\x09\xb1\x08\x9c\x9e\xac\x3e\x44\xb9\x39\x43\x16\xa9\x3c\xc0\x4f\x9e\x4b\x21\x5d\x64\x49\x84\x42\x9d\x89\x00\x0c\xba\x34\x06\x7a\xd5\xde\x4b\xfa\xd8\x38\x0e\x50\x8f\x06\x43\xd0\xdb\x6c\x2f\x33\xe8\x5b\xa6\x50\xcd\xc4\x90\xd2\x6b\x0b\x3c\xa2\x7c\xec\x02\xe0\x26\x4c\x7b\x33\x41\x01\x50\x05\x86\x87\x2c\x44\xee\x18\xe8\x61\xda\x2e\xf4\xcd\xdd\xf2\xf9\xf6\xfc\xed\x95\x5f\x9e\x3f\x51\xce\x6d\x87\xb5\x5a\x29\x07\xf4\xc6\x0a\x7e\x0f\x6f\xd5\x77\x78\x55\x66\x25\x7e\x19\xe3\x38\xd2\xa3\xad\xcf\xc3\xe1\xfd\xd1\xaa\x21\x26\xe0\x93\xed\x44\x30\x87\xe8\x8e\x76\x2a\x4d\x4f\xa6\x97\xce\xe9\x93\x68\x5c\x7c\x5c\x42\x85\x38\xe1\xd2\xbe\x0d\xd2\x94\x49\x2c\x1c\x3e\x29\xfd\x25\xb0\x9d\x64\x9b\x67\xcc\x5b\x97\xab\xec\x36\xc2\xe0\x8f\xdb\xd0\x8d\x44\x44\x85\xa5\x6e\x06\x7c\xcf\x74\x43\x79\x61\xd9\x9d\xb4\xec\xba\xba\xca\xef\x11\x61\xcd\xca\x41\xe4\x9c\x9e\xd6\xbd\xdd\xf2\x6a\xc4\x1b\x82\x68\xaa\x96\x1f\x02\xee\x9d\xc5\xe4\x61\x2b\x70\xb0\x3b\xbe\x56\x37\x8c\x8c\x03\x22\x95\x3c\xd1\x3c\xf3\xae\xe6\x73\xc9\x5c\x37\x48\xae\xf1\xe6\x59\x4f\xa8\x6c\x37\xe7\xf3\xf8\x93\x23\x06\x4d\x7a\x79\x11\xef\x11\x07\x88\x9d\x0a\x7a\x71\x60\x00\x1f\x02\x09\x67\xf9\x53\x34\x34\x47\x8e\xf8\x48\x3b\x88\xe3\xb0\x83\xad\xb7\xcd\x82\xb2\xf2\x09\x37\xee\x64\x9d\x2e\x1b\xd5\x82\x17\x95\x27\x5d\xd5\x18\xe3\x00\x4a\xb0\x93\x53\xfa\xd1\x1a\x88\x9d\xb0\x93\x8d\x28\x9a\x82\x04\xbb\x7e\xb9\x4c\xbc\xbe\x04\xb0\x03\x6c\x40\x8a\xd3\x81\x6a\xd6\xa7\x9c\x30\xa8\xbd\x79\xe4\xd5\x92\x91\x1b\x3e\xf8\xda\xf0\x16\xaa\xd9\x32\x57\xdb\x2d\xff\xb2\x34\xc3\x52\x18\x59\xe7\xd5\x57\x6a\xc7\x15\x9e\x32\x14\xe0\x88\xce\x17\x90\x59\x8c\xea\x65\xa8\x91\x2e\xc3\x46\xa1\x88\xe2\x23\x7c\x4a\x97\x10\x68\x0a\xd1\x91\x39\x79\xf8\xaf\x6a\x0a\xc6\xa2\xe7\x5c\x31\x13\xe2\x96\x8c\xb1\x53\x9f\x4c\x11\xa2\x7a\xe9\x89\x9e\xb2\x60\x5d\x34\xfa\xdc\xa5\xc4\xa3\xed\x60\xf5\x31\xc5\xf7\x4a\x81\x9b\x8b\x8a\x2b\x7a\x99\xdc\xe9\xeb\x61\xdf\x19\xcc\xb1\xec\x4e\x29\x13\xb6\xc9\x25\xb9\x39\x43\x89\x84\xbe\x91\x5a\xe6\x18\x99\xb8\xde\x30\xbe\x7e\x3a\x54\x30\xac\x83\x96\xb1\xdd\x00\xa6\x6e\xcc\xf3\x78\xe4\xb1\x58\xb9\xfd\x41\xe1\xaf\x3a\x66\x2f\x2b\x82\x6f\x08\x6e\x7d\xfd\x94\xa9\xb6\xa8\x05\x22\xb1\xaf\x56\xf1\x6f\x8b\x38\x9a\xe7\xef\x6d\xcb\x13\xe7\xd7\x1b\x3c\xff\x38\xa3\xf0\x1d\x5f\x52\xb8\xac\xfd\x1c\x2b\x46\x7e\x23\xb4\xfe\xf4\x95\x25\x35\xc4\x9a\x62\xa0\x0a\xd6\x46\x68\xa3\xf8\x93\x20\xef\x78\x15\x1c\x4c\x1d\x7d\x24\xb7\x1e\x90\x47\x2c\xde\xde\xd5\x9e\xe8\x98\xe1\xf8\x70\x4a\x79\x8b\xaf\xbb\xa5\x08\xa6\xec\x6c\xaf\xad\x34\x31\xfd\x99\x70\xac\x17\x66\xe9\xa7\x7c\xe6\xc4\x8d\xf6\xe5\xeb\xc6\x8a\x04\x61\x5a\x5f\x1b\x1d\xb0\xf9\xd7\x64\x37\xe4\xd3\x2d\x36\xad\x8e\x4f\x61\xfc\x80\x44\x30\xaf\xb4\xeb\x4c\x5b\x60\x9c\xaf\x16\x46\x9f\x78\x64\xd6\x6d\x45\x4a\x74\xaf\x7b\xb2\x5d\x9c\xa0\x66\x02\x1b\xfa\x89\x7d\xcb\xeb\x68\x89\xf5\x32\x20\x57\x01\x68\x2a\x4e\x51\xc5\x81\x3a\xdb\x3e\x18\x78\x34\xa7\x94\xbd\xf2\x98\xde\x9f\x0e\x4c\x25\x55\x26\x82\xdc\x68\x52\xda\xe2\x56\xa5\x57\x50\x4a\x34\x1e\xcf\x53\x17\x91\x79\xb3\xb9\x82\xe9\x77\x39\x49\x3f\xf6\xe3\xf3\xac\x61\x64\xdd\x9b\xd1\xc7\x78\xe0\x15\xfb\xb0\x21\x3b\xec\xd7\x3e\x0c\x00\xb3\x22\xe4\x10\x5d\x07\x19\x18\x9f\x84\xa0\xf0\x96\x7a\x83\x5c\x45\xef\xe3\xb0\xbf\xd9\xbe\xe9\xe9\x41\x80\x04\x1e\xdb\x59\xf9\x45\xf7\x5d\x6b\x62\x27\x30\x43\x4a\x65\x44\xc8\xb8\xe0\x91\x15\xbb\xc8\x1b\xcc\xed\x93\xce\xed\x74\x15\x5a\x1a\x62\x2c\x09\x37\xe2\x66\xca\x73\x7a\x97\x45\xff\x44\xd8\x40\x11\x12\xf9\xd5\x7d\x9d\xc7\x4a\xbf\x0f\xd4\xc3\x40\x59\x17\x07\xa5\x2b\x43\x39\x74\xaf\x87\x5a\xc9\x8f\xa9\xa8\x33\xf4\xe4\x22\xf7\xc1\xb9\x6c\x5c\x93\xb3\x27\x73\xe4\x02\xed\xe5\x51\x06\x1c\x24\xae\x7a\x41\x3e\xd4\xae\x2b\x9f\xc2\xe1\x3f\x79\x8c\xd0\x61\x63\xec\x32\xa5\x63\x4e\xde\xee\xf1\x55\x03\xc6\x0f\x2d\x03\xba\xbf\x67\x42\xfd\x8a\xb3\x54\x51\x73\xd0\x7d\x57\xd0\x81\x49\x58\xc9\x39\x22\x47\x3f\xf0\xa7\x6f\xde\x3a\x46\x81\xe2\xc2\x4e\xa9\x2c\xed\x33\x29\x98\xb5\x4d\x22\xa8\xf9\x70\x9e\x21\x8e\xaf\xbe\x48\x73\xb5\x71\xa2\x8e\xe1\x70\x6d\x6e\x60\xb0\xa4\x1d\x85\x4d\x2a\x78\x1b\x7b\x8b\x69\x6b\x4f\x53\x61\x54\xec\x09\x2c\x08\xf8\x19\xd3\xb5\x1e\x0d\x51\xf5\x60\x7d\x15\xca\x40\x4a\x8e\x22\xf4\xf8\xe4\xc9\xbf\xc1\xdb\x3b\xf6\xe8\xcc\x2a\xa6\x7c\x79\x01\x4e\x73\x0b\xe2\x3c\x3e\x7d\xcc\x71\x46\x17\xb7\x13\xc4\xd5\xb3\x00\x5c\x6c\x50\x75\x3b\x0a\x03\x1b\xec\x1f\xad\x9d\x74\x46\x00\xb9\x34\x57\x20\x23\xac\xcb\x9f\x9c\xba\x1d\x38\x00\x76\xda\x91\x41\xa0\x2f\x8c\x52\x69\x15\x14\x0d\xbf\xaf\xd2\x1f\xd5\xa9\xe9\x0f\xea\xc8\xb5\xf0\xaa\x2a\xe0\x03\x5e\x65\xf0\xfc\xd8\xef\x4e\xa7\x32\xd6\x80\xcf\x65\x0e\xd5\xe8\x28\x56\xab\xda\xdc\x7c\xf7\xe9\xe1\x41\x46\xc9\x03\x09\x61\xdc\x3e\xad\xdb\x56\x1d\xff\x6a\x1e\x24\x29\x66\x7c\x03\x5f\xab\x64\x2d\xdb\x4e\xb3\xd8\x87\xa5\xde\xf5\xeb\x0e\x85\xbf\xf5\xf2\x84\xf5\xf8\xbd\xed\x57\xab\xfc\xfc\x32\xc1\x9f\x57\x53\x84\x79\xdb\x7a\x2e\x67\xd4\x9c\x17\x53\x7d\x64\xc5\x3d\xd9\x3a\x61\x18\x5e\x60\xd5\x49\x95\xb4\x11\x2f\xab\x89\x9f\x8d\x17\xc9\x4a\x95\x44\x56\x5d\x22\x37\x9e\x2b\x47\x5a\xad\xd0\x21\x5b\x75\xa3\x5e\x39\xba\xdb\x7c\x4d\x4d\x47\xa8\x0b\x3b\x29\x40\x86\x0b\x50\x62\x67\xe1\xeb\xad\x8f\xf5\x7a\xb5\xdd\xd5\x4f\xb6\x7b\xbe\x62\xe0\x69\x11\xd0\x53\x14\x90\xca\xfe\x30\x27\x7b\xe1\xfd\xb3\x28\x28\xff\x33\x3f\xb7\x79\x6e\xcb\x05\x27\x70\xa4\xcc\xd0\xf5\xb2\x71\xea\x23\x28\xbb\xb5\xbc\x4d\x8b\x05\x74\x86\x1f\x8e\x63\xaa\x1b\x6c\xed\xfd\x87\x29\x0e\x8b\x79\xa5\x4c\xa8\xe5\x80\x49\x7d\xad\x86\xae\xdd\xbc\x29\xba\x53\x4c\xc8\x4d\xc2\x91\x97\xb4\xba\x6a\x9f\xca\x58\x7e\xb3\xd3\x6b\xe9\xa7\x9b\x0a\x0c\x93\xe7\x23\xc4\x87\xef\x73\xbb\x3e\xd9\x30\x9d\xf6\xdc\x82\x42\xd4\x11\x8f\xaf\xfd\x40\x79\xbd\xd4\x23\x9f\x3c\xce\xce\xa2\x41\xa9\x2d\x40\x1c\xd4\x9c\xfc\x6c\xf9\x69\x45\x1a\x42\xa2\x4b\x8c\x46\xbc\x52\x4b\x6e\x00\x6e\x2a\x69\xa0\x1d\x2d\x42\xb4\x24\x8c\xf2\x92\xa8\xb2\x51\x4a\x2b\xfc\xf1\x01\x31\xc6\xbc\x03\x34\xcf\x41\xe4\xdc\xd8\x1c\x32\x37\xe6\x5c\x1e\xa7\x33\xc6\x39\xca\x6e\x0b\x9d\x69\x22\x4d\xb7\x49\x95\x12\x5b\x95\x88\x08\x1a\xea\x6c\xc1\x6c\xe3\xa1\x2a\x39\x8a\x7b\x0e\xd3\xeb\x4d\x9a\x22\x0a\x73\xb6\xc6\xfb\xcd\x91\xef\x2e\xce\xe1\xa9\xe5\x54\x22\xcd\xca\x13\x00\xe3\x7a\x0e\xfb\xa7\xba\xec\xf5\xd4\xb0\x0e\x85\xa7\xbc\x4f\x8d\x0f\xc0\xe6\xd1\x0a\x19\xb7\x20\xe4\x06\xc4\xcd\x11\x24\x63\xec\x81\xdc\xe6\xdb\x3d\x77\x2e\x35\x03\xc5\x68\xcd\x30\x6f\x86\x49\x6a\x43\xa7\x30\x1e\x15\x62\xe5\x0e\x3f\x2e\x77\x4e\x84\x7d\xb6\xa4\x56\xa6\x4b\xd0\x2d\x20\x77\x23\x27\x7b\x03\x10\x86\x3a\x7a\xfa\x2a\x2f\x60\x35\x42\x55\x97\x71\xd1\x80\x2a\x28\x81\x30\x49\x70\x00\x63\x34\x58\x10\x3a\x0f\x02\x26\xca\x67\x62\xba\xd9\x15\xa3\x74\xee\x60\xe6\x84\xd0\x22\xfd\x98\xc0\x10\x85\x67\xaa\x84\x36\xa3\x72\xe7\x9a\x32\x77\x76\x9e\x4a\xb6\x7b\x6f\xc9\xe7\xed\x9d\xd9\x6b\x72\xde\x07\x38\x23\x6c\xbb\xdd\x06\xc6\xd1\x0a\x0b\x12\xfd\x45\x5f\xf6\xb0\xff\xa6\xa1\x78\x4f\xb7\xdf\x97\xb1\x4d\xc2\xaf\xf1\xfb\xf7\x29\x7d\x56\xb4\x4e\x6a\x16\x41\xfb\xdb\xb7\xd5\xc0\xd6\x85\x42\x8e\x6c\xc6\x60\x6d\x66\x81\x70\xd5\x02\x18\xd2\xe4\xc4\x1a\xd0\x9f\x58\x06\x62\xf2\x22\xf2\x0f\xac\x3b\xea\x5c\x67\xe6\x6e\x22\x62\xd3\xae\x18\x67\x73\x55\x91\x40\x9f\xdb\x32\xaf\xa4\xb9\x8e\xe9\xd2\x41\xc7\x50\x00\x19\x71\x4d\xc7\xb5\x0d\x24\x8f\x82\xb9\xd3\x5a\xdd\xab\x39\x3f\x7d\x08\x19\xc2\x89\x28\x23\xd7\xf1\x74\xf5\x31\xfb\x29\x52\xa8\x09\xd5\x48\x9d\x79\x07\x3d\x66\x52\xb1\x74\xa4\x25\x39\x45\x1f\xa7\xcf\x45\x93\x50\x2d\xad\x7e\x7a\x5c\xe0\xbf\x77\xbf\x6a\x2a\x45\x3f\xb1\xb0\x1e\xf0\x9e\x8f\xa4\xf6\x88\x9f\x68\xf4\x62\x4e\xc7\x80\x6c\x06\x44\xd4\x54\x22\xb2\x55\xef\xfd\x63\x74\x14\x3a\x3f\xdf\x6a\x5d\x98\x69\xbd\x12\x00\xa7\x60\xd4\x0b\x40\xf2\xcd\x7b\xed\xe7\x2b\xca\x97\x0c\xe8\xb4\x5d\xc1\xe6\xea\x39\xe8\x1c\x27\x86\xef\xed\x55\x46\x6c\xe2\x52\x06\x47\xdc\x19\xfb\xa3\xbe\x2f\xc8\x87\x4e\x9d\x24\xce\x4d\xeb\xf8\x98\xfd\xee\xa3\x60\xd6\x89\xc6\x9c\x32\x9d\xb8\x2d\x2a\x56\x7a\xbe\x19\xc0\x68\x9f\x00\x86\x46\x1a\x82\x40\x2b\x94\xef\x66\xd2\x3d\x67\x23\x5a\x18\xff\x9d\x7c\x2a\x8e\xc6\xd9\x4c\x2b\xe8\x86\xde\xd3\xb2\x83\x13\x9f\xd2\x06\x8b\x74\x6a\xdc\x25\x48\x71\x11\x8f\xd6\x9c\x28\xa1\xb8\xd4\xdb\x88\x13\xb9\x66\x2a\x91\x76\x2f\x15\x81\x77\x70\xcf\xe6\x17\xdb\x91\xe5\x27\x02\x78\x9d\x6e\xd3\x34\xf9\x58\x52\xb1\xfb\x85\x14\x08\x71\xff\xf9\x88\x96\xc8\xde\x7a\xb2\xc7\x6d\xf5\x98\xdf\xe6\x9e\xe7\x4e\x07\xa0\xfe\x01\xc9\xfc\xf6\x5a\x87\xc5\x10\xb1\x64\xf0\xd3\x53\x03\x77\x7a\x57\x25\xdf\x78\x26\x4f\x4f\x35\xa0\xc4\x49\x77\x8a\x1d\x9f\xe4\x5b\x01\x27\x9d\x3e\xa5\xf3\x0e\xe1\xb4\xee\x75\x8b\xcb\x2b\xeb\x4b\x2f\x5f\x3a\xc1\xeb\x81\x9e\xee\x59\xd9\xea\x97\x72\x93\x4a\x64\x11\x1f\x37\x72\xc4\xd3\x81\xf7\xf5\xfb\x1a\x4f\xd7\xd7\x7b\x7f\xbe\x67\x26\x8f\x0e\x2c\x30\xeb\x78\xc0\x9c\x1b\x78\x4b\xd9\xa2\x08\xe6\x99\x78\x8b\x0d\xeb\x06\x50\xe7\x63\x3d\xfa\x7d\x27\x34\xba\x07\x78\xb6\x30\xe9\x7b\x64\xb6\xe2\x14\x55\x3c\x10\xed\x62\x07\x1b\x8f\x5b\x15\x57\xd5\x60\xcb\xf7\xa7\x8a\xc5\xcf\xc4\x3a\x4c\xc6\x72\xe6\x04\xa9\x8c\x92\x63\xc1\x68\xa1\xc5\xc9\x98\x46\x48\x7e\xce\x82\xa9\x18\xc9\xf7\xea\xb7\xad\xb2\x1d\x3a\xf0\x09\xa8\x8d\xbe\x08\x38\x4c\x33\x2a\x26\x11\xa4\x33\xbd\xf3\xf4\x6b\x6d\x63\xab\xf1\xfd\x90\x92\x6a\x2c\x43\x1c\xa2\xa3\x5a\x8a\xac\x0d\x94\x38\xc2\x83\x7c\x8a\xf4\x8a\x96\x32\x5d\x04\xba\xf6\x02\x3e\xfe\x06\x64\x88\xea\x94\x2e\x9b\xb4\x89\xd2\x30\x54\x16\x50\xad\x7c\x21\xe3\x73\x86\x19\x75\xdb\x0a\x42\xa4\x0a\x87\x25\xa1\x3e\x2d\x21\xe9\x32\x57\x20\x0f\x83\xd7\xec\xaa\xdc\xac\x2b\x67\xd7\x3c\x77\x83\x99\xd8\x8a\x07\x7d\xba\xbf\x35\xf1\xf3\x1c\xb8\x0f\x99\xf4\xcf\xe7\x76\x6b\x7b\xe4\xda\x14\xf4\x7d\x0f\x24\x68\x12\x88\x1c\x22\x47\xcc\xfa\xad\x5f\x0c\x1c\xfd\xef\x6c\x7e\xc1\xf0\x77\x07\x40\xcd\x02\xcf\xdb\xe0\x90\x0e\x09\x1a\x23\x00\x74\xc9\x2c\xa2\x0f\xb2\xc7\x77\x7e\x5c\xf1\x8e\x60\xf3\xf3\xf1\xe0\x49\xd5\x6b\xe0\x0c\xac\x09\x8a\x06\x31\x37\x44\x5f\xac\x51\xc6\x55\xc5\xc4\x49\xc0\xeb\x3e\x78\xa2\x06\x9a\x10\x3a\x61\x7a\x1c\x28\xe2\x7d\x87\xcc\x9f\x1d\x81\x2e\xa7\xf0\xc1\x28\xa0\xe0\x20\x10\xa1\xc1\xb5\x55\x10\x7d\xc1\x29\xd8\x76\x27\x5e\xe0\xd1\x82\x8a\x64\x18\xb9\x80\xb5\x3f\x0c\x5c\x8b\x5a\x4d\x13\x3f\xaf\x14\x24\xc8\x31\x51\xcb\x59\x5c\x62\x75\x9d\x39\x1e\xc6\x3f\x8a\xa8\xc1\x1a\x55\xf1\xa6\x99\xc7\x05\x52\x68\xf0\xd3\xd8\xe7\x4c\x03\xc1\x34\x32\x08\x05\x2e\x9f\x96\x3e\x74\xfe\x9b\xff\x5a\x67\x4e\x43\x41\x62\x64\x02\x9f\xda\x2c\xb3\xe4\x72\x17\xb5\x14\xf1\xb8\xa9\xd3\xde\xd4\x71\x96\xa4\xc5\x74\xf4\xab\x91\x4c\x61\xaa\x81\x16\x29\x59\x6c\x5e\x8a\x91\xc1\xb4\x45\xf8\x64\x62\x0f\x4a\x6f\xd2\x59\xb7\x38\x9c\x0e\xcf\x6e\x1e\x37\x9a\x6c\xb7\xb0\x11\xe3\xd4\x49\x34\x08\x4c\x3f\x5c\x65\x76\x2c\x98\x90\x4c\xac\x21\xd6\xfb\x68\x8a\x09\x16\x92\xae\x1e\xb9\xe3\xd6\x82\x88\x06\xfd\xf6\xd3\x82\x98\x34\x9a\x87\xaa\xb6\x8d\xd9\x94\x1d\xe1\xf8\x6e\xed\x18\x76\x98\x1a\xb1\x60\x7f\xf4\xf4\x39\xc7\xec\xd1\xec\xd8\x78\x25\xea\xf3\xce\xf2\x15\x88\xdf\x9c\xa2\x87\x39\xfb\x3c\xbd\x85\x8c\x58\xc3\xa3\x71\x9f\xd7\xf4\x7d\xe3\x4e\x6d\x4a\x42\x39\x31\x8c\xde\xd0\x7a\xdb\x54\x11\x4d\x57\x1c\x05\x78\xd0\xdd\x27\x81\x5d\x82\x15\x84\x4b\xb9\xba\x07\x2e\xbe\xfc\xd6\x0e\xfb\xb8\x7b\xb3\x23\x13\x0a\xd9\xf8\xe2\x83\x38\xd7\x34\x1a\x2b\x8c\x17\x83\x23\x98\x68\x2f\x1c\x13\xca\xd5\x33\x51\xf8\xea\xe8\x46\xb6\xbe\x5c\x21\xdc\x5e\x58\x30\xd9\xe5\x70\xc7\xff\x60\x8f\xbe\xce\x1b\xde\x05\xb8\xc6\x73\xa0\x3a\x7a\xfb\xd1\x56\x6e\xc3\xfb\xfc\x0a\xed\x85\x5c\x12\xab\xce\xbb\xc0\x8b\x17\x91\x94\xd5\x1a\x45\x37\x65\x39\x94\xbf\x42\x36\xc7\xf3\x1a\xad\xfb\xf3\xee\x7c\xf2\x3a\xa0\x4f\x4f\x6d\x06\x7f\x37\x89\x87\x32\xe0\x81\x80\x32\x1b\xd1\x0c\x66\xda\x02\x47\x41\xf8\x82\x1a\xb8\x20\x63\x29\xc2\xad\x47\x3c\xb6\x56\xde\xcc\x63\x94\x82\x43\xe6\xa3\xcb\xcb\xd1\x78\x8f\x7b\xf3\xf9\xc4\x6a\x4a\x56\xb5\x14\xd1\x18\xe9\x53\xba\x87\xd0\x76\x75\x3a\x9b\xe7\xd5\x3c\x0a\x65\x76\x93\xba\x62\x8a\x9d\x37\x1f\x0c\x61\x04\xb8\x37\x88\xe4\xd2\x8a\xb1\x71\xbf\x9f\xc2\x13\x71\x71\x46\xda\xf7\x59\xdf\xc1\x74\xe3\x32\xe7\xc1\xc2\x1d\x51\xc3\xb1\x8d\xa9\x12\x4a\x0f\x4f\x80\xce\x93\x93\xcf\x12\x6b\xa3\x58\xf9\xc6\x89\x1c\xdc\x53\x7c\xb6\xc6\x66\xbb\x11\xd8\x06\x16\xa8\x49\x39\xba\xe5\x71\xf3\xc2\xef\xa2\x1c\x1a\xe4\x1b\x39\x3b\x22\x32\x62\x67\xa1\x94\xd9\xd9\xd0\x00\xbb\x2d\x90\x73\x45\x07\x2f\x6d\x08\x34\xec\xdf\x14\x7d\xdc\x4e\xe2\xf8\x9d\x79\x89\xfe\xd7\x6b\x91\xdf\xb7\x97\xf0\xad\x20\x2a\xac\x18\xfc\x94\x61\x22\x8f\x4d\x9c\x34\x6e\xac\xc6\xb5\x92\xe3\x7c\x56\x7c\x24\xb3\xae\x9a\x76\x44\x57\x70\x97\xe0\x0b\x67\x20\x85\xae\xcd\x53\x97\xb5\xfa\x18\xb1\x4e\xb9\xe3\x08\xed\x7a\xf4\xb1\x87\x02\xa4\x4f\xd3\xa3\x90\x72\x6a\x13\xfc\x2b\xde\x9b\x22\x1d\xdf\xc3\x1b\x20\xa9\x07\x75\x5c\xd9\x25\xae\x46\x7e\xe0\x94\xe5\xb9\x08\xce\x7b\x83\xbd\xde\x78\x14\x93\x4e\xf0\xd8\x08\x3d\x24\xda\x00\x58\xeb\xc7\x79\x05\x79\x7a\xc3\x21\x10\x00\xe4\x95\xfa\x60\x06\xbd\x01\x6c\xe0\xe0\x2b\xe3\xfb\xde\x5b\x57\x16\x2e\x77\xae\x36\x31\x13\x9d\x0c\xa9\x44\x53\x0f\x00\x8c\xb8\x0c\x2b\x1f\x60\x27\x3d\xe4\x17\xd4\x4f\x72\x1c\x8a\x7e\xca\x42\xb1\x97\xbc\x18\x96\xe6\xd5\x31\x70\x50\x0d\xc5\x47\x6e\x24\x8c\x6c\xa9\xfa\x64\xcf\xde\x1c\xde\xd8\xaa\x19\x09\x7a\xae\xfa\xc1\x93\xe9\x18\x7a\xb4\xeb\x9e\x7b\x1c\x87\xce\x4d\xa0\x34\xbb\x1b\xfa\x86\x95\x2a\x0e\xd1\x0b\x3a\x20\x35\xba\x3a\x36\x56\x6e\x77\xd1\xb7\xf3\x07\x41\x53\x29\xf4\x2e\xad\x49\x07\xfa\x3c\xd7\x0d\x32\x29\x3f\x2e\x9e\x0f\x8b\x9e\xdf\xfd\x34\x31\x4f\xdd\x7f\x7e\xb0\x91\xd6\xf8\xf9\x9d\x30\x6a\xee\x1f\xba\xe0\xb0\x12\x7f\xe7\xb8\x55\xf8\xd0\x46\xcc\xa0\x0c\xd9\x80\x0b\x01\x8a\xa2\x25\x10\x4c\xff\xb4\x14\xef\x1a\x81\x90\xa1\x0d\xae\x29\xda\x89\x5c\x2b\x49\x14\xd1\x1c\xe2\x5e\x0a\xf6\x91\x9d\x27\x96\x9b\x2c\x93\x86\x0f\xb5\x8d\xc9\xa3\x4b\x34\x02\x39\x26\x85\xb2\x73\xe1\x7a\x64\xc1\x5b\xf2\x15\x51\x4a\x34\x24\x5e\x8d\x41\x6d\x20\x3c\x66\xcf\x26\x10\x32\x6c\x01\x37\x5e\xd5\x3d\xfa\x09\x4f\x58\x59\x98\x22\x3e\xe8\x77\x72\xeb\xe0\x3b\x3f\x15\xfe\x1e\x01\x9b\xc6\xad\x1d\x7b\x49\xa3\x4a\x4c\x6b\xae\xe3\x37\x54\x19\xc4\xb4\xdd\xd6\xf2\xc0\xac\xbe\x39\x47\xb9\x13\x9c\x76\xf0\x79\xda\x2d\x7b\x06\x99\x23\xdb\x71\x9f\xad\xcf\x7a\x94\xd5\x87\xd3\x10\x22\x70\xe3\x89\x17\x4b\x9f\x77\x44\x69\x0e\xcb\x79\x47\x75\x89\x04\xd8\xa5\xe6\x57\x56\x0c\x67\x56\x75\x93\x02\xdd\xe8\xf1\x43\xe3\x31\xa2\x7b\xec\xa2\x8e\xcd\x43\x5a\x0c\x95\x30\xd0\x1b\x9d\x7c\xf6\x38\x0d\xb3\x1b\x3d\x8e\x9a\xd6\x5d\xc5\x91\x97\x3b\xde\xcf\x71\x98\x26\xf5\x40\x5a\x92\x24\xa2\xd7\xf3\x39\xc1\xfe\xae\xb8\xd2\x45\x6d\x5b\xe7\x93\xd6\x58\x64\xb9\x1d\xc7\xb1\xfb\xe8\x8f\xb8\x3b\xbc\x4e\x2c\x02\xce\x2e\x02\x58\x59\xe0\xb7\x55\xc5\x1c\xae\xc8\x1f\x99\x27\xaa\x25\x0a\xca\xa7\x5c\xcb\x1f\x1d\x7a\x74\x44\xb1\xeb\x59\xa9\x0f\x35\x49\xa4\x30\xf9\x79\xca\x7a\x1a\xc4\xe7\x34\xcf\x8a\x56\xb5\x25\x62\xcc\x00\xbc\x5e\x71\x44\x1b\x2f\xee\x35\xa3\x97\x53\x4b\x2d\x4a\x04\xdb\x89\xec\x53\x24\xad\x06\x5d\x3c\xc0\xdd\x8e\x69\x0e\x0f\xf5\x8f\x87\xb0\xfb\x18\xfc\xb6\x1e\x62\xf8\x9d\xcd\x2c\x68\xfa\x5f\x9d\xa1\xf1\xe3\x92\xa3\xa3\x06\x2a\x34\x44\x56\x58\x84\x16\xfe\x7d\xca\x77\xe6\xee\x0b\xca\xe2\x9a\x6e\x6d\xec\x6c\x3a\xdc\x3e\x83\xe7\x50\xad\x92\xbb\xfc\x73\x8c\x06\xd8\xb0\x49\x69\x25\x4d\x2c\x72\x48\x2a\x72\x40\x03\x09\x0f\x55\x67\xeb\xa3\x0f\xd2\xb4\xa3\xd5\xdb\x01\xe7\xf4\x0a\x84\x57\xa1\x7d\x88\xab\x3b\x46\x34\x4c\x34\x6c\xfa\x90\x43\xf4\x1c\xca\x32\x46\x49\xf2\xf1\xa9\x70\x62\x1e\x2d\xa1\x7e\xbc\xb6\x1c\x47\x06\xaa\x6e\x47\x07\x78\x68\xfa\x44\xe8\x73\x77\x27\x1d\x04\xf4\x0e\xd6\x7c\x04\x82\x95\xf5\x85\x29\x6b\x81\x56\x74\x80\xfb\x89\x5e\x04\x6e\x9e\x36\x59\x5a\xfb\x92\xd0\x9e\x9d\x91\x0e\x58\xa7\xaf\xa4\x92\x8d\xaa\xa5\xe4\x77\xf9\x61\x04\x76\x87\xd5\x95\x32\x75\xe6\x65\x84\xec\x82\x60\xd1\xde\x89\xa0\xad\xc6\x3e\xdf\x88\x6d\x40\x5b\xf6\xf8\x04\xe7\xf7\xd7\x8a\xc5\xc9\xad\x79\x0f\xf5\x35\x7b\x24\x8f\x0b\x6a\xdc\x87\x90\xd2\xc6\x30\x4b\xb6\x84\x70\xe3\x3b\x06\x38\x57\x73\x2f\xdc\xb9\xca\x3c\xfc\xda\xb2\xef\x1c\x7c\x8c\xd0\xe2\xb6\xc0\xcc\x07\x13\xbd\x39\x4f\xc4\x04\x1d\x35\xa6\x1b\x75\x4d\x85\xf2\x98\x77\x88\x1e\x77\xcd\xd1\xb3\xda\x7f\x4e\x0f\xb2\xf2\xcc\x94\x03\x7d\x69\x30\x6c\x4b\xc6\xe3\x61\x53\x43\x27\x82\x2f\xde\xbd\x6d\x1a\x1c\x99\x05\x79\x0d\x56\x0a\xe8\xd5\x0e\x25\xb2\x8e\xae\xc9\x29\x8e\xca\x7e\x56\x97\x6d\x30\x48\x6b\x9c\x8a\x9c\x44\x44\xc3\xdc\x4e\xcf\x1a\x75\x02\xf2\xc5\xc1\x8a\xc8\x8b\xf5\x81\x59\x24\x0d\x2e\x38\xa4\xbd\x68\xd8\xc7\xa0\x2e\x96\x2f\xf8\x06\x58\x6d\xab\xa6\x3d\x51\x6a\x11\xae\xe0\x9d\x1a\x68\x3c\xb3\xb8\xd7\x32\xe9\xe7\x5d\x47\xb5\x41\xc3\x9e\x3d\xbe\xfb\x31\x20\xb8\x14\x98\xda\xe4\x0d\x0b\x4b\xe4\xdf\xb2\x15\x10\xaa\xc5\xb5\x2d\xae\x9f\x68\x23\xbb\x5f\x70\x52\xd8\x28\x2e\x29\xb7\xf1\x53\x3b\xd7\x65\x3d\x4d\x11\x40\xeb\x89\xa6\x7c\xca\x3f\x9b\xd1\xec\x3d\x85\x98\x84\xd2\x63\x6d\x17\x77\xc4\x4b\x2e\xb3\xf3\x09\x8c\xd7\x63\x72\xaf\x70\x1d\xdc\x10\xe6\x4d\x6a\xaa\x37\x1b\x82\x96\x9e\x71\x56\x90\x7c\x3b\x28\x37\x86\x45\x94\x35\xf9\xe5\x37\xde\x55\x3c\x10\x0e\x58\x0b\x6d\x38\x3d\x06\xc6\x86\x03\x9e\x75\x5a\x0d\x72\x67\xe2\x69\xaf\x9d\x97\x0f\xdf\x97\x25\x28\x45\xe2\xf0\x12\x9c\x3b\x3d\x11\x39\x14\x62\xc1\x17\xcf\xb5\x46\xb5\x10\x2c\x37\x2d\xf1\xf0\xba\xdd\x47\x6c\x95\x15\xc3\x6e\xd2\x42\x8d\xf1\x73\x20\x5f\x77\x36\xa1\xa4\x8f\x08\x53\xc9\xdb\x48\x2e\xfb\xbe\x70\x0d\x74\xcf\x7f\xdb\x69\x42\xe7\xef\xcd\x98\x8b\xe3\x97\x23\x04\xfa\x9f\xb6\x3c\xcc\x74\x62\x78\xc4\x50\x32\xad\x1f\x04\xa6\xf7\x70\xaf\xe3\xe9\xe9\x72\x42\x0d\x74\xdb\x8d\x5a\x49\x03\x92\x9e\x98\x0c\x1e\x61\xf1\x04\x6d\xf8\x41\x0a\x90\x3d\xda\x77\x18\x5e\x04\x35\xd5\x5c\x7b\x5a\xc1\x62\x1a\xb6\x6b\xf5\xcc\xeb\xba\x31\x7f\x5e\xae\xe6\x14\xe6\x49\xb2\x65\x26\xaa\x9b\x8f\x07\x8c\xb9\xc7\xc5\xfb\xe8\xfc\x20\x40\x54\x9c\xc2\xbe\xd7\xc1\xd5\xc2\x16\x0d\xf5\xd2\xd9\x31\xa3\x8f\x3b\x82\x5f\x67\x12\x47\x04\xec\x7f\x48\x88\x7d\xd6\xfd\x0c\x7a\x98\x5f\x84\x02\x1d\x3c\x8b\xd7\xb0\x78\xfe\xba\x15\x16\xfa\xb8\x83\xa3\xad\x06\x2f\xa5\x8a\x38\xc6\xcb\x5b\xaa\xc9\xe9\xc5\x2b\xb5\xf4\x20\x5a\x10\x7a\x20\x6f\x9d\x84\xfd\x76\x0f\x61\x5b\x89\xf8\x5a\x17\xa0\x77\xfc\x32\x28\xe8\xee\x62\x2b\xc6\xea\xb6\x27\xc5\xf2\x21\x30\x60\xe8\x89\xc3\xea\x9c\x9b\x43\x27\x3f\x9b\xee\xc9\xc0\xc4\x32\x5f\x55\x48\x64\x94\x53\xc3\x1b\xbf\x1d\x5c\x2f\x63\x6b\x70\xae\x29\xe3\xf2\xfc\x3a\x84\x35\x74\x2c\xbe\xbe\x4f\xfb\x2b\x6c\xdf\x74\x9f\x1f\x63\xd5\x48\x05\xbc\x37\xf6\xc9\x7e\x48\xa2\x91\x41\x60\x51\xd6\x27\x0e\x18\xd1\x52\x4d\x05\x21\x84\xac\xce\x3c\x20\x75\xeb\x62\x5d\xd4\x33\xad\x48\x2a\xad\x7f\xb6\x80\x4a\xa9\x18\x20\xbc\x04\x1b\x39\x88\xf0\xb5\xca\xcb\x03\xa2\xb3\xa1\x11\x77\x4d\xd6\x56\xa0\x03\xf8\xed\xda\x5c\x43\x96\xae\xa2\xa9\x0a\x05\xa4\x87\x3b\xc8\xd6\x8d\xb4\x3d\x2c\xd3\xdd\xe3\x41\x04\xf2\xb7\x15\x8c\x90\xf4\xe6\x8e\x96\xfc\x78\x2a\xb2\xbd\x60\xe9\x99\x02\xca\x50\xc8\xdd\xb6\x26\x20\xf6\x34\xad\xc8\x47\x43\x8b\xbb\x4d\xce\x08\x83\xd3\x57\xe7\x93\x6d\x64\x51\x37\xb0\x6b\x91\x28\xb2\x3a\x07\x94\x95\x73\xd3\xdb\x3d\x20\x92\x8a\x68\x2e\x6c\x5b\xa2\x93\x71\xe5\xc9\x8e\xb1\x26\xf7\x6d\x19\xd2\xca\x98\x33\x46\x4a\xfe\xa0\x99\x79\xe4\x28\x1e\x55\x84\x86\x2e\xc6\x8a\x90\x9d\x14\x3c\x49\x26\xbd\xa5\x67\xe7\xa6\xb2\x57\x01\xd9\x5e\x3e\x8b\x7a\x5e\x4b\xce\xf1\x74\x77\x3f\x67\x81\xad\x64\x92\xe4\xa1\x07\x2e\x9d\x00\x46\xd7\x48\x1e\xd9\x89\x86\x3a\x5f\x98\x5a\xf3\x4b\xe2\xf9\x8e\x42\xc1\xc0\x7f\xd3\x88\x79\x35\xbf\xb3\xdd\xc2\x07\xea\x97\x63\xda\xa1\x1b\x11\x42\xb4\x5f\xe7\x80\x1e\xe3\x85\x39\x54\xa5\xa0\x54\xb0\x30\x06\xc1\xe4\x87\xbd\xc0\x42\x0b\x22\x0b\x02\xdc\x1e\x4c\x56\xab\x2d\xc6\xb8\x47\x5c\x2c\xb9\x15\xe7\xfc\x60\x0f\x32\xf7\x86\xaa\x85\x77\xe4\xa0\x39\x31\x6f\xe2\x88\x40\xb8\x04\x58\x1a\x1f\x37\x4e\x49\xb1\xb5\x36\xdf\x46\xdd\x4f\x0f\xfd\xc2\xae\x4f\x71\x5d\x13\xb4\x4f\x8e\xc7\x92\x4f\x6b\xee\x20\xd0\x63\x71\xe0\xc9\xad\x71\x93\x6b\x12\x45\x78\xb0\x9a\x23\x9b\xcd\xe5\x0b\x30\x4b\x68\xe9\xd3\x47\x70\xbd\x5e\xd0\xf3\x05\x73\x8e\x4e\xf2\x40\x63\x16\xdb\x23\x5f\xd1\x68\x48\x44\xfc\x0c\x7a\xa3\xc5\x97\xb1\xf7\xb3\x2a\xf0\x56\xe8\xfd\x10\xc4\x35\xc2\xb7\xe8\xcd\xe2\x41\xd7\x7b\x3c\xa0\xd2\x26\x68\x9a\x5f\xbb\x3c\xd9\x96\x63\x4f\x4b\xc4\x02\x5d\xc7\x5f\xc8\x9d\x27\x42\xe7\xb2\x6e\x24\xb6\x6a\xd7\xf9\xc9\xbf\x76\x92\x38\x9b\x85\x16\x9f\x80\x4d\xbb\x08\xcb\x72\x8e\x73\xe7\x75\xfa\x70\xb0\x40\x43\xb2\x58\x5b\x68\xec\x00\x5e\x3a\x61\x9a\xaa\x20\xde\xc0\xd3\xc4\x41\xc6\xbb\x91\xe2\x59\xda\x0c\x14\x03\xc3\x53\xb5\x3f\x49\x22\x42\xad\x5d\x95\x4e\x16\x92\x51\x31\x0e\x63\xb6\x50\x9a\x41\x2e\x79\x0e\xf8\x96\x64\x9c\x18\xb7\xbf\x49\xb9\x6f\xc7\x55\x96\x6d\x7e\x78\x50\xa0\x33\xba\xa7\x0e\xb3\x6a\x17\xa9\x89\xc1\x0c\x44\x27\x07\xd5\xa9\xe3\x7b\x45\xbf\xdc\xca\x51\xa9\xed\x6d\x3f\x25\xab\xd1\x05\x4e\x7a\x9f\x42\xb0\x68\x0e\xc5\x0e\xd0\x88\xc5\xd7\xad\x12\xee\xec\xb6\x6c\xd9\xdb\x4f\xc7\xca\xe0\x20\xc7\xde\xaf\x1b\xf2\x3c\x34\x24\xa4\x44\x3e\x65\xb4\xfc\xb0\x2b\x41\x9a\xc4\xac\xd3\x65\x4e\xc1\xa9\x12\xd8\x5b\x85\x0c\xa2\xb7\x53\xa9\x4d\x01\xcd\xc4\x73\xb5\x8b\xa3\xbb\xd3\x49\xf7\x4d\x23\x5d\x45\x0f\xb7\x03\xbc\xf9\x54\x53\x9f\xfa\xa3\x89\x96\xfe\x52\x95\x05\x7c\xa8\xef\x8f\x06\x1f\x90\x01\x39\xec\xad\xd3\x4d\x35\x72\xa9\xf6\x66\x0a\x69\xd4\x9b\x73\x9b\x7b\x66\x91\x82\x03\xde\xa7\x9a\xc3\x18\xb6\xdc\xc7\xf7\x21\xaa\x2a\xb7\xd3\xd5\x6a\x9e\xa4\xf2\x79\xc6\x9f\x0e\x78\x54\x63\x2f\x8a\xa9\x98\xec\x39\xbc\xc8\xe2\xc8\x5d\x28\x6d\x5a\x89\x1b\x68\xa9\x54\x05\xfb\x59\x8c\x0a\xa7\xc3\x12\xc6\x65\xb6\xc9\x7d\xaa\x9a\x41\xe3\xc9\xe4\x30\x4c\x7a\x8e\x52\xf5\xfa\x60\x25\xe1\x8e\xd0\x87\x6e\xa0\x65\x95\x34\x05\xa5\x3f\x05\xc9\x50\xd9\x24\x7d\xf0\xcd\x1a\xdd\x0f\xe5\xad\x5c\x8f\xaf\xfe\x67\x14\x1a\x39\x36\xab\x0f\x04\xdc\xde\x5f\x57\x07\xf3\x18\x87\xf5\xe4\x50\xbb\x01\x51\xdd\x73\x88\x86\x54\x21\x9e\x13\x61\x51\x32\x1f\x1c\x8f\x46\x18\xb7\x19\x69\x9d\xf4\x8a\xb9\xf3\x9d\x49\x7b\xb7\xef\xce\x11\x10\xac\x0f\x74\x88\x94\x47\x2f\x28\xa9\x40\xaa\x64\xe6\xae\x05\x14\x8b\xcf\x97\xa9\x64\xba\x58\x1e\x08\x7c\xe0\xf9\xf7\xbd\x48\xcd\x5e\xb6\x38\xe5\x9a\x89\x5c\xfb\x8d\x1c\xc5\xbf\x7d\xfb\xf6\xc7\x57\x3a\xcf\xe3\xfe\xed\x2f\x77\x22\xba\xd6\xff\xfe\x0d\x9a\x8e\xff\xf8\x5e\x3e\xa5\x45\xd1\xbc\xaa\x6f\x7f\xf9\x36\xa4\x73\xd5\xbc\xfe\xfd\x1b\x0c\xfd\xed\xe6\xff\xa8\xcb\xb4\x28\xe7\x6f\x7f\xb9\xaf\xbf\x7d\xfb\xa5\xf7\x7f\xff\xf6\x3f\x20\x08\xfa\x8f\xbb\xf4\x7b\xad\x7e\xac\xc6\x9f\xea\xd4\x65\x53\xd5\xeb\xbf\x7f\x43\x1e\x77\x17\xdf\x4b\x7e\xe9\xf5\xc7\x92\xef\x83\x7e\x77\x5c\xcd\x4d\xde\x4f\xcd\x7e\x24\x0a\xc6\x7f\xa9\xf2\x27\x70\x59\xcf\xbe\xfc\xf3\xd7\xe5\xda\xac\xf7\xd5\x5f\xfe\xf2\xed\x8f\x56\xba\xd6\xdf\xfe\xfa\xd7\x3f\x81\x3f\x16\xfd\xdb\x9f\xc0\x2f\x0a\xef\xff\xd9\x58\x9c\xdf\xeb\x16\xcd\xf6\xad\x29\xfe\xf3\x87\x1f\x29\xff\xe1\x5b\xde\xa7\xcb\xf2\x9f\x3f\xdc\x8f\xfe\xc3\x9f\xbf\x0f\xf5\xbd\xc6\x4f\xa5\xf9\xd8\xff\xe1\x58\xfe\x80\xfc\x74\xeb\x57\xcd\xbf\x1e\xe9\xe7\xc6\xdf\xa9\xfd\x5e\xf2\xe7\xff\xe3\x95\x2d\xd3\x7f\xfc\x09\xbc\xeb\xfd\xd4\xdd\xdf\x2e\x7f\xb9\xc8\x66\xf0\x67\x52\xfe\x7e\x20\x18\xf9\x07\x44\x4c\xe9\xab\xec\xbf\x7d\xff\xfc\x43\x51\x3e\xd3\x4f\xbf\xfe\x3d\x3d\xbf\xae\xf7\x87\xaf\xe7\xba\xc5\xf5\x73\x8d\xbb\xce\xb2\xce\xe3\xab\xfa\xb3\xfc\x2a\xca\xe3\xdb\xf8\xfc\xf6\x77\x8c\xfa\xe9\xe6\xdf\xfa\xfb\x85\xf0\x2f\xbe\xa6\x59\x5f\xfe\xad\xfb\x1f\xbf\x7c\xff\xfc\xc3\xdd\xa8\x99\xca\xe2\xd7\x83\xac\xf3\x2f\x5f\xbe\xbe\x16\x7f\x6b\xf7\xa3\x5e\xfd\xf0\xeb\xbb\x7f\x4f\xf7\x2f\xb2\xfe\x7e\xf5\x7f\x65\x7d\xfa\xea\xfe\x01\x27\xff\x46\xe1\x5a\xfc\x17\x23\xfd\xa4\xa9\xff\xcf\xa1\xd2\x6f\xf5\x5c\x3e\xff\xf3\x87\xbf\xfc\xe5\x8f\xcc\xad\xa1\x5a\xf3\xea\xfe\xfa\xd7\x1f\xfe\xfc\xc7\x3f\xfe\x09\x4c\xff\x49\xcf\x7f\xfe\xb9\xf1\xff\xb9\x8c\xf3\xfa\x9f\xeb\x39\x95\x3f\xfc\xd9\xbb\x3f\xbf\xda\xfe\x0b\x0d\xbe\x9b\xd9\x0f\x7f\x76\xef\xcf\x7f\xb1\xc5\x2b\x1d\xee\x06\x52\xba\xd4\xff\xef\x06\xf7\xb7\x5f\x71\xf9\x16\xe3\x9c\xbe\xaa\xf2\xdb\x1f\xb5\x66\x59\xbf\x4c\xf4\xaf\x7f\xfd\x9f\xc8\xe3\x5f\x11\xc0\x5f\xfe\xf2\xf5\x4f\x98\xc7\x81\x3f\xd6\x6f\x7f\x34\x6e\x5a\xbe\x58\xf5\xaf\x8b\xe2\xbf\x64\xfd\xcf\x1a\xf7\xc3\x77\x3b\xfd\xea\xf9\xbb\xfa\xfd\x53\x09\x7c\xd5\xfe\x62\xf8\x8f\x56\xfd\x0f\x6f\x4b\x9f\x21\x7d\x7d\xb1\xf8\xbf\xa8\xf3\x8f\x69\x02\xbf\x1e\x1b\xfc\xde\xfe\x66\xf6\x17\x65\xdf\xbe\x3b\x8d\x1f\xa9\xfd\x5b\xd9\xf7\x01\xdc\xfa\x96\xcc\x4f\x25\xff\x53\x92\x7f\x2d\xa0\xbb\x61\xf9\x2a\x7e\x91\xc9\x7d\xef\xcb\x76\xfe\xb1\x57\xf8\x13\xf8\xa3\x83\xba\xfd\xd5\x3a\xf4\x7f\xfe\xb7\xff\x1b\xf0\xb5\x62\x97\xc4\x9e\x01\x00")
+
+func dirIndexHtmlDirIndexHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_dirIndexHtmlDirIndexHtml,
+		"dir-index-html/dir-index.html",
+	)
+}
+
+func dirIndexHtmlDirIndexHtml() (*asset, error) {
+	bytes, err := dirIndexHtmlDirIndexHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dir-index-html/dir-index.html", size: 106180, mode: os.FileMode(420), modTime: time.Unix(1792380502, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _VendorDirIndexHtmlV100Gxlastpubver = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x0a\xcc\x4d\x8d\xb2\x34\x4d\xcf\x0a\x2c\xa9\x70\x4d\xf2\x4d\xca\x2b\x29\x09\xc9\x0c\xcb\x4c\x4c\x29\xcc\x0a\x4b\x32\xf2\x72\x89\x2a\x0c\x74\x29\x8e\x0c\x74\x34\x71\x72\x8a\x2a\x70\x35\x01\x04\x00\x00\xff\xff\x25\x3d\x09\x1c\x2e\x00\x00\x00")
 
 func VendorDirIndexHtmlV100GxlastpubverBytes() ([]byte, error) {
@@ -377,6 +419,8 @@ var _bindata = map[string]func() (*asset, error){
 	"init-doc/quick-start":                                 initDocQuickStart,
 	"init-doc/readme":                                      initDocReadme,
 	"init-doc/security-notes":                              initDocSecurityNotes,
+	"dir-index-html/dir-index-uncat.html":                  dirIndexHtmlDirIndexUncatHtml,
+	"dir-index-html/dir-index.html":                        dirIndexHtmlDirIndexHtml,
 	"../vendor/dir-index-html-v1.0.0/.gxlastpubver":        VendorDirIndexHtmlV100Gxlastpubver,
 	"../vendor/dir-index-html-v1.0.0/README.md":            VendorDirIndexHtmlV100ReadmeMd,
 	"../vendor/dir-index-html-v1.0.0/dir-index-uncat.html": VendorDirIndexHtmlV100DirIndexUncatHtml,
@@ -438,6 +482,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			}},
 		}},
 	}},
+	"dir-index-html": &bintree{nil, map[string]*bintree{
+		"dir-index-uncat.html": &bintree{dirIndexHtmlDirIndexUncatHtml, map[string]*bintree{}},
+		"dir-index.html":       &bintree{dirIndexHtmlDirIndexHtml, map[string]*bintree{}},
+	}},
 	"init-doc": &bintree{nil, map[string]*bintree{
 		"about":          &bintree{initDocAbout, map[string]*bintree{}},
 		"contact":        &bintree{initDocContact, map[string]*bintree{}},
@@ -15,6 +15,36 @@ import (
 type Block struct {
 	Multihash mh.Multihash
 	Data      []byte
+
+	// Pos is set on blocks whose data can be read back from a file on
+	// disk, see DataPos.
+	Pos *DataPos
+}
+
+// IdentityHash is the multihash code of the identity function. The digest
+// of an identity key is the data of its block itself, so such blocks are
+// never stored nor fetched.
+const IdentityHash = 0x00
+
+// MaxIdentitySize is the largest block an identity key can hold.
+const MaxIdentitySize = 127
+
+// IdentityBlock returns the block held by k if k is an identity key.
+func IdentityBlock(k key.Key) (*Block, bool) {
+	dec, err := mh.Decode(mh.Multihash(k))
+	if err != nil || dec.Code != IdentityHash {
+		return nil, false
+	}
+	return &Block{Data: dec.Digest, Multihash: mh.Multihash(k)}, true
+}
+
+// DataPos locates the data of a block inside a file on disk. Blocks added
+// without copying carry it, so that the blockstore can keep a reference to
+// the file instead of a copy of the data.
+type DataPos struct {
+	Path   string // absolute path of the file
+	Offset uint64 // offset of the data in the file
+	Size   uint64 // length of the data
 }
 
 // NewBlock creates a Block object from opaque data. It will hash the data.
@@ -22,19 +52,50 @@ func NewBlock(data []byte) *Block {
 	return &Block{Data: data, Multihash: u.Hash(data)}
 }
 
+// NewBlockWithHashFunc creates a Block object from opaque data, hashing it
+// with the given multihash function.
+func NewBlockWithHashFunc(data []byte, code int) (*Block, error) {
+	h, err := u.Sum(data, code, -1)
+	if err != nil {
+		return nil, err
+	}
+	return &Block{Data: data, Multihash: h}, nil
+}
+
 // NewBlockWithHash creates a new block when the hash of the data
 // is already known, this is used to save time in situations where
 // we are able to be confident that the data is correct
 func NewBlockWithHash(data []byte, h mh.Multihash) (*Block, error) {
 	if u.Debug {
-		chk := u.Hash(data)
-		if string(chk) != string(h) {
-			return nil, errors.New("Data did not match given hash!")
+		if err := VerifyHash(data, h); err != nil {
+			return nil, err
 		}
 	}
 	return &Block{Data: data, Multihash: h}, nil
 }
 
+// VerifyHash checks that data hashes to h, using the hash function and
+// length named by h.
+func VerifyHash(data []byte, h mh.Multihash) error {
+	dec, err := mh.Decode(h)
+	if err != nil {
+		return err
+	}
+	var chk mh.Multihash
+	if dec.Code == IdentityHash {
+		chk, err = mh.Encode(data, IdentityHash)
+	} else {
+		chk, err = u.Sum(data, dec.Code, dec.Length)
+	}
+	if err != nil {
+		return err
+	}
+	if string(chk) != string(h) {
+		return errors.New("Data did not match given hash!")
+	}
+	return nil
+}
+
 // Key returns the block's Multihash as a Key value.
 func (b *Block) Key() key.Key {
 	return key.Key(b.Multihash)
@@ -1,6 +1,10 @@
 package blocks
 
-import "testing"
+import (
+	"testing"
+
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
+)
 
 func TestBlocksBasic(t *testing.T) {
 
@@ -14,3 +18,28 @@ func TestBlocksBasic(t *testing.T) {
 	// Test some data
 	NewBlock([]byte("Hello world!"))
 }
+
+func TestBlockHashFunc(t *testing.T) {
+	data := []byte("Hello world!")
+	for _, code := range []int{mh.SHA3, mh.BLAKE2B} {
+		b, err := NewBlockWithHashFunc(data, code)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dec, err := mh.Decode(b.Multihash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dec.Code != code {
+			t.Fatalf("block hashed with %s, expected %s", dec.Name, mh.Codes[code])
+		}
+
+		if err := VerifyHash(data, b.Multihash); err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyHash([]byte("Hello world?"), b.Multihash); err == nil {
+			t.Fatal("expected a hash mismatch")
+		}
+	}
+}
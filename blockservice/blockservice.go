@@ -43,6 +43,10 @@ func New(bs blockstore.Blockstore, rem exchange.Interface) *BlockService {
 // TODO pass a context into this if the remote.HasBlock is going to remain here.
 func (s *BlockService) AddBlock(b *blocks.Block) (key.Key, error) {
 	k := b.Key()
+	if _, ok := blocks.IdentityBlock(k); ok {
+		// the key holds the data, there is nothing to store
+		return k, nil
+	}
 	err := s.Blockstore.Put(b)
 	if err != nil {
 		return k, err
@@ -54,17 +58,24 @@ func (s *BlockService) AddBlock(b *blocks.Block) (key.Key, error) {
 }
 
 func (s *BlockService) AddBlocks(bs []*blocks.Block) ([]key.Key, error) {
-	err := s.Blockstore.PutMany(bs)
+	var ks []key.Key
+	var stored []*blocks.Block
+	for _, b := range bs {
+		ks = append(ks, b.Key())
+		if _, ok := blocks.IdentityBlock(b.Key()); !ok {
+			stored = append(stored, b)
+		}
+	}
+
+	err := s.Blockstore.PutMany(stored)
 	if err != nil {
 		return nil, err
 	}
 
-	var ks []key.Key
-	for _, b := range bs {
+	for _, b := range stored {
 		if err := s.Exchange.HasBlock(b); err != nil {
 			return nil, errors.New("blockservice is closed")
 		}
-		ks = append(ks, b.Key())
 	}
 	return ks, nil
 }
@@ -73,6 +84,9 @@ func (s *BlockService) AddBlocks(bs []*blocks.Block) ([]key.Key, error) {
 // Getting it from the datastore using the key (hash).
 func (s *BlockService) GetBlock(ctx context.Context, k key.Key) (*blocks.Block, error) {
 	log.Debugf("BlockService GetBlock: '%s'", k)
+	if b, ok := blocks.IdentityBlock(k); ok {
+		return b, nil
+	}
 	block, err := s.Blockstore.Get(k)
 	if err == nil {
 		return block, nil
@@ -109,10 +123,14 @@ func (s *BlockService) GetBlocks(ctx context.Context, ks []key.Key) <-chan *bloc
 		defer close(out)
 		var misses []key.Key
 		for _, k := range ks {
-			hit, err := s.Blockstore.Get(k)
-			if err != nil {
-				misses = append(misses, k)
-				continue
+			hit, ok := blocks.IdentityBlock(k)
+			if !ok {
+				var err error
+				hit, err = s.Blockstore.Get(k)
+				if err != nil {
+					misses = append(misses, k)
+					continue
+				}
 			}
 			log.Debug("Blockservice: Got data in datastore.")
 			select {
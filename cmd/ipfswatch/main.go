@@ -130,7 +130,7 @@ func run(ipfsPath, watchPath string) error {
 						log.Println(err)
 					}
 					defer file.Close()
-					k, err := coreunix.Add(node, file)
+					k, err := coreunix.Add(node, file, true)
 					if err != nil {
 						log.Println(err)
 					}
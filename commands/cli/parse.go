@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"runtime"
 	"strings"
 
@@ -42,7 +43,23 @@ func Parse(input []string, stdin *os.File, root *cmds.Command) (cmds.Request, *c
 		}
 	}
 
-	stringArgs, fileArgs, err := parseArgs(stringVals, stdin, cmd.Arguments, recursive, root)
+	filter, err := parseFilter(req)
+	if err != nil {
+		return req, cmd, path, err
+	}
+
+	// with --nocopy, files are referenced from where they are on disk, so
+	// their absolute paths are kept
+	abspaths := false
+	nocopyOpt := req.Option(cmds.NoCopyOpt)
+	if nocopyOpt != nil && nocopyOpt.Definition() == cmds.OptionNoCopy {
+		abspaths, _, err = nocopyOpt.Bool()
+		if err != nil {
+			return req, nil, nil, u.ErrCast()
+		}
+	}
+
+	stringArgs, fileArgs, err := parseArgs(stringVals, stdin, cmd.Arguments, recursive, abspaths, filter, root)
 	if err != nil {
 		return req, cmd, path, err
 	}
@@ -212,7 +229,36 @@ func parseOpts(args []string, root *cmds.Command) (
 	return
 }
 
-func parseArgs(inputs []string, stdin *os.File, argDefs []cmds.Argument, recursive bool, root *cmds.Command) ([]string, []files.File, error) {
+// parseFilter returns the filter of the files of commands with the builtin
+// ignore options, which honors ignore files even if none of the options are
+// given. It returns nil for other commands.
+func parseFilter(req cmds.Request) (*files.Filter, error) {
+	ignoreOpt := req.Option(cmds.IgnoreOpt)
+	if ignoreOpt == nil || ignoreOpt.Definition() != cmds.OptionIgnore {
+		return nil, nil
+	}
+
+	ignore, _, err := ignoreOpt.String()
+	if err != nil {
+		return nil, u.ErrCast()
+	}
+	var patterns []string
+	if ignore != "" {
+		patterns = strings.Split(ignore, ",")
+	}
+
+	var rulesPath string
+	if rulesOpt := req.Option(cmds.RulesOpt); rulesOpt != nil && rulesOpt.Definition() == cmds.OptionIgnoreRulesPath {
+		rulesPath, _, err = rulesOpt.String()
+		if err != nil {
+			return nil, u.ErrCast()
+		}
+	}
+
+	return files.NewFilter(patterns, rulesPath)
+}
+
+func parseArgs(inputs []string, stdin *os.File, argDefs []cmds.Argument, recursive, abspaths bool, filter *files.Filter, root *cmds.Command) ([]string, []files.File, error) {
 	// ignore stdin on Windows
 	if runtime.GOOS == "windows" {
 		stdin = nil
@@ -270,6 +316,11 @@ func parseArgs(inputs []string, stdin *os.File, argDefs []cmds.Argument, recursi
 			argDefIndex++
 			argDef = getArgDef(argDefIndex, argDefs)
 		}
+		// and those not taking stdin, if only stdin is left
+		for len(inputs) == 0 && !argDef.Required && !argDef.SupportsStdin && argDefIndex < len(argDefs)-1 {
+			argDefIndex++
+			argDef = getArgDef(argDefIndex, argDefs)
+		}
 		if argDef.Required {
 			numRequired--
 		}
@@ -295,7 +346,7 @@ func parseArgs(inputs []string, stdin *os.File, argDefs []cmds.Argument, recursi
 		} else if argDef.Type == cmds.ArgFile {
 			if stdin == nil || !argDef.SupportsStdin {
 				// treat stringArg values as file paths
-				fileArgs, inputs, err = appendFile(fileArgs, inputs, argDef, recursive)
+				fileArgs, inputs, err = appendFile(fileArgs, inputs, argDef, recursive, abspaths, filter)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -356,7 +407,7 @@ func appendStdinAsString(args []string, stdin *os.File) ([]string, *os.File, err
 	return append(args, strings.Split(input, "\n")...), nil, nil
 }
 
-func appendFile(args []files.File, inputs []string, argDef *cmds.Argument, recursive bool) ([]files.File, []string, error) {
+func appendFile(args []files.File, inputs []string, argDef *cmds.Argument, recursive, abspaths bool, filter *files.Filter) ([]files.File, []string, error) {
 	fpath := inputs[0]
 
 	if fpath == "." {
@@ -384,7 +435,15 @@ func appendFile(args []files.File, inputs []string, argDef *cmds.Argument, recur
 		}
 	}
 
-	arg, err := files.NewSerialFile(path.Base(fpath), fpath, stat)
+	fullpath := fpath
+	if abspaths {
+		fullpath, err = filepath.Abs(fpath)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	arg, err := files.NewSerialFile(path.Base(fpath), fullpath, stat, filter)
 	if err != nil {
 		return nil, nil, err
 	}
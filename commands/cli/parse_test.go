@@ -190,6 +190,13 @@ func TestArgumentParsing(t *testing.T) {
 					commands.StringArg("b", true, false, "another arg").EnableStdin(),
 				},
 			},
+			"optionalthenstdin": &commands.Command{
+				Arguments: []commands.Argument{
+					commands.StringArg("a", true, false, "some arg"),
+					commands.StringArg("b", false, false, "another arg"),
+					commands.StringArg("c", false, true, "a third arg").EnableStdin(),
+				},
+			},
 		},
 	}
 
@@ -298,4 +305,9 @@ func TestArgumentParsing(t *testing.T) {
 
 	fstdin = fileToSimulateStdin(t, "stdin1")
 	test([]string{"optionalsecond", "value1", "value2"}, fstdin, []string{"value1", "value2"})
+
+	fstdin = fileToSimulateStdin(t, "stdin1\nstdin2")
+	test([]string{"optionalthenstdin", "value1"}, fstdin, []string{"value1", "stdin1", "stdin2"})
+	test([]string{"optionalthenstdin", "value1", "value2"}, fstdin, []string{"value1", "value2", "stdin1", "stdin2"})
+	test([]string{"optionalthenstdin", "value1", "value2", "value3"}, fstdin, []string{"value1", "value2", "value3"})
 }
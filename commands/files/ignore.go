@@ -0,0 +1,193 @@
+package files
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	fp "path/filepath"
+	"regexp"
+	"strings"
+)
+
+// IgnoreFileName is the name of the files listing patterns of entries to
+// ignore in their directory, like .gitignore files.
+const IgnoreFileName = ".ipfsignore"
+
+// Filter decides which entries of a directory tree are ignored, using
+// gitignore-style patterns. Patterns given to NewFilter apply to the whole
+// tree, patterns of an IgnoreFileName file to the directory it is in and
+// below. The last pattern matching an entry decides whether it is ignored.
+type Filter struct {
+	rules []ignoreRule
+}
+
+type ignoreRule struct {
+	base    string // directory the pattern is relative to
+	re      *regexp.Regexp
+	negate  bool // the pattern starts with '!'
+	dirOnly bool // the pattern ends with '/'
+	anchor  bool // the pattern is matched against the path, not the name
+}
+
+// NewFilter returns a Filter ignoring the entries that match patterns, or
+// the patterns in the file at rulesPath if it is not empty.
+func NewFilter(patterns []string, rulesPath string) (*Filter, error) {
+	f := new(Filter)
+	for _, p := range patterns {
+		f.addPattern("", p)
+	}
+
+	if rulesPath != "" {
+		rf, err := os.Open(rulesPath)
+		if err != nil {
+			return nil, err
+		}
+		defer rf.Close()
+
+		if err := f.readPatterns("", rf); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (f *Filter) readPatterns(base string, r io.Reader) error {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		f.addPattern(base, s.Text())
+	}
+	return s.Err()
+}
+
+func (f *Filter) addPattern(base, p string) {
+	p = strings.TrimRight(p, " \t\r")
+	if p == "" || p[0] == '#' {
+		return
+	}
+
+	r := ignoreRule{base: base}
+	switch {
+	case p[0] == '!':
+		r.negate = true
+		p = p[1:]
+	case strings.HasPrefix(p, `\#`), strings.HasPrefix(p, `\!`):
+		p = p[1:]
+	}
+	if strings.HasSuffix(p, "/") {
+		r.dirOnly = true
+		p = strings.TrimRight(p, "/")
+	}
+	if strings.Contains(p, "/") {
+		r.anchor = true
+		p = strings.TrimPrefix(p, "/")
+	}
+	if p == "" {
+		return
+	}
+
+	re, err := regexp.Compile("^" + globToRegexp(p) + "$")
+	if err != nil {
+		// an invalid character class, it can not match anything
+		return
+	}
+	r.re = re
+	f.rules = append(f.rules, r)
+}
+
+// globToRegexp translates a gitignore glob to a regular expression. '*' and
+// '?' do not match '/', '**' matches any number of directories.
+func globToRegexp(glob string) string {
+	var re string
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; {
+		case strings.HasPrefix(glob[i:], "**/"):
+			re += "(.*/)?"
+			i += 2
+		case strings.HasPrefix(glob[i:], "/**") && i+3 == len(glob):
+			re += "/.*"
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			re += ".*"
+			i++
+		case c == '*':
+			re += "[^/]*"
+		case c == '?':
+			re += "[^/]"
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				re += `\[`
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			re += "[" + strings.Replace(class, `\`, `\\`, -1) + "]"
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			re += regexp.QuoteMeta(glob[i : i+1])
+		default:
+			re += regexp.QuoteMeta(string(c))
+		}
+	}
+	return re
+}
+
+// Ignored reports whether the entry at rel, a slash separated path relative
+// to the root of the tree, is ignored.
+func (f *Filter) Ignored(rel string, isDir bool) bool {
+	if f == nil {
+		return false
+	}
+
+	ignored := false
+	for _, r := range f.rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+
+		sub := rel
+		if r.base != "" {
+			if !strings.HasPrefix(rel, r.base+"/") {
+				continue
+			}
+			sub = rel[len(r.base)+1:]
+		}
+		if !r.anchor {
+			sub = path.Base(sub)
+		}
+
+		if r.re.MatchString(sub) {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
+
+// enter returns the filter for the entries of the directory at rel, with
+// the patterns of the IgnoreFileName file in it if there is one. dirPath is
+// the path of the directory on disk.
+func (f *Filter) enter(dirPath, rel string) (*Filter, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	igf, err := os.Open(fp.Join(dirPath, IgnoreFileName))
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer igf.Close()
+
+	nf := &Filter{rules: make([]ignoreRule, len(f.rules))}
+	copy(nf.rules, f.rules)
+	if err := nf.readPatterns(rel, igf); err != nil {
+		return nil, err
+	}
+	return nf, nil
+}
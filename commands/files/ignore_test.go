@@ -0,0 +1,113 @@
+package files
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestFilterPatterns(t *testing.T) {
+	f, err := NewFilter([]string{"*.log", "build/", "/docs/*.md", "!keep.log", "a/**/z"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		rel     string
+		isDir   bool
+		ignored bool
+	}{
+		{"error.log", false, true},
+		{"sub/error.log", false, true},
+		{"keep.log", false, false},
+		{"build", true, true},
+		{"build", false, false},
+		{"sub/build", true, true},
+		{"docs/readme.md", false, true},
+		{"sub/docs/readme.md", false, false},
+		{"a/z", false, true},
+		{"a/b/c/z", false, true},
+		{"main.go", false, false},
+	}
+
+	for _, c := range cases {
+		if got := f.Ignored(c.rel, c.isDir); got != c.ignored {
+			t.Errorf("%s: expected ignored to be %v", c.rel, c.ignored)
+		}
+	}
+
+	var nf *Filter
+	if nf.Ignored("error.log", false) {
+		t.Error("a nil filter should not ignore anything")
+	}
+}
+
+func TestSerialFileIgnore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ipfsignore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name, data string) {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a.txt", "a")
+	write("a.tmp", "tmp")
+	write("sub/"+IgnoreFileName, "b.txt\n")
+	write("sub/b.txt", "b")
+	write("sub/c.txt", "c")
+	write("skip/d.txt", "d")
+
+	filter, err := NewFilter([]string{"*.tmp", "skip/"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stat, err := os.Stat(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sf, err := NewSerialFile("root", dir, stat, filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	var walk func(f File)
+	walk = func(f File) {
+		for {
+			child, err := f.NextFile()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			names = append(names, child.FileName())
+			if child.IsDirectory() {
+				walk(child)
+			}
+		}
+	}
+	walk(sf)
+	sort.Strings(names)
+
+	expected := []string{"root/a.txt", "root/sub", "root/sub/" + IgnoreFileName, "root/sub/c.txt"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, names)
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
+	"time"
 )
 
 const (
@@ -15,6 +18,18 @@ const (
 	applicationSymlink = "application/symlink"
 
 	contentTypeHeader = "Content-Type"
+
+	// AbspathHeader carries the absolute path of a file on the client, for
+	// files the daemon may read directly when it runs on the same machine.
+	AbspathHeader = "Abspath"
+
+	// ModeHeader and MtimeHeader carry the permission bits, in octal, and
+	// the modification time, in RFC 3339 format, of a file on the client.
+	// SizeHeader carries its size in bytes, for files that are not
+	// directories.
+	ModeHeader  = "Mode"
+	MtimeHeader = "Mtime"
+	SizeHeader  = "Size"
 )
 
 // MultipartFile implements File, and is created from a `multipart.Part`.
@@ -95,9 +110,58 @@ func (f *MultipartFile) FileName() string {
 }
 
 func (f *MultipartFile) FullPath() string {
+	if f.Part != nil {
+		if p := f.Part.Header.Get(AbspathHeader); p != "" {
+			return p
+		}
+	}
 	return f.FileName()
 }
 
+// Stat returns the mode, modification time and size sent along with the
+// file, or nil if there are none. Other fields of the result are not set.
+func (f *MultipartFile) Stat() os.FileInfo {
+	if f.Part == nil {
+		return nil
+	}
+	mode := f.Part.Header.Get(ModeHeader)
+	mtime := f.Part.Header.Get(MtimeHeader)
+	size := f.Part.Header.Get(SizeHeader)
+	if mode == "" && mtime == "" && size == "" {
+		return nil
+	}
+
+	fi := &partInfo{name: f.FileName()}
+	if m, err := strconv.ParseUint(mode, 8, 32); err == nil {
+		fi.mode = os.FileMode(m) & os.ModePerm
+	}
+	if t, err := time.Parse(time.RFC3339Nano, mtime); err == nil {
+		fi.mtime = t
+	}
+	if n, err := strconv.ParseInt(size, 10, 64); err == nil {
+		fi.size = n
+	}
+	if f.IsDirectory() {
+		fi.mode |= os.ModeDir
+	}
+	return fi
+}
+
+// partInfo is the os.FileInfo of a multipart file.
+type partInfo struct {
+	name  string
+	mode  os.FileMode
+	mtime time.Time
+	size  int64
+}
+
+func (fi *partInfo) Name() string       { return fi.name }
+func (fi *partInfo) Size() int64        { return fi.size }
+func (fi *partInfo) Mode() os.FileMode  { return fi.mode }
+func (fi *partInfo) ModTime() time.Time { return fi.mtime }
+func (fi *partInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi *partInfo) Sys() interface{}   { return nil }
+
 func (f *MultipartFile) Read(p []byte) (int, error) {
 	if f.IsDirectory() {
 		return 0, ErrNotReader
@@ -5,6 +5,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	fp "path/filepath"
 	"syscall"
 )
@@ -15,12 +16,21 @@ import (
 type serialFile struct {
 	name    string
 	path    string
+	rel     string // path relative to the root, for the filter
+	filter  *Filter
 	files   []os.FileInfo
 	stat    os.FileInfo
 	current *File
 }
 
-func NewSerialFile(name, path string, stat os.FileInfo) (File, error) {
+// NewSerialFile returns a File reading the file or directory at path. The
+// entries of directories that filter ignores are skipped without being
+// read, filter may be nil.
+func NewSerialFile(name, path string, stat os.FileInfo, filter *Filter) (File, error) {
+	return newSerialFile(name, path, "", stat, filter)
+}
+
+func newSerialFile(name, path, rel string, stat os.FileInfo, filter *Filter) (File, error) {
 	switch mode := stat.Mode(); {
 	case mode.IsRegular():
 		file, err := os.Open(path)
@@ -31,11 +41,15 @@ func NewSerialFile(name, path string, stat os.FileInfo) (File, error) {
 	case mode.IsDir():
 		// for directories, stat all of the contents first, so we know what files to
 		// open when NextFile() is called
-		contents, err := ioutil.ReadDir(path)
+		filter, err := filter.enter(path, rel)
 		if err != nil {
 			return nil, err
 		}
-		return &serialFile{name, path, contents, stat, nil}, nil
+		contents, err := readDir(path, rel, filter)
+		if err != nil {
+			return nil, err
+		}
+		return &serialFile{name, path, rel, filter, contents, stat, nil}, nil
 	case mode&os.ModeSymlink != 0:
 		target, err := os.Readlink(path)
 		if err != nil {
@@ -47,6 +61,23 @@ func NewSerialFile(name, path string, stat os.FileInfo) (File, error) {
 	}
 }
 
+// readDir returns the entries of the directory at dirPath, without the ones
+// ignored by filter.
+func readDir(dirPath, rel string, filter *Filter) ([]os.FileInfo, error) {
+	contents, err := ioutil.ReadDir(dirPath)
+	if err != nil || filter == nil {
+		return contents, err
+	}
+
+	var kept []os.FileInfo
+	for _, fi := range contents {
+		if !filter.Ignored(path.Join(rel, fi.Name()), fi.IsDir()) {
+			kept = append(kept, fi)
+		}
+	}
+	return kept, nil
+}
+
 func (f *serialFile) IsDirectory() bool {
 	// non-directories get created as a ReaderFile, so serialFiles should only
 	// represent directories
@@ -75,7 +106,8 @@ func (f *serialFile) NextFile() (File, error) {
 	// recursively call the constructor on the next file
 	// if it's a regular file, we will open it as a ReaderFile
 	// if it's a directory, files in it will be opened serially
-	sf, err := NewSerialFile(fileName, filePath, stat)
+	rel := path.Join(f.rel, stat.Name())
+	sf, err := newSerialFile(fileName, filePath, rel, stat, f.filter)
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +137,7 @@ func (f *serialFile) Close() error {
 		if err != nil && err != syscall.EINVAL {
 			return err
 		}
+		f.current = nil
 	}
 
 	return nil
@@ -119,12 +152,34 @@ func (f *serialFile) Size() (int64, error) {
 		return f.stat.Size(), nil
 	}
 
+	return dirSize(f.path, f.rel, f.files, f.filter)
+}
+
+// dirSize returns the size of the files in the directory at dirPath, given
+// its entries, skipping the ones filter ignores.
+func dirSize(dirPath, rel string, entries []os.FileInfo, filter *Filter) (int64, error) {
 	var du int64
-	err := fp.Walk(f.FileName(), func(p string, fi os.FileInfo, err error) error {
-		if fi != nil && fi.Mode()&(os.ModeSymlink|os.ModeNamedPipe) == 0 {
+	for _, fi := range entries {
+		switch {
+		case fi.Mode().IsRegular():
 			du += fi.Size()
+		case fi.IsDir():
+			subPath := fp.Join(dirPath, fi.Name())
+			subRel := path.Join(rel, fi.Name())
+			subFilter, err := filter.enter(subPath, subRel)
+			if err != nil {
+				return 0, err
+			}
+			sub, err := readDir(subPath, subRel, subFilter)
+			if err != nil {
+				return 0, err
+			}
+			n, err := dirSize(subPath, subRel, sub, subFilter)
+			if err != nil {
+				return 0, err
+			}
+			du += n
 		}
-		return nil
-	})
-	return du, err
+	}
+	return du, nil
 }
@@ -78,6 +78,9 @@ func (c *client) Send(req cmds.Request) (cmds.Response, error) {
 
 	if req.Files() != nil {
 		fileReader = NewMultiFileReader(req.Files(), true)
+		if nocopy := req.Option(cmds.NoCopyOpt); nocopy != nil && nocopy.Definition() == cmds.OptionNoCopy {
+			fileReader.abspaths, _, _ = nocopy.Bool()
+		}
 		reader = fileReader
 	} else {
 		// if we have no file data, use an empty Reader
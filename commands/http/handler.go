@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -136,6 +137,13 @@ func (i internalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !allowNoCopy(r, req) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("403 - Forbidden: --nocopy is only allowed from local clients"))
+		log.Warningf("API blocked --nocopy request from %s", r.RemoteAddr)
+		return
+	}
+
 	// get the node's context to pass into the commands.
 	node, err := i.ctx.GetNode()
 	if err != nil {
@@ -371,3 +379,23 @@ func allowReferer(r *http.Request, cfg *ServerConfig) bool {
 
 	return false
 }
+
+// allowNoCopy only lets --nocopy requests through from loopback addresses:
+// the daemon reads the files they reference from its own disk, with paths
+// given by the client.
+func allowNoCopy(r *http.Request, req cmds.Request) bool {
+	opt := req.Option(cmds.NoCopyOpt)
+	if opt == nil || opt.Definition() != cmds.OptionNoCopy {
+		return true
+	}
+	if nocopy, _, _ := opt.Bool(); !nocopy {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -346,3 +346,27 @@ func TestAllowedMethod(t *testing.T) {
 		tc.test(t)
 	}
 }
+
+func TestAllowNoCopy(t *testing.T) {
+	optDefs := map[string]cmds.Option{cmds.NoCopyOpt: cmds.OptionNoCopy}
+
+	for _, tc := range []struct {
+		remote string
+		nocopy bool
+		allow  bool
+	}{
+		{"127.0.0.1:4242", true, true},
+		{"[::1]:4242", true, true},
+		{"10.0.0.2:4242", true, false},
+		{"10.0.0.2:4242", false, true},
+	} {
+		req, err := cmds.NewRequest(nil, cmds.OptMap{cmds.NoCopyOpt: tc.nocopy}, nil, nil, nil, optDefs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := &http.Request{RemoteAddr: tc.remote}
+		if allowNoCopy(r, req) != tc.allow {
+			t.Errorf("nocopy=%t from %s: expected allowed=%t", tc.nocopy, tc.remote, tc.allow)
+		}
+	}
+}
@@ -7,7 +7,10 @@ import (
 	"mime/multipart"
 	"net/textproto"
 	"net/url"
+	"path/filepath"
+	"strconv"
 	"sync"
+	"time"
 
 	files "github.com/ipfs/go-ipfs/commands/files"
 )
@@ -27,6 +30,10 @@ type MultiFileReader struct {
 	// if true, the data will be type 'multipart/form-data'
 	// if false, the data will be type 'multipart/mixed'
 	form bool
+
+	// if true, files with an absolute path are sent with it in an Abspath
+	// header, for the daemon to read them from disk (add --nocopy)
+	abspaths bool
 }
 
 // NewMultiFileReader constructs a MultiFileReader. `file` can be any `commands.File`.
@@ -74,6 +81,7 @@ func (mfr *MultiFileReader) Read(buf []byte) (written int, err error) {
 				// if file is a directory, create a multifilereader from it
 				// (using 'multipart/mixed')
 				nmfr := NewMultiFileReader(file, false)
+				nmfr.abspaths = mfr.abspaths
 				mfr.currentFile = nmfr
 				contentType = fmt.Sprintf("multipart/mixed; boundary=%s", nmfr.Boundary())
 			} else {
@@ -93,6 +101,17 @@ func (mfr *MultiFileReader) Read(buf []byte) (written int, err error) {
 			}
 
 			header.Set("Content-Type", contentType)
+			if fpath := file.FullPath(); mfr.abspaths && filepath.IsAbs(fpath) {
+				header.Set(files.AbspathHeader, fpath)
+			}
+			if sf, ok := file.(files.StatFile); ok && sf.Stat() != nil {
+				stat := sf.Stat()
+				header.Set(files.ModeHeader, strconv.FormatUint(uint64(stat.Mode().Perm()), 8))
+				header.Set(files.MtimeHeader, stat.ModTime().Format(time.RFC3339Nano))
+				if !stat.IsDir() {
+					header.Set(files.SizeHeader, strconv.FormatInt(stat.Size(), 10))
+				}
+			}
 
 			_, err := mfr.mpWriter.CreatePart(header)
 			if err != nil {
@@ -4,8 +4,10 @@ import (
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	files "github.com/ipfs/go-ipfs/commands/files"
 )
@@ -104,3 +106,79 @@ func TestOutput(t *testing.T) {
 		t.Error("Expected to get (nil, io.EOF)")
 	}
 }
+
+func TestOutputStat(t *testing.T) {
+	tmp, err := ioutil.TempFile("", "multifilereader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString("hello"); err != nil {
+		t.Fatal(err)
+	}
+	tmp.Close()
+
+	mtime := time.Unix(1445000000, 123456789)
+	if err := os.Chmod(tmp.Name(), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(tmp.Name(), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	stat, err := os.Stat(tmp.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileset := []files.File{
+		files.NewReaderFile("run.sh", "run.sh", ioutil.NopCloser(strings.NewReader("")), stat),
+	}
+	mfr := NewMultiFileReader(files.NewSliceFile("", "", fileset), true)
+	mpReader := multipart.NewReader(mfr, mfr.Boundary())
+
+	part, err := mpReader.NextPart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mpf, err := files.NewFileFromPart(part)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fi := mpf.(files.StatFile).Stat()
+	if fi == nil {
+		t.Fatal("Expected the file to have a stat")
+	}
+	if fi.Mode() != 0750 {
+		t.Error("Expected mode to be 0750, got", fi.Mode())
+	}
+	if !fi.ModTime().Equal(mtime) {
+		t.Error("Expected mtime to be", mtime, "got", fi.ModTime())
+	}
+	if fi.Size() != 5 {
+		t.Error("Expected size to be 5, got", fi.Size())
+	}
+}
+
+func TestOutputAbspath(t *testing.T) {
+	for _, abspaths := range []bool{false, true} {
+		fileset := []files.File{
+			files.NewReaderFile("file.txt", "/home/user/file.txt", ioutil.NopCloser(strings.NewReader("")), nil),
+		}
+		mfr := NewMultiFileReader(files.NewSliceFile("", "", fileset), true)
+		mfr.abspaths = abspaths
+		mpReader := multipart.NewReader(mfr, mfr.Boundary())
+
+		part, err := mpReader.NextPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sent := part.Header.Get(files.AbspathHeader)
+		if abspaths && sent != "/home/user/file.txt" {
+			t.Errorf("Expected the absolute path to be sent, got %q", sent)
+		}
+		if !abspaths && sent != "" {
+			t.Errorf("Expected no absolute path without --nocopy, got %q", sent)
+		}
+	}
+}
@@ -95,13 +95,16 @@ func Parse(r *http.Request, root *cmds.Command) (cmds.Request, error) {
 	contentType := r.Header.Get(contentTypeHeader)
 	mediatype, _, _ := mime.ParseMediaType(contentType)
 
-	var f *files.MultipartFile
+	// f stays a nil interface without a body, for commands checking
+	// whether files were sent
+	var f files.File
 	if mediatype == "multipart/form-data" {
-		f = &files.MultipartFile{Mediatype: mediatype}
-		f.Reader, err = r.MultipartReader()
+		mf := &files.MultipartFile{Mediatype: mediatype}
+		mf.Reader, err = r.MultipartReader()
 		if err != nil {
 			return nil, err
 		}
+		f = mf
 	}
 
 	// if there is a required filearg, error if no files were provided
@@ -158,6 +158,9 @@ const (
 	EncLong    = "encoding"
 	RecShort   = "r"
 	RecLong    = "recursive"
+	IgnoreOpt  = "ignore"
+	RulesOpt   = "ignore-rules-path"
+	NoCopyOpt  = "nocopy"
 	ChanOpt    = "stream-channels"
 	TimeoutOpt = "timeout"
 )
@@ -165,6 +168,9 @@ const (
 // options that are used by this package
 var OptionEncodingType = StringOption(EncLong, EncShort, "The encoding type the output should be encoded with (json, xml, or text)")
 var OptionRecursivePath = BoolOption(RecLong, RecShort, "Add directory paths recursively")
+var OptionIgnore = StringOption(IgnoreOpt, "Comma separated gitignore-style patterns of paths to skip")
+var OptionIgnoreRulesPath = StringOption(RulesOpt, "A file with gitignore-style patterns of paths to skip")
+var OptionNoCopy = BoolOption(NoCopyOpt, "Reference the files on disk instead of copying their data (experimental)")
 var OptionStreamChannels = BoolOption(ChanOpt, "Stream channel output")
 var OptionTimeout = StringOption(TimeoutOpt, "set a global timeout on the command")
 
@@ -13,6 +13,8 @@ import (
 	key "github.com/ipfs/go-ipfs/blocks/key"
 	bserv "github.com/ipfs/go-ipfs/blockservice"
 	offline "github.com/ipfs/go-ipfs/exchange/offline"
+	filestore "github.com/ipfs/go-ipfs/filestore"
+	ipnsfs "github.com/ipfs/go-ipfs/ipnsfs"
 	dag "github.com/ipfs/go-ipfs/merkledag"
 	ci "github.com/ipfs/go-ipfs/p2p/crypto"
 	peer "github.com/ipfs/go-ipfs/p2p/peer"
@@ -129,7 +131,9 @@ func setupNode(ctx context.Context, n *IpfsNode, cfg *BuildCfg) error {
 	}
 
 	var err error
-	n.Blockstore, err = bstore.WriteCached(bstore.NewBlockstore(n.Repo.Datastore()), kSizeBlockstoreWriteCache)
+	n.Filestore = filestore.NewFilestore(n.Repo.Datastore())
+	bs := filestore.NewBlockstore(bstore.NewBlockstore(n.Repo.Datastore()), n.Filestore)
+	n.Blockstore, err = bstore.WriteCached(bs, kSizeBlockstoreWriteCache)
 	if err != nil {
 		return err
 	}
@@ -159,5 +163,7 @@ func setupNode(ctx context.Context, n *IpfsNode, cfg *BuildCfg) error {
 	}
 	n.Resolver = &path.Resolver{DAG: n.DAG}
 
+	n.FilesRoot = ipnsfs.NewLocalRoot(ctx, n.DAG, n.Pinning, n.Repo.Datastore())
+
 	return nil
 }
@@ -1,27 +1,40 @@
 package commands
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/cheggaaa/pb"
 	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
 	syncds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/sync"
 	cxt "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
+	blocks "github.com/ipfs/go-ipfs/blocks"
 	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
+	key "github.com/ipfs/go-ipfs/blocks/key"
 	bserv "github.com/ipfs/go-ipfs/blockservice"
 	cmds "github.com/ipfs/go-ipfs/commands"
 	files "github.com/ipfs/go-ipfs/commands/files"
 	core "github.com/ipfs/go-ipfs/core"
+	coreunix "github.com/ipfs/go-ipfs/core/coreunix"
 	offline "github.com/ipfs/go-ipfs/exchange/offline"
 	importer "github.com/ipfs/go-ipfs/importer"
 	"github.com/ipfs/go-ipfs/importer/chunk"
+	extract "github.com/ipfs/go-ipfs/importer/extract"
+	h "github.com/ipfs/go-ipfs/importer/helpers"
 	dag "github.com/ipfs/go-ipfs/merkledag"
+	traverse "github.com/ipfs/go-ipfs/merkledag/traverse"
 	dagutils "github.com/ipfs/go-ipfs/merkledag/utils"
 	pin "github.com/ipfs/go-ipfs/pin"
 	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
 	u "github.com/ipfs/go-ipfs/util"
 )
 
@@ -32,15 +45,27 @@ var ErrDepthLimitExceeded = fmt.Errorf("depth limit exceeded")
 const progressReaderIncrement = 1024 * 256
 
 const (
-	quietOptionName    = "quiet"
-	progressOptionName = "progress"
-	trickleOptionName  = "trickle"
-	wrapOptionName     = "wrap-with-directory"
-	hiddenOptionName   = "hidden"
-	onlyHashOptionName = "only-hash"
-	chunkerOptionName  = "chunker"
+	quietOptionName       = "quiet"
+	progressOptionName    = "progress"
+	trickleOptionName     = "trickle"
+	wrapOptionName        = "wrap-with-directory"
+	hiddenOptionName      = "hidden"
+	onlyHashOptionName    = "only-hash"
+	chunkerOptionName     = "chunker"
+	modeOptionName        = "preserve-mode"
+	mtimeOptionName       = "preserve-mtime"
+	extractOptionName     = "extract"
+	rawLeavesOptionName   = "raw-leaves"
+	inlineOptionName      = "inline"
+	inlineLimitOptionName = "inline-limit"
+	resumeOptionName      = "resume"
+	pinOptionName         = "pin"
 )
 
+// defaultInlineLimit is the largest encoded size of the files and
+// directories inlined by --inline, unless --inline-limit is given.
+const defaultInlineLimit = 32
+
 type AddedObject struct {
 	Name  string
 	Hash  string `json:",omitempty"`
@@ -55,6 +80,38 @@ Adds contents of <path> to ipfs. Use -r to add directories.
 Note that directories are added recursively, to form the ipfs
 MerkleDAG. A smarter partial add with a staging area (like git)
 remains to be implemented.
+
+Files matching the gitignore-style patterns of --ignore, of the file at
+--ignore-rules-path, or of a .ipfsignore file in their directory or
+above are skipped without being read.
+
+With --extract, each <path> must be a tar, gzipped tar or zip archive.
+Its entries are added as a directory tree named after the archive,
+without writing them to disk first. Archives with entries or symlinks
+pointing out of them are refused.
+
+With --raw-leaves, the data of files is stored in raw blocks holding
+the bytes of the file alone. The root of a file stays a unixfs node,
+even for a file of a single block, as blocks do not tell how they are
+encoded: the links to raw blocks are flagged as such instead. Nodes
+that do not know that flag can not read the files added this way.
+
+With --inline, files and directories that encode to at most
+--inline-limit bytes (32 by default, 127 at most) are not stored in
+blocks of their own: their hash is an identity hash holding them, so
+they travel inside the links of their parent.
+
+Files and directories are written to the repo as soon as they are
+imported, and the files imported so far are recorded in the repo until
+the add completes. If an add is interrupted, running it again with
+--resume skips the files it already imported, as long as their size,
+mode, modification time and first 64KiB did not change and their dag is
+still in the repo. Records of adds that never completed are dropped
+after a week.
+
+With --pin=false, the added objects are not pinned and may be removed
+by the next garbage collection. They are only kept from it until the
+add completes.
 `,
 	},
 
@@ -63,6 +120,8 @@ remains to be implemented.
 	},
 	Options: []cmds.Option{
 		cmds.OptionRecursivePath, // a builtin option that allows recursive paths (-r, --recursive)
+		cmds.OptionIgnore,
+		cmds.OptionIgnoreRulesPath,
 		cmds.BoolOption(quietOptionName, "q", "Write minimal output"),
 		cmds.BoolOption(progressOptionName, "p", "Stream progress data"),
 		cmds.BoolOption(trickleOptionName, "t", "Use trickle-dag format for dag generation"),
@@ -70,6 +129,16 @@ remains to be implemented.
 		cmds.BoolOption(wrapOptionName, "w", "Wrap files with a directory object"),
 		cmds.BoolOption(hiddenOptionName, "H", "Include files that are hidden"),
 		cmds.StringOption(chunkerOptionName, "s", "chunking algorithm to use"),
+		cmds.OptionNoCopy, // a builtin option that sends the absolute paths of files, for local daemons only
+		cmds.BoolOption(modeOptionName, "Record the permission bits of files and directories"),
+		cmds.BoolOption(mtimeOptionName, "Record the modification time of files and directories"),
+		cmds.BoolOption(extractOptionName, "Add the contents of tar, tar.gz and zip archives"),
+		cmds.BoolOption(rawLeavesOptionName, "Store the data of files as raw blocks, without unixfs framing"),
+		cmds.BoolOption(inlineOptionName, "Inline tiny files and directories in their parent"),
+		cmds.IntOption(inlineLimitOptionName, "Largest encoded size in bytes of inlined objects (default 32)"),
+		cmds.BoolOption(resumeOptionName, "Skip the files imported by an interrupted add"),
+		cmds.BoolOption(pinOptionName, "Pin the added objects (default true)"),
+		hashOption,
 	},
 	PreRun: func(req cmds.Request) error {
 		if quiet, _, _ := req.Option(quietOptionName).Bool(); quiet {
@@ -107,8 +176,40 @@ remains to be implemented.
 		hash, _, _ := req.Option(onlyHashOptionName).Bool()
 		hidden, _, _ := req.Option(hiddenOptionName).Bool()
 		chunker, _, _ := req.Option(chunkerOptionName).String()
+		nocopy, _, _ := req.Option(cmds.NoCopyOpt).Bool()
+		preserveMode, _, _ := req.Option(modeOptionName).Bool()
+		preserveMtime, _, _ := req.Option(mtimeOptionName).Bool()
+		extractArchives, _, _ := req.Option(extractOptionName).Bool()
+		rawLeaves, _, _ := req.Option(rawLeavesOptionName).Bool()
+		inline, _, _ := req.Option(inlineOptionName).Bool()
+		inlineLimit, found, _ := req.Option(inlineLimitOptionName).Int()
+		if !found {
+			inlineLimit = defaultInlineLimit
+		}
+		if inlineLimit < 0 || inlineLimit > blocks.MaxIdentitySize {
+			res.SetError(fmt.Errorf("--%s must be between 0 and %d", inlineLimitOptionName, blocks.MaxIdentitySize), cmds.ErrClient)
+			return
+		}
+		if !inline {
+			inlineLimit = 0
+		}
+		resume, _, _ := req.Option(resumeOptionName).Bool()
+		dopin, found, _ := req.Option(pinOptionName).Bool()
+		if !found {
+			dopin = true
+		}
+
+		if extractArchives && nocopy {
+			res.SetError(errors.New("cannot use --nocopy with --extract"), cmds.ErrClient)
+			return
+		}
+
+		hashFn, err := getHashFunc(req)
+		if err != nil {
+			res.SetError(err, cmds.ErrClient)
+			return
+		}
 
-		e := dagutils.NewDagEditor(NewMemoryDagService(), newDirNode())
 		if hash {
 			nilnode, err := core.NewNode(n.Context(), &core.BuildCfg{
 				//TODO: need this to be true or all files
@@ -122,6 +223,10 @@ remains to be implemented.
 			n = nilnode
 		}
 
+		// the editor only holds the top level entries, directories are
+		// built by addDir and written out as they are done.
+		e := dagutils.NewDagEditor(n.DAG, newDirNode(hashFn))
+
 		outChan := make(chan interface{}, 8)
 		res.SetOutput((<-chan interface{})(outChan))
 
@@ -135,6 +240,21 @@ remains to be implemented.
 			hidden:   hidden,
 			trickle:  trickle,
 			wrap:     wrap,
+			nocopy:   nocopy,
+			hashFn:   hashFn,
+
+			rawLeaves:     rawLeaves,
+			inlineLimit:   inlineLimit,
+			preserveMode:  preserveMode,
+			preserveMtime: preserveMtime,
+			resume:        resume,
+		}
+		if !dopin {
+			fileAdder.tempPin = pin.NewTempPinner(n.Pinning.GetManual())
+		}
+		if !hash {
+			// files are only recorded in adds that store them
+			fileAdder.checkpoint = coreunix.NewCheckpoint(n.Repo.Datastore(), fileAdder.checkpointParams())
 		}
 
 		// addAllFiles loops over a convenience slice file to
@@ -149,7 +269,12 @@ remains to be implemented.
 					return nil // done
 				}
 
-				if _, err := fileAdder.addFile(file); err != nil {
+				if extractArchives {
+					_, err = fileAdder.addArchive(file)
+				} else {
+					_, err = fileAdder.addFile(file)
+				}
+				if err != nil {
 					return err
 				}
 			}
@@ -172,25 +297,37 @@ remains to be implemented.
 				return err
 			}
 
-			if !hash {
-				// copy intermediary nodes from editor to our actual dagservice
-				err := e.WriteOutputTo(n.DAG)
-				if err != nil {
-					log.Error("WRITE OUT: ", err)
-					return err
-				}
-			}
-
 			rootnd, err := fileAdder.RootNode()
 			if err != nil {
 				return err
 			}
 
-			return pinRoot(rootnd)
+			// an empty root was never inserted into
+			if _, err := n.DAG.Add(rootnd); err != nil {
+				return err
+			}
+
+			if dopin {
+				if err := pinRoot(rootnd); err != nil {
+					return err
+				}
+			}
+
+			if fileAdder.checkpoint != nil {
+				return fileAdder.checkpoint.Clear()
+			}
+			return nil
 		}
 
 		go func() {
 			defer close(outChan)
+			if fileAdder.tempPin != nil {
+				defer func() {
+					if err := fileAdder.tempPin.Release(); err != nil {
+						log.Errorf("releasing the pins of the add: %s", err)
+					}
+				}()
+			}
 			if err := addAllAndPin(req.Files()); err != nil {
 				res.SetError(err, cmds.ErrNormal)
 				return
@@ -301,38 +438,185 @@ type adder struct {
 	hidden   bool
 	trickle  bool
 	wrap     bool
+	nocopy   bool
 	chunker  string
+	hashFn   int
+
+	rawLeaves     bool
+	inlineLimit   int // zero when not inlining
+	preserveMode  bool
+	preserveMtime bool
+
+	// checkpoint records the files imported, nil if they are not stored.
+	// With resume, the files it recorded are not imported again.
+	checkpoint *coreunix.Checkpoint
+	resume     bool
+
+	// tempPin keeps the objects of an add without --pin from the garbage
+	// collector until it completes, nil if they are pinned.
+	tempPin *pin.TempPinner
 
 	nextUntitled int
 }
 
-// Perform the actual add & pin locally, outputting results to reader
-func add(n *core.IpfsNode, reader io.Reader, useTrickle bool, chunker string) (*dag.Node, error) {
-	chnk, err := chunk.FromString(reader, chunker)
+// checkpointParams names the options that change how files are imported,
+// so that only adds with the same options resume each other.
+func (params *adder) checkpointParams() string {
+	return fmt.Sprintf("chunker=%s,hash=%d,trickle=%t,raw-leaves=%t,inline=%d,nocopy=%t,mode=%t,mtime=%t",
+		params.chunker, params.hashFn, params.trickle, params.rawLeaves, params.inlineLimit,
+		params.nocopy, params.preserveMode, params.preserveMtime)
+}
+
+// checkpointHead reads the first coreunix.CheckpointHeadSize bytes of file,
+// which the checkpoint checks along with its stat, if file can be
+// checkpointed. The reader returned reads the whole file.
+func (params *adder) checkpointHead(file files.File) ([]byte, io.Reader, error) {
+	if _, stat := checkpointStat(file); params.checkpoint == nil || stat == nil {
+		return nil, file, nil
+	}
+
+	head := make([]byte, coreunix.CheckpointHeadSize)
+	n, err := io.ReadFull(file, head)
+	switch err {
+	case nil, io.EOF, io.ErrUnexpectedEOF:
+	default:
+		return nil, nil, err
+	}
+	head = head[:n]
+	return head, io.MultiReader(bytes.NewReader(head), file), nil
+}
+
+// resumeFile returns the root file, starting with head, was imported as by
+// an interrupted add, or nil if it has to be imported.
+func (params *adder) resumeFile(file files.File, head []byte) *dag.Node {
+	fpath, stat := checkpointStat(file)
+	if !params.resume || params.checkpoint == nil || stat == nil {
+		return nil
+	}
+
+	k, ok := params.checkpoint.Lookup(fpath, stat, head)
+	if !ok {
+		return nil
+	}
+	// the file may have been interrupted midway, or garbage collected since,
+	// so it is only resumed if all of its blocks are in the repo
+	nd, err := params.localDAG(k)
+	if err != nil {
+		log.Debugf("not resuming %s: %s", file.FileName(), err)
+		return nil
+	}
+	log.Infof("resuming: %s was already imported as %s", file.FileName(), k)
+	return nd
+}
+
+// localDAG returns the root of the dag k, if all of its nodes are in the
+// blockstore of the node. Nothing is fetched from the network.
+func (params *adder) localDAG(k key.Key) (*dag.Node, error) {
+	bs := params.node.Blockstore
+	offlineDAG := dag.NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+
+	root, err := offlineDAG.Get(params.ctx, k)
 	if err != nil {
 		return nil, err
 	}
+	err = traverse.Traverse(root, traverse.Options{
+		DAG:            offlineDAG,
+		Order:          traverse.DFSPre,
+		Func:           func(traverse.State) error { return nil },
+		SkipDuplicates: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return root, nil
+}
 
-	var node *dag.Node
-	if useTrickle {
-		node, err = importer.BuildTrickleDagFromReader(
-			n.DAG,
-			chnk,
-			importer.PinIndirectCB(n.Pinning.GetManual()),
-		)
-	} else {
-		node, err = importer.BuildDagFromReader(
-			n.DAG,
-			chnk,
-			importer.PinIndirectCB(n.Pinning.GetManual()),
-		)
+// recordFile records in the checkpoint that file, starting with head, was
+// imported as nd.
+func (params *adder) recordFile(file files.File, head []byte, nd *dag.Node) error {
+	fpath, stat := checkpointStat(file)
+	if params.checkpoint == nil || stat == nil {
+		return nil
 	}
 
+	k, err := nd.Key()
+	if err != nil {
+		return err
+	}
+	return params.checkpoint.Record(fpath, stat, head, k)
+}
+
+// checkpointStat returns the path of file, absolute with --nocopy and as
+// given on the command line otherwise, and its stat, or a nil stat if file
+// can not be checkpointed.
+func checkpointStat(file files.File) (string, os.FileInfo) {
+	sf, ok := file.(files.StatFile)
+	if !ok || sf.Stat() == nil || file.FullPath() == "" {
+		return "", nil
+	}
+	return file.FullPath(), sf.Stat()
+}
+
+// Perform the actual add & pin locally, outputting results to reader.
+// If fpath is set, the leaves reference the file at that path instead of
+// being copied into the blockstore.
+// The mode and mtime given are recorded in the root of the file.
+func (params *adder) add(reader io.Reader, fpath string, mode os.FileMode, mtime time.Time) (*dag.Node, error) {
+	chnk, err := chunk.FromString(reader, params.chunker)
 	if err != nil {
 		return nil, err
 	}
 
-	return node, nil
+	dbp := h.DagBuilderParams{
+		Dagserv:  params.node.DAG,
+		Maxlinks: h.DefaultLinksPerBlock,
+		NodeCB:   params.nodeCB(),
+		FilePath: fpath,
+		HashFunc: params.hashFn,
+		Mode:     mode,
+		ModTime:  mtime,
+
+		RawLeaves:   params.rawLeaves,
+		InlineLimit: params.inlineLimit,
+	}
+
+	return importer.BuildDag(dbp, chnk, params.trickle)
+}
+
+// nodeCB returns the callback pinning the nodes of imported files.
+func (params *adder) nodeCB() h.NodeCB {
+	if params.tempPin != nil {
+		return importer.TempPinCB(params.tempPin)
+	}
+	return importer.PinIndirectCB(params.node.Pinning.GetManual())
+}
+
+// pinIndirect pins k indirectly, until the add completes if the added
+// objects are not pinned.
+func (params *adder) pinIndirect(k key.Key) {
+	if params.tempPin != nil {
+		params.tempPin.Pin(k)
+		return
+	}
+	params.node.Pinning.GetManual().PinWithMode(k, pin.Indirect)
+}
+
+// fileStat returns the permission bits and modification time of file to
+// record, zero values for the ones not asked for or not known.
+func (params *adder) fileStat(file files.File) (mode os.FileMode, mtime time.Time) {
+	sf, ok := file.(files.StatFile)
+	if !ok || sf.Stat() == nil {
+		return 0, time.Time{}
+	}
+
+	stat := sf.Stat()
+	if params.preserveMode {
+		mode = stat.Mode().Perm()
+	}
+	if params.preserveMtime {
+		mtime = stat.ModTime()
+	}
+	return mode, mtime
 }
 
 func (params *adder) RootNode() (*dag.Node, error) {
@@ -362,13 +646,34 @@ func (params *adder) addNode(node *dag.Node, path string) error {
 		path = key.Pretty()
 	}
 
-	if err := params.editor.InsertNodeAtPath(params.ctx, path, node, newDirNode); err != nil {
-		return err
+	// entries of directories are linked by addDir, only the top level
+	// entries go in the root
+	if !strings.Contains(path, "/") {
+		mkdir := func() *dag.Node { return newDirNode(params.hashFn) }
+		if err := params.editor.InsertNodeAtPath(params.ctx, path, node, mkdir); err != nil {
+			return err
+		}
 	}
 
 	return outputDagnode(params.out, path, node)
 }
 
+// addSymlink adds a symlink to target to the DAG.
+func (params *adder) addSymlink(target string) (*dag.Node, error) {
+	sdata, err := ft.SymlinkData(target)
+	if err != nil {
+		return nil, err
+	}
+
+	dagnode := &dag.Node{Data: sdata}
+	dagnode.SetHashFunc(params.hashFn)
+	_, err = params.node.DAG.Add(dagnode)
+	if err != nil {
+		return nil, err
+	}
+	return dagnode, nil
+}
+
 // Add the given file while respecting the params.
 func (params *adder) addFile(file files.File) (*dag.Node, error) {
 	// Check if file is hidden
@@ -383,32 +688,50 @@ func (params *adder) addFile(file files.File) (*dag.Node, error) {
 	}
 
 	if s, ok := file.(*files.Symlink); ok {
-		sdata, err := ft.SymlinkData(s.Target)
+		dagnode, err := params.addSymlink(s.Target)
 		if err != nil {
 			return nil, err
 		}
 
-		dagnode := &dag.Node{Data: sdata}
-		_, err = params.node.DAG.Add(dagnode)
-		if err != nil {
+		err = params.addNode(dagnode, s.FileName())
+		return dagnode, err
+	}
+
+	head, reader, err := params.checkpointHead(file)
+	if err != nil {
+		return nil, err
+	}
+	if dagnode := params.resumeFile(file, head); dagnode != nil {
+		// record it again, so the record goes once the add completes
+		if err := params.recordFile(file, head, dagnode); err != nil {
 			return nil, err
 		}
-
-		err = params.addNode(dagnode, s.FileName())
+		err := params.addNode(dagnode, file.FileName())
 		return dagnode, err
 	}
 
 	// if the progress flag was specified, wrap the file so that we can send
 	// progress updates to the client (over the output channel)
-	var reader io.Reader = file
 	if params.progress {
-		reader = &progressReader{file: file, out: params.out}
+		reader = &progressReader{file: file, r: reader, out: params.out}
+	}
+
+	var fpath string
+	if params.nocopy {
+		fpath = file.FullPath()
+		if !filepath.IsAbs(fpath) {
+			return nil, fmt.Errorf("cannot add %q without copying: it is not a file on disk", file.FileName())
+		}
 	}
 
-	dagnode, err := add(params.node, reader, params.trickle, params.chunker)
+	mode, mtime := params.fileStat(file)
+	dagnode, err := params.add(reader, fpath, mode, mtime)
 	if err != nil {
 		return nil, err
 	}
+	if err := params.recordFile(file, head, dagnode); err != nil {
+		return nil, err
+	}
 
 	// patch it into the root
 	log.Infof("adding file: %s", file.FileName())
@@ -417,7 +740,8 @@ func (params *adder) addFile(file files.File) (*dag.Node, error) {
 }
 
 func (params *adder) addDir(file files.File) (*dag.Node, error) {
-	tree := &dag.Node{Data: ft.FolderPBData()}
+	dirb := uio.NewDirectory(params.node.DAG)
+	dirb.SetHashFunc(params.hashFn)
 	log.Infof("adding directory: %s", file.FileName())
 
 	for {
@@ -440,13 +764,27 @@ func (params *adder) addDir(file files.File) (*dag.Node, error) {
 		if node != nil {
 			_, name := path.Split(file.FileName())
 
-			err = tree.AddNodeLink(name, node)
+			err = dirb.AddNode(params.ctx, name, node)
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
 
+	if err := dirb.SetStat(params.fileStat(file)); err != nil {
+		return nil, err
+	}
+
+	tree, err := dirb.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	if params.inlineLimit > 0 {
+		if _, err := tree.Inline(params.inlineLimit); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := params.addNode(tree, file.FileName()); err != nil {
 		return nil, err
 	}
@@ -456,11 +794,171 @@ func (params *adder) addDir(file files.File) (*dag.Node, error) {
 		return nil, err
 	}
 
-	params.node.Pinning.GetManual().PinWithMode(k, pin.Indirect)
+	params.pinIndirect(k)
 
 	return tree, nil
 }
 
+// addArchive adds the entries of the archive file as a directory named
+// after the archive. Entries are imported as they are read.
+func (params *adder) addArchive(file files.File) (*dag.Node, error) {
+	if file.IsDirectory() {
+		return nil, fmt.Errorf("%s is a directory, not an archive", file.FileName())
+	}
+
+	var reader io.Reader = file
+	if params.progress {
+		reader = &progressReader{file: file, r: file, out: params.out}
+	}
+
+	ar, err := extract.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	name := archiveName(file.FileName())
+	log.Infof("adding archive: %s", file.FileName())
+
+	// the tree is built in memory, and only written out once complete
+	mkdir := func() *dag.Node { return newDirNode(params.hashFn) }
+	e := dagutils.NewMemoryEditor(params.node.DAG, mkdir())
+
+	dirs := make(map[string]bool)        // directories inserted so far
+	others := make(map[string]*dag.Node) // everything else, for hard links
+	for {
+		entry, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// entries must not be inserted below files
+		for p := path.Dir(entry.Path); p != "."; p = path.Dir(p) {
+			if others[p] != nil {
+				return nil, fmt.Errorf("%s: %s is not a directory", entry.Path, p)
+			}
+			dirs[p] = true
+		}
+
+		mode, mtime := params.entryStat(entry)
+
+		var nd *dag.Node
+		switch entry.Type {
+		case extract.TDirectory:
+			if entry.Path == "" {
+				continue
+			}
+			if dirs[entry.Path] {
+				// created for an entry below it, or listed twice
+				nd, err = archiveNode(params.ctx, e, entry.Path)
+				if err != nil {
+					return nil, err
+				}
+				nd = nd.Copy()
+			} else {
+				nd = mkdir()
+			}
+			nd.Data, err = ft.SetStat(nd.Data, mode, mtime)
+		case extract.TFile:
+			nd, err = params.add(entry.Reader, "", mode, mtime)
+		case extract.TSymlink:
+			nd, err = params.addSymlink(entry.Linkname)
+		case extract.THardlink:
+			nd = others[entry.Linkname]
+			if nd == nil {
+				err = fmt.Errorf("%s: link to unknown file %s", entry.Path, entry.Linkname)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := e.InsertNodeAtPath(params.ctx, entry.Path, nd, mkdir); err != nil {
+			return nil, err
+		}
+
+		if entry.Type == extract.TDirectory {
+			dirs[entry.Path] = true
+			delete(others, entry.Path)
+			continue
+		}
+		others[entry.Path] = nd
+		delete(dirs, entry.Path)
+
+		if err := outputDagnode(params.out, path.Join(name, entry.Path), nd); err != nil {
+			return nil, err
+		}
+	}
+
+	// files are pinned as they are imported, the directories and symlinks
+	// once written out
+	err = e.WriteOutputCB(params.node.DAG, func(nd *dag.Node) error {
+		if pbn, err := ft.FromBytes(nd.Data); err == nil {
+			switch pbn.GetType() {
+			case ft.TFile, ft.TRaw:
+				return nil
+			}
+		}
+		k, err := nd.Key()
+		if err != nil {
+			return err
+		}
+		params.pinIndirect(k)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	root := e.GetNode()
+	if err := params.addNode(root, name); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// archiveNode returns the node at fpath in the tree being built by e.
+func archiveNode(ctx cxt.Context, e *dagutils.Editor, fpath string) (*dag.Node, error) {
+	nd := e.GetNode()
+	for _, name := range strings.Split(fpath, "/") {
+		lnk, err := uio.FindDirEntry(ctx, e.GetDagService(), nd, name)
+		if err != nil {
+			return nil, err
+		}
+		nd, err = lnk.GetNode(ctx, e.GetDagService())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nd, nil
+}
+
+// entryStat returns the permission bits and modification time of an
+// archive entry to record, zero values for the ones not asked for.
+func (params *adder) entryStat(entry *extract.Entry) (mode os.FileMode, mtime time.Time) {
+	if params.preserveMode {
+		mode = entry.Mode
+	}
+	if params.preserveMtime {
+		mtime = entry.ModTime
+	}
+	return mode, mtime
+}
+
+// archiveName returns the name of the directory the archive at fpath is
+// added as: its file name without the archive extension.
+func archiveName(fpath string) string {
+	_, name := path.Split(fpath)
+	for _, ext := range []string{".tar.gz", ".tgz", ".tar", ".zip"} {
+		if strings.HasSuffix(name, ext) && len(name) > len(ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+	return name
+}
+
 // outputDagnode sends dagnode info over the output channel
 func outputDagnode(out chan interface{}, name string, dn *dag.Node) error {
 	o, err := getOutput(dn)
@@ -494,13 +992,14 @@ func (e *ignoreFileError) Error() string {
 
 type progressReader struct {
 	file         files.File
+	r            io.Reader // reads file
 	out          chan interface{}
 	bytes        int64
 	lastProgress int64
 }
 
 func (i *progressReader) Read(p []byte) (int, error) {
-	n, err := i.file.Read(p)
+	n, err := i.r.Read(p)
 
 	i.bytes += int64(n)
 	if i.bytes-i.lastProgress >= progressReaderIncrement || err == io.EOF {
@@ -515,6 +1014,8 @@ func (i *progressReader) Read(p []byte) (int, error) {
 }
 
 // TODO: generalize this to more than unix-fs nodes.
-func newDirNode() *dag.Node {
-	return &dag.Node{Data: ft.FolderPBData()}
+func newDirNode(hashFn int) *dag.Node {
+	nd := &dag.Node{Data: ft.FolderPBData()}
+	nd.SetHashFunc(hashFn)
+	return nd
 }
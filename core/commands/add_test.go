@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	files "github.com/ipfs/go-ipfs/commands/files"
+	core "github.com/ipfs/go-ipfs/core"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	dagutils "github.com/ipfs/go-ipfs/merkledag/utils"
+	repo "github.com/ipfs/go-ipfs/repo"
+	config "github.com/ipfs/go-ipfs/repo/config"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
+	testutil "github.com/ipfs/go-ipfs/util/testutil"
+)
+
+func TestAddArchive(t *testing.T) {
+	ctx := context.Background()
+	r := &repo.Mock{
+		C: config.Config{
+			Identity: config.Identity{
+				PeerID: "Qmfoo", // required by offline node
+			},
+		},
+		D: testutil.ThreadSafeCloserMapDatastore(),
+	}
+	n, err := core.NewNode(ctx, &core.BuildCfg{Repo: r})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the entry of dir comes after the file below it
+	mtime := time.Unix(1000, 0)
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for _, h := range []*tar.Header{
+		{Name: "dir/sub/file", Mode: 0644, Typeflag: tar.TypeReg, Size: 4, ModTime: mtime},
+		{Name: "dir/", Mode: 0700, Typeflag: tar.TypeDir, ModTime: mtime},
+		{Name: "link", Linkname: "dir/sub/file", Mode: 0777, Typeflag: tar.TypeSymlink, ModTime: mtime},
+	} {
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if h.Typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte("data")); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	params := &adder{
+		ctx:           ctx,
+		node:          n,
+		editor:        dagutils.NewDagEditor(n.DAG, newDirNode(0)),
+		out:           make(chan interface{}, 16),
+		preserveMode:  true,
+		preserveMtime: true,
+	}
+	file := files.NewReaderFile("a.tar", "a.tar", ioutil.NopCloser(buf), nil)
+	root, err := params.addArchive(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lnk, err := uio.FindDirEntry(ctx, n.DAG, root, "dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := lnk.GetNode(ctx, n.DAG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pbn, err := ft.FromBytes(dir.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ft.Mode(pbn) != 0700 || !ft.ModTime(pbn).Equal(mtime) {
+		t.Fatal("expected the stat of the dir entry on the created directory")
+	}
+	if _, err := uio.FindDirEntry(ctx, n.DAG, dir, "sub"); err != nil {
+		t.Fatal("directory lost its entries:", err)
+	}
+
+	// only the nodes of the tree, and the root the adder wraps it in, are
+	// written, and all of them are pinned
+	reachable := make(map[key.Key]bool)
+	var walk func(nd *dag.Node)
+	walk = func(nd *dag.Node) {
+		k, err := nd.Key()
+		if err != nil {
+			t.Fatal(err)
+		}
+		reachable[k] = true
+		for _, l := range nd.Links {
+			child, err := l.GetNode(ctx, n.DAG)
+			if err != nil {
+				t.Fatal(err)
+			}
+			walk(child)
+		}
+	}
+	walk(root)
+	for k := range reachable {
+		if !n.Pinning.IsPinned(k) {
+			t.Fatalf("%s is not pinned", k)
+		}
+	}
+	walk(params.editor.GetNode())
+
+	keys, err := n.Blockstore.AllKeysChan(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range keys {
+		if !reachable[k] {
+			t.Fatalf("%s was written but is not in the tree", k)
+		}
+	}
+}
@@ -24,6 +24,31 @@ func (bs BlockStat) String() string {
 	return fmt.Sprintf("Key: %s\nSize: %d\n", bs.Key, bs.Size)
 }
 
+const hashOptionName = "hash"
+
+// hashOption selects the multihash function of the objects a command adds.
+var hashOption = cmds.StringOption(hashOptionName, "Hash function to use: sha1, sha2-256 (default), sha2-512, sha3 or blake2b")
+
+// getHashFunc returns the multihash code named by the hash option.
+func getHashFunc(req cmds.Request) (int, error) {
+	name, found, err := req.Option(hashOptionName).String()
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return mh.SHA2_256, nil
+	}
+
+	code, ok := mh.Names[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown hash function %q", name)
+	}
+	if _, err := u.Sum(nil, code, -1); err != nil {
+		return 0, fmt.Errorf("hash function %s is not supported", name)
+	}
+	return code, nil
+}
+
 var BlockCmd = &cmds.Command{
 	Helptext: cmds.HelpText{
 		Tagline: "Manipulate raw IPFS blocks",
@@ -113,6 +138,9 @@ It reads from stdin, and <key> is a base58 encoded multihash.
 	Arguments: []cmds.Argument{
 		cmds.FileArg("data", true, false, "The data to be stored as an IPFS block").EnableStdin(),
 	},
+	Options: []cmds.Option{
+		hashOption,
+	},
 	Run: func(req cmds.Request, res cmds.Response) {
 		n, err := req.InvocContext().GetNode()
 		if err != nil {
@@ -120,6 +148,12 @@ It reads from stdin, and <key> is a base58 encoded multihash.
 			return
 		}
 
+		hashFn, err := getHashFunc(req)
+		if err != nil {
+			res.SetError(err, cmds.ErrClient)
+			return
+		}
+
 		file, err := req.Files().NextFile()
 		if err != nil {
 			res.SetError(err, cmds.ErrNormal)
@@ -138,7 +172,11 @@ It reads from stdin, and <key> is a base58 encoded multihash.
 			return
 		}
 
-		b := blocks.NewBlock(data)
+		b, err := blocks.NewBlockWithHashFunc(data, hashFn)
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
 		log.Debugf("BlockPut key: '%q'", b.Key())
 
 		k, err := n.Blocks.AddBlock(b)
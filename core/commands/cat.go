@@ -1,11 +1,13 @@
 package commands
 
 import (
+	"bytes"
 	"io"
 
 	cmds "github.com/ipfs/go-ipfs/commands"
 	core "github.com/ipfs/go-ipfs/core"
 	coreunix "github.com/ipfs/go-ipfs/core/coreunix"
+	path "github.com/ipfs/go-ipfs/path"
 
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 )
@@ -17,7 +19,8 @@ var CatCmd = &cmds.Command{
 		Tagline: "Show IPFS object data",
 		ShortDescription: `
 Retrieves the object named by <ipfs-or-ipns-path> and outputs the data
-it contains.
+it contains. Paths ending inside a document output the value they name:
+the text of strings and numbers, the JSON encoding of other values.
 `,
 	},
 
@@ -59,6 +62,16 @@ func cat(ctx context.Context, node *core.IpfsNode, paths []string) ([]io.Reader,
 	length := uint64(0)
 	for _, fpath := range paths {
 		read, err := coreunix.Cat(ctx, node, fpath)
+		if ev, ok := err.(path.ErrValue); ok {
+			// the path names a value inside an object
+			data, err := path.ValueData(ev.Value)
+			if err != nil {
+				return nil, 0, err
+			}
+			readers = append(readers, bytes.NewReader(data))
+			length += uint64(len(data))
+			continue
+		}
 		if err != nil {
 			return nil, 0, err
 		}
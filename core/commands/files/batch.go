@@ -0,0 +1,121 @@
+package files
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	cmds "github.com/ipfs/go-ipfs/commands"
+	core "github.com/ipfs/go-ipfs/core"
+	nsfs "github.com/ipfs/go-ipfs/ipnsfs"
+)
+
+// BatchOp is an operation of 'ipfs files batch'.
+type BatchOp struct {
+	Op        string // mkdir, cp, mv or rm
+	Path      string `json:",omitempty"` // for mkdir and rm
+	Source    string `json:",omitempty"` // for cp and mv
+	Dest      string `json:",omitempty"` // for cp and mv
+	Parents   bool   `json:",omitempty"` // mkdir -p
+	Recursive bool   `json:",omitempty"` // rm -r
+}
+
+var FilesBatchCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Apply several changes at once",
+		ShortDescription: `
+'ipfs files batch' reads a sequence of JSON operations from <ops> and
+applies them as one change of the root: the filesystem is seen either
+before all of them or after all of them. If an operation fails, none of
+them is applied. If the filesystem is changed by something else in the
+meantime, the batch fails and has to be run again.
+`,
+		LongDescription: `
+'ipfs files batch' reads a sequence of JSON operations from <ops> and
+applies them as one change of the root: the filesystem is seen either
+before all of them or after all of them. If an operation fails, none of
+them is applied. If the filesystem is changed by something else in the
+meantime, the batch fails and has to be run again.
+
+The operations are objects with an "Op" field and the arguments of the
+command of the same name:
+
+    {"Op": "mkdir", "Path": "/a/b", "Parents": true}
+    {"Op": "cp", "Source": "/ipfs/<hash>", "Dest": "/a/b/x"}
+    {"Op": "mv", "Source": "/a/b/x", "Dest": "/c"}
+    {"Op": "rm", "Path": "/d", "Recursive": true}
+
+Paths of sources and destinations see the changes made by the operations
+before them.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.FileArg("ops", true, false, "JSON operations to apply").EnableStdin(),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		input, err := req.Files().NextFile()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		tx, err := n.FilesRoot.Begin(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		dec := json.NewDecoder(input)
+		for i := 0; ; i++ {
+			var op BatchOp
+			err := dec.Decode(&op)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				tx.Rollback()
+				res.SetError(fmt.Errorf("operation %d: %s", i, err), cmds.ErrClient)
+				return
+			}
+
+			if err := applyOp(req, n, tx.Root(), &op); err != nil {
+				tx.Rollback()
+				res.SetError(fmt.Errorf("operation %d (%s): %s", i, op.Op, err), cmds.ErrNormal)
+				return
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if err := flush(req, n); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+	},
+}
+
+// applyOp applies op to the tree below root.
+func applyOp(req cmds.Request, n *core.IpfsNode, root *nsfs.Directory, op *BatchOp) error {
+	switch op.Op {
+	case "mkdir":
+		return nsfs.Mkdir(root, op.Path, op.Parents)
+	case "cp":
+		return cp(req, n, root, op.Source, op.Dest)
+	case "mv":
+		return nsfs.Mv(root, op.Source, op.Dest)
+	case "rm":
+		return remove(root, op.Path, op.Recursive)
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+}
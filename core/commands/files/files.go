@@ -0,0 +1,761 @@
+package files
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	gopath "path"
+	"strings"
+
+	cmds "github.com/ipfs/go-ipfs/commands"
+	core "github.com/ipfs/go-ipfs/core"
+	nsfs "github.com/ipfs/go-ipfs/ipnsfs"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	path "github.com/ipfs/go-ipfs/path"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
+)
+
+const flushOptionName = "flush"
+
+var FilesCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Manipulate the mutable filesystem of this node",
+		ShortDescription: `
+'ipfs files' works on a filesystem that only lives on this node, like a
+filesystem on a disk: its files and directories are written, moved and
+removed in place. Objects already in ipfs are added to it with
+'ipfs files cp /ipfs/<hash> /<path>'.
+
+Every change is flushed to the repo, which pins the current root of the
+filesystem. With --flush=false, changes are only kept in memory until the
+next flush, or until 'ipfs files flush' is run.
+
+The filesystem is kept across restarts without being published. Run
+'ipfs files publish' to publish its root under the name of this node.
+
+Several changes are applied at once with 'ipfs files batch'.
+`,
+	},
+	Options: []cmds.Option{
+		cmds.BoolOption(flushOptionName, "f", "Flush the changes to the repo (default true)"),
+	},
+	Subcommands: map[string]*cmds.Command{
+		"ls":      FilesLsCmd,
+		"mkdir":   FilesMkdirCmd,
+		"write":   FilesWriteCmd,
+		"read":    FilesReadCmd,
+		"rm":      FilesRmCmd,
+		"mv":      FilesMvCmd,
+		"cp":      FilesCpCmd,
+		"stat":    FilesStatCmd,
+		"flush":   FilesFlushCmd,
+		"publish": FilesPublishCmd,
+		"batch":   FilesBatchCmd,
+	},
+}
+
+// Object describes an entry of the files root, as shown by stat.
+type Object struct {
+	Hash           string
+	Size           uint64
+	CumulativeSize uint64
+	Type           string
+}
+
+// Entry is an entry of a directory listed by ls.
+type Entry struct {
+	Name string
+	Type string
+	Size uint64 `json:",omitempty"`
+	Hash string `json:",omitempty"`
+}
+
+type ListOutput struct {
+	Entries []*Entry
+}
+
+var FilesStatCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Display the status of a file or directory",
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("path", true, false, "Path to the entry to stat"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		fsn, err := nsfs.Lookup(root, req.Arguments()[0])
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		o, err := statNode(fsn)
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		res.SetOutput(o)
+	},
+	Marshalers: cmds.MarshalerMap{
+		cmds.Text: func(res cmds.Response) (io.Reader, error) {
+			o := res.Output().(*Object)
+			buf := new(bytes.Buffer)
+			fmt.Fprintln(buf, o.Hash)
+			fmt.Fprintf(buf, "Size: %d\n", o.Size)
+			fmt.Fprintf(buf, "CumulativeSize: %d\n", o.CumulativeSize)
+			fmt.Fprintf(buf, "Type: %s\n", o.Type)
+			return buf, nil
+		},
+	},
+	Type: Object{},
+}
+
+// statNode returns the hash, sizes and type of fsn.
+func statNode(fsn nsfs.FSNode) (*Object, error) {
+	nd, err := fsn.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := nd.Key()
+	if err != nil {
+		return nil, err
+	}
+	cumulSize, err := nd.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	o := &Object{
+		Hash:           k.B58String(),
+		CumulativeSize: cumulSize,
+		Type:           typeName(fsn),
+	}
+	if fi, ok := fsn.(*nsfs.File); ok {
+		size, err := fi.Size()
+		if err != nil {
+			return nil, err
+		}
+		o.Size = uint64(size)
+	}
+	return o, nil
+}
+
+func typeName(fsn nsfs.FSNode) string {
+	if fsn.Type() == nsfs.TDir {
+		return "directory"
+	}
+	return "file"
+}
+
+var FilesLsCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "List directories",
+		ShortDescription: `
+'ipfs files ls' lists the entries of the directory at <path>, or the
+file at <path> itself. With -l, their type, size and hash are listed
+too.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("path", false, false, "Path to show listing for, / by default"),
+	},
+	Options: []cmds.Option{
+		cmds.BoolOption("l", "Use long listing format"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		pth := "/"
+		if len(req.Arguments()) > 0 {
+			pth = req.Arguments()[0]
+		}
+		long, _, _ := req.Option("l").Bool()
+
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		fsn, err := nsfs.Lookup(root, pth)
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		dir, ok := fsn.(*nsfs.Directory)
+		if !ok {
+			e, err := listEntry(gopath.Base(pth), fsn, long)
+			if err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+			res.SetOutput(&ListOutput{[]*Entry{e}})
+			return
+		}
+
+		out := new(ListOutput)
+		for _, name := range dir.List() {
+			child, err := dir.Child(name)
+			if err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+			e, err := listEntry(name, child, long)
+			if err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+			out.Entries = append(out.Entries, e)
+		}
+		res.SetOutput(out)
+	},
+	Marshalers: cmds.MarshalerMap{
+		cmds.Text: func(res cmds.Response) (io.Reader, error) {
+			out := res.Output().(*ListOutput)
+			long, _, _ := res.Request().Option("l").Bool()
+
+			buf := new(bytes.Buffer)
+			for _, e := range out.Entries {
+				if long {
+					fmt.Fprintf(buf, "%s\t%s\t%d\t%s\n", e.Name, e.Type, e.Size, e.Hash)
+				} else {
+					fmt.Fprintln(buf, e.Name)
+				}
+			}
+			return buf, nil
+		},
+	},
+	Type: ListOutput{},
+}
+
+// listEntry returns the entry listing fsn under name, with its size and
+// hash if long is set.
+func listEntry(name string, fsn nsfs.FSNode, long bool) (*Entry, error) {
+	e := &Entry{Name: name, Type: typeName(fsn)}
+	if !long {
+		return e, nil
+	}
+
+	o, err := statNode(fsn)
+	if err != nil {
+		return nil, err
+	}
+	e.Hash = o.Hash
+	e.Size = o.Size
+	return e, nil
+}
+
+var FilesMkdirCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Make directories",
+		ShortDescription: `
+'ipfs files mkdir' creates the directory at <path>. With -p, the missing
+directories leading to it are created too, and it is not an error for it
+to exist already.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("path", true, false, "Path to the directory to make"),
+	},
+	Options: []cmds.Option{
+		cmds.BoolOption("parents", "p", "No error if existing, make parent directories as needed"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		parents, _, _ := req.Option("parents").Bool()
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		if err := nsfs.Mkdir(root, req.Arguments()[0], parents); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if err := flush(req, n); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+	},
+}
+
+var FilesWriteCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Write to a file",
+		ShortDescription: `
+'ipfs files write' writes the data read from <data> to the file at <path>,
+from --offset on. The data after what is written is left in place unless
+--truncate is given.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("path", true, false, "Path to the file to write to"),
+		cmds.FileArg("data", true, false, "Data to write").EnableStdin(),
+	},
+	Options: []cmds.Option{
+		cmds.IntOption("offset", "o", "Offset to write at"),
+		cmds.BoolOption("create", "e", "Create the file if it does not exist"),
+		cmds.BoolOption("truncate", "t", "Truncate the file before writing"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		pth := req.Arguments()[0]
+		create, _, _ := req.Option("create").Bool()
+		trunc, _, _ := req.Option("truncate").Bool()
+		offset, _, _ := req.Option("offset").Int()
+		if offset < 0 {
+			res.SetError(errors.New("cannot write at a negative offset"), cmds.ErrClient)
+			return
+		}
+
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		fi, err := fileToWrite(root, pth, create)
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		input, err := req.Files().NextFile()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if err := writeFile(fi, input, int64(offset), trunc); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if err := flush(req, n); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+	},
+}
+
+// fileToWrite returns the file at pth, creating it empty if create is set
+// and there is none.
+func fileToWrite(root *nsfs.Directory, pth string, create bool) (*nsfs.File, error) {
+	fsn, err := nsfs.Lookup(root, pth)
+	if err == os.ErrNotExist && create {
+		nd := &dag.Node{Data: ft.FilePBData(nil, 0)}
+		if err := nsfs.PutNode(root, pth, nd); err != nil {
+			return nil, err
+		}
+		fsn, err = nsfs.Lookup(root, pth)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fi, ok := fsn.(*nsfs.File)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a file", pth)
+	}
+	return fi, nil
+}
+
+// writeFile writes the data read from r to fi at offset, and closes fi to
+// link the result in its directory. Writes go through WriteAt, as fi may
+// be written by other requests.
+func writeFile(fi *nsfs.File, r io.Reader, offset int64, trunc bool) error {
+	if trunc {
+		if err := fi.Truncate(0); err != nil {
+			return err
+		}
+	}
+
+	buf := make([]byte, 64*1024)
+	for {
+		nr, err := r.Read(buf)
+		if nr > 0 {
+			nw, werr := fi.WriteAt(buf[:nr], offset)
+			if werr != nil {
+				return werr
+			}
+			offset += int64(nw)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return fi.Close()
+}
+
+var FilesReadCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Read a file",
+		ShortDescription: `
+'ipfs files read' outputs the contents of the file at <path>, from
+--offset on and at most --count bytes of them if given.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("path", true, false, "Path to the file to read"),
+	},
+	Options: []cmds.Option{
+		cmds.IntOption("offset", "o", "Offset to read from"),
+		cmds.IntOption("count", "n", "Maximum number of bytes to read"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		pth := req.Arguments()[0]
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		fsn, err := nsfs.Lookup(root, pth)
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		fi, ok := fsn.(*nsfs.File)
+		if !ok {
+			res.SetError(fmt.Errorf("%s is not a file", pth), cmds.ErrNormal)
+			return
+		}
+
+		// read from the current node, the file may be written meanwhile
+		nd, err := fi.GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		dr, err := uio.NewDagReader(req.Context(), nd, n.DAG)
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		offset, _, _ := req.Option("offset").Int()
+		if offset < 0 || uint64(offset) > dr.Size() {
+			res.SetError(fmt.Errorf("offset %d is out of the file", offset), cmds.ErrClient)
+			return
+		}
+		if _, err := dr.Seek(int64(offset), os.SEEK_SET); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		var r io.Reader = dr
+		if count, found, _ := req.Option("count").Int(); found {
+			if count < 0 {
+				res.SetError(errors.New("cannot read a negative count of bytes"), cmds.ErrClient)
+				return
+			}
+			r = io.LimitReader(dr, int64(count))
+		}
+		res.SetOutput(r)
+	},
+}
+
+var FilesRmCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Remove a file or directory",
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("path", true, true, "Paths to the entries to remove"),
+	},
+	Options: []cmds.Option{
+		cmds.BoolOption("r", "Recursively remove directories"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		recursive, _, _ := req.Option("r").Bool()
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		for _, pth := range req.Arguments() {
+			if err := remove(root, pth, recursive); err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+		}
+
+		if err := flush(req, n); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+	},
+}
+
+// remove removes the entry at pth below root, which may only be a
+// directory if recursive is set.
+func remove(root *nsfs.Directory, pth string, recursive bool) error {
+	if strings.Trim(pth, "/") == "" {
+		return errors.New("cannot remove the root")
+	}
+
+	fsn, err := nsfs.Lookup(root, pth)
+	if err != nil {
+		return err
+	}
+	if fsn.Type() == nsfs.TDir && !recursive {
+		return fmt.Errorf("%s is a directory, use -r to remove directories", pth)
+	}
+
+	return nsfs.Remove(root, pth)
+}
+
+var FilesMvCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Move a file or directory",
+		ShortDescription: `
+'ipfs files mv' moves the entry at <source> to <dest>, or into <dest>
+if it is a directory.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("source", true, false, "Path to the entry to move"),
+		cmds.StringArg("dest", true, false, "Path to move it to"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		src, dst := req.Arguments()[0], req.Arguments()[1]
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		if err := nsfs.Mv(root, src, dst); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if err := flush(req, n); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+	},
+}
+
+var FilesCpCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Copy a file or directory",
+		ShortDescription: `
+'ipfs files cp' copies the entry at <source> to <dest>, or into <dest>
+if it is a directory. <source> is either a path in the files root, or an
+/ipfs/ or /ipns/ path: 'ipfs files cp /ipfs/<hash> /<path>' links
+existing content in, without copying its data.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("source", true, false, "Path to the entry to copy"),
+		cmds.StringArg("dest", true, false, "Path to copy it to"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		src, dst := req.Arguments()[0], req.Arguments()[1]
+		root, err := n.FilesRoot.Root(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		if err := cp(req, n, root, src, dst); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if err := flush(req, n); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+	},
+}
+
+// cp copies the entry at src, an /ipfs/ or /ipns/ path or a path below
+// root, to dst below root or into dst if it is a directory.
+func cp(req cmds.Request, n *core.IpfsNode, root *nsfs.Directory, src, dst string) error {
+	nd, err := sourceNode(req, n, root, src)
+	if err != nil {
+		return err
+	}
+
+	if fsn, err := nsfs.Lookup(root, dst); err == nil {
+		if fsn.Type() != nsfs.TDir {
+			return fmt.Errorf("%s already exists", dst)
+		}
+		dst = gopath.Join(dst, gopath.Base(src))
+	}
+
+	return nsfs.PutNode(root, dst, nd)
+}
+
+// sourceNode returns a copy of the node at src, an /ipfs/ or /ipns/ path
+// or a path below root.
+func sourceNode(req cmds.Request, n *core.IpfsNode, root *nsfs.Directory, src string) (*dag.Node, error) {
+	if strings.HasPrefix(src, "/ipfs/") || strings.HasPrefix(src, "/ipns/") {
+		p, err := path.ParsePath(src)
+		if err != nil {
+			return nil, err
+		}
+		return core.Resolve(req.Context(), n, p)
+	}
+
+	fsn, err := nsfs.Lookup(root, src)
+	if err != nil {
+		return nil, err
+	}
+	nd, err := fsn.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	// the node of a directory changes in place with the directory
+	return nd.Copy(), nil
+}
+
+var FilesFlushCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Flush the changes to the repo",
+		ShortDescription: `
+'ipfs files flush' records the current root in the repo and pins it.
+Only needed after changes made with --flush=false.
+`,
+	},
+
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if err := n.FilesRoot.Flush(req.Context()); err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+	},
+}
+
+// PublishOutput is the name the files root was published to, and the path
+// it resolves to.
+type PublishOutput struct {
+	Name  string
+	Value string
+}
+
+var FilesPublishCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Publish the root of the filesystem to ipns",
+		ShortDescription: `
+'ipfs files publish' flushes the changes and publishes the root under the
+ipns name of this node, like 'ipfs name publish' would.
+`,
+	},
+
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		if !n.OnlineMode() {
+			if err := n.SetupOfflineRouting(); err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+		}
+		if n.Identity == "" {
+			res.SetError(errors.New("Identity not loaded!"), cmds.ErrNormal)
+			return
+		}
+
+		p, err := n.FilesRoot.Publish(req.Context(), n.Namesys, n.PrivateKey)
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		res.SetOutput(&PublishOutput{
+			Name:  n.Identity.Pretty(),
+			Value: p.String(),
+		})
+	},
+	Marshalers: cmds.MarshalerMap{
+		cmds.Text: func(res cmds.Response) (io.Reader, error) {
+			v := res.Output().(*PublishOutput)
+			s := fmt.Sprintf("Published to %s: %s\n", v.Name, v.Value)
+			return strings.NewReader(s), nil
+		},
+	},
+	Type: PublishOutput{},
+}
+
+// flush records the changes made by req in the files root, unless it was
+// given --flush=false.
+func flush(req cmds.Request, n *core.IpfsNode) error {
+	doflush, found, _ := req.Option(flushOptionName).Bool()
+	if found && !doflush {
+		return nil
+	}
+	return n.FilesRoot.Flush(req.Context())
+}
@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	cmds "github.com/ipfs/go-ipfs/commands"
+	filestore "github.com/ipfs/go-ipfs/filestore"
+	u "github.com/ipfs/go-ipfs/util"
+)
+
+// FilestoreObj is a reference to data in a file, as listed by the
+// filestore commands.
+type FilestoreObj struct {
+	Key      string
+	FilePath string
+	Offset   uint64
+	Size     uint64
+	Status   string `json:",omitempty"`
+	Error    string `json:",omitempty"`
+}
+
+var FilestoreCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Interact with objects added with 'ipfs add --nocopy'",
+		ShortDescription: `
+Blocks added with 'ipfs add --nocopy' are not copied into the repo. The
+filestore keeps references to the files they were read from instead, and
+the blocks are read back from those files when needed.
+`,
+	},
+
+	Subcommands: map[string]*cmds.Command{
+		"ls":     filestoreLsCmd,
+		"verify": filestoreVerifyCmd,
+	},
+}
+
+var filestoreLsCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "List the references in the filestore",
+		ShortDescription: `
+'ipfs filestore ls' lists the hash of every block kept in the filestore,
+with the path of the file and the offset and size of its data.
+`,
+	},
+
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		refs, err := n.Filestore.List(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		res.SetOutput(filestoreOutput(refs, false))
+	},
+	Type: FilestoreObj{},
+	Marshalers: cmds.MarshalerMap{
+		cmds.Text: filestoreMarshaler(func(o *FilestoreObj) string {
+			return fmt.Sprintf("%s %s %d %d\n", o.Key, o.FilePath, o.Offset, o.Size)
+		}),
+	},
+}
+
+var filestoreVerifyCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Check that the files in the filestore still hold their data",
+		ShortDescription: `
+'ipfs filestore verify' reads every block in the filestore back from its
+file and prints its status:
+
+    ok        the file holds the data of the block
+    changed   the file was modified since it was added
+    no-file   the file is gone
+    error     the block could not be checked
+
+Use 'ipfs repo gc' to drop the references that are not pinned. Files are
+never removed by ipfs.
+`,
+	},
+
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		refs, err := n.Filestore.Verify(req.Context())
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		res.SetOutput(filestoreOutput(refs, true))
+	},
+	Type: FilestoreObj{},
+	Marshalers: cmds.MarshalerMap{
+		cmds.Text: filestoreMarshaler(func(o *FilestoreObj) string {
+			if o.Error != "" {
+				return fmt.Sprintf("%s %s %s: %s\n", o.Key, o.Status, o.FilePath, o.Error)
+			}
+			return fmt.Sprintf("%s %s %s\n", o.Key, o.Status, o.FilePath)
+		}),
+	},
+}
+
+func filestoreOutput(refs <-chan *filestore.ListRes, status bool) <-chan interface{} {
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		for r := range refs {
+			o := &FilestoreObj{Key: r.Key.B58String()}
+			if r.DataObj != nil {
+				o.FilePath = r.FilePath
+				o.Offset = r.Offset
+				o.Size = r.Size
+			}
+			if status {
+				o.Status = r.Status.String()
+			}
+			if r.Err != nil {
+				o.Error = r.Err.Error()
+			}
+			out <- o
+		}
+	}()
+	return out
+}
+
+func filestoreMarshaler(format func(*FilestoreObj) string) cmds.Marshaler {
+	return func(res cmds.Response) (io.Reader, error) {
+		outChan, ok := res.Output().(<-chan interface{})
+		if !ok {
+			return nil, u.ErrCast()
+		}
+
+		marshal := func(v interface{}) (io.Reader, error) {
+			o, ok := v.(*FilestoreObj)
+			if !ok {
+				return nil, u.ErrCast()
+			}
+			return bytes.NewBufferString(format(o)), nil
+		}
+
+		return &cmds.ChannelMarshaler{
+			Channel:   outChan,
+			Marshaler: marshal,
+			Res:       res,
+		}, nil
+	}
+}
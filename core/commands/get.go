@@ -10,9 +10,11 @@ import (
 	"strings"
 
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/cheggaaa/pb"
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	cmds "github.com/ipfs/go-ipfs/commands"
 	core "github.com/ipfs/go-ipfs/core"
+	traverse "github.com/ipfs/go-ipfs/merkledag/traverse"
 	path "github.com/ipfs/go-ipfs/path"
 	tar "github.com/ipfs/go-ipfs/thirdparty/tar"
 	uarchive "github.com/ipfs/go-ipfs/unixfs/archive"
@@ -44,6 +46,7 @@ may also specify the level of compression by specifying '-l=<1-9>'.
 		cmds.BoolOption("archive", "a", "Output a TAR archive"),
 		cmds.BoolOption("compress", "C", "Compress the output with GZIP compression"),
 		cmds.IntOption("compression-level", "l", "The level of compression (1-9)"),
+		fetchConcurrencyOption,
 	},
 	PreRun: func(req cmds.Request) error {
 		_, err := getCompressOptions(req)
@@ -69,6 +72,24 @@ may also specify the level of compression by specifying '-l=<1-9>'.
 			return
 		}
 
+		concurrency, _, err := req.Option(fetchConcurrencyOptionName).Int()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		// fetch in parallel what the archive will read in order
+		go func() {
+			err := traverse.Fetch(ctx, dn, traverse.FetchOptions{
+				DAG:         node.DAG,
+				Concurrency: concurrency,
+				Local:       node.Blockstore,
+			})
+			if err != nil && err != context.Canceled {
+				log.Debugf("get: prefetch: %s", err)
+			}
+		}()
+
 		archive, _, _ := req.Option("archive").Bool()
 		reader, err := uarchive.DagArchive(ctx, dn, p.String(), node.DAG, archive, cmplvl)
 		if err != nil {
@@ -195,7 +216,7 @@ func (gw *getWriter) writeExtracted(r io.Reader, fpath string) error {
 	bar.Start()
 	defer bar.Finish()
 
-	extractor := &tar.Extractor{fpath}
+	extractor := &tar.Extractor{Path: fpath}
 	return extractor.Extract(barR)
 }
 
@@ -12,9 +12,11 @@ import (
 	"text/tabwriter"
 
 	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	key "github.com/ipfs/go-ipfs/blocks/key"
 	cmds "github.com/ipfs/go-ipfs/commands"
+	files "github.com/ipfs/go-ipfs/commands/files"
 	core "github.com/ipfs/go-ipfs/core"
 	dag "github.com/ipfs/go-ipfs/merkledag"
 	dagutils "github.com/ipfs/go-ipfs/merkledag/utils"
@@ -31,6 +33,11 @@ const inputLimit = 512 * 1024
 type Node struct {
 	Links []Link
 	Data  string
+
+	// Document is the document held by document nodes, with links as
+	// {"/": "<hash>", "size": <size>}. Given to 'ipfs object put', it
+	// replaces Links and Data.
+	Document interface{} `json:",omitempty" xml:"-"`
 }
 
 type Link struct {
@@ -57,6 +64,8 @@ ipfs object links <key>     - Outputs links pointed to by object
 ipfs object stat <key>      - Outputs statistics of object
 ipfs object new <template>  - Create new ipfs objects
 ipfs object patch <args>    - Create new object from old ones
+ipfs object diff <a> <b>    - Display the changes between two objects
+ipfs object merge <base> <a> <b> - Merge the changes made to an object
 `,
 	},
 
@@ -68,9 +77,34 @@ ipfs object patch <args>    - Create new object from old ones
 		"stat":  objectStatCmd,
 		"new":   objectNewCmd,
 		"patch": objectPatchCmd,
+		"diff":  objectDiffCmd,
+		"merge": objectMergeCmd,
 	},
 }
 
+// ObjectDiffChange is a change between two objects, as output by
+// 'ipfs object diff'.
+type ObjectDiffChange struct {
+	Type   string // add, remove or mod
+	Path   string
+	Before string `json:",omitempty"`
+	After  string `json:",omitempty"`
+}
+
+// ObjectMergeOutput is the output of 'ipfs object merge': the merged
+// object and the conflicts resolved by the strategy.
+type ObjectMergeOutput struct {
+	Hash      string
+	Conflicts []ObjectMergeConflict `json:",omitempty"`
+}
+
+// ObjectMergeConflict is a pair of conflicting changes, A made in the first
+// object and B in the second one.
+type ObjectMergeConflict struct {
+	A ObjectDiffChange
+	B ObjectDiffChange
+}
+
 var objectDataCmd = &cmds.Command{
 	Helptext: cmds.HelpText{
 		Tagline: "Outputs the raw bytes in an IPFS object",
@@ -201,6 +235,14 @@ This command outputs data in the following encodings:
 			Data:  string(object.Data),
 		}
 
+		if object.IsDocument() {
+			node.Document, err = object.Document()
+			if err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+		}
+
 		for i, link := range object.Links {
 			node.Links[i] = Link{
 				Hash: link.Hash.B58String(),
@@ -325,6 +367,20 @@ create a file named node.json with the contents:
 and then run
 
 	ipfs object put node.json
+
+Structured documents, with links anywhere in nested maps and lists, are
+stored with a "Document" field instead of "Data" and "Links":
+
+    {
+        "Document": {
+            "title": "another",
+            "authors": [ { "/": "QmXg9Pp2ytZ14xgmQjYEiHjVjMFXzCVVEcRTWJBmLgR39V" } ]
+        }
+    }
+
+Links are maps with the key "/". The cumulative size of the object they
+link to is filled in under the key "size". They can be followed in paths:
+/ipfs/<key>/authors/0 is the object linked to above.
 `,
 	},
 
@@ -333,6 +389,7 @@ and then run
 	},
 	Options: []cmds.Option{
 		cmds.StringOption("inputenc", "Encoding type of input data, either \"protobuf\" or \"json\""),
+		hashOption,
 	},
 	Run: func(req cmds.Request, res cmds.Response) {
 		n, err := req.InvocContext().GetNode()
@@ -356,7 +413,13 @@ and then run
 			inputenc = "json"
 		}
 
-		output, err := objectPut(n, input, inputenc)
+		hashFn, err := getHashFunc(req)
+		if err != nil {
+			res.SetError(err, cmds.ErrClient)
+			return
+		}
+
+		output, err := objectPut(req.Context(), n, input, inputenc, hashFn)
 		if err != nil {
 			errType := cmds.ErrNormal
 			if err == ErrUnknownObjectEnc {
@@ -465,17 +528,46 @@ The data inside the node can be modified as well:
     ipfs object patch $FOO_BAR set-data < file.dat
     ipfs object patch $FOO_BAR append-data < file.dat
 
+With --batch, several patch commands are applied at once: they are read
+from stdin as a sequence of JSON objects, and only the nodes of the final
+object are written, not the ones of each step.
+
+    ipfs object patch --batch $ROOT < patch.json
+
+where patch.json holds:
+
+    {"Op": "add-link", "Name": "a/b", "Ref": "<hash>"}
+    {"Op": "rm-link", "Name": "c"}
+    {"Op": "set-data", "Data": "..."}
+    {"Op": "append-data", "Data": "..."}
+
 `,
 	},
 	Options: []cmds.Option{
 		cmds.BoolOption("create", "p", "create intermediate directories on add-link"),
+		cmds.BoolOption("batch", "apply the JSON patch commands read from stdin"),
 	},
 	Arguments: []cmds.Argument{
 		cmds.StringArg("root", true, false, "the hash of the node to modify"),
-		cmds.StringArg("command", true, false, "the operation to perform"),
-		cmds.StringArg("args", true, true, "extra arguments").EnableStdin(),
+		cmds.StringArg("command", false, false, "the operation to perform"),
+		cmds.StringArg("args", false, true, "extra arguments").EnableStdin(),
 	},
 	Type: Object{},
+	PreRun: func(req cmds.Request) error {
+		batch, _, err := req.Option("batch").Bool()
+		if err != nil || !batch {
+			return err
+		}
+
+		// send the patch commands in the body of the request, there may
+		// be too many for its url
+		args := req.Arguments()
+		ops := strings.Join(args[1:], "\n")
+		opsFile := files.NewReaderFile("", "", ioutil.NopCloser(strings.NewReader(ops)), nil)
+		req.SetFiles(files.NewSliceFile("", "", []files.File{opsFile}))
+		req.SetArguments(args[:1])
+		return nil
+	},
 	Run: func(req cmds.Request, res cmds.Response) {
 		nd, err := req.InvocContext().GetNode()
 		if err != nil {
@@ -499,6 +591,36 @@ The data inside the node can be modified as well:
 			return
 		}
 
+		batch, _, err := req.Option("batch").Bool()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		if batch {
+			var ops io.Reader
+			if req.Files() != nil {
+				ops, err = req.Files().NextFile()
+				if err != nil {
+					res.SetError(err, cmds.ErrNormal)
+					return
+				}
+			} else {
+				ops = strings.NewReader(strings.Join(req.Arguments()[1:], "\n"))
+			}
+
+			k, err := patchBatch(req, rnode, ops)
+			if err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+			res.SetOutput(&Object{Hash: k.B58String()})
+			return
+		}
+
+		if len(req.Arguments()) < 2 {
+			res.SetError(errors.New("missing the patch command"), cmds.ErrClient)
+			return
+		}
 		action := req.Arguments()[1]
 
 		switch action {
@@ -547,6 +669,78 @@ The data inside the node can be modified as well:
 	},
 }
 
+// PatchOp is a patch command of 'ipfs object patch --batch'.
+type PatchOp struct {
+	Op   string // add-link, rm-link, set-data or append-data
+	Name string `json:",omitempty"` // path of the link, for add-link and rm-link
+	Ref  string `json:",omitempty"` // for add-link
+	Data string `json:",omitempty"` // for set-data and append-data
+}
+
+// patchBatch applies the patch commands read from ops to root, through
+// one editor writing out only the nodes of the result.
+func patchBatch(req cmds.Request, root *dag.Node, ops io.Reader) (key.Key, error) {
+	nd, err := req.InvocContext().GetNode()
+	if err != nil {
+		return "", err
+	}
+
+	create, _, err := req.Option("create").Bool()
+	if err != nil {
+		return "", err
+	}
+	var createfunc func() *dag.Node
+	if create {
+		createfunc = func() *dag.Node {
+			return &dag.Node{Data: ft.FolderPBData()}
+		}
+	}
+
+	ctx := req.Context()
+	e := dagutils.NewMemoryEditor(nd.DAG, root)
+	dec := json.NewDecoder(ops)
+	for i := 0; ; i++ {
+		var op PatchOp
+		err := dec.Decode(&op)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("patch command %d: %s", i, err)
+		}
+
+		switch op.Op {
+		case "set-data":
+			e.SetRootData([]byte(op.Data))
+		case "append-data":
+			e.SetRootData(append(e.GetNode().Data, []byte(op.Data)...))
+		case "add-link":
+			childk := key.B58KeyDecode(op.Ref)
+			if childk == "" {
+				return "", fmt.Errorf("patch command %d: incorrectly formatted hash: %s", i, op.Ref)
+			}
+			childnd, err := nd.DAG.Get(ctx, childk)
+			if err != nil {
+				return "", fmt.Errorf("patch command %d: %s", i, err)
+			}
+			if err := e.InsertNodeAtPath(ctx, op.Name, childnd, createfunc); err != nil {
+				return "", fmt.Errorf("patch command %d: %s", i, err)
+			}
+		case "rm-link":
+			if err := e.RmLink(ctx, op.Name); err != nil {
+				return "", fmt.Errorf("patch command %d: %s", i, err)
+			}
+		default:
+			return "", fmt.Errorf("patch command %d: unrecognized command %q", i, op.Op)
+		}
+	}
+
+	if err := e.WriteOutputTo(nd.DAG); err != nil {
+		return "", err
+	}
+	return e.GetNode().Key()
+}
+
 func appendDataCaller(req cmds.Request, root *dag.Node) (key.Key, error) {
 	if len(req.Arguments()) < 3 {
 		return "", fmt.Errorf("not enough arguments for set-data")
@@ -668,7 +862,7 @@ func nodeFromTemplate(template string) (*dag.Node, error) {
 var ErrEmptyNode = errors.New("no data or links in this node")
 
 // objectPut takes a format option, serializes bytes from stdin and updates the dag with that data
-func objectPut(n *core.IpfsNode, input io.Reader, encoding string) (*Object, error) {
+func objectPut(ctx context.Context, n *core.IpfsNode, input io.Reader, encoding string, hashFn int) (*Object, error) {
 
 	data, err := ioutil.ReadAll(io.LimitReader(input, inputLimit+10))
 	if err != nil {
@@ -682,6 +876,15 @@ func objectPut(n *core.IpfsNode, input io.Reader, encoding string) (*Object, err
 	var dagnode *dag.Node
 	switch getObjectEnc(encoding) {
 	case objectEncodingJSON:
+		var doc struct{ Document json.RawMessage }
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		if len(doc.Document) > 0 && string(doc.Document) != "null" {
+			dagnode, err = documentNode(ctx, n.DAG, doc.Document)
+			break
+		}
+
 		node := new(Node)
 		err = json.Unmarshal(data, node)
 		if err != nil {
@@ -728,6 +931,7 @@ func objectPut(n *core.IpfsNode, input io.Reader, encoding string) (*Object, err
 		return nil, err
 	}
 
+	dagnode.SetHashFunc(hashFn)
 	_, err = n.DAG.Add(dagnode)
 	if err != nil {
 		return nil, err
@@ -736,6 +940,20 @@ func objectPut(n *core.IpfsNode, input io.Reader, encoding string) (*Object, err
 	return getOutput(dagnode)
 }
 
+// documentNode returns the document node holding the JSON document data,
+// with the sizes of its links filled in from ds.
+func documentNode(ctx context.Context, ds dag.DAGService, data []byte) (*dag.Node, error) {
+	doc, err := dag.ParseDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	doc, err = dag.FillDocLinkSizes(ctx, ds, doc)
+	if err != nil {
+		return nil, err
+	}
+	return dag.NewDocumentNode(doc)
+}
+
 // ErrUnknownObjectEnc is returned if a invalid encoding is supplied
 var ErrUnknownObjectEnc = errors.New("unknown object encoding")
 
@@ -803,3 +1021,227 @@ func deserializeNode(node *Node) (*dag.Node, error) {
 func NodeEmpty(node *Node) bool {
 	return (node.Data == "" && len(node.Links) == 0)
 }
+
+var objectDiffCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Display the changes between two objects",
+		ShortDescription: `
+'ipfs object diff' prints the changes turning the tree below <obj_a> into
+the tree below <obj_b>: the entries added, removed and changed, by path.
+Entries of unixfs directories are compared by name and files as a whole,
+so a modified file is one change, whatever number of its blocks changed.
+Changes are printed as they are found.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("obj_a", true, false, "Object to diff against"),
+		cmds.StringArg("obj_b", true, false, "Object to diff"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		ctx := req.Context()
+		a, err := core.Resolve(ctx, n, path.Path(req.Arguments()[0]))
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		b, err := core.Resolve(ctx, n, path.Path(req.Arguments()[1]))
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		outChan := make(chan interface{})
+		res.SetOutput((<-chan interface{})(outChan))
+
+		go func() {
+			defer close(outChan)
+			err := dagutils.DiffFunc(ctx, n.DAG, a, b, func(c *dagutils.Change) error {
+				select {
+				case outChan <- diffChangeOutput(c):
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+			if err != nil {
+				res.SetError(err, cmds.ErrNormal)
+			}
+		}()
+	},
+	Type: ObjectDiffChange{},
+	Marshalers: cmds.MarshalerMap{
+		cmds.Text: func(res cmds.Response) (io.Reader, error) {
+			outChan, ok := res.Output().(<-chan interface{})
+			if !ok {
+				return nil, u.ErrCast()
+			}
+
+			marshal := func(v interface{}) (io.Reader, error) {
+				c, ok := v.(*ObjectDiffChange)
+				if !ok {
+					return nil, u.ErrCast()
+				}
+
+				p := c.Path
+				if p == "" {
+					p = "/"
+				}
+				var s string
+				switch c.Type {
+				case "add":
+					s = fmt.Sprintf("Added %s at %s\n", c.After, p)
+				case "remove":
+					s = fmt.Sprintf("Removed %s from %s\n", c.Before, p)
+				default:
+					s = fmt.Sprintf("Changed %s to %s at %s\n", c.Before, c.After, p)
+				}
+				return strings.NewReader(s), nil
+			}
+
+			return &cmds.ChannelMarshaler{
+				Channel:   outChan,
+				Marshaler: marshal,
+				Res:       res,
+			}, nil
+		},
+	},
+}
+
+func diffChangeOutput(c *dagutils.Change) *ObjectDiffChange {
+	o := &ObjectDiffChange{Path: c.Path}
+	switch c.Type {
+	case dagutils.Add:
+		o.Type = "add"
+	case dagutils.Remove:
+		o.Type = "remove"
+	case dagutils.Mod:
+		o.Type = "mod"
+	}
+	if c.Before != "" {
+		o.Before = c.Before.B58String()
+	}
+	if c.After != "" {
+		o.After = c.After.B58String()
+	}
+	return o
+}
+
+var objectMergeCmd = &cmds.Command{
+	Helptext: cmds.HelpText{
+		Tagline: "Merge the changes made to an object",
+		ShortDescription: `
+'ipfs object merge' merges the changes made to the tree below <base> in the
+trees below <obj_a> and <obj_b>, and outputs the hash of the merged object.
+`,
+		LongDescription: `
+'ipfs object merge' merges the changes made to the tree below <base> in the
+trees below <obj_a> and <obj_b>, and outputs the hash of the merged object.
+The changes are found as by 'ipfs object diff'.
+
+Two changes conflict if they change the same path differently, or if one
+changes an entry and the other one something below it, like a directory
+removed in <obj_a> with a file in it changed in <obj_b>. The --strategy
+option selects what to do then:
+
+    fail       - fail and print the conflicts (default)
+    ours       - keep the version of <obj_a>
+    theirs     - keep the version of <obj_b>
+    keep-both  - keep the version of <obj_a>, and the one of <obj_b> next
+                 to it with the suffix '.theirs'
+
+Conflicts are resolved at the upper of the two paths: with 'ours', the
+directory removed in <obj_a> above stays removed.
+`,
+	},
+
+	Arguments: []cmds.Argument{
+		cmds.StringArg("base", true, false, "Object both sides were changed from"),
+		cmds.StringArg("obj_a", true, false, "Object with our changes"),
+		cmds.StringArg("obj_b", true, false, "Object with their changes"),
+	},
+	Options: []cmds.Option{
+		cmds.StringOption("strategy", "s", "How to resolve conflicts: fail, ours, theirs or keep-both (default fail)"),
+	},
+	Run: func(req cmds.Request, res cmds.Response) {
+		n, err := req.InvocContext().GetNode()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		s, _, _ := req.Option("strategy").String()
+		strategy, err := dagutils.ParseMergeStrategy(s)
+		if err != nil {
+			res.SetError(err, cmds.ErrClient)
+			return
+		}
+
+		ctx := req.Context()
+		var nodes []*dag.Node
+		for _, arg := range req.Arguments() {
+			nd, err := core.Resolve(ctx, n, path.Path(arg))
+			if err != nil {
+				res.SetError(err, cmds.ErrNormal)
+				return
+			}
+			nodes = append(nodes, nd)
+		}
+
+		merged, conflicts, err := dagutils.Merge(ctx, n.DAG, nodes[0], nodes[1], nodes[2], strategy)
+		if err == dagutils.ErrMergeConflict {
+			var paths []string
+			for _, c := range conflicts {
+				paths = append(paths, fmt.Sprintf("\n%s / %s", conflictPath(c.A), conflictPath(c.B)))
+			}
+			res.SetError(fmt.Errorf("%s:%s", err, strings.Join(paths, "")), cmds.ErrNormal)
+			return
+		}
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		k, err := merged.Key()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		out := &ObjectMergeOutput{Hash: k.B58String()}
+		for _, c := range conflicts {
+			out.Conflicts = append(out.Conflicts, ObjectMergeConflict{
+				A: *diffChangeOutput(c.A),
+				B: *diffChangeOutput(c.B),
+			})
+		}
+		res.SetOutput(out)
+	},
+	Type: ObjectMergeOutput{},
+	Marshalers: cmds.MarshalerMap{
+		cmds.Text: func(res cmds.Response) (io.Reader, error) {
+			out, ok := res.Output().(*ObjectMergeOutput)
+			if !ok {
+				return nil, u.ErrCast()
+			}
+
+			buf := new(bytes.Buffer)
+			for _, c := range out.Conflicts {
+				fmt.Fprintf(buf, "Resolved conflict: %s %s / %s %s\n", c.A.Type, c.A.Path, c.B.Type, c.B.Path)
+			}
+			fmt.Fprintln(buf, out.Hash)
+			return buf, nil
+		},
+	},
+}
+
+func conflictPath(c *dagutils.Change) string {
+	o := diffChangeOutput(c)
+	return o.Type + " " + o.Path
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	cli "github.com/ipfs/go-ipfs/commands/cli"
+)
+
+func TestObjectPatchBatchParse(t *testing.T) {
+	ops := `{"Op": "rm-link", "Name": "a"}` + "\n"
+	stdin, err := ioutil.TempFile("", "patch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stdin.Name())
+	defer stdin.Close()
+	if _, err := stdin.WriteString(ops); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stdin.Seek(0, os.SEEK_SET); err != nil {
+		t.Fatal(err)
+	}
+
+	root := "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn"
+	req, cmd, _, err := cli.Parse([]string{"object", "patch", "--batch", root}, stdin, Root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd != objectPatchCmd {
+		t.Fatal("expected the patch command")
+	}
+
+	// the commands are moved to the body of the request
+	if err := cmd.PreRun(req); err != nil {
+		t.Fatal(err)
+	}
+	if args := req.Arguments(); len(args) != 1 || args[0] != root {
+		t.Fatalf("expected the root as only argument, got %v", args)
+	}
+
+	f, err := req.Files().NextFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != strings.TrimSpace(ops) {
+		t.Fatalf("expected the ops from stdin, got %q", b)
+	}
+}
@@ -41,6 +41,7 @@ on disk.
 	},
 	Options: []cmds.Option{
 		cmds.BoolOption("recursive", "r", "Recursively pin the object linked to by the specified object(s)"),
+		fetchConcurrencyOption,
 	},
 	Type: PinOutput{},
 	Run: func(req cmds.Request, res cmds.Response) {
@@ -60,7 +61,13 @@ on disk.
 			recursive = true
 		}
 
-		added, err := corerepo.Pin(n, req.Context(), req.Arguments(), recursive)
+		concurrency, _, err := req.Option(fetchConcurrencyOptionName).Int()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		added, err := corerepo.Pin(n, req.Context(), req.Arguments(), recursive, concurrency)
 		if err != nil {
 			res.SetError(err, cmds.ErrNormal)
 			return
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -12,6 +13,7 @@ import (
 	cmds "github.com/ipfs/go-ipfs/commands"
 	"github.com/ipfs/go-ipfs/core"
 	dag "github.com/ipfs/go-ipfs/merkledag"
+	traverse "github.com/ipfs/go-ipfs/merkledag/traverse"
 	path "github.com/ipfs/go-ipfs/path"
 	u "github.com/ipfs/go-ipfs/util"
 )
@@ -31,6 +33,12 @@ func KeyListTextMarshaler(res cmds.Response) (io.Reader, error) {
 	return buf, nil
 }
 
+const fetchConcurrencyOptionName = "fetch-concurrency"
+
+// fetchConcurrencyOption sets how many blocks commands walking a dag fetch
+// at once.
+var fetchConcurrencyOption = cmds.IntOption(fetchConcurrencyOptionName, "Number of blocks fetched at once (default 32)")
+
 var RefsCmd = &cmds.Command{
 	Helptext: cmds.HelpText{
 		Tagline: "Lists links (references) from an object",
@@ -41,6 +49,29 @@ hashes it contains, with the following format:
   <link base58 hash>
 
 Note: list all refs recursively with -r.
+`,
+		LongDescription: `
+Retrieves the object named by <ipfs-path> and displays the link
+hashes it contains, with the following format:
+
+  <link base58 hash>
+
+Note: list all refs recursively with -r.
+
+--max-depth limits how deep -r goes: 1 lists the links of the object,
+2 those of its children too, and so on.
+
+--sizes adds the cumulative size of the object linked to, to each line.
+In --format strings, it is the <size> token.
+
+--graph emits the refs as a graph, instead of one per line:
+
+  dot    - a Graphviz digraph, to render with 'dot -Tsvg'
+  jsonl  - one JSON object per edge: {"Src", "Dst", "Name", "Size"}
+
+For example, to see what makes up the bulk of a pinned object:
+
+  ipfs refs -r -u --max-depth=3 --graph=dot <hash> | dot -Tsvg > refs.svg
 `,
 	},
 	Subcommands: map[string]*cmds.Command{
@@ -54,6 +85,10 @@ Note: list all refs recursively with -r.
 		cmds.BoolOption("edges", "e", "Emit edge format: `<from> -> <to>`"),
 		cmds.BoolOption("unique", "u", "Omit duplicate refs from output"),
 		cmds.BoolOption("recursive", "r", "Recursively list links of child nodes"),
+		cmds.IntOption("max-depth", "Only recurse that many levels deep (implies -r)"),
+		cmds.BoolOption("sizes", "s", "Annotate refs with the cumulative size of their objects"),
+		cmds.StringOption("graph", "Emit the refs as a graph: dot or jsonl"),
+		fetchConcurrencyOption,
 	},
 	Run: func(req cmds.Request, res cmds.Response) {
 		ctx := req.Context()
@@ -87,6 +122,39 @@ Note: list all refs recursively with -r.
 			return
 		}
 
+		maxDepth, _, err := req.Option("max-depth").Int()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		if maxDepth > 0 {
+			recursive = true
+		}
+
+		sizes, _, err := req.Option("sizes").Bool()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
+		graph, _, err := req.Option("graph").String()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+		switch graph {
+		case "", "dot", "jsonl":
+		default:
+			res.SetError(fmt.Errorf("unknown graph format %q, expected dot or jsonl", graph), cmds.ErrClient)
+			return
+		}
+
+		concurrency, _, err := req.Option(fetchConcurrencyOptionName).Int()
+		if err != nil {
+			res.SetError(err, cmds.ErrNormal)
+			return
+		}
+
 		objs, err := objectsForPaths(ctx, n, req.Arguments())
 		if err != nil {
 			res.SetError(err, cmds.ErrNormal)
@@ -107,14 +175,42 @@ Note: list all refs recursively with -r.
 				PrintEdge: edges,
 				PrintFmt:  format,
 				Recursive: recursive,
+				MaxDepth:  maxDepth,
+				Sizes:     sizes,
+				Graph:     graph,
 			}
 
+			if graph == "dot" {
+				out <- &RefWrapper{Ref: "digraph \"refs\" {"}
+			}
 			for _, o := range objs {
-				if _, err := rw.WriteRefs(o); err != nil {
+				fctx, cancel := context.WithCancel(ctx)
+				if recursive {
+					// fetch in parallel what the ordered walk below
+					// will read
+					go func(o *dag.Node) {
+						err := traverse.Fetch(fctx, o, traverse.FetchOptions{
+							DAG:         n.DAG,
+							Concurrency: concurrency,
+							Local:       n.Blockstore,
+							MaxDepth:    maxDepth,
+						})
+						if err != nil && err != context.Canceled {
+							log.Debugf("refs: prefetch: %s", err)
+						}
+					}(o)
+				}
+
+				_, err := rw.WriteRefs(o)
+				cancel()
+				if err != nil {
 					out <- &RefWrapper{Err: err.Error()}
 					return
 				}
 			}
+			if graph == "dot" {
+				out <- &RefWrapper{Ref: "}"}
+			}
 		}()
 	},
 	Marshalers: cmds.MarshalerMap{
@@ -213,10 +309,22 @@ type RefWriter struct {
 
 	Unique    bool
 	Recursive bool
+	MaxDepth  int // of recursion, no limit if not positive
 	PrintEdge bool
 	PrintFmt  string
+	Sizes     bool
+	Graph     string // dot, jsonl, or "" for lines
 
-	seen map[key.Key]struct{}
+	seen     map[key.Key]struct{}
+	seenDeep map[string]int // nodes visited by the recursive walks, see traverse.Options
+}
+
+// RefEdge is an edge of the graph of refs, as emitted by --graph=jsonl.
+type RefEdge struct {
+	Src  string
+	Dst  string
+	Name string
+	Size uint64 `json:",omitempty"`
 }
 
 // WriteRefs writes refs of the given object to the underlying writer.
@@ -228,34 +336,37 @@ func (rw *RefWriter) WriteRefs(n *dag.Node) (int, error) {
 }
 
 func (rw *RefWriter) writeRefsRecursive(n *dag.Node) (int, error) {
-	nkey, err := n.Key()
-	if err != nil {
-		return 0, err
+	if rw.Unique && rw.seenDeep == nil {
+		rw.seenDeep = make(map[string]int)
 	}
 
 	var count int
-	for i, ng := range rw.DAG.GetDAG(rw.Ctx, n) {
-		lk := key.Key(n.Links[i].Hash)
-		if rw.skip(lk) {
-			continue
-		}
-
-		if err := rw.WriteEdge(nkey, lk, n.Links[i].Name); err != nil {
-			return count, err
-		}
-
-		nd, err := ng.Get(rw.Ctx)
-		if err != nil {
-			return count, err
-		}
-
-		c, err := rw.writeRefsRecursive(nd)
-		count += c
-		if err != nil {
-			return count, err
-		}
-	}
-	return count, nil
+	err := traverse.Traverse(n, traverse.Options{
+		DAG:            rw.DAG,
+		Order:          traverse.DFSPre,
+		SkipDuplicates: rw.Unique,
+		Seen:           rw.seenDeep,
+		MaxDepth:       rw.MaxDepth,
+		Func: func(s traverse.State) error {
+			if s.Link == nil {
+				// the root
+				return nil
+			}
+			pkey, err := s.Parent.Key()
+			if err != nil {
+				return err
+			}
+			// with --max-depth, a node is visited again when reached
+			// shallower than before, but its ref is still written once
+			lk := key.Key(s.Link.Hash)
+			if rw.skip(lk) {
+				return nil
+			}
+			count++
+			return rw.WriteEdge(pkey, lk, s.Link.Name, s.Link.Size)
+		},
+	})
+	return count, err
 }
 
 func (rw *RefWriter) writeRefsSingle(n *dag.Node) (int, error) {
@@ -276,7 +387,7 @@ func (rw *RefWriter) writeRefsSingle(n *dag.Node) (int, error) {
 			continue
 		}
 
-		if err := rw.WriteEdge(nkey, lk, l.Name); err != nil {
+		if err := rw.WriteEdge(nkey, lk, l.Name, l.Size); err != nil {
 			return count, err
 		}
 		count++
@@ -301,8 +412,8 @@ func (rw *RefWriter) skip(k key.Key) bool {
 	return found
 }
 
-// Write one edge
-func (rw *RefWriter) WriteEdge(from, to key.Key, linkname string) error {
+// Write one edge, to an object of the given cumulative size
+func (rw *RefWriter) WriteEdge(from, to key.Key, linkname string, size uint64) error {
 	if rw.Ctx != nil {
 		select {
 		case <-rw.Ctx.Done(): // just in case.
@@ -313,17 +424,43 @@ func (rw *RefWriter) WriteEdge(from, to key.Key, linkname string) error {
 
 	var s string
 	switch {
+	case rw.Graph == "dot":
+		label := linkname
+		if rw.Sizes {
+			label = fmt.Sprintf("%s (%d)", linkname, size)
+		}
+		s = fmt.Sprintf("%s -> %s [label=%s]", dotQuote(from.Pretty()), dotQuote(to.Pretty()), dotQuote(label))
+	case rw.Graph == "jsonl":
+		e := RefEdge{Src: from.Pretty(), Dst: to.Pretty(), Name: linkname}
+		if rw.Sizes {
+			e.Size = size
+		}
+		b, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		s = string(b)
 	case rw.PrintFmt != "":
 		s = rw.PrintFmt
 		s = strings.Replace(s, "<src>", from.Pretty(), -1)
 		s = strings.Replace(s, "<dst>", to.Pretty(), -1)
 		s = strings.Replace(s, "<linkname>", linkname, -1)
+		s = strings.Replace(s, "<size>", fmt.Sprint(size), -1)
 	case rw.PrintEdge:
 		s = from.Pretty() + " -> " + to.Pretty()
 	default:
 		s += to.Pretty()
 	}
+	if rw.Sizes && rw.Graph == "" && rw.PrintFmt == "" {
+		s += fmt.Sprintf(" %d", size)
+	}
 
 	rw.out <- &RefWrapper{Ref: s}
 	return nil
 }
+
+// dotQuote quotes s as a Graphviz ID.
+func dotQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return `"` + strings.Replace(s, `"`, `\"`, -1) + `"`
+}
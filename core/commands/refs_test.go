@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"testing"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	dagmock "github.com/ipfs/go-ipfs/merkledag/test"
+)
+
+func TestRefsUniqueMaxDepth(t *testing.T) {
+	ds := dagmock.Mock()
+	add := func(nd *dag.Node) key.Key {
+		k, err := ds.Add(nd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+	link := func(parent *dag.Node, name string, child *dag.Node) {
+		if err := parent.AddNodeLinkClean(name, child); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// shared is below root at depths 2, through a, and 1
+	leaf := &dag.Node{Data: []byte("leaf")}
+	shared := &dag.Node{Data: []byte("shared")}
+	link(shared, "leaf", leaf)
+	a := &dag.Node{Data: []byte("a")}
+	link(a, "shared", shared)
+	root := &dag.Node{Data: []byte("root")}
+	link(root, "a", a)
+	link(root, "b", shared)
+	for _, nd := range []*dag.Node{leaf, shared, a, root} {
+		add(nd)
+	}
+
+	out := make(chan interface{}, 10)
+	rw := RefWriter{out: out, DAG: ds, Unique: true, Recursive: true, MaxDepth: 2}
+	if _, err := rw.WriteRefs(root); err != nil {
+		t.Fatal(err)
+	}
+	close(out)
+
+	var refs []string
+	for r := range out {
+		refs = append(refs, r.(*RefWrapper).Ref)
+	}
+	exp := []string{add(a).Pretty(), add(shared).Pretty(), add(leaf).Pretty()}
+	if len(refs) != len(exp) {
+		t.Fatalf("got refs %v, expected %v", refs, exp)
+	}
+	for i := range exp {
+		if refs[i] != exp[i] {
+			t.Fatalf("got refs %v, expected %v", refs, exp)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	cmds "github.com/ipfs/go-ipfs/commands"
+	files "github.com/ipfs/go-ipfs/core/commands/files"
 	unixfs "github.com/ipfs/go-ipfs/core/commands/unixfs"
 	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
 )
@@ -41,6 +42,7 @@ DATA STRUCTURE COMMANDS
     block         Interact with raw blocks in the datastore
     object        Interact with raw dag nodes
     file          Interact with Unix filesystem objects
+    files         Manipulate the mutable filesystem of this node
 
 ADVANCED COMMANDS
 
@@ -51,6 +53,7 @@ ADVANCED COMMANDS
     dns           Resolve DNS links
     pin           Pin objects to local storage
     repo gc       Garbage collect unpinned objects
+    filestore     Manage objects added without copying
 
 NETWORK COMMANDS
 
@@ -94,6 +97,7 @@ var rootSubcommands = map[string]*cmds.Command{
 	"dht":       DhtCmd,
 	"diag":      DiagCmd,
 	"dns":       DNSCmd,
+	"filestore": FilestoreCmd,
 	"get":       GetCmd,
 	"id":        IDCmd,
 	"log":       LogCmd,
@@ -111,6 +115,7 @@ var rootSubcommands = map[string]*cmds.Command{
 	"tar":       TarCmd,
 	"tour":      tourCmd,
 	"file":      unixfs.UnixFSCmd,
+	"files":     files.FilesCmd,
 	"update":    UpdateCmd,
 	"version":   VersionCmd,
 	"bitswap":   BitswapCmd,
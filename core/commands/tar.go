@@ -6,7 +6,9 @@ import (
 
 	cmds "github.com/ipfs/go-ipfs/commands"
 	core "github.com/ipfs/go-ipfs/core"
+	importer "github.com/ipfs/go-ipfs/importer"
 	path "github.com/ipfs/go-ipfs/path"
+	pin "github.com/ipfs/go-ipfs/pin"
 	tar "github.com/ipfs/go-ipfs/tar"
 )
 
@@ -26,12 +28,16 @@ var tarAddCmd = &cmds.Command{
 		Tagline: "import a tar file into ipfs",
 		ShortDescription: `
 'ipfs tar add' will parse a tar file and create a merkledag structure to represent it.
+With --pin=false, the objects created are not pinned.
 `,
 	},
 
 	Arguments: []cmds.Argument{
 		cmds.FileArg("file", true, false, "tar file to add").EnableStdin(),
 	},
+	Options: []cmds.Option{
+		cmds.BoolOption(pinOptionName, "Pin the added objects (default true)"),
+	},
 	Run: func(req cmds.Request, res cmds.Response) {
 		nd, err := req.InvocContext().GetNode()
 		if err != nil {
@@ -39,13 +45,26 @@ var tarAddCmd = &cmds.Command{
 			return
 		}
 
+		dopin, found, _ := req.Option(pinOptionName).Bool()
+		if !found {
+			dopin = true
+		}
+
 		fi, err := req.Files().NextFile()
 		if err != nil {
 			res.SetError(err, cmds.ErrNormal)
 			return
 		}
 
-		node, err := tar.ImportTar(fi, nd.DAG)
+		ncb := importer.BasicPinnerCB(nd.Pinning.GetManual())
+		if !dopin {
+			// keep the objects until they are all added
+			tp := pin.NewTempPinner(nd.Pinning.GetManual())
+			defer tp.Release()
+			ncb = importer.TempPinCB(tp)
+		}
+
+		node, err := tar.ImportTar(fi, nd.DAG, ncb)
 		if err != nil {
 			res.SetError(err, cmds.ErrNormal)
 			return
@@ -11,6 +11,7 @@ import (
 	core "github.com/ipfs/go-ipfs/core"
 	path "github.com/ipfs/go-ipfs/path"
 	unixfs "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
 	unixfspb "github.com/ipfs/go-ipfs/unixfs/pb"
 )
 
@@ -84,7 +85,7 @@ size is the IPFS link size.
 				continue
 			}
 
-			unixFSNode, err := unixfs.FromBytes(merkleNode.Data)
+			unixFSNode, err := unixfs.FromNode(merkleNode)
 			if err != nil {
 				res.SetError(err, cmds.ErrNormal)
 				return
@@ -99,18 +100,24 @@ size is the IPFS link size.
 			}
 
 			switch t {
-			case unixfspb.Data_File:
+			case unixfspb.Data_File, unixfspb.Data_Raw:
 				break
-			case unixfspb.Data_Directory:
-				links := make([]LsLink, len(merkleNode.Links))
+			case unixfspb.Data_Directory, unixfspb.Data_HAMTShard:
+				entries, err := uio.DirEntries(ctx, node.DAG, merkleNode)
+				if err != nil {
+					res.SetError(err, cmds.ErrNormal)
+					return
+				}
+
+				links := make([]LsLink, len(entries))
 				output.Objects[hash].Links = links
-				for i, link := range merkleNode.Links {
+				for i, link := range entries {
 					link.Node, err = link.GetNode(ctx, node.DAG)
 					if err != nil {
 						res.SetError(err, cmds.ErrNormal)
 						return
 					}
-					d, err := unixfs.FromBytes(link.Node.Data)
+					d, err := unixfs.FromNode(link.Node)
 					if err != nil {
 						res.SetError(err, cmds.ErrNormal)
 						return
@@ -154,7 +161,7 @@ size is the IPFS link size.
 					return nil, fmt.Errorf("unresolved hash: %s", hash)
 				}
 
-				if object.Type == "Directory" {
+				if object.Type == "Directory" || object.Type == "HAMTShard" {
 					directories = append(directories, argument)
 				} else {
 					nonDirectories = append(nonDirectories, argument)
@@ -46,6 +46,7 @@ import (
 	bitswap "github.com/ipfs/go-ipfs/exchange/bitswap"
 	bsnet "github.com/ipfs/go-ipfs/exchange/bitswap/network"
 	rp "github.com/ipfs/go-ipfs/exchange/reprovide"
+	filestore "github.com/ipfs/go-ipfs/filestore"
 
 	mount "github.com/ipfs/go-ipfs/fuse/mount"
 	ipnsfs "github.com/ipfs/go-ipfs/ipnsfs"
@@ -91,6 +92,7 @@ type IpfsNode struct {
 	// Services
 	Peerstore  peer.Peerstore       // storage for other Peer instances
 	Blockstore bstore.Blockstore    // the block store (lower level)
+	Filestore  *filestore.Filestore // references to blocks added without copying
 	Blocks     *bserv.BlockService  // the block service, get/add blocks.
 	DAG        merkledag.DAGService // the merkle dag service, get/add objects.
 	Resolver   *path.Resolver       // the path resolution system
@@ -108,7 +110,8 @@ type IpfsNode struct {
 	Reprovider   *rp.Reprovider // the value reprovider system
 	IpnsRepub    *ipnsrp.Republisher
 
-	IpnsFs *ipnsfs.Filesystem
+	IpnsFs    *ipnsfs.Filesystem
+	FilesRoot *ipnsfs.LocalRoot // the mutable filesystem of 'ipfs files'
 
 	proc goprocess.Process
 	ctx  context.Context
@@ -302,6 +305,12 @@ func (n *IpfsNode) teardown() error {
 		n.Repo,
 	}
 
+	// the files root is recorded in the repo, it is flushed before the
+	// repo is closed
+	if n.FilesRoot != nil {
+		closers = append([]io.Closer{n.FilesRoot}, closers...)
+	}
+
 	if n.Exchange != nil {
 		closers = append(closers, n.Exchange)
 	}
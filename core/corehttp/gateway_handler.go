@@ -1,15 +1,17 @@
 package corehttp
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	gopath "path"
 	"strings"
 	"time"
 
-	humanize "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/dustin/go-humanize"
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	key "github.com/ipfs/go-ipfs/blocks/key"
@@ -17,9 +19,13 @@ import (
 	"github.com/ipfs/go-ipfs/importer"
 	chunk "github.com/ipfs/go-ipfs/importer/chunk"
 	dag "github.com/ipfs/go-ipfs/merkledag"
+	namesys "github.com/ipfs/go-ipfs/namesys"
 	path "github.com/ipfs/go-ipfs/path"
+	pin "github.com/ipfs/go-ipfs/pin"
 	"github.com/ipfs/go-ipfs/routing"
+	ft "github.com/ipfs/go-ipfs/unixfs"
 	uio "github.com/ipfs/go-ipfs/unixfs/io"
+	u "github.com/ipfs/go-ipfs/util"
 )
 
 const (
@@ -43,13 +49,30 @@ func newGatewayHandler(node *core.IpfsNode, conf GatewayConfig) (*gatewayHandler
 }
 
 // TODO(cryptix):  find these helpers somewhere else
-func (i *gatewayHandler) newDagFromReader(r io.Reader) (*dag.Node, error) {
+// newDagFromReader imports the data read from r, pinning it unless
+// dopin is false. Unpinned data is kept from the garbage collector until
+// release is called.
+func (i *gatewayHandler) newDagFromReader(r io.Reader, dopin bool) (nd *dag.Node, release func(), err error) {
 	// TODO(cryptix): change and remove this helper once PR1136 is merged
 	// return ufs.AddFromReader(i.node, r.Body)
-	return importer.BuildDagFromReader(
-		i.node.DAG,
-		chunk.DefaultSplitter(r),
-		importer.BasicPinnerCB(i.node.Pinning.GetManual()))
+	ncb := importer.BasicPinnerCB(i.node.Pinning.GetManual())
+	release = func() {}
+	if !dopin {
+		tp := pin.NewTempPinner(i.node.Pinning.GetManual())
+		ncb = importer.TempPinCB(tp)
+		release = func() {
+			if err := tp.Release(); err != nil {
+				log.Errorf("releasing the pins of a gateway import: %s", err)
+			}
+		}
+	}
+
+	nd, err = importer.BuildDagFromReader(i.node.DAG, chunk.DefaultSplitter(r), ncb)
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+	return nd, release, nil
 }
 
 // TODO(btc): break this apart into separate handlers using a more expressive muxer
@@ -110,20 +133,54 @@ func (i *gatewayHandler) getOrHeadHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	nd, err := core.Resolve(ctx, i.node, path.Path(urlPath))
+	resolved, err := i.resolvePath(ctx, path.Path(urlPath))
 	if err != nil {
+		// sites served through their own hostname may define rewrites,
+		// redirects and a 404 page for paths that do not exist.
+		if _, ok := err.(path.ErrNoLink); ok && ipnsHostname {
+			siteRoot := strings.TrimSuffix(urlPath, originalUrlPath)
+			if i.serveRedirects(ctx, w, r, siteRoot, originalUrlPath) {
+				return
+			}
+		}
 		webError(w, "Path Resolve error", err, http.StatusBadRequest)
 		return
 	}
+	nd := resolved.node
 
-	etag := gopath.Base(urlPath)
-	if r.Header.Get("If-None-Match") == etag {
+	format := r.URL.Query().Get("format")
+	if !validFormat(format) {
+		webErrorWithCode(w, "Invalid format", fmt.Errorf("unsupported format %q", format), http.StatusBadRequest)
+		return
+	}
+
+	if format != "" && resolved.value != nil {
+		webErrorWithCode(w, "Invalid format", errors.New("the path names a value, not an object"), http.StatusBadRequest)
+		return
+	}
+
+	// the etag is the hash of the content being served, so it is the same
+	// for an /ipfs/ path and any /ipns/ name currently pointing at it.
+	wantJSON := acceptsJSON(r) && ft.IsDir(nd)
+	etag := resolved.roots[len(resolved.roots)-1].B58String()
+	if resolved.value != nil {
+		etag = key.Key(u.Hash(resolved.value)).B58String()
+	}
+	switch {
+	case format != "":
+		etag += "." + format
+	case wantJSON:
+		etag += ".json"
+	}
+	etag = `"` + etag + `"`
+	if etagMatch(r.Header.Get("If-None-Match"), etag) {
 		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
 	i.addUserHeaders(w) // ok, _now_ write user's headers.
 	w.Header().Set("X-IPFS-Path", urlPath)
+	w.Header().Set("X-Ipfs-Roots", resolved.rootsHeader())
 
 	// Suborigin header, sandboxes apps from each other in the browser (even
 	// though they are served from the same gateway domain).
@@ -138,6 +195,19 @@ func (i *gatewayHandler) getOrHeadHandler(w http.ResponseWriter, r *http.Request
 		w.Header().Set("Suborigin", pathRoot)
 	}
 
+	if format != "" {
+		modtime := setCacheHeaders(w, etag, resolved)
+		i.serveFormat(ctx, w, r, format, nd, modtime)
+		return
+	}
+
+	if resolved.value != nil {
+		modtime := setCacheHeaders(w, etag, resolved)
+		_, name := gopath.Split(urlPath)
+		http.ServeContent(w, r, name, modtime, bytes.NewReader(resolved.value))
+		return
+	}
+
 	dr, err := uio.NewDagReader(ctx, nd, i.node.DAG)
 	if err != nil && err != uio.ErrIsDir {
 		// not a directory and still an error
@@ -147,16 +217,7 @@ func (i *gatewayHandler) getOrHeadHandler(w http.ResponseWriter, r *http.Request
 
 	// set these headers _after_ the error, for we may just not have it
 	// and dont want the client to cache a 500 response...
-	// and only if it's /ipfs!
-	// TODO: break this out when we split /ipfs /ipns routes.
-	modtime := time.Now()
-	if strings.HasPrefix(urlPath, ipfsPathPrefix) {
-		w.Header().Set("Etag", etag)
-		w.Header().Set("Cache-Control", "public, max-age=29030400")
-
-		// set modtime to a really long time ago, since files are immutable and should stay cached
-		modtime = time.Unix(1, 0)
-	}
+	modtime := setCacheHeaders(w, etag, resolved)
 
 	if err == nil {
 		defer dr.Close()
@@ -165,14 +226,12 @@ func (i *gatewayHandler) getOrHeadHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// storage for directory listing
-	var dirListing []directoryItem
-	// loop through files
-	foundIndex := false
-	for _, link := range nd.Links {
-		if link.Name == "index.html" {
+	w.Header().Set("Vary", "Accept")
+
+	// clients asking for json get the listing even if there is an index page.
+	if !wantJSON {
+		if _, err := uio.FindDirEntry(ctx, i.node.DAG, nd, "index.html"); err == nil {
 			log.Debugf("found index.html link for %s", urlPath)
-			foundIndex = true
 
 			if urlPath[len(urlPath)-1] != '/' {
 				// See comment above where originalUrlPath is declared.
@@ -198,69 +257,298 @@ func (i *gatewayHandler) getOrHeadHandler(w http.ResponseWriter, r *http.Request
 			if r.Method != "HEAD" {
 				io.Copy(w, dr)
 			}
-			break
+			return
 		}
+	}
 
-		// See comment above where originalUrlPath is declared.
-		di := directoryItem{humanize.Bytes(link.Size), link.Name, gopath.Join(originalUrlPath, link.Name)}
-		dirListing = append(dirListing, di)
-	}
-
-	if !foundIndex {
-		if r.Method != "HEAD" {
-			// construct the correct back link
-			// https://github.com/ipfs/go-ipfs/issues/1365
-			var backLink string = urlPath
-
-			// don't go further up than /ipfs/$hash/
-			pathSplit := strings.Split(backLink, "/")
-			switch {
-			// keep backlink
-			case len(pathSplit) == 3: // url: /ipfs/$hash
-
-			// keep backlink
-			case len(pathSplit) == 4 && pathSplit[3] == "": // url: /ipfs/$hash/
-
-			// add the correct link depending on wether the path ends with a slash
-			default:
-				if strings.HasSuffix(backLink, "/") {
-					backLink += "./.."
-				} else {
-					backLink += "/.."
-				}
-			}
+	if r.Method == "HEAD" {
+		return
+	}
 
-			// strip /ipfs/$hash from backlink if IPNSHostnameOption touched the path.
-			if ipnsHostname {
-				backLink = "/"
-				if len(pathSplit) > 5 {
-					// also strip the trailing segment, because it's a backlink
-					backLinkParts := pathSplit[3 : len(pathSplit)-2]
-					backLink += strings.Join(backLinkParts, "/") + "/"
-				}
-			}
+	// See comment above where originalUrlPath is declared.
+	dirListing, err := i.listDirectory(ctx, nd, originalUrlPath)
+	if err != nil {
+		internalWebError(w, err)
+		return
+	}
+	if err := sortListing(dirListing, r.URL.Query().Get("sort")); err != nil {
+		webErrorWithCode(w, "Invalid sort order", err, http.StatusBadRequest)
+		return
+	}
 
-			// See comment above where originalUrlPath is declared.
-			tplData := listingTemplateData{
-				Listing:  dirListing,
-				Path:     originalUrlPath,
-				BackLink: backLink,
-			}
-			err := listingTemplate.Execute(w, tplData)
+	if wantJSON {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(directoryListing{
+			Path:    originalUrlPath,
+			Hash:    resolved.roots[len(resolved.roots)-1].B58String(),
+			Entries: dirListing,
+		})
+		if err != nil {
+			log.Errorf("error writing listing for %s: %s", urlPath, err)
+		}
+		return
+	}
+
+	// construct the correct back link
+	// https://github.com/ipfs/go-ipfs/issues/1365
+	var backLink string = urlPath
+
+	// don't go further up than /ipfs/$hash/
+	pathSplit := strings.Split(backLink, "/")
+	switch {
+	// keep backlink
+	case len(pathSplit) == 3: // url: /ipfs/$hash
+
+	// keep backlink
+	case len(pathSplit) == 4 && pathSplit[3] == "": // url: /ipfs/$hash/
+
+	// add the correct link depending on wether the path ends with a slash
+	default:
+		if strings.HasSuffix(backLink, "/") {
+			backLink += "./.."
+		} else {
+			backLink += "/.."
+		}
+	}
+
+	// strip /ipfs/$hash from backlink if IPNSHostnameOption touched the path.
+	if ipnsHostname {
+		backLink = "/"
+		if len(pathSplit) > 5 {
+			// also strip the trailing segment, because it's a backlink
+			backLinkParts := pathSplit[3 : len(pathSplit)-2]
+			backLink += strings.Join(backLinkParts, "/") + "/"
+		}
+	}
+
+	// See comment above where originalUrlPath is declared.
+	tplData := listingTemplateData{
+		Listing:  dirListing,
+		Path:     originalUrlPath,
+		BackLink: backLink,
+	}
+	err = listingTemplate.Execute(w, tplData)
+	if err != nil {
+		internalWebError(w, err)
+		return
+	}
+}
+
+// listingFetchBatch is the number of children of a directory requested at
+// once to list their type and size.
+const listingFetchBatch = 64
+
+// listDirectory builds the listing entries for the links of nd. Children
+// are fetched, listingFetchBatch at a time, for their unixfs type and size:
+// files and symlinks are sized by their unixfs filesize, directories and
+// other objects by the cumulative size of their link. Raw links are files
+// sized by their link, and are not fetched.
+func (i *gatewayHandler) listDirectory(ctx context.Context, nd *dag.Node, dirPath string) ([]directoryItem, error) {
+	links, err := uio.DirEntries(ctx, i.node.DAG, nd)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]directoryItem, len(links))
+	var fetch []int
+	for idx, link := range links {
+		items[idx] = directoryItem{
+			Name: link.Name,
+			Path: gopath.Join(dirPath, link.Name),
+			Hash: link.Hash.B58String(),
+			Size: link.Size,
+		}
+		if link.Raw {
+			items[idx].Type = "file"
+			continue
+		}
+		fetch = append(fetch, idx)
+	}
+
+	for len(fetch) > 0 {
+		batch := fetch
+		if len(batch) > listingFetchBatch {
+			batch = batch[:listingFetchBatch]
+		}
+		fetch = fetch[len(batch):]
+
+		blinks := make([]*dag.Link, len(batch))
+		for j, idx := range batch {
+			blinks[j] = links[idx]
+		}
+		for j, ng := range i.node.DAG.GetLinks(ctx, blinks) {
+			child, err := ng.Get(ctx)
 			if err != nil {
-				internalWebError(w, err)
-				return
+				return nil, err
 			}
+			setItemType(&items[batch[j]], child)
+		}
+	}
+	return items, nil
+}
+
+// setItemType sets the type of a listing entry, and its size for files and
+// symlinks, from its node.
+func setItemType(item *directoryItem, child *dag.Node) {
+	item.Type = "object"
+	pbd, err := ft.FromNode(child)
+	if err != nil {
+		return
+	}
+	switch pbd.GetType() {
+	case ft.TDirectory, ft.THAMTShard:
+		item.Type = "dir"
+	case ft.TFile, ft.TRaw:
+		item.Type = "file"
+		item.Size = pbd.GetFilesize()
+		if pbd.Filesize == nil {
+			item.Size = uint64(len(pbd.GetData()))
+		}
+	case ft.TMetadata:
+		item.Type = "file"
+	case ft.TSymlink:
+		item.Type = "symlink"
+		item.Size = uint64(len(pbd.GetData()))
+	}
+}
+
+// acceptsJSON reports whether the client asked for a JSON response.
+func acceptsJSON(r *http.Request) bool {
+	for _, v := range strings.Split(r.Header.Get("Accept"), ",") {
+		mt, _, err := mime.ParseMediaType(v)
+		if err == nil && mt == "application/json" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvedPath describes how a gateway path resolved to a node.
+type resolvedPath struct {
+	node *dag.Node
+
+	// roots holds the keys of every node along the path, ending with the
+	// key of node.
+	roots []key.Key
+
+	// value is set for paths ending inside node, at a value of its
+	// document: it holds the data of the value, see path.ValueData.
+	value []byte
+
+	// mutable is set for paths that went through a name.
+	mutable bool
+
+	// eol is the earliest EOL of the records the name resolved through, or
+	// the zero time if they had none.
+	eol time.Time
+}
+
+// rootsHeader formats the keys along the path for the X-Ipfs-Roots header.
+func (rp *resolvedPath) rootsHeader() string {
+	roots := make([]string, len(rp.roots))
+	for i, k := range rp.roots {
+		roots[i] = k.B58String()
+	}
+	return strings.Join(roots, ",")
+}
+
+// resolvePath works like core.Resolve, but keeps track of the nodes along the
+// path and the validity of the name it resolved through.
+func (i *gatewayHandler) resolvePath(ctx context.Context, p path.Path) (*resolvedPath, error) {
+	if err := p.IsValid(); err != nil {
+		return nil, err
+	}
+
+	rp := new(resolvedPath)
+	if strings.HasPrefix(p.String(), ipnsPathPrefix) {
+		if i.node.Namesys == nil {
+			return nil, core.ErrNoNamesys
+		}
+
+		seg := p.Segments()
+		if len(seg) < 2 || seg[1] == "" {
+			return nil, path.ErrNoComponents
+		}
+
+		name := ipnsPathPrefix + seg[1]
+		var respath path.Path
+		var err error
+		if er, ok := i.node.Namesys.(namesys.EOLResolver); ok {
+			respath, rp.eol, err = er.ResolveWithEOL(ctx, name)
+		} else {
+			respath, err = i.node.Namesys.Resolve(ctx, name)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		p, err = path.FromSegments("/", append(respath.Segments(), seg[2:]...)...)
+		if err != nil {
+			return nil, err
+		}
+		rp.mutable = true
+	}
+
+	nodes, err := i.node.Resolver.ResolvePathComponents(ctx, p)
+	if ev, ok := err.(path.ErrValue); ok {
+		rp.value, err = path.ValueData(ev.Value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nd := range nodes {
+		k, err := nd.Key()
+		if err != nil {
+			return nil, err
+		}
+		rp.roots = append(rp.roots, k)
+	}
+	rp.node = nodes[len(nodes)-1]
+	return rp, nil
+}
+
+// setCacheHeaders sets the Etag and Cache-Control headers, and returns the
+// modification time to serve the content with. /ipfs paths are immutable and
+// cached forever, /ipns paths only for as long as the records they resolved
+// through are valid.
+func setCacheHeaders(w http.ResponseWriter, etag string, rp *resolvedPath) time.Time {
+	w.Header().Set("Etag", etag)
+	if !rp.mutable {
+		w.Header().Set("Cache-Control", "public, max-age=29030400")
+
+		// set modtime to a really long time ago, since files are immutable and should stay cached
+		return time.Unix(1, 0)
+	}
+
+	if ttl := rp.eol.Sub(time.Now()); !rp.eol.IsZero() && ttl > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(ttl.Seconds())))
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	return time.Time{}
+}
+
+// etagMatch reports whether an If-None-Match header value matches etag.
+func etagMatch(header, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag || `"`+v+`"` == etag {
+			return true
 		}
 	}
+	return false
 }
 
 func (i *gatewayHandler) postHandler(w http.ResponseWriter, r *http.Request) {
-	nd, err := i.newDagFromReader(r.Body)
+	// objects are pinned unless ?pin=false is given
+	dopin := r.URL.Query().Get("pin") != "false"
+	nd, release, err := i.newDagFromReader(r.Body, dopin)
 	if err != nil {
 		internalWebError(w, err)
 		return
 	}
+	defer release()
 
 	k, err := i.node.DAG.Add(nd)
 	if err != nil {
@@ -303,7 +591,7 @@ func (i *gatewayHandler) putHandler(w http.ResponseWriter, r *http.Request) {
 	if pathext[len(pathext)-1] == '/' {
 		newnode = uio.NewEmptyDirectory()
 	} else {
-		newnode, err = i.newDagFromReader(r.Body)
+		newnode, _, err = i.newDagFromReader(r.Body, true)
 		if err != nil {
 			webError(w, "Could not create DAG from request", err, http.StatusInternalServerError)
 			return
@@ -353,7 +641,7 @@ func (i *gatewayHandler) putHandler(w http.ResponseWriter, r *http.Request) {
 	if _, ok := err.(path.ErrNoLink); ok {
 		// Create empty directories, links will be made further down the code
 		for len(pathNodes) < len(components) {
-			pathNodes = append(pathNodes, uio.NewDirectory(i.node.DAG).GetNode())
+			pathNodes = append(pathNodes, uio.NewEmptyDirectory())
 		}
 	} else if err != nil {
 		webError(w, "Could not resolve parent object", err, http.StatusBadRequest)
@@ -1,10 +1,14 @@
 package corehttp
 
 import (
+	"fmt"
 	"html/template"
 	"path"
+	"sort"
 	"strings"
 
+	humanize "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/dustin/go-humanize"
+
 	"github.com/ipfs/go-ipfs/assets"
 )
 
@@ -16,11 +20,86 @@ type listingTemplateData struct {
 }
 
 type directoryItem struct {
-	Size string
 	Name string
 	Path string
+	Hash string
+	// Size is the unixfs filesize of files and symlinks, and the
+	// cumulative size of the link for directories and other objects.
+	Size uint64
+
+	// Type is file, dir, symlink or object for non-unixfs nodes.
+	Type string
+}
+
+// HumanSize formats the size of the entry for the listing template.
+func (di directoryItem) HumanSize() string {
+	return humanize.Bytes(di.Size)
+}
+
+// ShortHash abbreviates the hash of the entry for the listing template.
+func (di directoryItem) ShortHash() string {
+	if len(di.Hash) <= 8 {
+		return di.Hash
+	}
+	return di.Hash[:4] + "\u2026" + di.Hash[len(di.Hash)-4:]
+}
+
+// directoryListing is the JSON form of a directory listing, served when a
+// client asks for application/json.
+type directoryListing struct {
+	Path    string
+	Hash    string
+	Entries []directoryItem
+}
+
+// sortListing orders entries by the given key, one of name, type or size,
+// prefixed with '-' for a descending order. Entries are kept in link order
+// for an empty key.
+func sortListing(entries []directoryItem, by string) error {
+	desc := strings.HasPrefix(by, "-")
+	by = strings.TrimPrefix(by, "-")
+
+	var less func(a, b directoryItem) bool
+	switch by {
+	case "":
+		return nil
+	case "name":
+		less = func(a, b directoryItem) bool { return a.Name < b.Name }
+	case "type":
+		less = func(a, b directoryItem) bool {
+			if a.Type == b.Type {
+				return a.Name < b.Name
+			}
+			return a.Type < b.Type
+		}
+	case "size":
+		less = func(a, b directoryItem) bool {
+			if a.Size == b.Size {
+				return a.Name < b.Name
+			}
+			return a.Size < b.Size
+		}
+	default:
+		return fmt.Errorf("cannot sort by %q", by)
+	}
+
+	if desc {
+		asc := less
+		less = func(a, b directoryItem) bool { return asc(b, a) }
+	}
+	sort.Stable(listingSorter{entries, less})
+	return nil
 }
 
+type listingSorter struct {
+	entries []directoryItem
+	less    func(a, b directoryItem) bool
+}
+
+func (ls listingSorter) Len() int           { return len(ls.entries) }
+func (ls listingSorter) Swap(a, b int)      { ls.entries[a], ls.entries[b] = ls.entries[b], ls.entries[a] }
+func (ls listingSorter) Less(a, b int) bool { return ls.less(ls.entries[a], ls.entries[b]) }
+
 var listingTemplate *template.Template
 
 func init() {
@@ -45,8 +124,9 @@ func init() {
 		return "ipfs-" + ext[1:] // slice of the first dot
 	}
 
-	// Directory listing template
-	dirIndexBytes, err := assets.Asset(assetPath + "dir-index.html")
+	// Directory listing template, the vendored one with the type, size
+	// and hash columns added
+	dirIndexBytes, err := assets.Asset("dir-index-html/dir-index.html")
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,222 @@
+package corehttp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	gopath "path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	core "github.com/ipfs/go-ipfs/core"
+	path "github.com/ipfs/go-ipfs/path"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
+)
+
+const (
+	// redirectsFile is the name of the optional file at the root of a site
+	// that describes rewrites and redirects for paths that do not exist.
+	redirectsFile = "_redirects"
+
+	// notFoundFile is served with a 404 status when no rule matches.
+	notFoundFile = "404.html"
+)
+
+// redirectRule is a single line of a _redirects file:
+//
+//   /from/:placeholder/*  /to/:placeholder/:splat  [status]
+//
+// A status of 200 rewrites the request to the target path, 301 and 302
+// redirect the client and 404 serves the target with a not found status.
+type redirectRule struct {
+	From   string
+	To     string
+	Status int
+}
+
+// parseRedirects reads the rules of a _redirects file. Empty lines and lines
+// starting with '#' are ignored.
+func parseRedirects(r io.Reader) ([]redirectRule, error) {
+	var rules []redirectRule
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("%s line %d: expected \"from to [status]\"", redirectsFile, n)
+		}
+
+		rule := redirectRule{From: fields[0], To: fields[1], Status: http.StatusMovedPermanently}
+		if len(fields) == 3 {
+			code, err := strconv.Atoi(strings.TrimSuffix(fields[2], "!"))
+			if err != nil {
+				return nil, fmt.Errorf("%s line %d: invalid status %q", redirectsFile, n, fields[2])
+			}
+			switch code {
+			case http.StatusOK, http.StatusMovedPermanently, http.StatusFound, http.StatusNotFound:
+			default:
+				return nil, fmt.Errorf("%s line %d: unsupported status %d", redirectsFile, n, code)
+			}
+			rule.Status = code
+		}
+
+		if !strings.HasPrefix(rule.From, "/") {
+			return nil, fmt.Errorf("%s line %d: source must be an absolute path", redirectsFile, n)
+		}
+		if rule.Status != http.StatusMovedPermanently && rule.Status != http.StatusFound &&
+			!strings.HasPrefix(rule.To, "/") {
+			return nil, fmt.Errorf("%s line %d: only redirects may point outside the site", redirectsFile, n)
+		}
+		rules = append(rules, rule)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// match checks whether the given request path matches the rule, and returns
+// the target with placeholders and the splat substituted.
+func (rr redirectRule) match(p string) (string, bool) {
+	from := strings.Split(strings.Trim(rr.From, "/"), "/")
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+
+	vars := make(map[string]string)
+	for i, f := range from {
+		if f == "*" && i == len(from)-1 {
+			if i > len(segs) {
+				return "", false
+			}
+			vars["splat"] = strings.Join(segs[i:], "/")
+			segs = segs[:i]
+			from = from[:i]
+			break
+		}
+		if i >= len(segs) {
+			return "", false
+		}
+		if strings.HasPrefix(f, ":") {
+			vars[f[1:]] = segs[i]
+		} else if f != segs[i] {
+			return "", false
+		}
+	}
+	if len(from) != len(segs) {
+		return "", false
+	}
+
+	// substitute longer names first, so :id does not clobber :idx
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+
+	to := rr.To
+	for _, name := range names {
+		to = strings.Replace(to, ":"+name, vars[name], -1)
+	}
+	return to, true
+}
+
+// serveRedirects looks up a _redirects file and a 404.html page at the root
+// of the site for a request that did not resolve. It returns false if
+// neither could handle the request.
+func (i *gatewayHandler) serveRedirects(ctx context.Context, w http.ResponseWriter, r *http.Request, root, requestPath string) bool {
+	rules, err := i.loadRedirects(ctx, root)
+	if err != nil {
+		webError(w, "Could not read "+redirectsFile, err, http.StatusInternalServerError)
+		return true
+	}
+
+	for _, rule := range rules {
+		to, ok := rule.match(requestPath)
+		if !ok {
+			continue
+		}
+
+		switch rule.Status {
+		case http.StatusMovedPermanently, http.StatusFound:
+			http.Redirect(w, r, to, rule.Status)
+			return true
+		default:
+			if i.serveSitePath(ctx, w, r, root, to, rule.Status) {
+				return true
+			}
+		}
+	}
+
+	return i.serveSitePath(ctx, w, r, root, "/"+notFoundFile, http.StatusNotFound)
+}
+
+// loadRedirects returns the rules of the _redirects file at the given root,
+// or nil if there is none.
+func (i *gatewayHandler) loadRedirects(ctx context.Context, root string) ([]redirectRule, error) {
+	nd, err := core.Resolve(ctx, i.node, path.Path(gopath.Join(root, redirectsFile)))
+	if _, ok := err.(path.ErrNoLink); ok {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	dr, err := uio.NewDagReader(ctx, nd, i.node.DAG)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+
+	return parseRedirects(dr)
+}
+
+// serveSitePath writes the file at p below root with the given status. A
+// directory is served through its index.html. It returns false if there is
+// nothing to serve at that path.
+func (i *gatewayHandler) serveSitePath(ctx context.Context, w http.ResponseWriter, r *http.Request, root, p string, status int) bool {
+	fpath := gopath.Join(root, p)
+	nd, err := core.Resolve(ctx, i.node, path.Path(fpath))
+	if err != nil {
+		return false
+	}
+
+	dr, err := uio.NewDagReader(ctx, nd, i.node.DAG)
+	if err == uio.ErrIsDir {
+		fpath = gopath.Join(fpath, "index.html")
+		nd, err = core.Resolve(ctx, i.node, path.Path(fpath))
+		if err != nil {
+			return false
+		}
+		dr, err = uio.NewDagReader(ctx, nd, i.node.DAG)
+	}
+	if err != nil {
+		return false
+	}
+	defer dr.Close()
+
+	i.addUserHeaders(w) // ok, _now_ write user's headers.
+	w.Header().Set("X-IPFS-Path", fpath)
+
+	name := gopath.Base(fpath)
+	if status == http.StatusOK {
+		http.ServeContent(w, r, name, time.Now(), dr)
+		return true
+	}
+
+	if ctype := mime.TypeByExtension(gopath.Ext(name)); ctype != "" {
+		w.Header().Set("Content-Type", ctype)
+	}
+	w.WriteHeader(status)
+	if r.Method != "HEAD" {
+		io.Copy(w, dr)
+	}
+	return true
+}
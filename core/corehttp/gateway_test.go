@@ -1,7 +1,10 @@
 package corehttp
 
 import (
+	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -10,13 +13,18 @@ import (
 	"time"
 
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	key "github.com/ipfs/go-ipfs/blocks/key"
 	core "github.com/ipfs/go-ipfs/core"
 	coreunix "github.com/ipfs/go-ipfs/core/coreunix"
+	dag "github.com/ipfs/go-ipfs/merkledag"
 	namesys "github.com/ipfs/go-ipfs/namesys"
 	ci "github.com/ipfs/go-ipfs/p2p/crypto"
 	path "github.com/ipfs/go-ipfs/path"
 	repo "github.com/ipfs/go-ipfs/repo"
 	config "github.com/ipfs/go-ipfs/repo/config"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
+	u "github.com/ipfs/go-ipfs/util"
 	testutil "github.com/ipfs/go-ipfs/util/testutil"
 )
 
@@ -110,7 +118,7 @@ func TestGatewayGet(t *testing.T) {
 	ts, n := newTestServerAndNode(t, ns)
 	defer ts.Close()
 
-	k, err := coreunix.Add(n, strings.NewReader("fnord"))
+	k, err := coreunix.Add(n, strings.NewReader("fnord"), true)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -342,3 +350,504 @@ func TestIPNSHostnameBacklinks(t *testing.T) {
 		t.Fatalf("expected file in directory listing")
 	}
 }
+
+func TestIPNSHostnameRedirects(t *testing.T) {
+	ns := mockNamesys{}
+	ts, n := newTestServerAndNode(t, ns)
+	t.Logf("test server url: %s", ts.URL)
+	defer ts.Close()
+
+	// create /ipns/example.net/{_redirects,index.html,404.html}
+	redirects := "# single page app\n" +
+		"/old/*  /new/:splat  301\n" +
+		"/app/*  /index.html  200\n"
+	_, root, err := coreunix.AddWrapped(n, strings.NewReader(redirects), "_redirects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, index, err := coreunix.AddWrapped(n, strings.NewReader("app"), "index.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, notFound, err := coreunix.AddWrapped(n, strings.NewReader("gone"), "404.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, nd := range []*dag.Node{index, notFound} {
+		err = root.AddNodeLink(nd.Links[0].Name, nd.Links[0].Node)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = n.DAG.AddRecursive(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k, err := root.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ns["/ipns/example.net"] = path.FromString("/ipfs/" + k.String())
+
+	for _, test := range []struct {
+		path     string
+		status   int
+		text     string
+		location string
+	}{
+		{"/old/a/b", http.StatusMovedPermanently, "", "/new/a/b"},
+		{"/app/deep/link", http.StatusOK, "app", ""},
+		{"/missing", http.StatusNotFound, "gone", ""},
+	} {
+		req, err := http.NewRequest("GET", ts.URL+test.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Host = "example.net"
+
+		res, err := doWithoutRedirect(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != test.status {
+			t.Errorf("status for %s is %d, expected %d", test.path, res.StatusCode, test.status)
+			continue
+		}
+		if test.location != "" {
+			if loc := res.Header.Get("Location"); loc != test.location {
+				t.Errorf("location for %s is %q, expected %q", test.path, loc, test.location)
+			}
+			continue
+		}
+		body, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("error reading response: %s", err)
+		}
+		if string(body) != test.text {
+			t.Errorf("body for %s is %q, expected %q", test.path, body, test.text)
+		}
+	}
+}
+
+func TestRedirectRuleMatch(t *testing.T) {
+	rules, err := parseRedirects(strings.NewReader("/users/:id/posts/:idx  /u/:id/:idx  302\n/docs/*  /manual/:splat\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+	if rules[1].Status != http.StatusMovedPermanently {
+		t.Fatalf("expected default status 301, got %d", rules[1].Status)
+	}
+
+	for _, test := range []struct {
+		rule  int
+		path  string
+		to    string
+		match bool
+	}{
+		{0, "/users/7/posts/3", "/u/7/3", true},
+		{0, "/users/7/posts", "", false},
+		{1, "/docs/a/b.html", "/manual/a/b.html", true},
+		{1, "/docs", "/manual/", true},
+		{1, "/other/a", "", false},
+	} {
+		to, ok := rules[test.rule].match(test.path)
+		if ok != test.match || to != test.to {
+			t.Errorf("matching %s: got (%q, %t), expected (%q, %t)", test.path, to, ok, test.to, test.match)
+		}
+	}
+
+	if _, err := parseRedirects(strings.NewReader("/a  http://example.com  200\n")); err == nil {
+		t.Fatal("expected rewrite to an external url to be rejected")
+	}
+}
+
+func TestGatewayCacheHeaders(t *testing.T) {
+	ns := mockNamesys{}
+	ts, n := newTestServerAndNode(t, ns)
+	t.Logf("test server url: %s", ts.URL)
+	defer ts.Close()
+
+	_, dagn, err := coreunix.AddWrapped(n, strings.NewReader("fnord"), "file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dk, err := dagn.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	k := dk.B58String()
+	ns["/ipns/example.net"] = path.FromString("/ipfs/" + k)
+
+	fk, err := dagn.Links[0].Node.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag := `"` + fk.B58String() + `"`
+
+	for _, test := range []struct {
+		path  string
+		cache string
+	}{
+		{"/ipfs/" + k + "/file.txt", "public, max-age=29030400"},
+		{"/ipns/example.net/file.txt", "no-cache"},
+	} {
+		res, err := http.Get(ts.URL + test.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+
+		if got := res.Header.Get("Etag"); got != etag {
+			t.Errorf("etag for %s is %s, expected %s", test.path, got, etag)
+		}
+		if got := res.Header.Get("Cache-Control"); got != test.cache {
+			t.Errorf("cache-control for %s is %q, expected %q", test.path, got, test.cache)
+		}
+		if got := res.Header.Get("X-Ipfs-Roots"); got != k+","+fk.B58String() {
+			t.Errorf("roots for %s are %q, expected %q", test.path, got, k+","+fk.B58String())
+		}
+
+		req, err := http.NewRequest("GET", ts.URL+test.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("If-None-Match", etag)
+		res, err = http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusNotModified {
+			t.Errorf("status for %s with matching etag is %d, expected 304", test.path, res.StatusCode)
+		}
+	}
+}
+
+func TestGatewayFormats(t *testing.T) {
+	ns := mockNamesys{}
+	ts, n := newTestServerAndNode(t, ns)
+	t.Logf("test server url: %s", ts.URL)
+	defer ts.Close()
+
+	_, root, err := coreunix.AddWrapped(n, strings.NewReader("fnord"), "file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rk, err := root.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ?format=raw returns the block itself
+	res, err := http.Get(ts.URL + "/ipfs/" + rk.B58String() + "?format=raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := res.Header.Get("Content-Type"); ct != rawContentType {
+		t.Fatalf("content type is %q, expected %q", ct, rawContentType)
+	}
+	if k := key.Key(u.Hash(body)); k != rk {
+		t.Fatalf("raw block hashes to %s, expected %s", k, rk)
+	}
+
+	// ?format=dag returns the root and every block under it
+	res, err = http.Get(ts.URL + "/ipfs/" + rk.B58String() + "?format=dag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	section := func() []byte {
+		l, n := binary.Uvarint(body)
+		if n <= 0 || uint64(len(body)-n) < l {
+			t.Fatal("truncated dag stream")
+		}
+		s := body[n : n+int(l)]
+		body = body[n+int(l):]
+		return s
+	}
+	if k := key.Key(section()); k != rk {
+		t.Fatalf("dag stream root is %s, expected %s", k, rk)
+	}
+	var got []key.Key
+	for len(body) > 0 {
+		k := key.Key(section())
+		if hk := key.Key(u.Hash(section())); hk != k {
+			t.Fatalf("block %s in dag stream hashes to %s", k, hk)
+		}
+		got = append(got, k)
+	}
+	fk, err := root.Links[0].Node.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != rk || got[1] != fk {
+		t.Fatalf("dag stream has blocks %v, expected [%s %s]", got, rk, fk)
+	}
+
+	res, err = http.Get(ts.URL + "/ipfs/" + rk.B58String() + "?format=tar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("status for unknown format is %d, expected 400", res.StatusCode)
+	}
+}
+
+func TestGatewayDocumentValue(t *testing.T) {
+	ns := mockNamesys{}
+	ts, n := newTestServerAndNode(t, ns)
+	t.Logf("test server url: %s", ts.URL)
+	defer ts.Close()
+
+	doc, err := dag.NewDocumentNode(map[string]interface{}{
+		"meta": map[string]interface{}{"title": "hello"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := n.DAG.Add(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := http.Get(ts.URL + "/ipfs/" + k.B58String() + "/meta/title")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK || string(body) != "hello" {
+		t.Fatalf("got %d %q, expected the value of the field", res.StatusCode, body)
+	}
+
+	res, err = http.Get(ts.URL + "/ipfs/" + k.B58String() + "/meta/title?format=raw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("got %d for a format of a value, expected %d", res.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestGatewayDirectoryListing(t *testing.T) {
+	ns := mockNamesys{}
+	ts, n := newTestServerAndNode(t, ns)
+	t.Logf("test server url: %s", ts.URL)
+	defer ts.Close()
+
+	_, root, err := coreunix.AddWrapped(n, strings.NewReader("small"), "b.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, other, err := coreunix.AddWrapped(n, strings.NewReader("a bit larger"), "a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, sub, err := coreunix.AddWrapped(n, strings.NewReader("x"), "x.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := root.AddNodeLink("a.txt", other.Links[0].Node); err != nil {
+		t.Fatal(err)
+	}
+	if err := root.AddNodeLink("c", sub); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.DAG.AddRecursive(root); err != nil {
+		t.Fatal(err)
+	}
+	k, err := root.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest("GET", ts.URL+"/ipfs/"+k.B58String()+"/?sort=-size", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var listing directoryListing
+	if err := json.NewDecoder(res.Body).Decode(&listing); err != nil {
+		t.Fatal(err)
+	}
+	if listing.Hash != k.B58String() {
+		t.Fatalf("listing hash is %s, expected %s", listing.Hash, k)
+	}
+
+	// files are sized by their content, directories by their links
+	dirSize, err := sub.Size()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		name string
+		typ  string
+		size uint64
+	}{
+		{"c", "dir", dirSize},
+		{"a.txt", "file", uint64(len("a bit larger"))},
+		{"b.txt", "file", uint64(len("small"))},
+	}
+	if len(listing.Entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(listing.Entries))
+	}
+	for i, e := range expected {
+		got := listing.Entries[i]
+		if got.Name != e.name || got.Type != e.typ || got.Size != e.size {
+			t.Errorf("entry %d is %s (%s, %d), expected %s (%s, %d)", i, got.Name, got.Type, got.Size, e.name, e.typ, e.size)
+		}
+	}
+
+	ak, err := other.Links[0].Node.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err = http.Get(ts.URL + "/ipfs/" + k.B58String() + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), "<a href=\"/ipfs/"+ak.B58String()+"\"") {
+		t.Fatalf("expected hash of a.txt in directory listing")
+	}
+}
+
+func TestGatewayShardedDirectoryListing(t *testing.T) {
+	defer func(n int) { uio.ShardThreshold = n }(uio.ShardThreshold)
+	uio.ShardThreshold = 20
+
+	ns := mockNamesys{}
+	ts, n := newTestServerAndNode(t, ns)
+	defer ts.Close()
+
+	// more entries than a fetch batch, in a sharded directory
+	dir := uio.NewDirectory(n.DAG)
+	names := make(map[string]bool)
+	for i := 0; i < 2*listingFetchBatch+10; i++ {
+		name := fmt.Sprintf("file-%d", i)
+		nd := &dag.Node{Data: ft.FilePBData([]byte(name), uint64(len(name)))}
+		if _, err := n.DAG.Add(nd); err != nil {
+			t.Fatal(err)
+		}
+		if err := dir.AddNode(context.Background(), name, nd); err != nil {
+			t.Fatal(err)
+		}
+		names[name] = true
+	}
+	root, err := dir.GetNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := n.DAG.Add(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest("GET", ts.URL+"/ipfs/"+k.B58String()+"/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	var listing directoryListing
+	if err := json.NewDecoder(res.Body).Decode(&listing); err != nil {
+		t.Fatal(err)
+	}
+	if len(listing.Entries) != len(names) {
+		t.Fatalf("expected %d entries, got %d", len(names), len(listing.Entries))
+	}
+	for _, e := range listing.Entries {
+		if !names[e.Name] || e.Type != "file" || e.Size != uint64(len(e.Name)) {
+			t.Fatalf("wrong entry %s (%s, %d)", e.Name, e.Type, e.Size)
+		}
+	}
+}
+
+// fetchCountingDAG counts the links each child is requested through.
+type fetchCountingDAG struct {
+	dag.DAGService
+	fetched map[key.Key]int
+}
+
+func (d *fetchCountingDAG) GetLinks(ctx context.Context, links []*dag.Link) []dag.NodeGetter {
+	for _, l := range links {
+		d.fetched[key.Key(l.Hash)]++
+	}
+	return d.DAGService.GetLinks(ctx, links)
+}
+
+func TestWriteDAGSharedSubtree(t *testing.T) {
+	n, err := newNodeWithMockNamesys(mockNamesys{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaf := &dag.Node{Data: []byte("leaf")}
+	shared := &dag.Node{Data: []byte("shared")}
+	if err := shared.AddNodeLink("leaf", leaf); err != nil {
+		t.Fatal(err)
+	}
+	root := &dag.Node{Data: []byte("root")}
+	for _, name := range []string{"a", "b"} {
+		nd := &dag.Node{Data: []byte(name)}
+		if err := nd.AddNodeLink("shared", shared); err != nil {
+			t.Fatal(err)
+		}
+		if err := root.AddNodeLink(name, nd); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := n.DAG.AddRecursive(root); err != nil {
+		t.Fatal(err)
+	}
+
+	ds := &fetchCountingDAG{DAGService: n.DAG, fetched: make(map[key.Key]int)}
+	if err := writeDAG(context.Background(), ioutil.Discard, ds, n.Blocks, root); err != nil {
+		t.Fatal(err)
+	}
+
+	sk, err := shared.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := ds.fetched[sk]; c != 1 {
+		t.Fatalf("shared subtree fetched %d times, expected once", c)
+	}
+}
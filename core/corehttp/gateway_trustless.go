@@ -0,0 +1,150 @@
+package corehttp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	bserv "github.com/ipfs/go-ipfs/blockservice"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+)
+
+const (
+	// rawFormat serves the bytes of the single block a path resolves to.
+	rawFormat = "raw"
+
+	// dagFormat serves every block of the DAG under a path, see writeDAG.
+	dagFormat = "dag"
+
+	rawContentType = "application/vnd.ipfs.raw"
+	dagContentType = "application/vnd.ipfs.dag"
+)
+
+// validFormat reports whether f is a value the gateway accepts for the
+// format query parameter. The empty format serves reassembled files.
+func validFormat(f string) bool {
+	switch f {
+	case "", rawFormat, dagFormat:
+		return true
+	default:
+		return false
+	}
+}
+
+// serveFormat answers requests for ?format=raw and ?format=dag, which return
+// blocks instead of file contents so clients can check every hash themselves.
+func (i *gatewayHandler) serveFormat(ctx context.Context, w http.ResponseWriter, r *http.Request, format string, nd *dag.Node, modtime time.Time) {
+	k, err := nd.Key()
+	if err != nil {
+		internalWebError(w, err)
+		return
+	}
+
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	switch format {
+	case rawFormat:
+		b, err := i.node.Blocks.GetBlock(ctx, k)
+		if err != nil {
+			internalWebError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", rawContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.bin\"", k.B58String()))
+		http.ServeContent(w, r, "", modtime, bytes.NewReader(b.Data))
+
+	case dagFormat:
+		w.Header().Set("Content-Type", dagContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.dag\"", k.B58String()))
+		if r.Method == "HEAD" {
+			return
+		}
+
+		// the status is sent with the first byte, so errors while walking
+		// the DAG can only cut the stream short.
+		bw := bufio.NewWriter(w)
+		if err := writeDAG(ctx, bw, i.node.DAG, i.node.Blocks, nd); err != nil {
+			log.Errorf("error writing dag for %s: %s", k, err)
+			return
+		}
+		if err := bw.Flush(); err != nil {
+			log.Errorf("error writing dag for %s: %s", k, err)
+		}
+	}
+}
+
+// writeDAG streams the DAG under root in a self-describing container. The
+// container starts with the multihash of the root, followed by every block
+// of the DAG in depth-first order, children in the order of their links.
+// Blocks shared by several parents are only sent the first time:
+//
+//   <uvarint len(root)><root multihash>
+//   <uvarint len(mh)><multihash><uvarint len(data)><block data>...
+//
+// The children of a node that were not sent yet are requested at once with
+// DAGService.GetLinks.
+func writeDAG(ctx context.Context, w io.Writer, ds dag.DAGService, bs *bserv.BlockService, root *dag.Node) error {
+	k, err := root.Key()
+	if err != nil {
+		return err
+	}
+	if err := writeSection(w, []byte(k)); err != nil {
+		return err
+	}
+	return writeDAGBlocks(ctx, w, ds, bs, root, k, make(map[key.Key]struct{}))
+}
+
+func writeDAGBlocks(ctx context.Context, w io.Writer, ds dag.DAGService, bs *bserv.BlockService, nd *dag.Node, k key.Key, seen map[key.Key]struct{}) error {
+	if _, ok := seen[k]; ok {
+		return nil
+	}
+	seen[k] = struct{}{}
+
+	b, err := bs.GetBlock(ctx, k)
+	if err != nil {
+		return err
+	}
+	if err := writeSection(w, []byte(k)); err != nil {
+		return err
+	}
+	if err := writeSection(w, b.Data); err != nil {
+		return err
+	}
+
+	// shared subtrees are only fetched the first time they are reached
+	var links []*dag.Link
+	for _, l := range nd.Links {
+		if _, ok := seen[key.Key(l.Hash)]; !ok {
+			links = append(links, l)
+		}
+	}
+
+	for i, ng := range ds.GetLinks(ctx, links) {
+		child, err := ng.Get(ctx)
+		if err != nil {
+			return err
+		}
+		if err := writeDAGBlocks(ctx, w, ds, bs, child, key.Key(links[i].Hash), seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeSection writes a length prefixed byte slice.
+func writeSection(w io.Writer, b []byte) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(b)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
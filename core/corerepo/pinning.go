@@ -24,7 +24,10 @@ import (
 	path "github.com/ipfs/go-ipfs/path"
 )
 
-func Pin(n *core.IpfsNode, ctx context.Context, paths []string, recursive bool) ([]key.Key, error) {
+// Pin pins the objects at paths. The objects below those pinned
+// recursively are fetched concurrency at a time, or
+// the default of merkledag/traverse if not positive.
+func Pin(n *core.IpfsNode, ctx context.Context, paths []string, recursive bool, concurrency int) ([]key.Key, error) {
 	dagnodes := make([]*merkledag.Node, 0)
 	for _, fpath := range paths {
 		dagnode, err := core.Resolve(ctx, n, path.Path(fpath))
@@ -43,7 +46,11 @@ func Pin(n *core.IpfsNode, ctx context.Context, paths []string, recursive bool)
 
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
-		err = n.Pinning.Pin(ctx, dagnode, recursive)
+		if recursive {
+			err = n.Pinning.PinRecursive(ctx, dagnode, concurrency)
+		} else {
+			err = n.Pinning.Pin(ctx, dagnode, false)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("pin: %s", err)
 		}
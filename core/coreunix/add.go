@@ -15,20 +15,29 @@ import (
 	merkledag "github.com/ipfs/go-ipfs/merkledag"
 	"github.com/ipfs/go-ipfs/pin"
 	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
-	unixfs "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
 )
 
 var log = logging.Logger("coreunix")
 
 // Add builds a merkledag from the a reader, pinning all objects to the local
-// datastore. Returns a key representing the root node.
-func Add(n *core.IpfsNode, r io.Reader) (string, error) {
+// datastore if dopin is set. Returns a key representing the root node.
+// Unpinned objects are only kept from the garbage collector until Add
+// returns.
+func Add(n *core.IpfsNode, r io.Reader, dopin bool) (string, error) {
 	// TODO more attractive function signature importer.BuildDagFromReader
 
+	tp := newTempPinner(n, dopin)
+	ncb := importer.BasicPinnerCB(n.Pinning.GetManual())
+	if tp != nil {
+		ncb = importer.TempPinCB(tp)
+		defer tp.Release()
+	}
+
 	dagNode, err := importer.BuildDagFromReader(
 		n.DAG,
 		chunk.NewSizeSplitter(r, chunk.DefaultBlockSize),
-		importer.BasicPinnerCB(n.Pinning.GetManual()),
+		ncb,
 	)
 	if err != nil {
 		return "", err
@@ -41,21 +50,25 @@ func Add(n *core.IpfsNode, r io.Reader) (string, error) {
 	return k.String(), nil
 }
 
-// AddR recursively adds files in |path|.
-func AddR(n *core.IpfsNode, root string) (key string, err error) {
+// AddR recursively adds files in |path|, pinning them if dopin is set.
+func AddR(n *core.IpfsNode, root string, dopin bool) (key string, err error) {
 	stat, err := os.Lstat(root)
 	if err != nil {
 		return "", err
 	}
 
-	f, err := files.NewSerialFile(root, root, stat)
+	f, err := files.NewSerialFile(root, root, stat, nil)
 	if err != nil {
 		return "", err
 	}
 	defer f.Close()
 
-	dagnode, err := addFile(n, f)
+	tp := newTempPinner(n, dopin)
+	dagnode, err := addFile(n, tp, f)
 	if err != nil {
+		if tp != nil {
+			tp.Release()
+		}
 		return "", err
 	}
 
@@ -64,6 +77,13 @@ func AddR(n *core.IpfsNode, root string) (key string, err error) {
 		return "", err
 	}
 
+	if tp != nil {
+		if err := tp.Release(); err != nil {
+			return "", err
+		}
+		return k.String(), nil
+	}
+
 	n.Pinning.GetManual().RemovePinWithMode(k, pin.Indirect)
 	if err := n.Pinning.Flush(); err != nil {
 		return "", err
@@ -79,7 +99,7 @@ func AddR(n *core.IpfsNode, root string) (key string, err error) {
 func AddWrapped(n *core.IpfsNode, r io.Reader, filename string) (string, *merkledag.Node, error) {
 	file := files.NewReaderFile(filename, filename, ioutil.NopCloser(r), nil)
 	dir := files.NewSliceFile("", "", []files.File{file})
-	dagnode, err := addDir(n, dir)
+	dagnode, err := addDir(n, nil, dir)
 	if err != nil {
 		return "", nil, err
 	}
@@ -90,36 +110,58 @@ func AddWrapped(n *core.IpfsNode, r io.Reader, filename string) (string, *merkle
 	return gopath.Join(k.String(), filename), dagnode, nil
 }
 
-func add(n *core.IpfsNode, reader io.Reader) (*merkledag.Node, error) {
-	mp := n.Pinning.GetManual()
+// newTempPinner returns the pinner keeping the objects of an unpinned add
+// from the garbage collector, nil if dopin is set.
+func newTempPinner(n *core.IpfsNode, dopin bool) *pin.TempPinner {
+	if dopin {
+		return nil
+	}
+	return pin.NewTempPinner(n.Pinning.GetManual())
+}
+
+// add imports the file read from reader. Its objects are pinned through tp
+// if it is not nil, and for good otherwise, like in addFile and addDir.
+func add(n *core.IpfsNode, tp *pin.TempPinner, reader io.Reader) (*merkledag.Node, error) {
+	ncb := importer.PinIndirectCB(n.Pinning.GetManual())
+	if tp != nil {
+		ncb = importer.TempPinCB(tp)
+	}
 
 	return importer.BuildDagFromReader(
 		n.DAG,
 		chunk.DefaultSplitter(reader),
-		importer.PinIndirectCB(mp),
+		ncb,
 	)
 }
 
-func addNode(n *core.IpfsNode, node *merkledag.Node) error {
+func addNode(n *core.IpfsNode, tp *pin.TempPinner, node *merkledag.Node) error {
 	if err := n.DAG.AddRecursive(node); err != nil { // add the file to the graph + local storage
 		return err
 	}
+	if tp != nil {
+		// its children were pinned as they were added
+		k, err := node.Key()
+		if err != nil {
+			return err
+		}
+		tp.Pin(k)
+		return nil
+	}
 	ctx, cancel := context.WithCancel(n.Context())
 	defer cancel()
 	err := n.Pinning.Pin(ctx, node, true) // ensure we keep it
 	return err
 }
 
-func addFile(n *core.IpfsNode, file files.File) (*merkledag.Node, error) {
+func addFile(n *core.IpfsNode, tp *pin.TempPinner, file files.File) (*merkledag.Node, error) {
 	if file.IsDirectory() {
-		return addDir(n, file)
+		return addDir(n, tp, file)
 	}
-	return add(n, file)
+	return add(n, tp, file)
 }
 
-func addDir(n *core.IpfsNode, dir files.File) (*merkledag.Node, error) {
-
-	tree := &merkledag.Node{Data: unixfs.FolderPBData()}
+func addDir(n *core.IpfsNode, tp *pin.TempPinner, dir files.File) (*merkledag.Node, error) {
+	dirb := uio.NewDirectory(n.DAG)
 
 Loop:
 	for {
@@ -131,19 +173,24 @@ Loop:
 			break Loop
 		}
 
-		node, err := addFile(n, file)
+		node, err := addFile(n, tp, file)
 		if err != nil {
 			return nil, err
 		}
 
 		_, name := gopath.Split(file.FileName())
 
-		if err := tree.AddNodeLink(name, node); err != nil {
+		if err := dirb.AddNode(n.Context(), name, node); err != nil {
 			return nil, err
 		}
 	}
 
-	if err := addNode(n, tree); err != nil {
+	tree, err := dirb.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := addNode(n, tp, tree); err != nil {
 		return nil, err
 	}
 	return tree, nil
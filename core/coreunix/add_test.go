@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	key "github.com/ipfs/go-ipfs/blocks/key"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/ipfs/go-ipfs/repo"
 	"github.com/ipfs/go-ipfs/repo/config"
@@ -29,9 +30,41 @@ func TestAddRecursive(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if k, err := AddR(node, path.Join(here, "test_data")); err != nil {
+	if k, err := AddR(node, path.Join(here, "test_data"), true); err != nil {
 		t.Fatal(err)
 	} else if k != "QmWCCga8AbTyfAQ7pTnGT6JgmRMAB3Qp8ZmTEFi5q5o8jC" {
 		t.Fatal("keys do not match")
 	}
 }
+
+func TestAddRecursiveUnpinned(t *testing.T) {
+	here, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &repo.Mock{
+		C: config.Config{
+			Identity: config.Identity{
+				PeerID: "Qmfoo", // required by offline node
+			},
+		},
+		D: testutil.ThreadSafeCloserMapDatastore(),
+	}
+	node, err := core.NewNode(context.Background(), &core.BuildCfg{Repo: r})
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := AddR(node, path.Join(here, "test_data"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k != "QmWCCga8AbTyfAQ7pTnGT6JgmRMAB3Qp8ZmTEFi5q5o8jC" {
+		t.Fatal("keys do not match")
+	}
+	if node.Pinning.IsPinned(key.B58KeyDecode(k)) {
+		t.Fatal("root of an unpinned add is pinned")
+	}
+	if len(node.Pinning.IndirectKeys()) != 0 {
+		t.Fatal("unpinned add left indirect pins")
+	}
+}
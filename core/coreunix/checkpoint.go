@@ -0,0 +1,159 @@
+package coreunix
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	dsq "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/query"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	u "github.com/ipfs/go-ipfs/util"
+)
+
+// checkpointPrefix is where the files imported by adds are recorded in the
+// datastore of the repo, see Checkpoint.
+var checkpointPrefix = ds.NewKey("/local/add/checkpoint")
+
+// CheckpointHeadSize is the number of bytes at the start of a file that
+// are checked, along with its stat, before resuming it: the same path may
+// name another file in another directory or on another client.
+const CheckpointHeadSize = 64 * 1024
+
+// checkpointExpiry is how long records are kept. Those of adds that were
+// never completed are removed by the next add clearing its own.
+var checkpointExpiry = 7 * 24 * time.Hour
+
+// Checkpoint records the files an add has imported, by their path,
+// so that an add interrupted midway can be resumed without importing them
+// again. Records are only shared between adds with the same params, which
+// name the options that change how files are imported.
+type Checkpoint struct {
+	d      ds.Datastore
+	params string
+
+	// records made through this checkpoint, removed by Clear
+	recorded []ds.Key
+}
+
+type checkpointEntry struct {
+	Size    int64
+	Mode    os.FileMode
+	ModTime time.Time
+	Head    string // hash of the first CheckpointHeadSize bytes
+	Root    string
+
+	Recorded time.Time
+}
+
+// NewCheckpoint returns the checkpoint of adds with the given params,
+// recorded in d.
+func NewCheckpoint(d ds.Datastore, params string) *Checkpoint {
+	return &Checkpoint{d: d, params: params}
+}
+
+func (c *Checkpoint) dsKey(fpath string) ds.Key {
+	k := key.Key(u.Hash([]byte(c.params + "\x00" + fpath)))
+	return checkpointPrefix.ChildString(k.B58String())
+}
+
+// headHash returns the hash head is recorded with.
+func headHash(head []byte) string {
+	return key.Key(u.Hash(head)).B58String()
+}
+
+// Lookup returns the root the file at fpath was imported as, if it was
+// recorded with the size, mode and modification time of fi and starting
+// with head, its first CheckpointHeadSize bytes, less than
+// checkpointExpiry ago.
+func (c *Checkpoint) Lookup(fpath string, fi os.FileInfo, head []byte) (key.Key, bool) {
+	val, err := c.d.Get(c.dsKey(fpath))
+	if err != nil {
+		return "", false
+	}
+	buf, ok := val.([]byte)
+	if !ok {
+		return "", false
+	}
+
+	var e checkpointEntry
+	if err := json.Unmarshal(buf, &e); err != nil {
+		log.Debugf("invalid checkpoint entry for %s: %s", fpath, err)
+		return "", false
+	}
+	if e.Size != fi.Size() || e.Mode != fi.Mode() || !e.ModTime.Equal(fi.ModTime()) {
+		return "", false
+	}
+	if e.Head != headHash(head) || e.expired() {
+		return "", false
+	}
+	return key.B58KeyDecode(e.Root), true
+}
+
+func (e *checkpointEntry) expired() bool {
+	return time.Since(e.Recorded) > checkpointExpiry
+}
+
+// Record records that the file at fpath, as described by fi and starting
+// with head, was imported as root.
+func (c *Checkpoint) Record(fpath string, fi os.FileInfo, head []byte, root key.Key) error {
+	if fi == nil {
+		return errors.New("cannot record a file without stat")
+	}
+	buf, err := json.Marshal(&checkpointEntry{
+		Size:    fi.Size(),
+		Mode:    fi.Mode(),
+		ModTime: fi.ModTime(),
+		Head:    headHash(head),
+		Root:    root.B58String(),
+
+		Recorded: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	k := c.dsKey(fpath)
+	if err := c.d.Put(k, buf); err != nil {
+		return err
+	}
+	c.recorded = append(c.recorded, k)
+	return nil
+}
+
+// Clear removes the records made through c, once the add they belong to
+// is complete, and the expired records of any add.
+func (c *Checkpoint) Clear() error {
+	for _, k := range c.recorded {
+		if err := c.d.Delete(k); err != nil && err != ds.ErrNotFound {
+			return err
+		}
+	}
+	c.recorded = nil
+
+	return c.clearExpired()
+}
+
+func (c *Checkpoint) clearExpired() error {
+	qr, err := c.d.Query(dsq.Query{Prefix: checkpointPrefix.String()})
+	if err != nil {
+		return err
+	}
+	res, err := qr.Rest()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range res {
+		var e checkpointEntry
+		if buf, ok := r.Value.([]byte); ok && json.Unmarshal(buf, &e) == nil && !e.expired() {
+			continue
+		}
+		err := c.d.Delete(ds.NewKey(r.Key))
+		if err != nil && err != ds.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
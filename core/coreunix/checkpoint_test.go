@@ -0,0 +1,114 @@
+package coreunix
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	u "github.com/ipfs/go-ipfs/util"
+)
+
+type fakeStat struct {
+	size  int64
+	mtime time.Time
+}
+
+func (s fakeStat) Name() string       { return "file" }
+func (s fakeStat) Size() int64        { return s.size }
+func (s fakeStat) Mode() os.FileMode  { return 0644 }
+func (s fakeStat) ModTime() time.Time { return s.mtime }
+func (s fakeStat) IsDir() bool        { return false }
+func (s fakeStat) Sys() interface{}   { return nil }
+
+func TestCheckpoint(t *testing.T) {
+	d := ds.NewMapDatastore()
+	root := key.Key(u.Hash([]byte("root")))
+	stat := fakeStat{size: 42, mtime: time.Unix(1000, 5)}
+
+	head := []byte("the start of the file")
+
+	c := NewCheckpoint(d, "params")
+	if _, ok := c.Lookup("/a/file", stat, head); ok {
+		t.Fatal("nothing was recorded yet")
+	}
+	if err := c.Record("/a/file", stat, head, root); err != nil {
+		t.Fatal(err)
+	}
+
+	// an add resuming with the same params finds it
+	resumed := NewCheckpoint(d, "params")
+	k, ok := resumed.Lookup("/a/file", stat, head)
+	if !ok || k != root {
+		t.Fatal("expected the recorded root")
+	}
+
+	changed := fakeStat{size: 42, mtime: time.Unix(2000, 0)}
+	if _, ok := resumed.Lookup("/a/file", changed, head); ok {
+		t.Fatal("a modified file should not be resumed")
+	}
+	rewritten := fakeStat{size: 43, mtime: stat.mtime}
+	if _, ok := resumed.Lookup("/a/file", rewritten, head); ok {
+		t.Fatal("a file rewritten within the same mtime should not be resumed")
+	}
+	if _, ok := resumed.Lookup("/a/file", stat, []byte("another file")); ok {
+		t.Fatal("a file starting with other data should not be resumed")
+	}
+	if _, ok := NewCheckpoint(d, "other").Lookup("/a/file", stat, head); ok {
+		t.Fatal("adds with other params should not share records")
+	}
+
+	if err := c.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resumed.Lookup("/a/file", stat, head); ok {
+		t.Fatal("the record should be gone once cleared")
+	}
+}
+
+func TestCheckpointExpiry(t *testing.T) {
+	d := ds.NewMapDatastore()
+	root := key.Key(u.Hash([]byte("root")))
+	stat := fakeStat{size: 42, mtime: time.Unix(1000, 5)}
+	head := []byte("head")
+
+	// an add that never completed
+	stale := NewCheckpoint(d, "params")
+	if err := stale.Record("/stale", stat, head, root); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func(exp time.Duration) { checkpointExpiry = exp }(checkpointExpiry)
+	checkpointExpiry = 0
+
+	c := NewCheckpoint(d, "params")
+	if _, ok := c.Lookup("/stale", stat, head); ok {
+		t.Fatal("an expired record should not be resumed")
+	}
+
+	checkpointExpiry = time.Hour
+	if err := c.Record("/current", stat, head, root); err != nil {
+		t.Fatal(err)
+	}
+	other := NewCheckpoint(d, "params")
+	if err := other.Record("/other", stat, head, root); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Put(stale.dsKey("/stale"), []byte(`{"Recorded":"2000-01-01T00:00:00Z"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	// clearing removes the records of the add and the expired ones
+	if err := c.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	for _, fpath := range []string{"/current", "/stale"} {
+		if has, _ := d.Has(c.dsKey(fpath)); has {
+			t.Fatalf("record of %s not removed", fpath)
+		}
+	}
+	if _, ok := other.Lookup("/other", stat, head); !ok {
+		t.Fatal("the records of another add in progress should be kept")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
 	process "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/goprocess"
 	procctx "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/goprocess/context"
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
@@ -22,6 +23,7 @@ import (
 	wantlist "github.com/ipfs/go-ipfs/exchange/bitswap/wantlist"
 	peer "github.com/ipfs/go-ipfs/p2p/peer"
 	"github.com/ipfs/go-ipfs/thirdparty/delay"
+	u "github.com/ipfs/go-ipfs/util"
 	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
 )
 
@@ -304,6 +306,10 @@ func (bs *Bitswap) ReceiveMessage(ctx context.Context, p peer.ID, incoming bsmsg
 		return
 	}
 
+	for i, b := range iblocks {
+		iblocks[i] = bs.wantedBlock(b)
+	}
+
 	// quickly send out cancels, reduces chances of duplicate block receives
 	var keys []key.Key
 	for _, block := range iblocks {
@@ -337,6 +343,40 @@ func (bs *Bitswap) ReceiveMessage(ctx context.Context, p peer.ID, incoming bsmsg
 	wg.Wait()
 }
 
+// wantedBlock returns b under the key it was requested with. Blocks travel
+// without their key and are hashed with the default hash function when
+// received, so blocks that are not wanted under that key are hashed again
+// with every other hash function used in the wantlist. This also verifies
+// the data against the requested key.
+func (bs *Bitswap) wantedBlock(b *blocks.Block) *blocks.Block {
+	if _, found := bs.wm.wl.Contains(b.Key()); found {
+		return b
+	}
+
+	type hashFn struct{ code, length int }
+	tried := make(map[hashFn]bool)
+	for _, e := range bs.wm.wl.Entries() {
+		dec, err := mh.Decode(mh.Multihash(e.Key))
+		if err != nil {
+			continue
+		}
+		fn := hashFn{dec.Code, dec.Length}
+		if tried[fn] {
+			continue
+		}
+		tried[fn] = true
+
+		h, err := u.Sum(b.Data, fn.code, fn.length)
+		if err != nil {
+			continue
+		}
+		if _, found := bs.wm.wl.Contains(key.Key(h)); found {
+			return &blocks.Block{Data: b.Data, Multihash: h}
+		}
+	}
+	return b
+}
+
 var ErrAlreadyHaveBlock = errors.New("already have block")
 
 func (bs *Bitswap) updateReceiveCounters(b *blocks.Block) error {
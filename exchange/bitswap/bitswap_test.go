@@ -7,12 +7,14 @@ import (
 	"time"
 
 	detectrace "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-detect-race"
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 	travis "github.com/ipfs/go-ipfs/util/testutil/ci/travis"
 
 	blocks "github.com/ipfs/go-ipfs/blocks"
 	blocksutil "github.com/ipfs/go-ipfs/blocks/blocksutil"
 	key "github.com/ipfs/go-ipfs/blocks/key"
+	bsmsg "github.com/ipfs/go-ipfs/exchange/bitswap/message"
 	tn "github.com/ipfs/go-ipfs/exchange/bitswap/testnet"
 	p2ptestutil "github.com/ipfs/go-ipfs/p2p/test/util"
 	mockrouting "github.com/ipfs/go-ipfs/routing/mock"
@@ -283,3 +285,52 @@ func TestBasicBitswap(t *testing.T) {
 		}
 	}
 }
+
+func TestGetBlockWithOtherHashFunc(t *testing.T) {
+	net := tn.VirtualNetwork(mockrouting.NewServer(), delay.Fixed(kNetworkDelay))
+	g := NewTestSessionGenerator(net)
+	defer g.Close()
+
+	peers := g.Instances(2)
+	sender, receiver := peers[0], peers[1]
+	defer receiver.Exchange.Close()
+
+	block, err := blocks.NewBlockWithHashFunc([]byte("block"), mh.SHA3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ch, err := receiver.Exchange.GetBlocks(ctx, []key.Key{block.Key()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the wantlist is updated asynchronously
+	for {
+		if _, found := receiver.Exchange.wm.wl.Contains(block.Key()); found {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatal("block never added to the wantlist")
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	// blocks go over the wire without their key, the receiver only gets the
+	// data hashed with the default function.
+	msg := bsmsg.New(false)
+	msg.AddBlock(blocks.NewBlock(block.Data))
+	receiver.Exchange.ReceiveMessage(ctx, sender.Peer, msg)
+
+	select {
+	case received := <-ch:
+		if received.Key() != block.Key() {
+			t.Fatalf("received %s, expected %s", received.Key(), block.Key())
+		}
+	case <-ctx.Done():
+		t.Fatal("did not receive the block")
+	}
+}
@@ -0,0 +1,120 @@
+package filestore
+
+import (
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	blocks "github.com/ipfs/go-ipfs/blocks"
+	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+)
+
+// NewBlockstore returns a blockstore that keeps blocks carrying a DataPos as
+// references in fs, and every other block in bs.
+func NewBlockstore(bs bstore.Blockstore, fs *Filestore) bstore.Blockstore {
+	return &blockstore{bs: bs, fs: fs}
+}
+
+type blockstore struct {
+	bs bstore.Blockstore
+	fs *Filestore
+}
+
+func (b *blockstore) Get(k key.Key) (*blocks.Block, error) {
+	blk, err := b.bs.Get(k)
+	if err != bstore.ErrNotFound {
+		return blk, err
+	}
+
+	blk, err = b.fs.Get(k)
+	if err == ErrNotFound {
+		return nil, bstore.ErrNotFound
+	}
+	return blk, err
+}
+
+func (b *blockstore) Put(blk *blocks.Block) error {
+	if blk.Pos == nil {
+		return b.bs.Put(blk)
+	}
+
+	// a full copy is as good as a reference
+	if has, err := b.bs.Has(blk.Key()); err == nil && has {
+		return nil
+	}
+	return b.fs.Put(blk)
+}
+
+func (b *blockstore) PutMany(blks []*blocks.Block) error {
+	var copied []*blocks.Block
+	for _, blk := range blks {
+		if blk.Pos == nil {
+			copied = append(copied, blk)
+			continue
+		}
+		if err := b.Put(blk); err != nil {
+			return err
+		}
+	}
+	return b.bs.PutMany(copied)
+}
+
+func (b *blockstore) Has(k key.Key) (bool, error) {
+	has, err := b.bs.Has(k)
+	if err != nil || has {
+		return has, err
+	}
+	return b.fs.Has(k)
+}
+
+// DeleteBlock removes the block and its reference, if any. Files in the
+// filestore are left alone, only the reference to them is removed.
+func (b *blockstore) DeleteBlock(k key.Key) error {
+	err := b.bs.DeleteBlock(k)
+	ferr := b.fs.Delete(k)
+	if ferr == nil {
+		return nil
+	}
+	if ferr != ErrNotFound {
+		return ferr
+	}
+	return err
+}
+
+// AllKeysChan returns the keys of the underlying blockstore followed by the
+// keys of the references that have no full copy.
+func (b *blockstore) AllKeysChan(ctx context.Context) (<-chan key.Key, error) {
+	bch, err := b.bs.AllKeysChan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fch, err := b.fs.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(chan key.Key)
+	go func() {
+		defer close(output)
+
+		for k := range bch {
+			select {
+			case output <- k:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for r := range fch {
+			if has, err := b.bs.Has(r.Key); err == nil && has {
+				continue
+			}
+
+			select {
+			case output <- r.Key:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return output, nil
+}
@@ -0,0 +1,317 @@
+// package filestore keeps blocks added without copying as references to
+// the files they were read from. A reference records the path of the file
+// and where the data of the block lies in it; the block is rebuilt from the
+// file whenever it is read.
+package filestore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
+	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	dsns "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/namespace"
+	dsq "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/query"
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	blocks "github.com/ipfs/go-ipfs/blocks"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	ftpb "github.com/ipfs/go-ipfs/unixfs/pb"
+	u "github.com/ipfs/go-ipfs/util"
+	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
+)
+
+var log = logging.Logger("filestore")
+
+// FilestorePrefix namespaces the references in the datastore.
+var FilestorePrefix = ds.NewKey("filestore")
+
+var ErrNotFound = errors.New("filestore: reference not found")
+
+// ErrFileChanged is returned when the data in a file no longer matches the
+// block that was added from it.
+var ErrFileChanged = errors.New("filestore: file changed since it was added")
+
+// IsBroken reports whether err was returned for a reference whose file has
+// changed or is gone.
+func IsBroken(err error) bool {
+	return err == ErrFileChanged || os.IsNotExist(err)
+}
+
+// DataObj is a reference to the data of a block in a file on disk.
+type DataObj struct {
+	FilePath string
+	Offset   uint64
+	Size     uint64
+
+	// Node is the encoded leaf with its data left out. The block is the
+	// same node with the data read from the file put back in. It is empty
+	// for raw leaves, whose block is the data itself.
+	Node []byte
+}
+
+// Status of a reference, as reported by Verify.
+type Status int
+
+const (
+	StatusOk      Status = iota
+	StatusChanged        // the file no longer holds the data of the block
+	StatusMissing        // the file can not be found
+	StatusError          // the reference could not be checked
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusOk:
+		return "ok"
+	case StatusChanged:
+		return "changed"
+	case StatusMissing:
+		return "no-file"
+	default:
+		return "error"
+	}
+}
+
+// ListRes is a reference as returned by List and Verify.
+type ListRes struct {
+	Key key.Key
+	*DataObj
+	Status Status
+	Err    error
+}
+
+// Filestore stores block references in a datastore.
+type Filestore struct {
+	ds ds.Datastore
+}
+
+func NewFilestore(d ds.Datastore) *Filestore {
+	return &Filestore{ds: dsns.Wrap(d, FilestorePrefix)}
+}
+
+func dsKey(k key.Key) ds.Key {
+	return ds.NewKey(k.B58String())
+}
+
+// Put stores a reference for a block that carries a DataPos. The file is
+// not read, the block is trusted to hold its data.
+func (f *Filestore) Put(b *blocks.Block) error {
+	if b.Pos == nil {
+		return fmt.Errorf("filestore: block %s has no file position", b.Key())
+	}
+
+	if _, err := os.Stat(b.Pos.Path); err != nil {
+		return err
+	}
+
+	var nd []byte
+	if uint64(len(b.Data)) != b.Pos.Size {
+		// not a raw leaf, the unixfs framing makes it longer
+		var err error
+		nd, err = setData(b.Data, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	d, err := json.Marshal(&DataObj{
+		FilePath: b.Pos.Path,
+		Offset:   b.Pos.Offset,
+		Size:     b.Pos.Size,
+		Node:     nd,
+	})
+	if err != nil {
+		return err
+	}
+	return f.ds.Put(dsKey(b.Key()), d)
+}
+
+// Get rebuilds a block from the file it references. It returns
+// ErrFileChanged if the data read does not hash to the key anymore.
+func (f *Filestore) Get(k key.Key) (*blocks.Block, error) {
+	obj, err := f.Reference(k)
+	if err != nil {
+		return nil, err
+	}
+	return obj.block(k)
+}
+
+// Reference returns the reference stored for the given key.
+func (f *Filestore) Reference(k key.Key) (*DataObj, error) {
+	v, err := f.ds.Get(dsKey(k))
+	if err == ds.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("filestore: invalid value for %s", k)
+	}
+
+	obj := new(DataObj)
+	if err := json.Unmarshal(d, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (f *Filestore) Has(k key.Key) (bool, error) {
+	return f.ds.Has(dsKey(k))
+}
+
+// Delete removes the reference for the given key. The file it points to is
+// never touched.
+func (f *Filestore) Delete(k key.Key) error {
+	err := f.ds.Delete(dsKey(k))
+	if err == ds.ErrNotFound {
+		return ErrNotFound
+	}
+	return err
+}
+
+// List returns every reference in the filestore. Their status is not
+// checked.
+func (f *Filestore) List(ctx context.Context) (<-chan *ListRes, error) {
+	return f.list(ctx, func(k key.Key, obj *DataObj) *ListRes {
+		return &ListRes{Key: k, DataObj: obj}
+	})
+}
+
+// Verify returns every reference in the filestore along with whether the
+// file it points to still holds the data of the block.
+func (f *Filestore) Verify(ctx context.Context) (<-chan *ListRes, error) {
+	return f.list(ctx, verify)
+}
+
+func (f *Filestore) list(ctx context.Context, res func(key.Key, *DataObj) *ListRes) (<-chan *ListRes, error) {
+	// datastore/namespace does *NOT* fix up Query.Prefix
+	q := dsq.Query{Prefix: FilestorePrefix.String()}
+	qr, err := f.ds.Query(q)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *ListRes)
+	go func() {
+		defer close(out)
+		defer qr.Process().Close()
+
+		for e := range qr.Next() {
+			if e.Error != nil {
+				log.Debug("filestore.List got err:", e.Error)
+				return
+			}
+
+			k := key.B58KeyDecode(ds.NewKey(e.Key).BaseNamespace())
+			r := &ListRes{Key: k, Status: StatusError}
+			d, ok := e.Value.([]byte)
+			if !ok {
+				r.Err = fmt.Errorf("filestore: invalid value for %s", e.Key)
+			} else {
+				obj := new(DataObj)
+				if err := json.Unmarshal(d, obj); err != nil {
+					r.Err = err
+				} else {
+					r = res(k, obj)
+				}
+			}
+
+			select {
+			case out <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func verify(k key.Key, obj *DataObj) *ListRes {
+	r := &ListRes{Key: k, DataObj: obj}
+	_, err := obj.block(k)
+	switch {
+	case err == nil:
+		r.Status = StatusOk
+	case err == ErrFileChanged:
+		r.Status = StatusChanged
+	case os.IsNotExist(err):
+		r.Status = StatusMissing
+	default:
+		r.Status = StatusError
+		r.Err = err
+	}
+	return r
+}
+
+// block reads the data back from the file and rebuilds the block.
+func (obj *DataObj) block(k key.Key) (*blocks.Block, error) {
+	fi, err := os.Open(obj.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer fi.Close()
+
+	data := make([]byte, obj.Size)
+	_, err = fi.ReadAt(data, int64(obj.Offset))
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		// the file was truncated
+		return nil, ErrFileChanged
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b := data
+	if len(obj.Node) > 0 {
+		b, err = setData(obj.Node, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dec, err := mh.Decode(mh.Multihash(k))
+	if err != nil {
+		return nil, err
+	}
+	h, err := u.Sum(b, dec.Code, dec.Length)
+	if err != nil {
+		return nil, err
+	}
+	if string(h) != string(k) {
+		return nil, ErrFileChanged
+	}
+	return &blocks.Block{Data: b, Multihash: h}, nil
+}
+
+// setData returns the encoded leaf b with its unixfs data replaced. With nil
+// data it gives the node stored in a reference, with the data read from the
+// file it gives back the block.
+func setData(b, data []byte) ([]byte, error) {
+	nd, err := dag.Decoded(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(nd.Links) > 0 {
+		return nil, errors.New("filestore: only leaves can be stored as references")
+	}
+
+	pbdata := new(ftpb.Data)
+	if err := proto.Unmarshal(nd.Data, pbdata); err != nil {
+		return nil, err
+	}
+	pbdata.Data = data
+
+	nd.Data, err = proto.Marshal(pbdata)
+	if err != nil {
+		return nil, err
+	}
+	return nd.Marshal()
+}
@@ -0,0 +1,154 @@
+package filestore_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	dssync "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/sync"
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	bserv "github.com/ipfs/go-ipfs/blockservice"
+	offline "github.com/ipfs/go-ipfs/exchange/offline"
+	. "github.com/ipfs/go-ipfs/filestore"
+	importer "github.com/ipfs/go-ipfs/importer"
+	chunk "github.com/ipfs/go-ipfs/importer/chunk"
+	h "github.com/ipfs/go-ipfs/importer/helpers"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
+	u "github.com/ipfs/go-ipfs/util"
+)
+
+func addFile(t *testing.T, data []byte) (string, *dag.Node, bstore.Blockstore, *Filestore, dag.DAGService) {
+	dir, err := ioutil.TempDir("", "filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fpath := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(fpath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := dssync.MutexWrap(ds.NewMapDatastore())
+	fs := NewFilestore(d)
+	bs := NewBlockstore(bstore.NewBlockstore(d), fs)
+	dserv := dag.NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+
+	f, err := os.Open(fpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	dbp := h.DagBuilderParams{
+		Dagserv:  dserv,
+		Maxlinks: h.DefaultLinksPerBlock,
+		FilePath: fpath,
+	}
+	nd, err := importer.BuildDag(dbp, chunk.NewSizeSplitter(f, 1000), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fpath, nd, bs, fs, dserv
+}
+
+func TestAddNoCopy(t *testing.T) {
+	data := make([]byte, 10500)
+	u.NewTimeSeededRand().Read(data)
+
+	fpath, nd, _, fs, dserv := addFile(t, data)
+	defer os.RemoveAll(filepath.Dir(fpath))
+
+	// every leaf is a reference, the root is copied
+	for _, l := range nd.Links {
+		obj, err := fs.Reference(key.Key(l.Hash))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if obj.FilePath != fpath {
+			t.Fatalf("reference to %s, expected %s", obj.FilePath, fpath)
+		}
+	}
+	root, err := nd.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has, _ := fs.Has(root); has {
+		t.Fatal("root should not be a reference")
+	}
+
+	dr, err := uio.NewDagReader(context.Background(), nd, dserv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(dr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatal("data read back does not match the file")
+	}
+}
+
+func TestFileChanged(t *testing.T) {
+	data := make([]byte, 3000)
+	u.NewTimeSeededRand().Read(data)
+
+	fpath, nd, bs, fs, _ := addFile(t, data)
+	defer os.RemoveAll(filepath.Dir(fpath))
+
+	// change the second chunk
+	data[1500]++
+	if err := ioutil.WriteFile(fpath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	first, second := key.Key(nd.Links[0].Hash), key.Key(nd.Links[1].Hash)
+	if _, err := bs.Get(first); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bs.Get(second); err != ErrFileChanged {
+		t.Fatalf("expected ErrFileChanged, got %v", err)
+	}
+
+	refs, err := fs.Verify(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	status := make(map[key.Key]Status)
+	for r := range refs {
+		status[r.Key] = r.Status
+	}
+	if len(status) != 3 {
+		t.Fatalf("expected 3 references, got %d", len(status))
+	}
+	if status[first] != StatusOk || status[second] != StatusChanged {
+		t.Fatalf("wrong status: %s and %s", status[first], status[second])
+	}
+
+	// deleting the blocks only drops the references
+	keys, err := bs.AllKeysChan(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := range keys {
+		if err := bs.DeleteBlock(k); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if has, _ := bs.Has(first); has {
+		t.Fatal("block still in the blockstore")
+	}
+	if _, err := os.Stat(fpath); err != nil {
+		t.Fatal("file was removed:", err)
+	}
+
+	os.Remove(fpath)
+	if _, err := bs.Get(first); err != bstore.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -219,10 +219,18 @@ type File struct {
 // Attr returns the attributes of a given node.
 func (d *Directory) Attr(ctx context.Context, a *fuse.Attr) error {
 	log.Debug("Directory Attr")
+	mode, mtime, err := d.dir.Stat()
+	if err != nil {
+		return fmt.Errorf("fuse/ipns: failed to get dir.Stat(): %s", err)
+	}
+	if mode == 0 {
+		mode = 0555
+	}
 	*a = fuse.Attr{
-		Mode: os.ModeDir | 0555,
-		Uid:  uint32(os.Getuid()),
-		Gid:  uint32(os.Getgid()),
+		Mode:  os.ModeDir | mode,
+		Mtime: mtime,
+		Uid:   uint32(os.Getuid()),
+		Gid:   uint32(os.Getgid()),
 	}
 	return nil
 }
@@ -235,11 +243,19 @@ func (fi *File) Attr(ctx context.Context, a *fuse.Attr) error {
 		// In this case, the dag node in question may not be unixfs
 		return fmt.Errorf("fuse/ipns: failed to get file.Size(): %s", err)
 	}
+	mode, mtime, err := fi.fi.Stat()
+	if err != nil {
+		return fmt.Errorf("fuse/ipns: failed to get file.Stat(): %s", err)
+	}
+	if mode == 0 {
+		mode = 0666
+	}
 	*a = fuse.Attr{
-		Mode: os.FileMode(0666),
-		Size: uint64(size),
-		Uid:  uint32(os.Getuid()),
-		Gid:  uint32(os.Getgid()),
+		Mode:  mode,
+		Mtime: mtime,
+		Size:  uint64(size),
+		Uid:   uint32(os.Getuid()),
+		Gid:   uint32(os.Getgid()),
 	}
 	return nil
 }
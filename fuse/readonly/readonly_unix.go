@@ -11,12 +11,12 @@ import (
 
 	fuse "github.com/ipfs/go-ipfs/Godeps/_workspace/src/bazil.org/fuse"
 	fs "github.com/ipfs/go-ipfs/Godeps/_workspace/src/bazil.org/fuse/fs"
-	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 	core "github.com/ipfs/go-ipfs/core"
 	mdag "github.com/ipfs/go-ipfs/merkledag"
 	path "github.com/ipfs/go-ipfs/path"
 	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
+	ft "github.com/ipfs/go-ipfs/unixfs"
 	uio "github.com/ipfs/go-ipfs/unixfs/io"
 	ftpb "github.com/ipfs/go-ipfs/unixfs/pb"
 	lgbl "github.com/ipfs/go-ipfs/util/eventlog/loggables"
@@ -83,8 +83,9 @@ type Node struct {
 }
 
 func (s *Node) loadData() error {
-	s.cached = new(ftpb.Data)
-	return proto.Unmarshal(s.Nd.Data, s.cached)
+	var err error
+	s.cached, err = ft.FromNode(s.Nd)
+	return err
 }
 
 // Attr returns the attributes of a given node.
@@ -96,13 +97,13 @@ func (s *Node) Attr(ctx context.Context, a *fuse.Attr) error {
 		}
 	}
 	switch s.cached.GetType() {
-	case ftpb.Data_Directory:
-		a.Mode = os.ModeDir | 0555
+	case ftpb.Data_Directory, ftpb.Data_HAMTShard:
+		a.Mode = os.ModeDir | readonlyMode(s.cached, 0555)
 		a.Uid = uint32(os.Getuid())
 		a.Gid = uint32(os.Getgid())
 	case ftpb.Data_File:
 		size := s.cached.GetFilesize()
-		a.Mode = 0444
+		a.Mode = readonlyMode(s.cached, 0444)
 		a.Size = uint64(size)
 		a.Blocks = uint64(len(s.Nd.Links))
 		a.Uid = uint32(os.Getuid())
@@ -122,9 +123,19 @@ func (s *Node) Attr(ctx context.Context, a *fuse.Attr) error {
 	default:
 		return fmt.Errorf("Invalid data type - %s", s.cached.GetType())
 	}
+	a.Mtime = ft.ModTime(s.cached)
 	return nil
 }
 
+// readonlyMode returns the permission bits recorded in pbd without the
+// write bits, or def if there are none.
+func readonlyMode(pbd *ftpb.Data, def os.FileMode) os.FileMode {
+	if mode := ft.Mode(pbd); mode != 0 {
+		return mode &^ 0222
+	}
+	return def
+}
+
 // Lookup performs a lookup under this node.
 func (s *Node) Lookup(ctx context.Context, name string) (fs.Node, error) {
 	log.Debugf("Lookup '%s'", name)
@@ -140,8 +151,13 @@ func (s *Node) Lookup(ctx context.Context, name string) (fs.Node, error) {
 // ReadDirAll reads the link structure as directory entries
 func (s *Node) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
 	log.Debug("Node ReadDir")
-	entries := make([]fuse.Dirent, len(s.Nd.Links))
-	for i, link := range s.Nd.Links {
+	links, err := uio.DirEntries(ctx, s.Ipfs.DAG, s.Nd)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]fuse.Dirent, len(links))
+	for i, link := range links {
 		n := link.Name
 		if len(n) == 0 {
 			n = link.Hash.B58String()
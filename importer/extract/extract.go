@@ -0,0 +1,249 @@
+// Package extract reads the entries of tar, gzipped tar and zip archives so
+// that they can be imported as unixfs files, directories and symlinks.
+package extract
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+var ErrEscapesRoot = errors.New("archive entry escapes the root of the archive")
+
+// EntryType is the kind of an archive entry.
+type EntryType int
+
+const (
+	TFile EntryType = iota
+	TDirectory
+	TSymlink
+	// THardlink entries are the same file as the entry at Linkname
+	THardlink
+)
+
+// Entry is one file, directory or link of an archive.
+type Entry struct {
+	Type EntryType
+
+	// Path is the cleaned path of the entry, relative to the root of the
+	// archive
+	Path string
+
+	// Linkname is the target of symlinks, relative and within the
+	// archive, and the cleaned path of the entry hard links point to
+	Linkname string
+
+	Mode    os.FileMode
+	ModTime time.Time
+
+	// Reader reads the contents of files, until the next call to Next
+	Reader io.Reader
+}
+
+// Reader reads the entries of an archive in order. Next returns io.EOF
+// after the last entry.
+type Reader interface {
+	Next() (*Entry, error)
+}
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+// NewReader returns a Reader of the archive in r, guessing its format from
+// its first bytes. Zip archives are read in memory first, as their index is
+// at the end.
+func NewReader(r io.Reader) (Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return NewTarReader(gzr), nil
+	case bytes.HasPrefix(magic, zipMagic):
+		data, err := ioutil.ReadAll(br)
+		if err != nil {
+			return nil, err
+		}
+		return NewZipReader(bytes.NewReader(data), int64(len(data)))
+	default:
+		return NewTarReader(br), nil
+	}
+}
+
+// CleanPath returns the cleaned path of an entry named name. It returns
+// ErrEscapesRoot for absolute paths and paths that leave the archive
+// through "..", and an empty path for the root itself.
+func CleanPath(name string) (string, error) {
+	if path.IsAbs(name) {
+		return "", ErrEscapesRoot
+	}
+
+	p := path.Clean(name)
+	if p == ".." || strings.HasPrefix(p, "../") {
+		return "", ErrEscapesRoot
+	}
+	if p == "." {
+		return "", nil
+	}
+	return p, nil
+}
+
+// CheckSymlink returns ErrEscapesRoot if the symlink at the cleaned path p
+// points to target out of the archive: to an absolute path, or above its
+// root through "..".
+func CheckSymlink(p, target string) error {
+	if path.IsAbs(target) {
+		return ErrEscapesRoot
+	}
+	_, err := CleanPath(path.Join(path.Dir(p), target))
+	return err
+}
+
+type tarReader struct {
+	tr *tar.Reader
+}
+
+// NewTarReader returns a Reader of the tar archive in r. Entries other than
+// files, directories and links are skipped.
+func NewTarReader(r io.Reader) Reader {
+	return &tarReader{tr: tar.NewReader(r)}
+}
+
+func (r *tarReader) Next() (*Entry, error) {
+	for {
+		h, err := r.tr.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := CleanPath(h.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", h.Name, err)
+		}
+
+		e := &Entry{
+			Path:    p,
+			Mode:    os.FileMode(h.Mode).Perm(),
+			ModTime: h.ModTime,
+		}
+
+		switch h.Typeflag {
+		case tar.TypeReg, tar.TypeRegA:
+			e.Type = TFile
+			e.Reader = r.tr
+		case tar.TypeDir:
+			e.Type = TDirectory
+		case tar.TypeSymlink:
+			e.Type = TSymlink
+			e.Linkname = h.Linkname
+			if err := CheckSymlink(p, h.Linkname); err != nil {
+				return nil, fmt.Errorf("%s: %s", h.Name, err)
+			}
+		case tar.TypeLink:
+			e.Type = THardlink
+			e.Linkname, err = CleanPath(h.Linkname)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", h.Linkname, err)
+			}
+		default:
+			// devices, fifos and the like have no unixfs equivalent
+			continue
+		}
+
+		if p == "" && e.Type != TDirectory {
+			return nil, fmt.Errorf("%s: not a directory", h.Name)
+		}
+		return e, nil
+	}
+}
+
+type zipReader struct {
+	files []*zip.File
+	cur   io.ReadCloser
+}
+
+// NewZipReader returns a Reader of the zip archive in r.
+func NewZipReader(r io.ReaderAt, size int64) (Reader, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	return &zipReader{files: zr.File}, nil
+}
+
+func (r *zipReader) Next() (*Entry, error) {
+	if r.cur != nil {
+		r.cur.Close()
+		r.cur = nil
+	}
+	if len(r.files) == 0 {
+		return nil, io.EOF
+	}
+
+	f := r.files[0]
+	r.files = r.files[1:]
+
+	p, err := CleanPath(f.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", f.Name, err)
+	}
+
+	fi := f.FileInfo()
+	e := &Entry{
+		Path:    p,
+		Mode:    fi.Mode().Perm(),
+		ModTime: fi.ModTime(),
+	}
+
+	switch {
+	case fi.IsDir():
+		e.Type = TDirectory
+		return e, nil
+	case p == "":
+		return nil, fmt.Errorf("%s: not a directory", f.Name)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	if fi.Mode()&os.ModeSymlink != 0 {
+		// the target of a symlink is its content
+		target, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		e.Type = TSymlink
+		e.Linkname = string(target)
+		if err := CheckSymlink(p, e.Linkname); err != nil {
+			return nil, fmt.Errorf("%s: %s", f.Name, err)
+		}
+		return e, nil
+	}
+
+	r.cur = rc
+	e.Type = TFile
+	e.Reader = rc
+	return e, nil
+}
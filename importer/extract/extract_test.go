@@ -0,0 +1,205 @@
+package extract
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCleanPath(t *testing.T) {
+	cases := []struct {
+		name, path string
+		escapes    bool
+	}{
+		{"a/b.txt", "a/b.txt", false},
+		{"./a//b/", "a/b", false},
+		{"a/../b", "b", false},
+		{"./", "", false},
+		{"../a", "", true},
+		{"a/../../b", "", true},
+		{"/etc/passwd", "", true},
+	}
+
+	for _, c := range cases {
+		p, err := CleanPath(c.name)
+		if c.escapes {
+			if err != ErrEscapesRoot {
+				t.Errorf("%s: expected ErrEscapesRoot, got %v", c.name, err)
+			}
+			continue
+		}
+		if err != nil || p != c.path {
+			t.Errorf("%s: expected %q, got %q (%v)", c.name, c.path, p, err)
+		}
+	}
+}
+
+func makeTar(t *testing.T, names ...string) []byte {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for _, name := range names {
+		h := &tar.Header{Name: name, Mode: 0644, Typeflag: tar.TypeReg, Size: int64(len(name))}
+		if name[len(name)-1] == '/' {
+			h.Typeflag = tar.TypeDir
+			h.Mode = 0755
+			h.Size = 0
+		}
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if h.Typeflag == tar.TypeReg {
+			if _, err := tw.Write([]byte(name)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func readAll(t *testing.T, r Reader) []*Entry {
+	var entries []*Entry
+	for {
+		e, err := r.Next()
+		if err == io.EOF {
+			return entries
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Type == TFile {
+			data, err := ioutil.ReadAll(e.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != e.Path {
+				t.Fatalf("wrong contents for %s: %q", e.Path, data)
+			}
+		}
+		entries = append(entries, e)
+	}
+}
+
+func checkEntries(t *testing.T, entries []*Entry) {
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Type != TDirectory || entries[0].Path != "dir" || entries[0].Mode != 0755 {
+		t.Fatal("wrong first entry", entries[0])
+	}
+	if entries[1].Type != TFile || entries[1].Path != "dir/file" || entries[1].Mode != 0644 {
+		t.Fatal("wrong second entry", entries[1])
+	}
+}
+
+func TestTarReader(t *testing.T) {
+	r, err := NewReader(bytes.NewReader(makeTar(t, "dir/", "dir/file")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEntries(t, readAll(t, r))
+
+	gzbuf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(gzbuf)
+	gzw.Write(makeTar(t, "dir/", "dir/file"))
+	gzw.Close()
+
+	r, err = NewReader(gzbuf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEntries(t, readAll(t, r))
+}
+
+func TestTarEscape(t *testing.T) {
+	r, err := NewReader(bytes.NewReader(makeTar(t, "dir/", "dir/../../evil")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Next(); err == nil {
+		t.Fatal("expected an error for an entry outside of the root")
+	}
+}
+
+func TestSymlinkEscape(t *testing.T) {
+	cases := []struct {
+		path, target string
+		escapes      bool
+	}{
+		{"link", "dir/file", false},
+		{"dir/link", "../file", false},
+		{"dir/link", "./sub/../file", false},
+		{"link", "/etc/passwd", true},
+		{"link", "../file", true},
+		{"dir/link", "../../file", true},
+		{"dir/link", "sub/../../../file", true},
+	}
+
+	for _, c := range cases {
+		buf := new(bytes.Buffer)
+		tw := tar.NewWriter(buf)
+		h := &tar.Header{Name: c.path, Linkname: c.target, Mode: 0777, Typeflag: tar.TypeSymlink}
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if err := tw.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := NewReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := r.Next()
+		if c.escapes {
+			if err == nil {
+				t.Errorf("%s -> %s: expected an error for a link out of the root", c.path, c.target)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s -> %s: %s", c.path, c.target, err)
+			continue
+		}
+		if e.Type != TSymlink || e.Linkname != c.target {
+			t.Errorf("%s -> %s: wrong entry %v", c.path, c.target, e)
+		}
+	}
+}
+
+func TestZipReader(t *testing.T) {
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+
+	dh := &zip.FileHeader{Name: "dir/"}
+	dh.SetMode(os.ModeDir | 0755)
+	if _, err := zw.CreateHeader(dh); err != nil {
+		t.Fatal(err)
+	}
+	fh := &zip.FileHeader{Name: "dir/file"}
+	fh.SetMode(0644)
+	w, err := zw.CreateHeader(fh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte("dir/file"))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEntries(t, readAll(t, r))
+}
@@ -1,6 +1,10 @@
 package helpers
 
 import (
+	"os"
+	"time"
+
+	blocks "github.com/ipfs/go-ipfs/blocks"
 	dag "github.com/ipfs/go-ipfs/merkledag"
 	"github.com/ipfs/go-ipfs/pin"
 )
@@ -24,6 +28,13 @@ type DagBuilderHelper struct {
 	nextData []byte // the next item to return.
 	maxlinks int
 	ncb      NodeCB
+	path     string // file the data is read from, see DagBuilderParams
+	hashFn   int    // multihash function of the nodes, zero for the default
+	offset   uint64 // offset in the file of the next chunk
+	mode     os.FileMode
+	mtime    time.Time
+	raw      bool // store leaves as raw nodes
+	inline   int  // inline the root if it encodes to at most this many bytes
 
 	batch *dag.Batch
 }
@@ -37,6 +48,30 @@ type DagBuilderParams struct {
 
 	// Callback for each block added
 	NodeCB NodeCB
+
+	// FilePath is the absolute path of the file the data is read from. If
+	// set, leaves record where their data is in the file so they can be
+	// stored without copying.
+	FilePath string
+
+	// HashFunc is the multihash function the nodes are hashed with. Zero
+	// uses the default of merkledag.
+	HashFunc int
+
+	// Mode and ModTime are recorded in the root of the file if set.
+	Mode    os.FileMode
+	ModTime time.Time
+
+	// RawLeaves stores the leaves as raw nodes holding the bytes of the
+	// file, rather than as unixfs nodes. The root is always a unixfs node,
+	// as only a link tells a raw node apart, so a file of a single leaf is
+	// not raw.
+	RawLeaves bool
+
+	// InlineLimit inlines the root of files that encode to at most this
+	// many bytes in the links of their parents, see merkledag.Node.Inline.
+	// Zero never inlines.
+	InlineLimit int
 }
 
 // Generate a new DagBuilderHelper from the given params, using 'in' as a
@@ -53,6 +88,12 @@ func (dbp *DagBuilderParams) New(in <-chan []byte, errs <-chan error) *DagBuilde
 		errs:     errs,
 		maxlinks: dbp.Maxlinks,
 		ncb:      ncb,
+		path:     dbp.FilePath,
+		hashFn:   dbp.HashFunc,
+		mode:     dbp.Mode,
+		mtime:    dbp.ModTime,
+		raw:      dbp.RawLeaves,
+		inline:   dbp.InlineLimit,
 		batch:    dbp.Dagserv.Batch(),
 	}
 }
@@ -136,14 +177,34 @@ func (db *DagBuilderHelper) FillNodeWithData(node *UnixfsNode) error {
 	}
 
 	node.SetData(data)
+	node.raw = db.raw
+	if db.path != "" {
+		node.pos = &blocks.DataPos{
+			Path:   db.path,
+			Offset: db.offset,
+			Size:   uint64(len(data)),
+		}
+	}
+	db.offset += uint64(len(data))
 	return nil
 }
 
 func (db *DagBuilderHelper) Add(node *UnixfsNode) (*dag.Node, error) {
-	dn, err := node.GetDagNode()
+	// the root is fetched by its key alone, it can not be raw
+	node.raw = false
+	if db.mode != 0 || !db.mtime.IsZero() {
+		node.SetStat(db.mode, db.mtime)
+	}
+
+	dn, err := db.dagNode(node)
 	if err != nil {
 		return nil, err
 	}
+	if db.inline > 0 {
+		if _, err := dn.Inline(db.inline); err != nil {
+			return nil, err
+		}
+	}
 
 	_, err = db.dserv.Add(dn)
 	if err != nil {
@@ -159,6 +220,19 @@ func (db *DagBuilderHelper) Add(node *UnixfsNode) (*dag.Node, error) {
 	return dn, nil
 }
 
+// dagNode returns the dag node of n, hashed with the hash function of the
+// builder.
+func (db *DagBuilderHelper) dagNode(n *UnixfsNode) (*dag.Node, error) {
+	dn, err := n.GetDagNode()
+	if err != nil {
+		return nil, err
+	}
+	if db.hashFn != 0 {
+		dn.SetHashFunc(db.hashFn)
+	}
+	return dn, nil
+}
+
 func (db *DagBuilderHelper) Maxlinks() int {
 	return db.maxlinks
 }
@@ -2,8 +2,11 @@ package helpers
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	blocks "github.com/ipfs/go-ipfs/blocks"
 	key "github.com/ipfs/go-ipfs/blocks/key"
 	chunk "github.com/ipfs/go-ipfs/importer/chunk"
 	dag "github.com/ipfs/go-ipfs/merkledag"
@@ -41,6 +44,11 @@ var ErrSizeLimitExceeded = fmt.Errorf("object size limit exceeded")
 type UnixfsNode struct {
 	node *dag.Node
 	ufmt *ft.FSNode
+	pos  *blocks.DataPos
+
+	// raw is set on leaves stored as raw nodes while they have no
+	// children
+	raw bool
 }
 
 // NewUnixfsNode creates a new Unixfs node to represent a file
@@ -61,6 +69,15 @@ func NewUnixfsBlock() *UnixfsNode {
 
 // NewUnixfsNodeFromDag reconstructs a Unixfs node from a given dag node
 func NewUnixfsNodeFromDag(nd *dag.Node) (*UnixfsNode, error) {
+	if nd.IsRaw() {
+		// a raw leaf given children becomes a file node
+		return &UnixfsNode{
+			node: new(dag.Node),
+			ufmt: &ft.FSNode{Type: ft.TFile, Data: nd.Data},
+			raw:  true,
+		}, nil
+	}
+
 	mb, err := ft.FSNodeFromBytes(nd.Data)
 	if err != nil {
 		return nil, err
@@ -91,7 +108,7 @@ func (n *UnixfsNode) GetChild(ctx context.Context, i int, ds dag.DAGService) (*U
 func (n *UnixfsNode) AddChild(child *UnixfsNode, db *DagBuilderHelper) error {
 	n.ufmt.AddBlockSize(child.ufmt.FileSize())
 
-	childnode, err := child.GetDagNode()
+	childnode, err := db.dagNode(child)
 	if err != nil {
 		return err
 	}
@@ -131,13 +148,29 @@ func (n *UnixfsNode) SetData(data []byte) {
 	n.ufmt.Data = data
 }
 
+// SetStat sets the permission bits and modification time recorded in the
+// node. Zero values are not recorded.
+func (n *UnixfsNode) SetStat(mode os.FileMode, mtime time.Time) {
+	n.ufmt.Mode = mode
+	n.ufmt.ModTime = mtime
+}
+
 // getDagNode fills out the proper formatting for the unixfs node
 // inside of a DAG node and returns the dag node
 func (n *UnixfsNode) GetDagNode() (*dag.Node, error) {
+	if n.raw && n.NumChildren() == 0 {
+		nd := dag.NewRawNode(n.ufmt.Data)
+		nd.DataPos = n.pos
+		return nd, nil
+	}
+
 	data, err := n.ufmt.GetBytes()
 	if err != nil {
 		return nil, err
 	}
 	n.node.Data = data
+	if n.NumChildren() == 0 {
+		n.node.DataPos = n.pos
+	}
 	return n.node, nil
 }
@@ -30,7 +30,7 @@ func BuildDagFromFile(fpath string, ds dag.DAGService, mp pin.ManualPinner) (*da
 		return nil, fmt.Errorf("`%s` is a directory", fpath)
 	}
 
-	f, err := files.NewSerialFile(fpath, fpath, stat)
+	f, err := files.NewSerialFile(fpath, fpath, stat, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -40,29 +40,36 @@ func BuildDagFromFile(fpath string, ds dag.DAGService, mp pin.ManualPinner) (*da
 }
 
 func BuildDagFromReader(ds dag.DAGService, spl chunk.Splitter, ncb h.NodeCB) (*dag.Node, error) {
-	// Start the splitter
-	blkch, errch := chunk.Chan(spl)
-
 	dbp := h.DagBuilderParams{
 		Dagserv:  ds,
 		Maxlinks: h.DefaultLinksPerBlock,
 		NodeCB:   ncb,
 	}
 
-	return bal.BalancedLayout(dbp.New(blkch, errch))
+	return BuildDag(dbp, spl, false)
 }
 
 func BuildTrickleDagFromReader(ds dag.DAGService, spl chunk.Splitter, ncb h.NodeCB) (*dag.Node, error) {
-	// Start the splitter
-	blkch, errch := chunk.Chan(spl)
-
 	dbp := h.DagBuilderParams{
 		Dagserv:  ds,
 		Maxlinks: h.DefaultLinksPerBlock,
 		NodeCB:   ncb,
 	}
 
-	return trickle.TrickleLayout(dbp.New(blkch, errch))
+	return BuildDag(dbp, spl, true)
+}
+
+// BuildDag builds a DAG from the chunks of spl with the given parameters,
+// in the trickle layout if useTrickle is set and in the balanced layout
+// otherwise.
+func BuildDag(dbp h.DagBuilderParams, spl chunk.Splitter, useTrickle bool) (*dag.Node, error) {
+	// Start the splitter
+	blkch, errch := chunk.Chan(spl)
+
+	if useTrickle {
+		return trickle.TrickleLayout(dbp.New(blkch, errch))
+	}
+	return bal.BalancedLayout(dbp.New(blkch, errch))
 }
 
 func BasicPinnerCB(p pin.ManualPinner) h.NodeCB {
@@ -93,3 +100,16 @@ func PinIndirectCB(p pin.ManualPinner) h.NodeCB {
 		return nil
 	}
 }
+
+// TempPinCB pins every node built through tp, until tp is released.
+func TempPinCB(tp *pin.TempPinner) h.NodeCB {
+	return func(n *dag.Node, last bool) error {
+		k, err := n.Key()
+		if err != nil {
+			return err
+		}
+
+		tp.Pin(k)
+		return nil
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"io/ioutil"
 	"testing"
 
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	key "github.com/ipfs/go-ipfs/blocks/key"
 	chunk "github.com/ipfs/go-ipfs/importer/chunk"
+	h "github.com/ipfs/go-ipfs/importer/helpers"
 	dag "github.com/ipfs/go-ipfs/merkledag"
 	mdtest "github.com/ipfs/go-ipfs/merkledag/test"
 	uio "github.com/ipfs/go-ipfs/unixfs/io"
@@ -60,6 +63,47 @@ func TestBalancedDag(t *testing.T) {
 	}
 }
 
+func TestBuildDagHashFunc(t *testing.T) {
+	ds := mdtest.Mock()
+	r := io.LimitReader(u.NewTimeSeededRand(), 50000)
+	dbp := h.DagBuilderParams{
+		Dagserv:  ds,
+		Maxlinks: 4,
+		HashFunc: mh.SHA3,
+	}
+
+	nd, err := BuildDag(dbp, chunk.NewSizeSplitter(r, 1000), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// check the root and every node below it
+	var check func(k key.Key)
+	check = func(k key.Key) {
+		dec, err := mh.Decode(mh.Multihash(k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dec.Code != mh.SHA3 {
+			t.Fatalf("%s hashed with %s, expected sha3", k, dec.Name)
+		}
+
+		nd, err := ds.Get(context.Background(), k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range nd.Links {
+			check(key.Key(l.Hash))
+		}
+	}
+
+	k, err := nd.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(k)
+}
+
 func BenchmarkBalancedReadSmallBlock(b *testing.B) {
 	b.StopTimer()
 	nbytes := int64(10000000)
@@ -115,3 +159,127 @@ func runReadBench(b *testing.B, nd *dag.Node, ds dag.DAGService) {
 		cancel()
 	}
 }
+
+func TestRawLeaves(t *testing.T) {
+	for _, useTrickle := range []bool{false, true} {
+		ds := mdtest.Mock()
+		buf := make([]byte, 10000)
+		u.NewTimeSeededRand().Read(buf)
+
+		dbp := h.DagBuilderParams{
+			Dagserv:   ds,
+			Maxlinks:  h.DefaultLinksPerBlock,
+			RawLeaves: true,
+		}
+		nd, err := BuildDag(dbp, chunk.NewSizeSplitter(bytes.NewReader(buf), 512), useTrickle)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, lnk := range nd.Links {
+			if !lnk.Raw {
+				t.Fatal("expected the leaves to be raw")
+			}
+		}
+
+		dr, err := uio.NewDagReader(context.Background(), nd, ds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := ioutil.ReadAll(dr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out, buf) {
+			t.Fatal("bad read")
+		}
+	}
+}
+
+func TestRawLeavesSingleBlock(t *testing.T) {
+	ds := mdtest.Mock()
+	data := []byte("a file of a single block")
+
+	dbp := h.DagBuilderParams{
+		Dagserv:   ds,
+		Maxlinks:  h.DefaultLinksPerBlock,
+		RawLeaves: true,
+	}
+	nd, err := BuildDag(dbp, chunk.DefaultSplitter(bytes.NewReader(data)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nd.IsRaw() {
+		t.Fatal("expected the root of a file of a single block to be a unixfs node")
+	}
+
+	// the root is read back from its key alone
+	k, err := nd.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nd, err = ds.Get(context.Background(), k)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dr, err := uio.NewDagReader(context.Background(), nd, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(dr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, data) {
+		t.Fatal("bad read")
+	}
+}
+
+func TestInlineLimit(t *testing.T) {
+	ds := mdtest.Mock()
+	data := []byte("tiny")
+
+	dbp := h.DagBuilderParams{
+		Dagserv:     ds,
+		Maxlinks:    h.DefaultLinksPerBlock,
+		InlineLimit: 32,
+	}
+	nd, err := BuildDag(dbp, chunk.DefaultSplitter(bytes.NewReader(data)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !nd.IsInline() {
+		t.Fatal("expected a tiny file to be inlined")
+	}
+
+	k, err := nd.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing was stored, the key is enough to read the file back
+	out, err := mdtest.Mock().Get(context.Background(), k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dr, err := uio.NewDagReader(context.Background(), out, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := ioutil.ReadAll(dr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read, data) {
+		t.Fatal("bad read")
+	}
+
+	large := bytes.Repeat([]byte("a"), 64)
+	nd, err = BuildDag(dbp, chunk.DefaultSplitter(bytes.NewReader(large)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nd.IsInline() {
+		t.Fatal("a file larger than the limit should not be inlined")
+	}
+}
@@ -4,17 +4,22 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	dag "github.com/ipfs/go-ipfs/merkledag"
+	dagutils "github.com/ipfs/go-ipfs/merkledag/utils"
 	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
 	ufspb "github.com/ipfs/go-ipfs/unixfs/pb"
 )
 
 var ErrNotYetImplemented = errors.New("not yet implemented")
 var ErrInvalidChild = errors.New("invalid child node")
+var ErrDetached = errors.New("entry was moved, replaced or removed")
 
 type Directory struct {
 	fs     *Filesystem
@@ -23,6 +28,9 @@ type Directory struct {
 	childDirs map[string]*Directory
 	files     map[string]*File
 
+	// refs holds the parents given to the children loaded so far
+	refs map[string]*childRef
+
 	lock sync.Mutex
 	node *dag.Node
 	ctx  context.Context
@@ -39,12 +47,23 @@ func NewDirectory(ctx context.Context, name string, node *dag.Node, parent child
 		parent:    parent,
 		childDirs: make(map[string]*Directory),
 		files:     make(map[string]*File),
+		refs:      make(map[string]*childRef),
 	}
 }
 
+// childRef is the parent of a child loaded by a directory. When the entry
+// of the child is removed or replaced from above, by a commit or a move,
+// the child is detached: its changes then fail with ErrDetached instead of
+// overwriting the entry with the old tree.
+type childRef struct {
+	dir      *Directory
+	detached bool // guarded by the lock of dir
+}
+
 // closeChild updates the child by the given name to the dag node 'nd'
 // and changes its own dag node, then propogates the changes upward
-func (d *Directory) closeChild(name string, nd *dag.Node) error {
+func (r *childRef) closeChild(name string, nd *dag.Node) error {
+	d := r.dir
 	_, err := d.fs.dserv.Add(nd)
 	if err != nil {
 		return err
@@ -52,12 +71,10 @@ func (d *Directory) closeChild(name string, nd *dag.Node) error {
 
 	d.lock.Lock()
 	defer d.lock.Unlock()
-	err = d.node.RemoveNodeLink(name)
-	if err != nil && err != dag.ErrNotFound {
-		return err
+	if r.detached {
+		return ErrDetached
 	}
-
-	err = d.node.AddNodeLinkClean(name, nd)
+	err = d.setEntry(name, nd)
 	if err != nil {
 		return err
 	}
@@ -65,6 +82,30 @@ func (d *Directory) closeChild(name string, nd *dag.Node) error {
 	return d.parent.closeChild(d.name, d.node)
 }
 
+// newRef returns the parent of a child loaded under name.
+func (d *Directory) newRef(name string) *childRef {
+	r := &childRef{dir: d}
+	d.refs[name] = r
+	return r
+}
+
+// dropChild forgets and detaches the child loaded under name, if any.
+func (d *Directory) dropChild(name string) {
+	if r, ok := d.refs[name]; ok {
+		r.detached = true
+		delete(d.refs, name)
+	}
+	delete(d.childDirs, name)
+	delete(d.files, name)
+}
+
+// dropChildren forgets and detaches all the children loaded so far.
+func (d *Directory) dropChildren() {
+	for name := range d.refs {
+		d.dropChild(name)
+	}
+}
+
 func (d *Directory) Type() NodeType {
 	return TDir
 }
@@ -80,16 +121,16 @@ func (d *Directory) childFile(name string) (*File, error) {
 	if err != nil {
 		return nil, err
 	}
-	i, err := ft.FromBytes(nd.Data)
+	i, err := ft.FromNode(nd)
 	if err != nil {
 		return nil, err
 	}
 
 	switch i.GetType() {
-	case ufspb.Data_Directory:
+	case ufspb.Data_Directory, ufspb.Data_HAMTShard:
 		return nil, ErrIsDirectory
-	case ufspb.Data_File:
-		nfi, err := NewFile(name, nd, d, d.fs)
+	case ufspb.Data_File, ufspb.Data_Raw:
+		nfi, err := NewFile(name, nd, d.newRef(name), d.fs)
 		if err != nil {
 			return nil, err
 		}
@@ -115,17 +156,17 @@ func (d *Directory) childDir(name string) (*Directory, error) {
 		return nil, err
 	}
 
-	i, err := ft.FromBytes(nd.Data)
+	i, err := ft.FromNode(nd)
 	if err != nil {
 		return nil, err
 	}
 
 	switch i.GetType() {
-	case ufspb.Data_Directory:
-		ndir := NewDirectory(d.ctx, name, nd, d, d.fs)
+	case ufspb.Data_Directory, ufspb.Data_HAMTShard:
+		ndir := NewDirectory(d.ctx, name, nd, d.newRef(name), d.fs)
 		d.childDirs[name] = ndir
 		return ndir, nil
-	case ufspb.Data_File:
+	case ufspb.Data_File, ufspb.Data_Raw:
 		return nil, fmt.Errorf("%s is not a directory", name)
 	case ufspb.Data_Metadata:
 		return nil, ErrNotYetImplemented
@@ -137,13 +178,26 @@ func (d *Directory) childDir(name string) (*Directory, error) {
 // childFromDag searches through this directories dag node for a child link
 // with the given name
 func (d *Directory) childFromDag(name string) (*dag.Node, error) {
-	for _, lnk := range d.node.Links {
-		if lnk.Name == name {
-			return lnk.GetNode(d.ctx, d.fs.dserv)
-		}
+	lnk, err := uio.FindDirEntry(d.ctx, d.fs.dserv, d.node, name)
+	switch err {
+	case nil:
+		return lnk.GetNode(d.ctx, d.fs.dserv)
+	case dag.ErrNotFound:
+		return nil, os.ErrNotExist
+	default:
+		return nil, err
 	}
+}
 
-	return nil, os.ErrNotExist
+// setEntry links nd under the given name in the dag node of this directory,
+// which is sharded when it grows large.
+func (d *Directory) setEntry(name string, nd *dag.Node) error {
+	nnode, err := uio.AddDirEntry(d.ctx, d.fs.dserv, d.node, name, nd)
+	if err != nil {
+		return err
+	}
+	d.node = nnode
+	return nil
 }
 
 // Child returns the child of this directory by the given name
@@ -172,8 +226,12 @@ func (d *Directory) List() []string {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
+	// errors loading the shards of a sharded directory are not
+	// reported, the entries that could be listed are returned.
+	links, _ := uio.DirEntries(d.ctx, d.fs.dserv, d.node)
+
 	var out []string
-	for _, lnk := range d.node.Links {
+	for _, lnk := range links {
 		out = append(out, lnk.Name)
 	}
 	return out
@@ -193,7 +251,10 @@ func (d *Directory) Mkdir(name string) (*Directory, error) {
 	}
 
 	ndir := &dag.Node{Data: ft.FolderPBData()}
-	err = d.node.AddNodeLinkClean(name, ndir)
+	if _, err := d.fs.dserv.Add(ndir); err != nil {
+		return nil, err
+	}
+	err = d.setEntry(name, ndir)
 	if err != nil {
 		return nil, err
 	}
@@ -210,14 +271,59 @@ func (d *Directory) Unlink(name string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
 
-	delete(d.childDirs, name)
-	delete(d.files, name)
+	d.dropChild(name)
 
-	err := d.node.RemoveNodeLink(name)
+	nnode, err := uio.RemoveDirEntry(d.ctx, d.fs.dserv, d.node, name)
 	if err != nil {
 		return err
 	}
+	d.node = nnode
+
+	return d.parent.closeChild(d.name, d.node)
+}
+
+// move moves the entry at the path src below this directory to the path
+// dst, in a single change of its node. The children loaded for the first
+// components of both paths are detached: they hold the old tree.
+func (d *Directory) move(src, dst []string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	// links may cache the nodes they point to, which are not to be changed
+	// in place by the editor
+	root := d.node.Copy()
+	for i, l := range root.Links {
+		cl := *l
+		cl.Node = nil
+		root.Links[i] = &cl
+	}
+
+	nd := root
+	for _, name := range src {
+		lnk, err := uio.FindDirEntry(d.ctx, d.fs.dserv, nd, name)
+		if err != nil {
+			return err
+		}
+		nd, err = lnk.GetNode(d.ctx, d.fs.dserv)
+		if err != nil {
+			return err
+		}
+	}
+
+	e := dagutils.NewMemoryEditor(d.fs.dserv, root)
+	if err := e.RmLink(d.ctx, strings.Join(src, "/")); err != nil {
+		return err
+	}
+	if err := e.InsertNodeAtPath(d.ctx, strings.Join(dst, "/"), nd, nil); err != nil {
+		return err
+	}
+	if err := e.WriteOutputTo(d.fs.dserv); err != nil {
+		return err
+	}
 
+	d.node = e.GetNode()
+	d.dropChild(src[0])
+	d.dropChild(dst[0])
 	return d.parent.closeChild(d.name, d.node)
 }
 
@@ -225,7 +331,7 @@ func (d *Directory) Unlink(name string) error {
 func (d *Directory) AddChild(name string, nd *dag.Node) error {
 	d.Lock()
 	defer d.Unlock()
-	pbn, err := ft.FromBytes(nd.Data)
+	pbn, err := ft.FromNode(nd)
 	if err != nil {
 		return err
 	}
@@ -235,16 +341,19 @@ func (d *Directory) AddChild(name string, nd *dag.Node) error {
 		return errors.New("directory already has entry by that name")
 	}
 
-	err = d.node.AddNodeLinkClean(name, nd)
+	if _, err := d.fs.dserv.Add(nd); err != nil {
+		return err
+	}
+	err = d.setEntry(name, nd)
 	if err != nil {
 		return err
 	}
 
 	switch pbn.GetType() {
-	case ft.TDirectory:
-		d.childDirs[name] = NewDirectory(d.ctx, name, nd, d, d.fs)
+	case ft.TDirectory, ft.THAMTShard:
+		d.childDirs[name] = NewDirectory(d.ctx, name, nd, d.newRef(name), d.fs)
 	case ft.TFile, ft.TMetadata, ft.TRaw:
-		nfi, err := NewFile(name, nd, d, d.fs)
+		nfi, err := NewFile(name, nd, d.newRef(name), d.fs)
 		if err != nil {
 			return err
 		}
@@ -255,6 +364,19 @@ func (d *Directory) AddChild(name string, nd *dag.Node) error {
 	return d.parent.closeChild(d.name, d.node)
 }
 
+// Stat returns the permission bits and modification time recorded for this
+// directory, zero values if there are none.
+func (d *Directory) Stat() (os.FileMode, time.Time, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	pbn, err := ft.FromBytes(d.node.Data)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return ft.Mode(pbn), ft.ModTime(pbn), nil
+}
+
 func (d *Directory) GetNode() (*dag.Node, error) {
 	return d.node, nil
 }
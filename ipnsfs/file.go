@@ -1,7 +1,9 @@
 package ipnsfs
 
 import (
+	"os"
 	"sync"
+	"time"
 
 	chunk "github.com/ipfs/go-ipfs/importer/chunk"
 	dag "github.com/ipfs/go-ipfs/merkledag"
@@ -116,6 +118,14 @@ func (fi *File) Size() (int64, error) {
 	return fi.mod.Size()
 }
 
+// Stat returns the permission bits and modification time recorded for this
+// file, zero values if there are none.
+func (fi *File) Stat() (os.FileMode, time.Time, error) {
+	fi.Lock()
+	defer fi.Unlock()
+	return fi.mod.Stat()
+}
+
 // GetNode returns the dag node associated with this file
 func (fi *File) GetNode() (*dag.Node, error) {
 	fi.Lock()
@@ -0,0 +1,221 @@
+package ipnsfs
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	namesys "github.com/ipfs/go-ipfs/namesys"
+	ci "github.com/ipfs/go-ipfs/p2p/crypto"
+	path "github.com/ipfs/go-ipfs/path"
+	pin "github.com/ipfs/go-ipfs/pin"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+)
+
+// closeFlushTimeout bounds the flush of a local root being closed.
+const closeFlushTimeout = time.Minute
+
+// loadTimeout bounds the fetch of the recorded root, in case it is not
+// local anymore.
+const loadTimeout = time.Minute
+
+// localRootKey is where the key of the flushed local root is recorded.
+var localRootKey = ds.NewKey("/local/filesroot")
+
+// LocalRoot is the root directory of a mutable filesystem that is not
+// published: it only lives on this node. It is loaded on first use.
+// Changes are kept in memory until Flush records the root in the datastore
+// and pins it, so that it survives restarts without a round-trip through
+// namesys. Publish is the explicit step making the root reachable through
+// ipns.
+type LocalRoot struct {
+	fs     *Filesystem
+	dstore ds.Datastore
+
+	lock   sync.Mutex
+	dir    *Directory // nil until loaded
+	pinned key.Key    // root pinned by the last flush, if any
+
+	// dirty is set when the root directory changed since the last flush,
+	// guarded by the lock of dir
+	dirty bool
+}
+
+// NewLocalRoot returns the local root recorded in dstore. Nothing is read
+// until it is used.
+func NewLocalRoot(ctx context.Context, dserv dag.DAGService, pins pin.Pinner, dstore ds.Datastore) *LocalRoot {
+	fs := &Filesystem{
+		ctx:      ctx,
+		roots:    make(map[string]*KeyRoot),
+		dserv:    dserv,
+		pins:     pins,
+		resolver: &path.Resolver{DAG: dserv},
+	}
+	return &LocalRoot{
+		fs:     fs,
+		dstore: dstore,
+	}
+}
+
+// load loads the recorded root, starting with an empty directory if there
+// is none. lr.lock must be held.
+func (lr *LocalRoot) load(ctx context.Context) error {
+	if lr.dir != nil {
+		return nil
+	}
+
+	var nd *dag.Node
+	val, err := lr.dstore.Get(localRootKey)
+	switch err {
+	case nil:
+		b, ok := val.([]byte)
+		if !ok {
+			return errors.New("local root key is not bytes")
+		}
+		k := key.Key(b)
+
+		ctx, cancel := context.WithTimeout(ctx, loadTimeout)
+		defer cancel()
+		nd, err = lr.fs.dserv.Get(ctx, k)
+		if err != nil {
+			return err
+		}
+		lr.pinned = k
+	case ds.ErrNotFound:
+		nd = &dag.Node{Data: ft.FolderPBData()}
+	default:
+		return err
+	}
+
+	lr.dir = NewDirectory(lr.fs.ctx, "", nd, lr, lr.fs)
+	return nil
+}
+
+// Root returns the root directory, loading it on first use.
+func (lr *LocalRoot) Root(ctx context.Context) (*Directory, error) {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+	if err := lr.load(ctx); err != nil {
+		return nil, err
+	}
+	return lr.dir, nil
+}
+
+// closeChild implements the childCloser interface. Changes are only
+// recorded by Flush. It is called with the lock of the root directory held.
+func (lr *LocalRoot) closeChild(name string, nd *dag.Node) error {
+	lr.dirty = true
+	return nil
+}
+
+// Flush writes the root directory out, moves the pin of the root pinned
+// before to it and records it, so that it is loaded again on the next start.
+// Nothing is done if the root did not change since the last flush.
+func (lr *LocalRoot) Flush(ctx context.Context) (err error) {
+	lr.lock.Lock()
+	defer lr.lock.Unlock()
+	if lr.dir == nil {
+		return nil
+	}
+
+	lr.dir.Lock()
+	if !lr.dirty {
+		lr.dir.Unlock()
+		return nil
+	}
+	lr.dirty = false
+	nd := lr.dir.node.Copy()
+	lr.dir.Unlock()
+	defer func() {
+		if err != nil {
+			lr.dir.Lock()
+			lr.dirty = true
+			lr.dir.Unlock()
+		}
+	}()
+
+	k, err := lr.fs.dserv.Add(nd)
+	if err != nil {
+		return err
+	}
+	if k == lr.pinned {
+		return nil
+	}
+
+	// move the pin of the previous root over, which only walks the parts
+	// of the tree that changed
+	if lr.pinned != "" {
+		err = lr.fs.pins.Update(ctx, lr.pinned, nd)
+	} else {
+		err = lr.fs.pins.Pin(ctx, nd, true)
+	}
+	if err != nil {
+		return err
+	}
+	if err := lr.fs.pins.Flush(); err != nil {
+		return err
+	}
+
+	if err := lr.dstore.Put(localRootKey, []byte(k)); err != nil {
+		return err
+	}
+	lr.pinned = k
+	return nil
+}
+
+// Publish flushes the root and publishes it through nsys under the name of
+// k. It returns the path published.
+func (lr *LocalRoot) Publish(ctx context.Context, nsys namesys.NameSystem, k ci.PrivKey) (path.Path, error) {
+	dir, err := lr.Root(ctx)
+	if err != nil {
+		return "", err
+	}
+	lr.lock.Lock()
+	recorded := lr.pinned != ""
+	lr.lock.Unlock()
+	if !recorded {
+		// an empty root that was never changed is not recorded yet
+		dir.Lock()
+		lr.dirty = true
+		dir.Unlock()
+	}
+
+	if err := lr.Flush(ctx); err != nil {
+		return "", err
+	}
+
+	lr.lock.Lock()
+	p := path.FromKey(lr.pinned)
+	lr.lock.Unlock()
+
+	ev := &logging.Metadata{"key": p}
+	defer log.EventBegin(ctx, "ipnsfsLocalPublishing", ev).Done()
+
+	if err := nsys.Publish(ctx, k, p); err != nil {
+		return "", err
+	}
+	return p, nil
+}
+
+// Close flushes the root, if it was loaded. The node is shutting down, so objects that are
+// not local are only waited for until closeFlushTimeout.
+func (lr *LocalRoot) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), closeFlushTimeout)
+	defer cancel()
+	return lr.Flush(ctx)
+}
+
+// Begin starts a transaction on the root directory.
+func (lr *LocalRoot) Begin(ctx context.Context) (*Txn, error) {
+	dir, err := lr.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return lr.fs.Begin(dir)
+}
@@ -0,0 +1,262 @@
+package ipnsfs
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	dssync "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/sync"
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
+	bserv "github.com/ipfs/go-ipfs/blockservice"
+	offline "github.com/ipfs/go-ipfs/exchange/offline"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	pin "github.com/ipfs/go-ipfs/pin"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
+)
+
+func setupLocalRoot(t *testing.T) (*LocalRoot, dag.DAGService, pin.Pinner, ds.ThreadSafeDatastore) {
+	dstore := dssync.MutexWrap(ds.NewMapDatastore())
+	bs := bstore.NewBlockstore(dstore)
+	dserv := dag.NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+	pins := pin.NewPinner(dstore, dserv)
+
+	lr := NewLocalRoot(context.Background(), dserv, pins, dstore)
+	return lr, dserv, pins, dstore
+}
+
+func localRootDir(t *testing.T, lr *LocalRoot) *Directory {
+	dir, err := lr.Root(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func readFile(t *testing.T, dserv dag.DAGService, root *Directory, pth string) string {
+	fsn, err := Lookup(root, pth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nd, err := fsn.GetNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dr, err := uio.NewDagReader(context.Background(), nd, dserv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(dr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestLocalRootOps(t *testing.T) {
+	ctx := context.Background()
+	lr, dserv, pins, dstore := setupLocalRoot(t)
+	root := localRootDir(t, lr)
+
+	if err := Mkdir(root, "/a/b", false); err == nil {
+		t.Fatal("created a directory without its parent")
+	}
+	if err := Mkdir(root, "/a/b", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := Mkdir(root, "/a", false); err != os.ErrExist {
+		t.Fatalf("expected %s, got %v", os.ErrExist, err)
+	}
+
+	fnd := &dag.Node{Data: ft.FilePBData([]byte("hello"), 5)}
+	if err := PutNode(root, "/a/b/hello", fnd); err != nil {
+		t.Fatal(err)
+	}
+	if s := readFile(t, dserv, root, "/a/b/hello"); s != "hello" {
+		t.Fatalf("read %q", s)
+	}
+
+	// moving into a directory keeps the name
+	if err := Mv(root, "/a/b/hello", "/a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Lookup(root, "/a/b/hello"); err != os.ErrNotExist {
+		t.Fatal("moved file still at its source")
+	}
+	if s := readFile(t, dserv, root, "/a/hello"); s != "hello" {
+		t.Fatalf("read %q", s)
+	}
+
+	if err := Remove(root, "/a/b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Lookup(root, "/a/b"); err != os.ErrNotExist {
+		t.Fatal("removed directory still there")
+	}
+
+	if err := lr.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	rnd, err := root.GetNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rk, err := rnd.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pins.IsPinned(rk) {
+		t.Fatal("flushed root not pinned")
+	}
+
+	// the flushed root is loaded back
+	lr2 := NewLocalRoot(ctx, dserv, pins, dstore)
+	if s := readFile(t, dserv, localRootDir(t, lr2), "/a/hello"); s != "hello" {
+		t.Fatalf("read %q", s)
+	}
+}
+
+func TestMv(t *testing.T) {
+	lr, dserv, _, _ := setupLocalRoot(t)
+	root := localRootDir(t, lr)
+
+	if err := Mkdir(root, "/a/b", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := Mkdir(root, "/c", false); err != nil {
+		t.Fatal(err)
+	}
+	fnd := &dag.Node{Data: ft.FilePBData([]byte("hello"), 5)}
+	if err := PutNode(root, "/a/b/f", fnd); err != nil {
+		t.Fatal(err)
+	}
+
+	// a directory can not go below itself, not even into itself
+	for _, dst := range []string{"/a/b", "/a", "/a/b/g"} {
+		if err := Mv(root, "/a", dst); err == nil {
+			t.Fatalf("moved /a to %s", dst)
+		}
+	}
+	if err := Mv(root, "/c", "/c"); err == nil {
+		t.Fatal("moved /c into itself")
+	}
+	for _, pth := range []string{"/a/b/f", "/c"} {
+		if _, err := Lookup(root, pth); err != nil {
+			t.Fatalf("%s lost by a refused move: %s", pth, err)
+		}
+	}
+
+	// nothing is changed when the destination is missing
+	if err := Mv(root, "/a/b/f", "/missing/f"); err == nil {
+		t.Fatal("moved to a missing directory")
+	}
+	if _, err := Lookup(root, "/a/b/f"); err != nil {
+		t.Fatal("source lost by a failed move")
+	}
+
+	// a handle on the moved file can not bring it back
+	fsn, err := Lookup(root, "/a/b/f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi := fsn.(*File)
+	if err := Mv(root, "/a/b/f", "/c/g"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fi.WriteAt([]byte("stale"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := fi.Close(); err != ErrDetached {
+		t.Fatalf("expected %s, got %v", ErrDetached, err)
+	}
+	if _, err := Lookup(root, "/a/b/f"); err != os.ErrNotExist {
+		t.Fatal("moved file still at its source")
+	}
+	if s := readFile(t, dserv, root, "/c/g"); s != "hello" {
+		t.Fatalf("read %q", s)
+	}
+
+	// renaming within a directory, with an entry in the way first
+	if err := PutNode(root, "/c/h", fnd); err != nil {
+		t.Fatal(err)
+	}
+	if err := Mv(root, "/c/g", "/c/h"); err != os.ErrExist {
+		t.Fatalf("expected %s, got %v", os.ErrExist, err)
+	}
+	if err := Mv(root, "/c/g", "/c/i"); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Lookup(root, "/c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := c.(*Directory).List(); len(names) != 2 || names[0] != "h" || names[1] != "i" {
+		t.Fatalf("expected entries h and i, got %v", names)
+	}
+}
+
+func TestLocalRootFlushUnpinsPrevious(t *testing.T) {
+	ctx := context.Background()
+	lr, _, pins, _ := setupLocalRoot(t)
+	root := localRootDir(t, lr)
+
+	if err := Mkdir(root, "/a", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := lr.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	old := lr.pinned
+
+	if err := Mkdir(root, "/b", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := lr.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if lr.pinned == old {
+		t.Fatal("root did not change")
+	}
+	if pins.IsPinned(old) {
+		t.Fatal("previous root still pinned")
+	}
+	if !pins.IsPinned(lr.pinned) {
+		t.Fatal("new root not pinned")
+	}
+}
+
+func TestLocalRootFlushUnchanged(t *testing.T) {
+	ctx := context.Background()
+	lr, _, pins, dstore := setupLocalRoot(t)
+
+	// a root that was never used is not written out
+	if err := lr.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dstore.Get(localRootKey); err != ds.ErrNotFound {
+		t.Fatal("unused root was recorded")
+	}
+
+	// nor one that did not change
+	localRootDir(t, lr)
+	if err := lr.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if lr.pinned != "" || len(pins.RecursiveKeys()) != 0 {
+		t.Fatal("unchanged root was pinned")
+	}
+
+	if err := Mkdir(localRootDir(t, lr), "/a", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := lr.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if lr.pinned == "" {
+		t.Fatal("changed root was not pinned")
+	}
+}
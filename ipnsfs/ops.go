@@ -0,0 +1,201 @@
+package ipnsfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	gopath "path"
+	"strings"
+
+	dag "github.com/ipfs/go-ipfs/merkledag"
+)
+
+var ErrInvalidPath = errors.New("paths must start with a leading slash")
+
+// splitPath returns the components of the absolute path pth, none for the
+// root.
+func splitPath(pth string) ([]string, error) {
+	if !strings.HasPrefix(pth, "/") {
+		return nil, ErrInvalidPath
+	}
+	pth = strings.Trim(gopath.Clean(pth), "/")
+	if pth == "" {
+		return nil, nil
+	}
+	return strings.Split(pth, "/"), nil
+}
+
+// Lookup returns the node at the absolute path pth below root.
+func Lookup(root *Directory, pth string) (FSNode, error) {
+	parts, err := splitPath(pth)
+	if err != nil {
+		return nil, err
+	}
+
+	var cur FSNode = root
+	for i, name := range parts {
+		dir, ok := cur.(*Directory)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a directory", gopath.Join(parts[:i]...))
+		}
+		cur, err = dir.Child(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cur, nil
+}
+
+// lookupParent returns the directory holding the entry at pth, and the
+// name of the entry.
+func lookupParent(root *Directory, pth string) (*Directory, string, error) {
+	parts, err := splitPath(pth)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(parts) == 0 {
+		return nil, "", errors.New("the root has no parent")
+	}
+
+	dir, name := gopath.Split(gopath.Join(append([]string{"/"}, parts...)...))
+	parent, err := Lookup(root, dir)
+	if err != nil {
+		return nil, "", err
+	}
+	pdir, ok := parent.(*Directory)
+	if !ok {
+		return nil, "", fmt.Errorf("%s is not a directory", dir)
+	}
+	return pdir, name, nil
+}
+
+// Mkdir creates the directory at pth below root. With parents, the missing
+// directories leading to it are created, and it is not an error for it to
+// exist already.
+func Mkdir(root *Directory, pth string, parents bool) error {
+	parts, err := splitPath(pth)
+	if err != nil {
+		return err
+	}
+	if len(parts) == 0 {
+		if parents {
+			return nil
+		}
+		return os.ErrExist
+	}
+
+	cur := root
+	for i, name := range parts {
+		last := i == len(parts)-1
+		child, err := cur.Child(name)
+		switch {
+		case err == os.ErrNotExist && (parents || last):
+			cur, err = cur.Mkdir(name)
+			if err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		case last && !parents:
+			return os.ErrExist
+		default:
+			dir, ok := child.(*Directory)
+			if !ok {
+				return fmt.Errorf("%s is not a directory", gopath.Join(parts[:i+1]...))
+			}
+			cur = dir
+		}
+	}
+	return nil
+}
+
+// PutNode links nd at pth below root. There must be no entry at pth.
+func PutNode(root *Directory, pth string, nd *dag.Node) error {
+	dir, name, err := lookupParent(root, pth)
+	if err != nil {
+		return err
+	}
+	return dir.AddChild(name, nd)
+}
+
+// Remove unlinks the entry at pth below root.
+func Remove(root *Directory, pth string) error {
+	dir, name, err := lookupParent(root, pth)
+	if err != nil {
+		return err
+	}
+	if _, err := dir.Child(name); err != nil {
+		return err
+	}
+	return dir.Unlink(name)
+}
+
+// Mv moves the entry at src below root to dst. If dst is a directory, the
+// entry is moved into it under its own name. A directory can not be moved
+// below itself. The move is made as a single update of the closest
+// directory holding both src and dst.
+func Mv(root *Directory, src, dst string) error {
+	srcParts, err := splitPath(src)
+	if err != nil {
+		return err
+	}
+	if len(srcParts) == 0 {
+		return errors.New("the root can not be moved")
+	}
+	if _, err := Lookup(root, src); err != nil {
+		return err
+	}
+
+	dstParts, err := splitPath(dst)
+	if err != nil {
+		return err
+	}
+	if dstnd, err := Lookup(root, dst); err == nil {
+		if _, ok := dstnd.(*Directory); !ok {
+			return os.ErrExist
+		}
+		dstParts = append(dstParts, srcParts[len(srcParts)-1])
+	}
+	if len(dstParts) == 0 {
+		return os.ErrExist
+	}
+	if hasPrefix(dstParts, srcParts) {
+		return fmt.Errorf("cannot move %s below itself", gopath.Join(srcParts...))
+	}
+
+	dstPath := "/" + gopath.Join(dstParts...)
+	if _, err := Lookup(root, dstPath); err == nil {
+		return os.ErrExist
+	}
+	if _, _, err := lookupParent(root, dstPath); err != nil {
+		return err
+	}
+
+	// the closest directory holding both entries
+	common := 0
+	for common < len(srcParts)-1 && common < len(dstParts)-1 && srcParts[common] == dstParts[common] {
+		common++
+	}
+	parent, err := Lookup(root, "/"+gopath.Join(srcParts[:common]...))
+	if err != nil {
+		return err
+	}
+	dir, ok := parent.(*Directory)
+	if !ok {
+		return fmt.Errorf("%s is not a directory", gopath.Join(srcParts[:common]...))
+	}
+	return dir.move(srcParts[common:], dstParts[common:])
+}
+
+// hasPrefix reports whether the path components prefix start parts.
+func hasPrefix(parts, prefix []string) bool {
+	if len(parts) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if parts[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -178,14 +178,14 @@ func (fs *Filesystem) newKeyRoot(parent context.Context, k ci.PrivKey) (*KeyRoot
 	root.repub = NewRepublisher(root, time.Millisecond*300, time.Second*3)
 	go root.repub.Run(parent)
 
-	pbn, err := ft.FromBytes(mnode.Data)
+	pbn, err := ft.FromNode(mnode)
 	if err != nil {
 		log.Error("IPNS pointer was not unixfs node")
 		return nil, err
 	}
 
 	switch pbn.GetType() {
-	case ft.TDirectory:
+	case ft.TDirectory, ft.THAMTShard:
 		root.val = NewDirectory(ctx, pointsTo.String(), mnode, root, fs)
 	case ft.TFile, ft.TMetadata, ft.TRaw:
 		fi, err := NewFile(pointsTo.String(), mnode, root, fs)
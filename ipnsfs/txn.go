@@ -0,0 +1,110 @@
+package ipnsfs
+
+import (
+	"errors"
+	"sync"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+)
+
+var ErrTxnConflict = errors.New("directory changed during the transaction")
+var ErrTxnDone = errors.New("transaction already committed or rolled back")
+
+// Txn batches changes to the tree below a directory. They are made on a
+// working copy of the directory, which nothing else sees, and Commit
+// replaces the directory with it in a single update propagated to the
+// root. Rollback drops the changes.
+type Txn struct {
+	target *Directory
+	base   key.Key // key of target when the transaction began
+	root   *Directory
+
+	lock sync.Mutex
+	done bool
+}
+
+// txnRoot is the parent of the working copy of a transaction: the changes
+// stop there until they are committed.
+type txnRoot struct{}
+
+func (txnRoot) closeChild(string, *dag.Node) error {
+	return nil
+}
+
+// Begin starts a transaction on the tree below dir.
+func (fs *Filesystem) Begin(dir *Directory) (*Txn, error) {
+	dir.Lock()
+	defer dir.Unlock()
+
+	base, err := dir.node.Key()
+	if err != nil {
+		return nil, err
+	}
+
+	// links may cache the nodes they point to, which are not to be changed
+	// in place by the working copy
+	nd := dir.node.Copy()
+	for i, l := range nd.Links {
+		cl := *l
+		cl.Node = nil
+		nd.Links[i] = &cl
+	}
+
+	return &Txn{
+		target: dir,
+		base:   base,
+		root:   NewDirectory(dir.ctx, dir.name, nd, txnRoot{}, fs),
+	}, nil
+}
+
+// Root returns the working copy of the directory, to be changed with the
+// operations of this package, like Mkdir, PutNode, Remove and Mv.
+func (tx *Txn) Root() *Directory {
+	return tx.root
+}
+
+// Commit replaces the directory with the working copy. It fails with
+// ErrTxnConflict if the directory was changed since the transaction
+// began, in which case nothing is changed.
+func (tx *Txn) Commit() error {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+	if tx.done {
+		return ErrTxnDone
+	}
+	tx.done = true
+
+	tx.root.Lock()
+	nd := tx.root.node
+	tx.root.Unlock()
+	if _, err := tx.target.fs.dserv.Add(nd); err != nil {
+		return err
+	}
+
+	d := tx.target
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	cur, err := d.node.Key()
+	if err != nil {
+		return err
+	}
+	if cur != tx.base {
+		return ErrTxnConflict
+	}
+
+	d.node = nd
+	// the children loaded so far hold the nodes of the old tree, changes
+	// still made through them must not undo the commit
+	d.dropChildren()
+
+	return d.parent.closeChild(d.name, d.node)
+}
+
+// Rollback drops the changes made in the transaction.
+func (tx *Txn) Rollback() {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+	tx.done = true
+}
@@ -0,0 +1,139 @@
+package ipnsfs
+
+import (
+	"os"
+	"testing"
+
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+)
+
+func TestTxnCommit(t *testing.T) {
+	lr, dserv, _, _ := setupLocalRoot(t)
+	root := localRootDir(t, lr)
+
+	if err := Mkdir(root, "/old", false); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := lr.Begin(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Mkdir(tx.Root(), "/a/b", true); err != nil {
+		t.Fatal(err)
+	}
+	fnd := &dag.Node{Data: ft.FilePBData([]byte("txn"), 3)}
+	if err := PutNode(tx.Root(), "/a/b/f", fnd); err != nil {
+		t.Fatal(err)
+	}
+	if err := Remove(tx.Root(), "/old"); err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing is seen before the commit
+	if _, err := Lookup(root, "/a"); err != os.ErrNotExist {
+		t.Fatal("uncommitted change visible")
+	}
+	if _, err := Lookup(root, "/old"); err != nil {
+		t.Fatal("uncommitted removal visible")
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if s := readFile(t, dserv, root, "/a/b/f"); s != "txn" {
+		t.Fatalf("read %q", s)
+	}
+	if _, err := Lookup(root, "/old"); err != os.ErrNotExist {
+		t.Fatal("removal not committed")
+	}
+	if err := tx.Commit(); err != ErrTxnDone {
+		t.Fatalf("expected %s, got %v", ErrTxnDone, err)
+	}
+}
+
+func TestTxnRollbackAndConflict(t *testing.T) {
+	lr, _, _, _ := setupLocalRoot(t)
+	root := localRootDir(t, lr)
+
+	tx, err := lr.Begin(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Mkdir(tx.Root(), "/a", false); err != nil {
+		t.Fatal(err)
+	}
+	tx.Rollback()
+	if err := tx.Commit(); err != ErrTxnDone {
+		t.Fatalf("expected %s, got %v", ErrTxnDone, err)
+	}
+	if _, err := Lookup(root, "/a"); err != os.ErrNotExist {
+		t.Fatal("rolled back change visible")
+	}
+
+	tx, err = lr.Begin(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Mkdir(tx.Root(), "/a", false); err != nil {
+		t.Fatal(err)
+	}
+	// changed by someone else meanwhile
+	if err := Mkdir(root, "/b", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != ErrTxnConflict {
+		t.Fatalf("expected %s, got %v", ErrTxnConflict, err)
+	}
+	if _, err := Lookup(root, "/a"); err != os.ErrNotExist {
+		t.Fatal("conflicting change applied")
+	}
+	if _, err := Lookup(root, "/b"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTxnCommitDetachesChildren(t *testing.T) {
+	lr, dserv, _, _ := setupLocalRoot(t)
+	root := localRootDir(t, lr)
+
+	fnd := &dag.Node{Data: ft.FilePBData([]byte("old"), 3)}
+	if err := PutNode(root, "/f", fnd); err != nil {
+		t.Fatal(err)
+	}
+	// a handle opened before the transaction, like a fuse one
+	fsn, err := Lookup(root, "/f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fi := fsn.(*File)
+
+	tx, err := lr.Begin(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Remove(tx.Root(), "/f"); err != nil {
+		t.Fatal(err)
+	}
+	nnd := &dag.Node{Data: ft.FilePBData([]byte("txn"), 3)}
+	if err := PutNode(tx.Root(), "/f", nnd); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// changes made through the old handle do not undo the commit
+	if _, err := fi.WriteAt([]byte("new"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := fi.Close(); err != ErrDetached {
+		t.Fatalf("expected %s, got %v", ErrDetached, err)
+	}
+	if s := readFile(t, dserv, root, "/f"); s != "txn" {
+		t.Fatalf("read %q", s)
+	}
+}
@@ -1,11 +1,13 @@
 package merkledag
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 
 	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
 
+	blocks "github.com/ipfs/go-ipfs/blocks"
 	pb "github.com/ipfs/go-ipfs/merkledag/pb"
 	u "github.com/ipfs/go-ipfs/util"
 )
@@ -14,8 +16,12 @@ import (
 // because native go objects are nice.
 
 // Unmarshal decodes raw data into a *Node instance.
-// The conversion uses an intermediate PBNode.
+// The conversion uses an intermediate PBNode, except for document nodes.
 func (n *Node) Unmarshal(encoded []byte) error {
+	if bytes.HasPrefix(encoded, documentHeader) {
+		return n.unmarshalDocument(encoded[len(documentHeader):])
+	}
+
 	var pbn pb.PBNode
 	if err := pbn.Unmarshal(encoded); err != nil {
 		return fmt.Errorf("Unmarshal failed. %v", err)
@@ -24,7 +30,7 @@ func (n *Node) Unmarshal(encoded []byte) error {
 	pbnl := pbn.GetLinks()
 	n.Links = make([]*Link, len(pbnl))
 	for i, l := range pbnl {
-		n.Links[i] = &Link{Name: l.GetName(), Size: l.GetTsize()}
+		n.Links[i] = &Link{Name: l.GetName(), Size: l.GetTsize(), Raw: l.GetRaw()}
 		h, err := mh.Cast(l.GetHash())
 		if err != nil {
 			return fmt.Errorf("Link hash is not valid multihash. %v", err)
@@ -38,8 +44,15 @@ func (n *Node) Unmarshal(encoded []byte) error {
 }
 
 // Marshal encodes a *Node instance into a new byte slice.
-// The conversion uses an intermediate PBNode.
+// The conversion uses an intermediate PBNode, raw nodes are encoded as
+// their data and document nodes as their document.
 func (n *Node) Marshal() ([]byte, error) {
+	if n.raw {
+		return n.Data, nil
+	}
+	if n.doc {
+		return append(append([]byte{}, documentHeader...), n.Data...), nil
+	}
 	pbn := n.getPBNode()
 	data, err := pbn.Marshal()
 	if err != nil {
@@ -58,6 +71,9 @@ func (n *Node) getPBNode() *pb.PBNode {
 		pbn.Links[i].Name = &l.Name
 		pbn.Links[i].Tsize = &l.Size
 		pbn.Links[i].Hash = []byte(l.Hash)
+		if l.Raw {
+			pbn.Links[i].Raw = &l.Raw
+		}
 	}
 
 	pbn.Data = n.Data
@@ -74,7 +90,15 @@ func (n *Node) Encoded(force bool) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		n.cached = u.Hash(n.encoded)
+		if n.inline && len(n.encoded) <= blocks.MaxIdentitySize {
+			n.cached, err = mh.Encode(n.encoded, blocks.IdentityHash)
+		} else {
+			n.cached, err = u.Sum(n.encoded, n.HashFunc(), -1)
+		}
+		if err != nil {
+			n.encoded = nil
+			return nil, err
+		}
 	}
 
 	return n.encoded, nil
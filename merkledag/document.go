@@ -0,0 +1,260 @@
+package merkledag
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+)
+
+// Document nodes hold a structured document: maps, lists, strings,
+// numbers, booleans, null and links to other nodes, anywhere in nested
+// maps and lists. They are a codec of their own: a document node is
+// encoded as the JSON multicodec, its header followed by the document as
+// canonical JSON, rather than as a PBNode. The links of the document are
+// the links of the node, named by their path in the document. So pinning,
+// refs and GC follow the links of documents like any others, and paths
+// through documents resolve to the nodes they link to.
+//
+// In JSON, a link is a map with the key "/", whose value is the base58
+// hash of the node linked to, and the key "size", the cumulative size of
+// that node:
+//
+//     {"author": {"/": "QmXg9Pp2ytZ14xgmQjYEiHjVjMFXzCVVEcRTWJBmLgR39V", "size": 1234}}
+//
+// Map keys may not be empty or hold a "/", so that the paths of links are
+// unambiguous.
+
+// documentHeader starts the encoding of document nodes: the multicodec
+// header of JSON, its length as a varint then its name. The first byte of
+// a PBNode is a protobuf field tag, never 6, so the two can not be taken
+// for each other.
+var documentHeader = []byte("\x06/json\n")
+
+var ErrNotDocument = errors.New("merkledag: node is not a document")
+
+// ErrDocumentLinks is returned when changing the links of a document node
+// directly: they are those of its document.
+var ErrDocumentLinks = errors.New("merkledag: the links of a document node are those of its document")
+
+// DocLink is a link in a document, to the node with the key Hash, whose
+// cumulative size is Size.
+type DocLink struct {
+	Hash key.Key
+	Size uint64
+}
+
+type docLinkJSON struct {
+	Hash string `json:"/"`
+	Size uint64 `json:"size"`
+}
+
+func (l DocLink) MarshalJSON() ([]byte, error) {
+	return json.Marshal(docLinkJSON{Hash: l.Hash.B58String(), Size: l.Size})
+}
+
+// NewDocumentNode returns a node holding doc. The values of doc are nil,
+// bool, string, numbers, json.Number, DocLink, []interface{} and
+// map[string]interface{}. The sizes of the links are taken as they are,
+// see FillDocLinkSizes.
+func NewDocumentNode(doc interface{}) (*Node, error) {
+	n := new(Node)
+	if err := collectDocLinks(n, "", doc); err != nil {
+		return nil, err
+	}
+
+	enc, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	n.Data = enc
+	n.doc = true
+	return n, nil
+}
+
+// unmarshalDocument decodes the encoding of a document node, without its
+// header, into n.
+func (n *Node) unmarshalDocument(data []byte) error {
+	doc, err := ParseDocument(data)
+	if err != nil {
+		return err
+	}
+	n.Links = nil
+	if err := collectDocLinks(n, "", doc); err != nil {
+		return err
+	}
+	n.Data = data
+	n.doc = true
+	return nil
+}
+
+// FillDocLinkSizes sets the size of the links of doc to the cumulative
+// size of the nodes they link to, fetched from ds. It returns doc.
+func FillDocLinkSizes(ctx context.Context, ds DAGService, doc interface{}) (interface{}, error) {
+	switch v := doc.(type) {
+	case DocLink:
+		nd, err := ds.Get(ctx, v.Hash)
+		if err != nil {
+			return nil, err
+		}
+		v.Size, err = nd.Size()
+		return v, err
+	case []interface{}:
+		for i, e := range v {
+			fe, err := FillDocLinkSizes(ctx, ds, e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = fe
+		}
+	case map[string]interface{}:
+		for k, e := range v {
+			fe, err := FillDocLinkSizes(ctx, ds, e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = fe
+		}
+	}
+	return doc, nil
+}
+
+// ParseDocument parses a document from its JSON encoding, numbers as
+// json.Number and links as DocLink.
+func ParseDocument(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return parseDocValue(v)
+}
+
+func parseDocValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if h, ok := v["/"]; ok {
+			return parseDocLink(v, h)
+		}
+		for k, e := range v {
+			pe, err := parseDocValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = pe
+		}
+		return v, nil
+	case []interface{}:
+		for i, e := range v {
+			pe, err := parseDocValue(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = pe
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
+
+// parseDocLink parses the link v, whose "/" key holds h. Its "size" key
+// is optional.
+func parseDocLink(v map[string]interface{}, h interface{}) (DocLink, error) {
+	s, ok := h.(string)
+	if !ok {
+		return DocLink{}, fmt.Errorf("malformed link: %v", v)
+	}
+	var size uint64
+	switch len(v) {
+	case 1:
+	case 2:
+		n, ok := v["size"].(json.Number)
+		if !ok {
+			return DocLink{}, fmt.Errorf("malformed link: %v", v)
+		}
+		var err error
+		size, err = strconv.ParseUint(n.String(), 10, 64)
+		if err != nil {
+			return DocLink{}, fmt.Errorf("malformed link size %q: %s", n, err)
+		}
+	default:
+		return DocLink{}, fmt.Errorf("malformed link: %v", v)
+	}
+	m, err := mh.FromB58String(s)
+	if err != nil {
+		return DocLink{}, fmt.Errorf("malformed link %q: %s", s, err)
+	}
+	return DocLink{Hash: key.Key(m), Size: size}, nil
+}
+
+// IsDocument reports whether the node is a document node, see
+// NewDocumentNode.
+func (n *Node) IsDocument() bool {
+	return n.doc
+}
+
+// Document returns the document held by the node.
+func (n *Node) Document() (interface{}, error) {
+	if !n.IsDocument() {
+		return nil, ErrNotDocument
+	}
+	return ParseDocument(n.Data)
+}
+
+// collectDocLinks checks the value v at path pth of a document, and adds
+// the links in it to n.
+func collectDocLinks(n *Node, pth string, v interface{}) error {
+	switch v := v.(type) {
+	case nil, bool, string, json.Number,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return nil
+	case DocLink:
+		if pth == "" {
+			return errors.New("a document can not be a link")
+		}
+		return n.AddRawLink(pth, &Link{Hash: mh.Multihash(v.Hash), Size: v.Size})
+	case []interface{}:
+		for i, e := range v {
+			if err := collectDocLinks(n, joinDocPath(pth, strconv.Itoa(i)), e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		names := make([]string, 0, len(v))
+		for k := range v {
+			if k == "" || strings.Contains(k, "/") {
+				return fmt.Errorf("invalid document key %q at %q", k, pth)
+			}
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		for _, k := range names {
+			if err := collectDocLinks(n, joinDocPath(pth, k), v[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid document value of type %T at %q", v, pth)
+	}
+}
+
+func joinDocPath(pth, name string) string {
+	if pth == "" {
+		return name
+	}
+	return pth + "/" + name
+}
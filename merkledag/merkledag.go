@@ -3,8 +3,8 @@ package merkledag
 
 import (
 	"fmt"
-	"sync"
 
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 	blocks "github.com/ipfs/go-ipfs/blocks"
 	key "github.com/ipfs/go-ipfs/blocks/key"
@@ -22,11 +22,19 @@ type DAGService interface {
 	Get(context.Context, key.Key) (*Node, error)
 	Remove(*Node) error
 
+	// GetRaw retrieves the block at the given key as a raw node, see
+	// NewRawNode.
+	GetRaw(context.Context, key.Key) (*Node, error)
+
 	// GetDAG returns, in order, all the single leve child
 	// nodes of the passed in node.
 	GetDAG(context.Context, *Node) []NodeGetter
 	GetNodes(context.Context, []key.Key) []NodeGetter
 
+	// GetLinks returns, in order, the nodes the given links point to,
+	// decoding the targets of raw links as raw nodes.
+	GetLinks(context.Context, []*Link) []NodeGetter
+
 	Batch() *Batch
 }
 
@@ -56,6 +64,7 @@ func (n *dagService) Add(nd *Node) (key.Key, error) {
 
 	b := new(blocks.Block)
 	b.Data = d
+	b.Pos = nd.DataPos
 	b.Multihash, err = nd.Multihash()
 	if err != nil {
 		return "", err
@@ -88,8 +97,19 @@ func (n *dagService) AddRecursive(nd *Node) error {
 	return nil
 }
 
-// Get retrieves a node from the dagService, fetching the block in the BlockService
+// Get retrieves a node from the dagService, fetching the block in the
+// BlockService. The block must be a PBNode; raw nodes are only told apart
+// by the links to them, and are read with GetRaw.
 func (n *dagService) Get(ctx context.Context, k key.Key) (*Node, error) {
+	return n.get(ctx, k, false)
+}
+
+// GetRaw retrieves the block at k as a raw node.
+func (n *dagService) GetRaw(ctx context.Context, k key.Key) (*Node, error) {
+	return n.get(ctx, k, true)
+}
+
+func (n *dagService) get(ctx context.Context, k key.Key, raw bool) (*Node, error) {
 	if n == nil {
 		return nil, fmt.Errorf("dagService is nil")
 	}
@@ -104,7 +124,34 @@ func (n *dagService) Get(ctx context.Context, k key.Key) (*Node, error) {
 		return nil, err
 	}
 
-	return Decoded(b.Data)
+	return decodeBlock(b, raw)
+}
+
+// decodeBlock decodes the node in b, as a raw node if raw is set. The node
+// keeps the hash function of the block, so that modified copies of it are
+// hashed the same way.
+func decodeBlock(b *blocks.Block, raw bool) (*Node, error) {
+	var nd *Node
+	if raw {
+		nd = NewRawNode(b.Data)
+	} else {
+		var err error
+		nd, err = Decoded(b.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dec, err := mh.Decode(b.Multihash)
+	if err != nil {
+		return nil, err
+	}
+	if dec.Code == blocks.IdentityHash {
+		nd.inline = true
+	} else {
+		nd.SetHashFunc(dec.Code)
+	}
+	return nd, nil
 }
 
 // Remove deletes the given node and all of its children from the BlockService
@@ -121,43 +168,6 @@ func (n *dagService) Remove(nd *Node) error {
 	return n.Blocks.DeleteBlock(k)
 }
 
-// FetchGraph asynchronously fetches all nodes that are children of the given
-// node, and returns a channel that may be waited upon for the fetch to complete
-func FetchGraph(ctx context.Context, root *Node, serv DAGService) chan struct{} {
-	log.Warning("Untested.")
-	var wg sync.WaitGroup
-	done := make(chan struct{})
-
-	for _, l := range root.Links {
-		wg.Add(1)
-		go func(lnk *Link) {
-
-			// Signal child is done on way out
-			defer wg.Done()
-			select {
-			case <-ctx.Done():
-				return
-			}
-
-			nd, err := lnk.GetNode(ctx, serv)
-			if err != nil {
-				log.Debug(err)
-				return
-			}
-
-			// Wait for children to finish
-			<-FetchGraph(ctx, nd, serv)
-		}(l)
-	}
-
-	go func() {
-		wg.Wait()
-		done <- struct{}{}
-	}()
-
-	return done
-}
-
 // FindLinks searches this nodes links for the given key,
 // returns the indexes of any links pointing to it
 func FindLinks(links []key.Key, k key.Key, start int) []int {
@@ -174,17 +184,31 @@ func FindLinks(links []key.Key, k key.Key, start int) []int {
 // It returns a channel of nodes, which the caller can receive
 // all the child nodes of 'root' on, in proper order.
 func (ds *dagService) GetDAG(ctx context.Context, root *Node) []NodeGetter {
-	var keys []key.Key
-	for _, lnk := range root.Links {
-		keys = append(keys, key.Key(lnk.Hash))
-	}
+	return ds.GetLinks(ctx, root.Links)
+}
 
-	return ds.GetNodes(ctx, keys)
+// GetLinks returns an array of 'NodeGetter' promises, with each corresponding
+// to the link with the same index as the passed in links
+func (ds *dagService) GetLinks(ctx context.Context, links []*Link) []NodeGetter {
+	keys := make([]key.Key, len(links))
+	raw := make(map[key.Key]bool)
+	for i, lnk := range links {
+		keys[i] = key.Key(lnk.Hash)
+		if lnk.Raw {
+			raw[keys[i]] = true
+		}
+	}
+	return ds.getNodes(ctx, keys, raw)
 }
 
 // GetNodes returns an array of 'NodeGetter' promises, with each corresponding
 // to the key with the same index as the passed in keys
 func (ds *dagService) GetNodes(ctx context.Context, keys []key.Key) []NodeGetter {
+	return ds.getNodes(ctx, keys, nil)
+}
+
+// getNodes is GetNodes, decoding the blocks of the keys in raw as raw nodes.
+func (ds *dagService) getNodes(ctx context.Context, keys []key.Key, raw map[key.Key]bool) []NodeGetter {
 
 	// Early out if no work to do
 	if len(keys) == 0 {
@@ -211,7 +235,7 @@ func (ds *dagService) GetNodes(ctx context.Context, keys []key.Key) []NodeGetter
 					return
 				}
 
-				nd, err := Decoded(blk.Data)
+				nd, err := decodeBlock(blk, raw[blk.Key()])
 				if err != nil {
 					// NB: can happen with improperly formatted input data
 					log.Debug("Got back bad block!")
@@ -297,6 +321,7 @@ func (t *Batch) Add(nd *Node) (key.Key, error) {
 
 	b := new(blocks.Block)
 	b.Data = d
+	b.Pos = nd.DataPos
 	b.Multihash, err = nd.Multihash()
 	if err != nil {
 		return "", err
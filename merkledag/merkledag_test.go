@@ -11,6 +11,7 @@ import (
 
 	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
 	dssync "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/sync"
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
 	key "github.com/ipfs/go-ipfs/blocks/key"
@@ -20,6 +21,7 @@ import (
 	imp "github.com/ipfs/go-ipfs/importer"
 	chunk "github.com/ipfs/go-ipfs/importer/chunk"
 	. "github.com/ipfs/go-ipfs/merkledag"
+	pb "github.com/ipfs/go-ipfs/merkledag/pb"
 	"github.com/ipfs/go-ipfs/pin"
 	uio "github.com/ipfs/go-ipfs/unixfs/io"
 	u "github.com/ipfs/go-ipfs/util"
@@ -286,3 +288,241 @@ func TestCantGet(t *testing.T) {
 		t.Fatal("expected err not found, got: ", err)
 	}
 }
+
+func TestHashFunc(t *testing.T) {
+	dsp := getDagservAndPinner(t)
+
+	a := &Node{Data: []byte("A")}
+	a.SetHashFunc(mh.SHA3)
+	k, err := dsp.ds.Add(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dec, err := mh.Decode(mh.Multihash(k))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dec.Code != mh.SHA3 {
+		t.Fatalf("node hashed with %s, expected sha3", dec.Name)
+	}
+
+	// nodes read back, and their copies, keep the hash function
+	out, err := dsp.ds.Get(context.Background(), k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Node{Data: []byte("B")}
+	c, err := out.UpdateNodeLink("b", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.HashFunc() != mh.SHA3 {
+		t.Fatalf("copy hashed with %d, expected sha3", c.HashFunc())
+	}
+	if b.HashFunc() != mh.SHA2_256 {
+		t.Fatal("children keep their own hash function")
+	}
+}
+
+func TestRawNode(t *testing.T) {
+	dsp := getDagservAndPinner(t)
+
+	data := []byte("some raw data")
+	raw := NewRawNode(data)
+	k, err := dsp.ds.Add(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k != key.Key(u.Hash(data)) {
+		t.Fatal("a raw node should hash to the hash of its data")
+	}
+
+	if err := raw.AddNodeLink("child", &Node{}); err != ErrRawLinks {
+		t.Fatal("expected ErrRawLinks, got", err)
+	}
+
+	parent := &Node{Data: []byte("parent")}
+	if err := parent.AddNodeLinkClean("raw", raw); err != nil {
+		t.Fatal(err)
+	}
+	pk, err := dsp.ds.Add(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the link keeps telling the target is raw once decoded
+	out, err := dsp.ds.Get(context.Background(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !out.Links[0].Raw {
+		t.Fatal("expected the decoded link to be raw")
+	}
+
+	child, err := out.Links[0].GetNode(context.Background(), dsp.ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !child.IsRaw() || !bytes.Equal(child.Data, data) {
+		t.Fatal("expected the raw node back")
+	}
+
+	child, err = dsp.ds.GetDAG(context.Background(), out)[0].Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !child.IsRaw() || !bytes.Equal(child.Data, data) {
+		t.Fatal("expected the raw node back from GetDAG")
+	}
+}
+
+func TestLinkEncodingWithoutRaw(t *testing.T) {
+	child := &Node{Data: []byte("child")}
+	parent := &Node{Data: []byte("parent")}
+	if err := parent.AddNodeLinkClean("child", child); err != nil {
+		t.Fatal(err)
+	}
+	enc, err := parent.Encoded(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// links to PBNodes encode without the raw flag, as they did before it
+	var pbn pb.PBNode
+	if err := pbn.Unmarshal(enc); err != nil {
+		t.Fatal(err)
+	}
+	if pbn.Links[0].Raw != nil {
+		t.Fatal("raw flag encoded for a link to a PBNode")
+	}
+	old, err := (&pb.PBNode{
+		Links: []*pb.PBLink{{
+			Hash:  pbn.Links[0].Hash,
+			Name:  pbn.Links[0].Name,
+			Tsize: pbn.Links[0].Tsize,
+		}},
+		Data: pbn.Data,
+	}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(enc, old) {
+		t.Fatal("encoding changed for nodes without raw links")
+	}
+}
+
+func TestInlineNode(t *testing.T) {
+	db := dssync.MutexWrap(ds.NewMapDatastore())
+	bs := bstore.NewBlockstore(db)
+	dserv := NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+
+	small := &Node{Data: []byte("tiny")}
+	ok, err := small.Inline(32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("expected a tiny node to be inlined")
+	}
+
+	big := &Node{Data: bytes.Repeat([]byte("a"), 64)}
+	if ok, _ := big.Inline(32); ok {
+		t.Fatal("a node larger than the limit should not be inlined")
+	}
+
+	k, err := dserv.Add(small)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has, _ := bs.Has(k); has {
+		t.Fatal("inlined nodes should not be stored")
+	}
+
+	parent := &Node{Data: []byte("parent")}
+	if err := parent.AddNodeLinkClean("small", small); err != nil {
+		t.Fatal(err)
+	}
+	pk, err := dserv.Add(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := dserv.Get(context.Background(), pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := out.Links[0].GetNode(context.Background(), dserv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(child.Data, small.Data) {
+		t.Fatal("expected the inlined node back")
+	}
+
+	// the decoded node keeps its key
+	ck, err := child.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ck != k {
+		t.Fatal("the key of the decoded node changed")
+	}
+
+	// and so does a copy of it
+	cpk, err := child.Copy().Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cpk != k {
+		t.Fatal("the key of a copy of an inlined node changed")
+	}
+}
+
+func TestRawNodeParsingAsPB(t *testing.T) {
+	dsp := getDagservAndPinner(t)
+	ctx := context.Background()
+
+	// both parse as a PBNode: an empty one, and one with Data "hi"
+	for _, data := range [][]byte{{}, []byte("\x0a\x02hi")} {
+		raw := NewRawNode(data)
+		parent := &Node{Data: []byte("parent")}
+		if err := parent.AddNodeLinkClean("raw", raw); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := dsp.ds.Add(raw); err != nil {
+			t.Fatal(err)
+		}
+
+		child, err := parent.Links[0].GetNode(ctx, dsp.ds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !child.IsRaw() || !bytes.Equal(child.Data, data) {
+			t.Fatalf("expected raw node %q back, got %q", data, child.Data)
+		}
+
+		child, err = dsp.ds.GetDAG(ctx, parent)[0].Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !child.IsRaw() || !bytes.Equal(child.Data, data) {
+			t.Fatalf("expected raw node %q back from GetDAG, got %q", data, child.Data)
+		}
+	}
+}
+
+func TestGetNotPBNode(t *testing.T) {
+	dsp := getDagservAndPinner(t)
+
+	k, err := dsp.ds.Add(NewRawNode([]byte("\xffnot a PBNode")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dsp.ds.Get(context.Background(), k); err == nil {
+		t.Fatal("expected an error getting a block that is not a PBNode")
+	}
+	if _, err := dsp.ds.GetRaw(context.Background(), k); err != nil {
+		t.Fatal(err)
+	}
+}
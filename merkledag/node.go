@@ -1,11 +1,13 @@
 package merkledag
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
+	blocks "github.com/ipfs/go-ipfs/blocks"
 	key "github.com/ipfs/go-ipfs/blocks/key"
 )
 
@@ -15,10 +17,66 @@ type Node struct {
 	Links []*Link
 	Data  []byte
 
+	// DataPos is set by the importer on leaves whose data was read from a
+	// file on disk. It is not part of the encoding.
+	DataPos *blocks.DataPos
+
 	// cache encoded/marshaled value
 	encoded []byte
 
 	cached mh.Multihash
+
+	// multihash function of the node, zero means sha2-256
+	hashFn int
+
+	// raw nodes are encoded as their data alone, see NewRawNode
+	raw bool
+
+	// inline nodes are keyed by their encoding itself, see Inline
+	inline bool
+
+	// document nodes are encoded as their document, see NewDocumentNode
+	doc bool
+}
+
+// ErrRawLinks is returned when adding links to a raw node.
+var ErrRawLinks = errors.New("merkledag: raw nodes can not have links")
+
+// NewRawNode returns a node holding data, encoded as the data itself rather
+// than as a PBNode, so its hash is the hash of data. Raw nodes have no
+// links.
+func NewRawNode(data []byte) *Node {
+	return &Node{Data: data, raw: true}
+}
+
+// IsRaw reports whether the node is a raw node, see NewRawNode.
+func (n *Node) IsRaw() bool {
+	return n.raw
+}
+
+// Inline makes the key of the node hold the encoded node itself, through
+// an identity multihash, if it encodes to at most limit bytes. Inlined
+// nodes are never stored: their parents carry them in their links. The
+// limit is capped at blocks.MaxIdentitySize.
+func (n *Node) Inline(limit int) (bool, error) {
+	if limit > blocks.MaxIdentitySize {
+		limit = blocks.MaxIdentitySize
+	}
+	enc, err := n.Encoded(false)
+	if err != nil {
+		return false, err
+	}
+	if len(enc) == 0 || len(enc) > limit {
+		return false, nil
+	}
+	n.inline = true
+	n.encoded = nil
+	return true, nil
+}
+
+// IsInline reports whether the key of the node holds the node, see Inline.
+func (n *Node) IsInline() bool {
+	return n.inline
 }
 
 // NodeStat is a statistics object for a Node. Mostly sizes.
@@ -47,6 +105,9 @@ type Link struct {
 	// multihash of the target object
 	Hash mh.Multihash
 
+	// the target object is a raw node
+	Raw bool
+
 	// a ptr to the actual node for graph manipulation
 	Node *Node
 }
@@ -71,6 +132,7 @@ func MakeLink(n *Node) (*Link, error) {
 	return &Link{
 		Size: s,
 		Hash: h,
+		Raw:  n.raw,
 	}, nil
 }
 
@@ -80,6 +142,9 @@ func (l *Link) GetNode(ctx context.Context, serv DAGService) (*Node, error) {
 		return l.Node, nil
 	}
 
+	if l.Raw {
+		return serv.GetRaw(ctx, key.Key(l.Hash))
+	}
 	return serv.Get(ctx, key.Key(l.Hash))
 }
 
@@ -88,16 +153,12 @@ func (n *Node) AddNodeLink(name string, that *Node) error {
 	n.encoded = nil
 
 	lnk, err := MakeLink(that)
-
-	lnk.Name = name
-	lnk.Node = that
 	if err != nil {
 		return err
 	}
+	lnk.Node = that
 
-	n.AddRawLink(name, lnk)
-
-	return nil
+	return n.AddRawLink(name, lnk)
 }
 
 // AddNodeLinkClean adds a link to another node. without keeping a reference to
@@ -108,18 +169,23 @@ func (n *Node) AddNodeLinkClean(name string, that *Node) error {
 	if err != nil {
 		return err
 	}
-	n.AddRawLink(name, lnk)
-
-	return nil
+	return n.AddRawLink(name, lnk)
 }
 
 // AddRawLink adds a copy of a link to this node
 func (n *Node) AddRawLink(name string, l *Link) error {
+	if n.raw {
+		return ErrRawLinks
+	}
+	if n.doc {
+		return ErrDocumentLinks
+	}
 	n.encoded = nil
 	n.Links = append(n.Links, &Link{
 		Name: name,
 		Size: l.Size,
 		Hash: l.Hash,
+		Raw:  l.Raw,
 		Node: l.Node,
 	})
 
@@ -128,6 +194,9 @@ func (n *Node) AddRawLink(name string, l *Link) error {
 
 // Remove a link on this node by the given name
 func (n *Node) RemoveNodeLink(name string) error {
+	if n.doc {
+		return ErrDocumentLinks
+	}
 	n.encoded = nil
 	good := make([]*Link, 0, len(n.Links))
 	var found bool
@@ -156,6 +225,7 @@ func (n *Node) GetNodeLink(name string) (*Link, error) {
 				Name: l.Name,
 				Size: l.Size,
 				Hash: l.Hash,
+				Raw:  l.Raw,
 				Node: l.Node,
 			}, nil
 		}
@@ -176,6 +246,10 @@ func (n *Node) GetLinkedNode(ctx context.Context, ds DAGService, name string) (*
 // NOTE: does not make copies of Node objects in the links.
 func (n *Node) Copy() *Node {
 	nnode := new(Node)
+	nnode.hashFn = n.hashFn
+	nnode.raw = n.raw
+	nnode.inline = n.inline
+	nnode.doc = n.doc
 	nnode.Data = make([]byte, len(n.Data))
 	copy(nnode.Data, n.Data)
 
@@ -247,6 +321,23 @@ func (n *Node) Multihash() (mh.Multihash, error) {
 	return n.cached, nil
 }
 
+// HashFunc returns the multihash function the node is hashed with.
+func (n *Node) HashFunc() int {
+	if n.hashFn == 0 {
+		return mh.SHA2_256
+	}
+	return n.hashFn
+}
+
+// SetHashFunc sets the multihash function used to compute the hash of the
+// node. Children keep their own hash function.
+func (n *Node) SetHashFunc(code int) {
+	if code != n.hashFn {
+		n.hashFn = code
+		n.encoded = nil
+	}
+}
+
 // Key returns the Multihash as a key, for maps.
 func (n *Node) Key() (key.Key, error) {
 	h, err := n.Multihash()
@@ -1,6 +1,9 @@
 package merkledag
 
 import (
+	"bytes"
+	"encoding/json"
+	"reflect"
 	"testing"
 )
 
@@ -52,3 +55,62 @@ func TestRemoveLink(t *testing.T) {
 		t.Fatal("link order wrong")
 	}
 }
+
+func TestDocumentNode(t *testing.T) {
+	child := &Node{Data: []byte("child")}
+	ck, err := child.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := map[string]interface{}{
+		"title": "doc",
+		"count": json.Number("12345678901234567890"),
+		"meta": map[string]interface{}{
+			"authors": []interface{}{"a", DocLink{Hash: ck, Size: 12}},
+		},
+	}
+	nd, err := NewDocumentNode(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !nd.IsDocument() {
+		t.Fatal("not a document node")
+	}
+	if l, err := nd.GetNodeLink("meta/authors/1"); err != nil || l.Size != 12 {
+		t.Fatal("link of the document not in the links of the node")
+	}
+	if err := nd.AddNodeLink("other", child); err != ErrDocumentLinks {
+		t.Fatalf("expected %s, got %v", ErrDocumentLinks, err)
+	}
+
+	// the document survives encoding, large numbers included
+	enc, err := nd.Encoded(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := Decoded(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dec.IsDocument() || !bytes.HasPrefix(enc, documentHeader) {
+		t.Fatal("not encoded as a document")
+	}
+	if l, err := dec.GetNodeLink("meta/authors/1"); err != nil || l.Size != 12 {
+		t.Fatal("links of the document not decoded")
+	}
+	out, err := dec.Document()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, doc) {
+		t.Fatalf("got document %v, expected %v", out, doc)
+	}
+
+	if _, err := NewDocumentNode(map[string]interface{}{"a/b": 1}); err == nil {
+		t.Fatal("accepted a key holding a slash")
+	}
+	if _, err := (&Node{Data: []byte("data")}).Document(); err != ErrNotDocument {
+		t.Fatalf("expected %s, got %v", ErrNotDocument, err)
+	}
+}
@@ -42,8 +42,10 @@ type PBLink struct {
 	// utf string name. should be unique per object
 	Name *string `protobuf:"bytes,2,opt" json:"Name,omitempty"`
 	// cumulative size of target object
-	Tsize            *uint64 `protobuf:"varint,3,opt" json:"Tsize,omitempty"`
-	XXX_unrecognized []byte  `json:"-"`
+	Tsize *uint64 `protobuf:"varint,3,opt" json:"Tsize,omitempty"`
+	// the target object is a raw block, not a PBNode
+	Raw              *bool  `protobuf:"varint,4,opt" json:"Raw,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *PBLink) Reset()      { *m = PBLink{} }
@@ -70,6 +72,13 @@ func (m *PBLink) GetTsize() uint64 {
 	return 0
 }
 
+func (m *PBLink) GetRaw() bool {
+	if m != nil && m.Raw != nil {
+		return *m.Raw
+	}
+	return false
+}
+
 // An IPFS MerkleDAG Node
 type PBNode struct {
 	// refs to other objects
@@ -179,6 +188,24 @@ func (m *PBLink) Unmarshal(data []byte) error {
 				}
 			}
 			m.Tsize = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Raw = &b
 		default:
 			var sizeOfWire int
 			for {
@@ -297,6 +324,7 @@ func (this *PBLink) String() string {
 		`Hash:` + valueToStringMerkledag(this.Hash) + `,`,
 		`Name:` + valueToStringMerkledag(this.Name) + `,`,
 		`Tsize:` + valueToStringMerkledag(this.Tsize) + `,`,
+		`Raw:` + valueToStringMerkledag(this.Raw) + `,`,
 		`XXX_unrecognized:` + fmt.Sprintf("%v", this.XXX_unrecognized) + `,`,
 		`}`,
 	}, "")
@@ -336,6 +364,9 @@ func (m *PBLink) Size() (n int) {
 	if m.Tsize != nil {
 		n += 1 + sovMerkledag(uint64(*m.Tsize))
 	}
+	if m.Raw != nil {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -391,8 +422,12 @@ func NewPopulatedPBLink(r randyMerkledag, easy bool) *PBLink {
 		v3 := uint64(r.Uint32())
 		this.Tsize = &v3
 	}
+	if r.Intn(10) != 0 {
+		v4 := bool(r.Intn(2) == 0)
+		this.Raw = &v4
+	}
 	if !easy && r.Intn(10) != 0 {
-		this.XXX_unrecognized = randUnrecognizedMerkledag(r, 4)
+		this.XXX_unrecognized = randUnrecognizedMerkledag(r, 5)
 	}
 	return this
 }
@@ -517,6 +552,16 @@ func (m *PBLink) MarshalTo(data []byte) (n int, err error) {
 		i++
 		i = encodeVarintMerkledag(data, i, uint64(*m.Tsize))
 	}
+	if m.Raw != nil {
+		data[i] = 0x20
+		i++
+		if *m.Raw {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -597,6 +642,7 @@ func (this *PBLink) GoString() string {
 		`Hash:` + valueToGoStringMerkledag(this.Hash, "byte"),
 		`Name:` + valueToGoStringMerkledag(this.Name, "string"),
 		`Tsize:` + valueToGoStringMerkledag(this.Tsize, "uint64"),
+		`Raw:` + valueToGoStringMerkledag(this.Raw, "bool"),
 		`XXX_unrecognized:` + fmt.Sprintf("%#v", this.XXX_unrecognized) + `}`}, ", ")
 	return s
 }
@@ -676,6 +722,15 @@ func (this *PBLink) VerboseEqual(that interface{}) error {
 	} else if that1.Tsize != nil {
 		return fmt.Errorf("Tsize this(%v) Not Equal that(%v)", this.Tsize, that1.Tsize)
 	}
+	if this.Raw != nil && that1.Raw != nil {
+		if *this.Raw != *that1.Raw {
+			return fmt.Errorf("Raw this(%v) Not Equal that(%v)", *this.Raw, *that1.Raw)
+		}
+	} else if this.Raw != nil {
+		return fmt.Errorf("this.Raw == nil && that.Raw != nil")
+	} else if that1.Raw != nil {
+		return fmt.Errorf("Raw this(%v) Not Equal that(%v)", this.Raw, that1.Raw)
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return fmt.Errorf("XXX_unrecognized this(%v) Not Equal that(%v)", this.XXX_unrecognized, that1.XXX_unrecognized)
 	}
@@ -722,6 +777,15 @@ func (this *PBLink) Equal(that interface{}) bool {
 	} else if that1.Tsize != nil {
 		return false
 	}
+	if this.Raw != nil && that1.Raw != nil {
+		if *this.Raw != *that1.Raw {
+			return false
+		}
+	} else if this.Raw != nil {
+		return false
+	} else if that1.Raw != nil {
+		return false
+	}
 	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
 		return false
 	}
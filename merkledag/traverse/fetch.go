@@ -0,0 +1,143 @@
+package traverse
+
+import (
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	mdag "github.com/ipfs/go-ipfs/merkledag"
+)
+
+// DefaultConcurrency is the number of nodes Fetch requests at once by
+// default.
+const DefaultConcurrency = 32
+
+// FetchOptions specifies how Fetch walks a dag.
+type FetchOptions struct {
+	DAG mdag.DAGService // the dagservice to fetch nodes
+
+	// Concurrency is the number of nodes requested at once,
+	// DefaultConcurrency if not positive.
+	Concurrency int
+
+	// Local, if set, is checked for the nodes first: those it has are
+	// read directly, and only the missing ones are requested, Concurrency
+	// at a time.
+	Local bstore.Blockstore
+
+	// MaxDepth, if positive, is the depth of the deepest nodes fetched.
+	MaxDepth int
+
+	// Visit, if set, is called with every node below root, once each, as
+	// it is fetched. An error stops the walk.
+	Visit func(*mdag.Node) error
+}
+
+type fetchJob struct {
+	link  *mdag.Link
+	depth int
+}
+
+type fetchResult struct {
+	node  *mdag.Node
+	depth int
+	err   error
+}
+
+// Fetch fetches the nodes below root, so that they can be read locally
+// afterwards. Nodes are requested in parallel, each only once, in no
+// particular order. It returns the first error met, after which nothing
+// more is requested, or the error of ctx once it is done.
+func Fetch(ctx context.Context, root *mdag.Node, o FetchOptions) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := o.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	rk, err := root.Key()
+	if err != nil {
+		return err
+	}
+	seen := map[key.Key]struct{}{rk: struct{}{}}
+
+	var queue []fetchJob
+	enqueue := func(nd *mdag.Node, depth int) error {
+		if depth > 0 && o.Visit != nil {
+			if err := o.Visit(nd); err != nil {
+				return err
+			}
+		}
+		if o.MaxDepth > 0 && depth >= o.MaxDepth {
+			return nil
+		}
+		for _, l := range nd.Links {
+			k := key.Key(l.Hash)
+			if _, found := seen[k]; found {
+				continue
+			}
+			seen[k] = struct{}{}
+			queue = append(queue, fetchJob{link: l, depth: depth + 1})
+		}
+		return nil
+	}
+	if err := enqueue(root, 0); err != nil {
+		return err
+	}
+
+	results := make(chan fetchResult)
+	inflight := 0
+	for len(queue) > 0 || inflight > 0 {
+		for len(queue) > 0 && inflight < concurrency {
+			j := queue[0]
+			queue = queue[1:]
+
+			if o.Local != nil {
+				has, err := o.Local.Has(key.Key(j.link.Hash))
+				if err != nil {
+					return err
+				}
+				if has {
+					nd, err := j.link.GetNode(ctx, o.DAG)
+					if err != nil {
+						return err
+					}
+					if err := enqueue(nd, j.depth); err != nil {
+						return err
+					}
+					continue
+				}
+			}
+
+			inflight++
+			go func(j fetchJob) {
+				nd, err := j.link.GetNode(ctx, o.DAG)
+				select {
+				case results <- fetchResult{node: nd, depth: j.depth, err: err}:
+				case <-ctx.Done():
+				}
+			}(j)
+		}
+
+		if inflight == 0 {
+			// all read locally
+			continue
+		}
+
+		select {
+		case r := <-results:
+			inflight--
+			if r.err != nil {
+				return r.err
+			}
+			if err := enqueue(r.node, r.depth); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package traverse
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	ds "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	dssync "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/sync"
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	bserv "github.com/ipfs/go-ipfs/blockservice"
+	offline "github.com/ipfs/go-ipfs/exchange/offline"
+	mdag "github.com/ipfs/go-ipfs/merkledag"
+)
+
+// countingDAG counts the gets of each key, and the most made at once.
+type countingDAG struct {
+	mdag.DAGService
+
+	lock     sync.Mutex
+	gets     map[key.Key]int
+	inflight int
+	max      int
+}
+
+func (c *countingDAG) Get(ctx context.Context, k key.Key) (*mdag.Node, error) {
+	c.lock.Lock()
+	c.gets[k]++
+	c.inflight++
+	if c.inflight > c.max {
+		c.max = c.inflight
+	}
+	c.lock.Unlock()
+
+	time.Sleep(time.Millisecond)
+	nd, err := c.DAGService.Get(ctx, k)
+
+	c.lock.Lock()
+	c.inflight--
+	c.lock.Unlock()
+	return nd, err
+}
+
+// newFetchDAG returns a dag of depth levels, where each node links to the
+// same two children twice.
+func newFetchDAG(t *testing.T, dserv mdag.DAGService, depth int) *mdag.Node {
+	nd := &mdag.Node{Data: []byte("leaf")}
+	for d := 0; d < depth; d++ {
+		a := &mdag.Node{Data: []byte(fmt.Sprintf("%d/a", d))}
+		b := &mdag.Node{Data: []byte(fmt.Sprintf("%d/b", d))}
+		for _, p := range []*mdag.Node{a, b} {
+			if err := p.AddNodeLinkClean("x", nd); err != nil {
+				t.Fatal(err)
+			}
+			if err := p.AddNodeLinkClean("y", nd); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := dserv.Add(p); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := dserv.Add(nd); err != nil {
+			t.Fatal(err)
+		}
+
+		nd = &mdag.Node{Data: []byte(fmt.Sprintf("%d", d))}
+		if err := nd.AddNodeLinkClean("a", a); err != nil {
+			t.Fatal(err)
+		}
+		if err := nd.AddNodeLinkClean("b", b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return nd
+}
+
+func TestFetch(t *testing.T) {
+	bs := bstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+	dserv := mdag.NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+	root := newFetchDAG(t, dserv, 6)
+
+	c := &countingDAG{DAGService: dserv, gets: make(map[key.Key]int)}
+	err := Fetch(context.Background(), root, FetchOptions{DAG: c, Concurrency: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.gets) != 6*3 {
+		t.Fatalf("fetched %d nodes, expected %d", len(c.gets), 6*3)
+	}
+	for k, n := range c.gets {
+		if n != 1 {
+			t.Fatalf("%s fetched %d times", k, n)
+		}
+	}
+	if c.max > 3 {
+		t.Fatalf("%d fetches at once, expected at most 3", c.max)
+	}
+
+	// nodes found locally are read one at a time
+	c = &countingDAG{DAGService: dserv, gets: make(map[key.Key]int)}
+	err = Fetch(context.Background(), root, FetchOptions{DAG: c, Concurrency: 3, Local: bs, MaxDepth: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.gets) != 2+1 {
+		t.Fatalf("fetched %d nodes down to depth 2, expected 3", len(c.gets))
+	}
+	if c.max != 1 {
+		t.Fatalf("%d local reads at once", c.max)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	missing := newFetchDAG(t, dserv, 1)
+	other := bstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+	empty := mdag.NewDAGService(bserv.New(other, offline.Exchange(other)))
+	if err := Fetch(ctx, missing, FetchOptions{DAG: empty}); err == nil {
+		t.Fatal("fetched from an empty dag")
+	}
+}
+
+func TestFetchVisit(t *testing.T) {
+	bs := bstore.NewBlockstore(dssync.MutexWrap(ds.NewMapDatastore()))
+	dserv := mdag.NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+	root := newFetchDAG(t, dserv, 4)
+
+	visited := make(map[key.Key]int)
+	err := Fetch(context.Background(), root, FetchOptions{
+		DAG: dserv,
+		Visit: func(nd *mdag.Node) error {
+			k, err := nd.Key()
+			if err != nil {
+				return err
+			}
+			visited[k]++
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visited) != 4*3 {
+		t.Fatalf("visited %d nodes, expected %d", len(visited), 4*3)
+	}
+	for k, n := range visited {
+		if n != 1 {
+			t.Fatalf("%s visited %d times", k, n)
+		}
+	}
+
+	stop := fmt.Errorf("stop")
+	err = Fetch(context.Background(), root, FetchOptions{
+		DAG:   dserv,
+		Visit: func(*mdag.Node) error { return stop },
+	})
+	if err != stop {
+		t.Fatalf("expected the error of Visit, got %v", err)
+	}
+}
@@ -25,31 +25,50 @@ type Options struct {
 	Func    Func            // the function to perform at each step
 	ErrFunc ErrFunc         // see ErrFunc. Optional
 
-	SkipDuplicates bool // whether to skip duplicate nodes
+	// SkipDuplicates skips the nodes visited before. With MaxDepth, a node
+	// is only skipped if it was visited at the same depth or a shallower
+	// one: reached shallower than before, it is visited again, so that its
+	// children within MaxDepth are.
+	SkipDuplicates bool
+
+	// Seen holds the keys of the nodes visited with SkipDuplicates, and the
+	// shallowest depth they were visited at, so that duplicates are skipped
+	// across several traversals sharing it. Optional.
+	Seen map[string]int
+
+	// MaxDepth, if positive, is the depth of the deepest nodes visited:
+	// their children are not fetched.
+	MaxDepth int
 }
 
 // State is a current traversal state
 type State struct {
 	Node  *mdag.Node
 	Depth int
+
+	// Parent is the node linking to Node through Link, both nil for the
+	// root.
+	Parent *mdag.Node
+	Link   *mdag.Link
 }
 
 type traversal struct {
 	opts Options
-	seen map[string]struct{}
+	seen map[string]int
 }
 
-func (t *traversal) shouldSkip(n *mdag.Node) (bool, error) {
+// shouldSkip reports whether to skip the node n, reached at depth.
+func (t *traversal) shouldSkip(n *mdag.Node, depth int) (bool, error) {
 	if t.opts.SkipDuplicates {
 		k, err := n.Key()
 		if err != nil {
 			return true, err
 		}
 
-		if _, found := t.seen[string(k)]; found {
+		if d, found := t.seen[string(k)]; found && (t.opts.MaxDepth <= 0 || d <= depth) {
 			return true, nil
 		}
-		t.seen[string(k)] = struct{}{}
+		t.seen[string(k)] = depth
 	}
 
 	return false, nil
@@ -59,11 +78,16 @@ func (t *traversal) callFunc(next State) error {
 	return t.opts.Func(next)
 }
 
-// getNode returns the node for link. If it return an error,
+// descend reports whether to visit the children of the node at state.
+func (t *traversal) descend(state State) bool {
+	return t.opts.MaxDepth <= 0 || state.Depth < t.opts.MaxDepth
+}
+
+// getNode returns the node for link, reached at depth. If it return an error,
 // stop processing. if it returns a nil node, just skip it.
 //
 // the error handling is a little complicated.
-func (t *traversal) getNode(link *mdag.Link) (*mdag.Node, error) {
+func (t *traversal) getNode(link *mdag.Link, depth int) (*mdag.Node, error) {
 
 	getNode := func(l *mdag.Link) (*mdag.Node, error) {
 		next, err := l.GetNode(context.TODO(), t.opts.DAG)
@@ -71,7 +95,7 @@ func (t *traversal) getNode(link *mdag.Link) (*mdag.Node, error) {
 			return nil, err
 		}
 
-		skip, err := t.shouldSkip(next)
+		skip, err := t.shouldSkip(next, depth)
 		if skip {
 			next = nil
 		}
@@ -106,7 +130,10 @@ type ErrFunc func(err error) error
 func Traverse(root *mdag.Node, o Options) error {
 	t := traversal{
 		opts: o,
-		seen: map[string]struct{}{},
+		seen: o.Seen,
+	}
+	if t.seen == nil {
+		t.seen = map[string]int{}
 	}
 
 	state := State{
@@ -149,8 +176,11 @@ func dfsPostTraverse(state State, t *traversal) error {
 }
 
 func dfsDescend(df dfsFunc, curr State, t *traversal) error {
+	if !t.descend(curr) {
+		return nil
+	}
 	for _, l := range curr.Node.Links {
-		node, err := t.getNode(l)
+		node, err := t.getNode(l, curr.Depth+1)
 		if err != nil {
 			return err
 		}
@@ -159,8 +189,10 @@ func dfsDescend(df dfsFunc, curr State, t *traversal) error {
 		}
 
 		next := State{
-			Node:  node,
-			Depth: curr.Depth + 1,
+			Node:   node,
+			Depth:  curr.Depth + 1,
+			Parent: curr.Node,
+			Link:   l,
 		}
 		if err := df(next, t); err != nil {
 			return err
@@ -171,7 +203,7 @@ func dfsDescend(df dfsFunc, curr State, t *traversal) error {
 
 func bfsTraverse(root State, t *traversal) error {
 
-	if skip, err := t.shouldSkip(root.Node); skip || err != nil {
+	if skip, err := t.shouldSkip(root.Node, root.Depth); skip || err != nil {
 		return err
 	}
 
@@ -188,8 +220,11 @@ func bfsTraverse(root State, t *traversal) error {
 			return err
 		}
 
+		if !t.descend(curr) {
+			continue
+		}
 		for _, l := range curr.Node.Links {
-			node, err := t.getNode(l)
+			node, err := t.getNode(l, curr.Depth+1)
 			if err != nil {
 				return err
 			}
@@ -198,8 +233,10 @@ func bfsTraverse(root State, t *traversal) error {
 			}
 
 			q.enq(State{
-				Node:  node,
-				Depth: curr.Depth + 1,
+				Node:   node,
+				Depth:  curr.Depth + 1,
+				Parent: curr.Node,
+				Link:   l,
 			})
 		}
 	}
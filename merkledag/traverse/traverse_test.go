@@ -314,6 +314,67 @@ func TestBFSSkip(t *testing.T) {
 `))
 }
 
+func TestMaxDepth(t *testing.T) {
+	testWalkOutputs(t, newLinkedList(t), Options{Order: DFSPre, MaxDepth: 2}, []byte(`
+0 /a
+1 /a/aa
+2 /a/aa/aaa
+`))
+
+	testWalkOutputs(t, newBinaryTree(t), Options{Order: BFS, MaxDepth: 1}, []byte(`
+0 /a
+1 /a/aa
+1 /a/ab
+`))
+}
+
+func TestMaxDepthSkipDuplicates(t *testing.T) {
+	// aaa is reached at the depth limit first, then shallower through a
+	// link of a, where its child is within the limit
+	a := newLinkedList(t)
+	aaa := a.Links[0].Node.Links[0].Node
+	addLink(t, a, aaa)
+
+	testWalkOutputs(t, a, Options{Order: DFSPre, MaxDepth: 2, SkipDuplicates: true}, []byte(`
+0 /a
+1 /a/aa
+2 /a/aa/aaa
+1 /a/aa/aaa
+2 /a/aa/aaa/aaaa
+`))
+
+	// reached deeper than before, it is skipped
+	testWalkOutputs(t, newBinaryDAG(t), Options{Order: DFSPre, MaxDepth: 3, SkipDuplicates: true}, []byte(`
+0 /a
+1 /a/aa
+2 /a/aa/aaa
+3 /a/aa/aaa/aaaa
+`))
+}
+
+func TestStateLinks(t *testing.T) {
+	root := newBinaryTree(t)
+	err := Traverse(root, Options{
+		Order: DFSPre,
+		Func: func(s State) error {
+			if s.Depth == 0 {
+				if s.Parent != nil || s.Link != nil {
+					t.Error("root has a parent")
+				}
+				return nil
+			}
+			exp := string(s.Parent.Data) + "2" + string(s.Node.Data)
+			if s.Link.Name != exp {
+				t.Errorf("reached %s through %s", s.Node.Data, s.Link.Name)
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testWalkOutputs(t *testing.T, root *mdag.Node, opts Options, expect []byte) {
 	expect = bytes.TrimLeft(expect, "\n")
 
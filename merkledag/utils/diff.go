@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"path"
+	"sort"
+	"strings"
 
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 	key "github.com/ipfs/go-ipfs/blocks/key"
 	dag "github.com/ipfs/go-ipfs/merkledag"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
 )
 
 const (
@@ -36,7 +40,18 @@ func (c *Change) String() string {
 	}
 }
 
+// ApplyChange applies the changes cs to a copy of the tree below nd, and
+// returns the root of the changed tree. nd is left as it is.
 func ApplyChange(ctx context.Context, ds dag.DAGService, nd *dag.Node, cs []*Change) (*dag.Node, error) {
+	// the editor changes the nodes it walks through in place, so it works on
+	// a copy whose links do not share the nodes they cache with nd
+	nd = nd.Copy()
+	for i, l := range nd.Links {
+		cl := *l
+		cl.Node = nil
+		nd.Links[i] = &cl
+	}
+
 	e := NewDagEditor(ds, nd)
 	for _, c := range cs {
 		switch c.Type {
@@ -74,87 +89,227 @@ func ApplyChange(ctx context.Context, ds dag.DAGService, nd *dag.Node, cs []*Cha
 	return e.GetNode(), nil
 }
 
-func Diff(ctx context.Context, ds dag.DAGService, a, b *dag.Node) []*Change {
-	if len(a.Links) == 0 && len(b.Links) == 0 {
-		ak, _ := a.Key()
-		bk, _ := b.Key()
-		return []*Change{
-			&Change{
-				Type:   Mod,
-				Before: ak,
-				After:  bk,
-			},
-		}
+// Diff returns the changes turning the tree below a into the tree below b,
+// as found by DiffFunc.
+func Diff(ctx context.Context, ds dag.DAGService, a, b *dag.Node) ([]*Change, error) {
+	var out []*Change
+	err := DiffFunc(ctx, ds, a, b, func(c *Change) error {
+		out = append(out, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return out, nil
+}
 
-	var out []*Change
-	clean_a := a.Copy()
-	clean_b := b.Copy()
-
-	// strip out unchanged stuff
-	for _, lnk := range a.Links {
-		l, err := b.GetNodeLink(lnk.Name)
-		if err == nil {
-			if bytes.Equal(l.Hash, lnk.Hash) {
-				// no change... ignore it
-			} else {
-				anode, _ := lnk.GetNode(ctx, ds)
-				bnode, _ := l.GetNode(ctx, ds)
-				sub := Diff(ctx, ds, anode, bnode)
-
-				for _, subc := range sub {
-					subc.Path = path.Join(lnk.Name, subc.Path)
-					out = append(out, subc)
-				}
-			}
-			clean_a.RemoveNodeLink(l.Name)
-			clean_b.RemoveNodeLink(l.Name)
-		}
+// DiffFunc compares the trees below a and b, calling fn with each change as
+// it is found so that huge trees are never held whole. The entries of unixfs
+// directories, sharded or not, and the links of objects that are not unixfs
+// are compared by name. Files are compared as a whole: the chunks they are
+// made of are not entries, so a modified file is a single Mod change.
+// Errors fetching nodes, and errors returned by fn, stop the comparison and
+// are returned.
+func DiffFunc(ctx context.Context, ds dag.DAGService, a, b *dag.Node, fn func(*Change) error) error {
+	return diff(ctx, ds, "", a, b, fn)
+}
+
+func diff(ctx context.Context, ds dag.DAGService, pth string, a, b *dag.Node, fn func(*Change) error) error {
+	ak, err := a.Key()
+	if err != nil {
+		return err
+	}
+	bk, err := b.Key()
+	if err != nil {
+		return err
+	}
+	if ak == bk {
+		return nil
 	}
 
-	for _, lnk := range clean_a.Links {
-		out = append(out, &Change{
-			Type:   Remove,
-			Path:   lnk.Name,
-			Before: key.Key(lnk.Hash),
+	if !hasEntries(a) || !hasEntries(b) {
+		return fn(&Change{
+			Type:   Mod,
+			Path:   pth,
+			Before: ak,
+			After:  bk,
 		})
 	}
-	for _, lnk := range clean_b.Links {
-		out = append(out, &Change{
+
+	aents, err := entries(ctx, ds, a)
+	if err != nil {
+		return err
+	}
+	bents, err := entries(ctx, ds, b)
+	if err != nil {
+		return err
+	}
+
+	added := make(map[string]*dag.Link, len(bents))
+	for _, l := range bents {
+		added[l.Name] = l
+	}
+
+	for _, al := range aents {
+		bl, ok := added[al.Name]
+		if !ok {
+			err := fn(&Change{
+				Type:   Remove,
+				Path:   path.Join(pth, al.Name),
+				Before: key.Key(al.Hash),
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		delete(added, al.Name)
+
+		if bytes.Equal(al.Hash, bl.Hash) {
+			continue
+		}
+		anode, err := al.GetNode(ctx, ds)
+		if err != nil {
+			return err
+		}
+		bnode, err := bl.GetNode(ctx, ds)
+		if err != nil {
+			return err
+		}
+		if err := diff(ctx, ds, path.Join(pth, al.Name), anode, bnode, fn); err != nil {
+			return err
+		}
+	}
+
+	// in the order of b
+	for _, bl := range bents {
+		if added[bl.Name] != bl {
+			continue
+		}
+		err := fn(&Change{
 			Type:  Add,
-			Path:  lnk.Name,
-			After: key.Key(lnk.Hash),
+			Path:  path.Join(pth, bl.Name),
+			After: key.Key(bl.Hash),
 		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasEntries reports whether the links of nd are entries compared by
+// name: nd is a unixfs directory, or an object that is not unixfs with
+// named links.
+func hasEntries(nd *dag.Node) bool {
+	if ft.IsDir(nd) {
+		return true
+	}
+	if nd.IsRaw() || len(nd.Links) == 0 {
+		return false
+	}
+	if _, err := ft.FromNode(nd); err == nil {
+		// files, symlinks and metadata are compared as a whole
+		return false
 	}
+	for _, l := range nd.Links {
+		if l.Name == "" {
+			return false
+		}
+	}
+	return true
+}
 
-	return out
+// entries returns the links to the entries of nd.
+func entries(ctx context.Context, ds dag.DAGService, nd *dag.Node) ([]*dag.Link, error) {
+	if ft.IsDir(nd) {
+		return uio.DirEntries(ctx, ds, nd)
+	}
+	return nd.Links, nil
 }
 
+// Conflict is a pair of changes that can not both be applied: they are
+// different changes of the same path, or one of them changes an entry the
+// other one changes something below.
 type Conflict struct {
 	A *Change
 	B *Change
 }
 
+// MergeDiffs merges the changes a and b made to the same tree. It returns
+// the changes that can be applied together, a change made in both only
+// once, and the pairs of changes in conflict.
 func MergeDiffs(a, b []*Change) ([]*Change, []Conflict) {
-	var out []*Change
-	var conflicts []Conflict
-	paths := make(map[string]*Change)
-	for _, c := range a {
-		paths[c.Path] = c
+	bpaths := make(map[string]*Change, len(b))
+	sorted := make([]string, 0, len(b))
+	for _, c := range b {
+		bpaths[c.Path] = c
+		sorted = append(sorted, c.Path)
 	}
+	sort.Strings(sorted)
 
-	for _, c := range b {
-		if ca, ok := paths[c.Path]; ok {
-			conflicts = append(conflicts, Conflict{
-				A: ca,
-				B: c,
-			})
-		} else {
-			out = append(out, c)
+	var conflicts []Conflict
+	skip := make(map[*Change]bool) // changes of b in conflict or in a
+	var out []*Change
+	for _, ca := range a {
+		conflict := false
+
+		// the same path and the entries above it
+		for p := ca.Path; ; p = parentPath(p) {
+			if cb, ok := bpaths[p]; ok {
+				if p == ca.Path && sameChange(ca, cb) {
+					skip[cb] = true
+				} else {
+					conflicts = append(conflicts, Conflict{A: ca, B: cb})
+					skip[cb] = true
+					conflict = true
+				}
+			}
+			if p == "" {
+				break
+			}
+		}
+
+		// the entries below it
+		prefix := ca.Path + "/"
+		if ca.Path == "" {
+			prefix = ""
+		}
+		for i := sort.SearchStrings(sorted, prefix); i < len(sorted) && strings.HasPrefix(sorted[i], prefix); i++ {
+			if sorted[i] == ca.Path {
+				continue
+			}
+			cb := bpaths[sorted[i]]
+			conflicts = append(conflicts, Conflict{A: ca, B: cb})
+			skip[cb] = true
+			conflict = true
+		}
+
+		if !conflict {
+			out = append(out, ca)
 		}
 	}
-	for _, c := range paths {
-		out = append(out, c)
+
+	for _, cb := range b {
+		if !skip[cb] {
+			out = append(out, cb)
+		}
 	}
 	return out, conflicts
 }
+
+// sameChange reports whether a and b make the same change.
+func sameChange(a, b *Change) bool {
+	return a.Type == b.Type && a.Path == b.Path && a.After == b.After
+}
+
+// parentPath returns the path of the entry holding the entry at p, "" for
+// the root.
+func parentPath(p string) string {
+	dir := path.Dir(p)
+	if dir == "." || dir == "/" {
+		return ""
+	}
+	return dir
+}
@@ -0,0 +1,196 @@
+package dagutils
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	importer "github.com/ipfs/go-ipfs/importer"
+	chunk "github.com/ipfs/go-ipfs/importer/chunk"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	mdtest "github.com/ipfs/go-ipfs/merkledag/test"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+)
+
+func diffFile(t *testing.T, ds dag.DAGService, data []byte) *dag.Node {
+	nd, err := importer.BuildDagFromReader(ds, chunk.NewSizeSplitter(bytes.NewReader(data), 512), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nd
+}
+
+func diffDir(t *testing.T, ds dag.DAGService, entries map[string]*dag.Node) *dag.Node {
+	nd := &dag.Node{Data: ft.FolderPBData()}
+	for name, child := range entries {
+		if err := nd.AddNodeLinkClean(name, child); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := ds.Add(nd); err != nil {
+		t.Fatal(err)
+	}
+	return nd
+}
+
+func nodeKey(t *testing.T, nd *dag.Node) key.Key {
+	k, err := nd.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func TestDiffUnixfs(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+
+	big := make([]byte, 4096)
+	fa := diffFile(t, ds, big)
+	big[2000] = 1
+	fb := diffFile(t, ds, big)
+	z := diffFile(t, ds, []byte("z"))
+	w := diffFile(t, ds, []byte("w"))
+
+	a := diffDir(t, ds, map[string]*dag.Node{
+		"x": fa,
+		"y": diffDir(t, ds, map[string]*dag.Node{"z": z}),
+	})
+	b := diffDir(t, ds, map[string]*dag.Node{
+		"x": fb,
+		"y": diffDir(t, ds, nil),
+		"w": w,
+	})
+
+	changes, err := Diff(ctx, ds, a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := map[string]Change{
+		"x":   {Type: Mod, Path: "x", Before: nodeKey(t, fa), After: nodeKey(t, fb)},
+		"y/z": {Type: Remove, Path: "y/z", Before: nodeKey(t, z)},
+		"w":   {Type: Add, Path: "w", After: nodeKey(t, w)},
+	}
+	if len(changes) != len(exp) {
+		t.Fatalf("expected %d changes, got %d: %v", len(exp), len(changes), changes)
+	}
+	for _, c := range changes {
+		e, ok := exp[c.Path]
+		if !ok || *c != e {
+			t.Fatalf("unexpected change %s", c)
+		}
+	}
+
+	same, err := Diff(ctx, ds, a, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(same) != 0 {
+		t.Fatalf("changes between identical trees: %v", same)
+	}
+}
+
+func TestDiffErrors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ds := mdtest.Mock()
+
+	// the entries of the directories are not in ds
+	missing := &dag.Node{Data: []byte("missing")}
+	a := &dag.Node{Data: ft.FolderPBData()}
+	b := &dag.Node{Data: ft.FolderPBData()}
+	if err := a.AddNodeLinkClean("m", missing); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.AddNodeLinkClean("m", diffFile(t, ds, []byte("m"))); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Diff(ctx, ds, a, b); err == nil {
+		t.Fatal("expected an error fetching a missing node")
+	}
+
+	stop := errors.New("stop")
+	b2 := diffDir(t, ds, map[string]*dag.Node{"n": diffFile(t, ds, []byte("n"))})
+	err := DiffFunc(context.Background(), ds, diffDir(t, ds, nil), b2, func(*Change) error {
+		return stop
+	})
+	if err != stop {
+		t.Fatalf("expected %s, got %v", stop, err)
+	}
+}
+
+func TestMergeDiffsConflicts(t *testing.T) {
+	same := &Change{Type: Remove, Path: "x", Before: "k1"}
+	rmDir := &Change{Type: Remove, Path: "d", Before: "k2"}
+	modChild := &Change{Type: Mod, Path: "d/f", Before: "k3", After: "k4"}
+	addOther := &Change{Type: Add, Path: "dd", After: "k5"}
+
+	a := []*Change{same, rmDir}
+	b := []*Change{{Type: Remove, Path: "x", Before: "k1"}, modChild, addOther}
+
+	out, conflicts := MergeDiffs(a, b)
+	if len(conflicts) != 1 || conflicts[0].A != rmDir || conflicts[0].B != modChild {
+		t.Fatalf("unexpected conflicts: %v", conflicts)
+	}
+	if len(out) != 2 || out[0] != same || out[1] != addOther {
+		t.Fatalf("unexpected changes: %v", out)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+
+	f := diffFile(t, ds, []byte("f"))
+	fa := diffFile(t, ds, []byte("fa"))
+	fb := diffFile(t, ds, []byte("fb"))
+	g := diffFile(t, ds, []byte("g"))
+
+	base := diffDir(t, ds, map[string]*dag.Node{
+		"f": f,
+		"d": diffDir(t, ds, map[string]*dag.Node{"f": f}),
+	})
+	a := diffDir(t, ds, map[string]*dag.Node{"f": fa})
+	b := diffDir(t, ds, map[string]*dag.Node{
+		"f": fb,
+		"d": diffDir(t, ds, map[string]*dag.Node{"f": fb}),
+		"g": g,
+	})
+
+	if _, conflicts, err := Merge(ctx, ds, base, a, b, MergeFail); err != ErrMergeConflict || len(conflicts) != 2 {
+		t.Fatalf("expected 2 conflicts and %s, got %d and %v", ErrMergeConflict, len(conflicts), err)
+	}
+
+	baseKey := nodeKey(t, base)
+	check := func(strategy MergeStrategy, exp map[string]*dag.Node) {
+		nd, _, err := Merge(ctx, ds, base, a, b, strategy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nodeKey(t, base) != baseKey {
+			t.Fatalf("strategy %d: merge changed the base", strategy)
+		}
+		if k := nodeKey(t, nd); k != nodeKey(t, diffDir(t, ds, exp)) {
+			changes, _ := Diff(ctx, ds, diffDir(t, ds, exp), nd)
+			t.Fatalf("strategy %d: unexpected merge: %v", strategy, changes)
+		}
+	}
+
+	check(MergeOurs, map[string]*dag.Node{"f": fa, "g": g})
+	check(MergeTheirs, map[string]*dag.Node{
+		"f": fb,
+		"d": diffDir(t, ds, map[string]*dag.Node{"f": fb}),
+		"g": g,
+	})
+	check(MergeKeepBoth, map[string]*dag.Node{
+		"f":        fa,
+		"f.theirs": fb,
+		"d":        diffDir(t, ds, map[string]*dag.Node{"f": fb}),
+		"g":        g,
+	})
+}
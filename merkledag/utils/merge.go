@@ -0,0 +1,294 @@
+package dagutils
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
+)
+
+var ErrMergeConflict = errors.New("merge conflict")
+
+// MergeStrategy selects how Merge resolves conflicts.
+type MergeStrategy int
+
+const (
+	// MergeFail fails the merge on conflicts
+	MergeFail MergeStrategy = iota
+	// MergeOurs keeps the version of the first tree
+	MergeOurs
+	// MergeTheirs keeps the version of the second tree
+	MergeTheirs
+	// MergeKeepBoth keeps the version of the first tree, and the one of the
+	// second tree next to it under a new name
+	MergeKeepBoth
+)
+
+// KeepBothSuffix is appended to the name of the entries of the second tree
+// kept next to the ones of the first tree by MergeKeepBoth.
+const KeepBothSuffix = ".theirs"
+
+// ParseMergeStrategy returns the strategy named s: fail, ours, theirs or
+// keep-both.
+func ParseMergeStrategy(s string) (MergeStrategy, error) {
+	switch s {
+	case "fail", "":
+		return MergeFail, nil
+	case "ours":
+		return MergeOurs, nil
+	case "theirs":
+		return MergeTheirs, nil
+	case "keep-both":
+		return MergeKeepBoth, nil
+	default:
+		return 0, fmt.Errorf("unknown merge strategy %q", s)
+	}
+}
+
+// Merge merges the changes made to base in a and in b, and returns the
+// merged tree, added to ds, along with the conflicts found. On conflicts,
+// MergeFail returns ErrMergeConflict; the other strategies resolve each of
+// them at the upper of the two conflicting paths.
+func Merge(ctx context.Context, ds dag.DAGService, base, a, b *dag.Node, strategy MergeStrategy) (*dag.Node, []Conflict, error) {
+	da, err := Diff(ctx, ds, base, a)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := Diff(ctx, ds, base, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes, conflicts := MergeDiffs(da, db)
+	if len(conflicts) > 0 {
+		if strategy == MergeFail {
+			return nil, conflicts, ErrMergeConflict
+		}
+		changes, err = resolveConflicts(ctx, ds, base, a, b, changes, conflicts, strategy)
+		if err != nil {
+			return nil, conflicts, err
+		}
+	}
+
+	// a change of the root itself replaces the whole tree, which happens
+	// only if the roots have no entries
+	for _, c := range changes {
+		if c.Path == "" {
+			nd, err := ds.Get(ctx, c.After)
+			return nd, conflicts, err
+		}
+	}
+
+	nd, err := ApplyChange(ctx, ds, base, changes)
+	if err != nil {
+		return nil, conflicts, err
+	}
+	if _, err := ds.Add(nd); err != nil {
+		return nil, conflicts, err
+	}
+	return nd, conflicts, nil
+}
+
+// resolveConflicts replaces the conflicting changes with the changes
+// setting the entry at the upper path of each conflict to the version the
+// strategy keeps.
+func resolveConflicts(ctx context.Context, ds dag.DAGService, base, a, b *dag.Node, changes []*Change, conflicts []Conflict, strategy MergeStrategy) ([]*Change, error) {
+	var points []string
+	for _, c := range conflicts {
+		p := c.A.Path
+		if len(c.B.Path) < len(p) {
+			p = c.B.Path
+		}
+		points = append(points, p)
+	}
+
+	// a conflict below another one is resolved with it
+	var upper []string
+	for _, p := range points {
+		covered := false
+		for _, q := range points {
+			if q != p && isBelow(p, q) {
+				covered = true
+				break
+			}
+		}
+		for _, q := range upper {
+			if q == p {
+				covered = true
+			}
+		}
+		if !covered {
+			upper = append(upper, p)
+		}
+	}
+
+	var out []*Change
+	for _, c := range changes {
+		kept := true
+		for _, p := range upper {
+			if c.Path == p || isBelow(c.Path, p) {
+				kept = false
+				break
+			}
+		}
+		if kept {
+			out = append(out, c)
+		}
+	}
+
+	for _, p := range upper {
+		basen, err := nodeAtPath(ctx, ds, base, p)
+		if err != nil {
+			return nil, err
+		}
+		an, err := nodeAtPath(ctx, ds, a, p)
+		if err != nil {
+			return nil, err
+		}
+		bn, err := nodeAtPath(ctx, ds, b, p)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strategy {
+		case MergeOurs:
+			out, err = appendSet(out, p, basen, an)
+		case MergeTheirs:
+			out, err = appendSet(out, p, basen, bn)
+		case MergeKeepBoth:
+			switch {
+			case an == nil:
+				out, err = appendSet(out, p, basen, bn)
+			case bn == nil:
+				out, err = appendSet(out, p, basen, an)
+			default:
+				if p == "" {
+					return nil, fmt.Errorf("cannot keep both versions of the root")
+				}
+				out, err = appendSet(out, p, basen, an)
+				if err != nil {
+					return nil, err
+				}
+				var renamed string
+				renamed, err = keepBothPath(ctx, ds, p, base, a, b)
+				if err != nil {
+					return nil, err
+				}
+				out, err = appendSet(out, renamed, nil, bn)
+			}
+		default:
+			return nil, fmt.Errorf("unknown merge strategy %d", strategy)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// appendSet appends to cs the change setting the entry at p, before
+// being, to nd. A nil node is no entry.
+func appendSet(cs []*Change, p string, before, nd *dag.Node) ([]*Change, error) {
+	switch {
+	case before == nil && nd == nil:
+		return cs, nil
+	case before == nil:
+		k, err := nd.Key()
+		if err != nil {
+			return nil, err
+		}
+		return append(cs, &Change{Type: Add, Path: p, After: k}), nil
+	case nd == nil:
+		k, err := before.Key()
+		if err != nil {
+			return nil, err
+		}
+		return append(cs, &Change{Type: Remove, Path: p, Before: k}), nil
+	}
+
+	bk, err := before.Key()
+	if err != nil {
+		return nil, err
+	}
+	k, err := nd.Key()
+	if err != nil {
+		return nil, err
+	}
+	if bk == k {
+		return cs, nil
+	}
+	return append(cs, &Change{Type: Mod, Path: p, Before: bk, After: k}), nil
+}
+
+// keepBothPath returns the path the version of the second tree of the
+// entry at p is kept at, free in all the trees.
+func keepBothPath(ctx context.Context, ds dag.DAGService, p string, trees ...*dag.Node) (string, error) {
+	for i := 0; ; i++ {
+		cand := p + KeepBothSuffix
+		if i > 0 {
+			cand = fmt.Sprintf("%s%s.%d", p, KeepBothSuffix, i)
+		}
+
+		free := true
+		for _, t := range trees {
+			nd, err := nodeAtPath(ctx, ds, t, cand)
+			if err != nil {
+				return "", err
+			}
+			if nd != nil {
+				free = false
+				break
+			}
+		}
+		if free {
+			return cand, nil
+		}
+	}
+}
+
+// nodeAtPath returns the entry at p in the tree below root, or nil if
+// there is none.
+func nodeAtPath(ctx context.Context, ds dag.DAGService, root *dag.Node, p string) (*dag.Node, error) {
+	if p == "" {
+		return root, nil
+	}
+
+	nd := root
+	for _, name := range strings.Split(p, "/") {
+		if !hasEntries(nd) {
+			return nil, nil
+		}
+
+		var lnk *dag.Link
+		var err error
+		if isDir(nd) {
+			lnk, err = uio.FindDirEntry(ctx, ds, nd, name)
+		} else {
+			lnk, err = nd.GetNodeLink(name)
+		}
+		if err == dag.ErrNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		nd, err = lnk.GetNode(ctx, ds)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nd, nil
+}
+
+// isBelow reports whether the path p is below the path of the entry dir.
+func isBelow(p, dir string) bool {
+	if dir == "" {
+		return p != ""
+	}
+	return strings.HasPrefix(p, path.Clean(dir)+"/")
+}
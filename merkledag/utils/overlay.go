@@ -0,0 +1,66 @@
+package dagutils
+
+import (
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+)
+
+// overlay is a DAGService adding nodes to tmp, and getting them from tmp
+// or, if they are not there, from src. It lets an editor read a tree from
+// src without writing the intermediate nodes there.
+type overlay struct {
+	dag.DAGService // tmp
+	src            dag.DAGService
+}
+
+func (o *overlay) Get(ctx context.Context, k key.Key) (*dag.Node, error) {
+	nd, err := o.DAGService.Get(ctx, k)
+	if err == dag.ErrNotFound {
+		return o.src.Get(ctx, k)
+	}
+	return nd, err
+}
+
+func (o *overlay) GetRaw(ctx context.Context, k key.Key) (*dag.Node, error) {
+	nd, err := o.DAGService.GetRaw(ctx, k)
+	if err == dag.ErrNotFound {
+		return o.src.GetRaw(ctx, k)
+	}
+	return nd, err
+}
+
+func (o *overlay) GetDAG(ctx context.Context, root *dag.Node) []dag.NodeGetter {
+	return o.GetLinks(ctx, root.Links)
+}
+
+func (o *overlay) GetNodes(ctx context.Context, keys []key.Key) []dag.NodeGetter {
+	out := make([]dag.NodeGetter, len(keys))
+	for i, k := range keys {
+		out[i] = &overlayGetter{o: o, k: k}
+	}
+	return out
+}
+
+func (o *overlay) GetLinks(ctx context.Context, links []*dag.Link) []dag.NodeGetter {
+	out := make([]dag.NodeGetter, len(links))
+	for i, l := range links {
+		out[i] = &overlayGetter{o: o, k: key.Key(l.Hash), raw: l.Raw}
+	}
+	return out
+}
+
+// overlayGetter gets a node from an overlay when asked for it.
+type overlayGetter struct {
+	o   *overlay
+	k   key.Key
+	raw bool
+}
+
+func (g *overlayGetter) Get(ctx context.Context) (*dag.Node, error) {
+	if g.raw {
+		return g.o.GetRaw(ctx, g.k)
+	}
+	return g.o.Get(ctx, g.k)
+}
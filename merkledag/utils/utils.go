@@ -4,14 +4,25 @@ import (
 	"errors"
 	"strings"
 
+	dstore "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore"
+	dssync "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/sync"
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
+	bstore "github.com/ipfs/go-ipfs/blocks/blockstore"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	bserv "github.com/ipfs/go-ipfs/blockservice"
+	offline "github.com/ipfs/go-ipfs/exchange/offline"
 	dag "github.com/ipfs/go-ipfs/merkledag"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
 )
 
 type Editor struct {
 	root *dag.Node
 	ds   dag.DAGService
+
+	// tmp holds the nodes written by the edits of a memory editor
+	tmp dag.DAGService
 }
 
 func NewDagEditor(ds dag.DAGService, root *dag.Node) *Editor {
@@ -21,6 +32,23 @@ func NewDagEditor(ds dag.DAGService, root *dag.Node) *Editor {
 	}
 }
 
+// NewMemoryEditor returns an editor of the tree below root, read from src.
+// The nodes written by the edits are kept in memory, and only those still
+// in the tree in the end are written out, by WriteOutputTo.
+func NewMemoryEditor(src dag.DAGService, root *dag.Node) *Editor {
+	tmp := newMemoryDagService()
+	return &Editor{
+		root: root,
+		ds:   &overlay{DAGService: tmp, src: src},
+		tmp:  tmp,
+	}
+}
+
+func newMemoryDagService() dag.DAGService {
+	bs := bstore.NewBlockstore(dssync.MutexWrap(dstore.NewMapDatastore()))
+	return dag.NewDAGService(bserv.New(bs, offline.Exchange(bs)))
+}
+
 func (e *Editor) GetNode() *dag.Node {
 	return e.root.Copy()
 }
@@ -29,6 +57,13 @@ func (e *Editor) GetDagService() dag.DAGService {
 	return e.ds
 }
 
+// SetRootData replaces the data of the root, leaving its links as they are.
+func (e *Editor) SetRootData(data []byte) {
+	nd := e.root.Copy()
+	nd.Data = data
+	e.root = nd
+}
+
 func addLink(ctx context.Context, ds dag.DAGService, root *dag.Node, childname string, childnd *dag.Node) (*dag.Node, error) {
 	if childname == "" {
 		return nil, errors.New("cannot create link with no name!")
@@ -40,10 +75,8 @@ func addLink(ctx context.Context, ds dag.DAGService, root *dag.Node, childname s
 		return nil, err
 	}
 
-	// ensure no link with that name already exists
-	_ = root.RemoveNodeLink(childname) // ignore error, only option is ErrNotFound
-
-	if err := root.AddNodeLinkClean(childname, childnd); err != nil {
+	root, err = setLink(ctx, ds, root, childname, childnd)
+	if err != nil {
 		return nil, err
 	}
 
@@ -68,7 +101,7 @@ func insertNodeAtPath(ctx context.Context, ds dag.DAGService, root *dag.Node, pa
 		return addLink(ctx, ds, root, path[0], toinsert)
 	}
 
-	nd, err := root.GetLinkedNode(ctx, ds, path[0])
+	nd, err := getLink(ctx, ds, root, path[0])
 	if err != nil {
 		// if 'create' is true, we create directories on the way down as needed
 		if err == dag.ErrNotFound && create != nil {
@@ -83,8 +116,7 @@ func insertNodeAtPath(ctx context.Context, ds dag.DAGService, root *dag.Node, pa
 		return nil, err
 	}
 
-	_ = root.RemoveNodeLink(path[0])
-	err = root.AddNodeLinkClean(path[0], ndprime)
+	root, err = setLink(ctx, ds, root, path[0], ndprime)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +142,7 @@ func (e *Editor) RmLink(ctx context.Context, path string) error {
 func rmLink(ctx context.Context, ds dag.DAGService, root *dag.Node, path []string) (*dag.Node, error) {
 	if len(path) == 1 {
 		// base case, remove node in question
-		err := root.RemoveNodeLink(path[0])
+		root, err := removeLink(ctx, ds, root, path[0])
 		if err != nil {
 			return nil, err
 		}
@@ -123,7 +155,7 @@ func rmLink(ctx context.Context, ds dag.DAGService, root *dag.Node, path []strin
 		return root, nil
 	}
 
-	nd, err := root.GetLinkedNode(ctx, ds, path[0])
+	nd, err := getLink(ctx, ds, root, path[0])
 	if err != nil {
 		return nil, err
 	}
@@ -133,8 +165,7 @@ func rmLink(ctx context.Context, ds dag.DAGService, root *dag.Node, path []strin
 		return nil, err
 	}
 
-	_ = root.RemoveNodeLink(path[0])
-	err = root.AddNodeLinkClean(path[0], nnode)
+	root, err = setLink(ctx, ds, root, path[0], nnode)
 	if err != nil {
 		return nil, err
 	}
@@ -147,18 +178,78 @@ func rmLink(ctx context.Context, ds dag.DAGService, root *dag.Node, path []strin
 	return root, nil
 }
 
+// isDir reports whether nd is a unixfs directory, sharded or not. The
+// links of directories are edited through the unixfs/io helpers so that
+// large directories get sharded.
+func isDir(nd *dag.Node) bool {
+	return ft.IsDir(nd)
+}
+
+func getLink(ctx context.Context, ds dag.DAGService, root *dag.Node, name string) (*dag.Node, error) {
+	if !isDir(root) {
+		return root.GetLinkedNode(ctx, ds, name)
+	}
+
+	lnk, err := uio.FindDirEntry(ctx, ds, root, name)
+	if err != nil {
+		return nil, err
+	}
+	return lnk.GetNode(ctx, ds)
+}
+
+func setLink(ctx context.Context, ds dag.DAGService, root *dag.Node, name string, child *dag.Node) (*dag.Node, error) {
+	if isDir(root) {
+		return uio.AddDirEntry(ctx, ds, root, name, child)
+	}
+
+	_ = root.RemoveNodeLink(name) // ignore error, only option is ErrNotFound
+	if err := root.AddNodeLinkClean(name, child); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func removeLink(ctx context.Context, ds dag.DAGService, root *dag.Node, name string) (*dag.Node, error) {
+	if isDir(root) {
+		return uio.RemoveDirEntry(ctx, ds, root, name)
+	}
+
+	if err := root.RemoveNodeLink(name); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// WriteOutputTo adds the edited tree to ds. Only the nodes written by the
+// edits are copied, the others are expected to be in ds already.
 func (e *Editor) WriteOutputTo(ds dag.DAGService) error {
-	return copyDag(e.GetNode(), e.ds, ds)
+	return e.WriteOutputCB(ds, nil)
+}
+
+// WriteOutputCB is WriteOutputTo, calling cb, if not nil, with every node
+// copied once it and the nodes below it are in ds. The root comes last.
+func (e *Editor) WriteOutputCB(ds dag.DAGService, cb func(*dag.Node) error) error {
+	from := e.ds
+	if e.tmp != nil {
+		from = e.tmp
+	}
+	return copyDag(e.GetNode(), from, ds, cb)
 }
 
-func copyDag(nd *dag.Node, from, to dag.DAGService) error {
+func copyDag(nd *dag.Node, from, to dag.DAGService, cb func(*dag.Node) error) error {
 	_, err := to.Add(nd)
 	if err != nil {
 		return err
 	}
 
 	for _, lnk := range nd.Links {
-		child, err := lnk.GetNode(context.Background(), from)
+		if lnk.Raw {
+			// raw leaves are never edited
+			continue
+		}
+
+		// not lnk.GetNode: the link may hold a node that was not edited
+		child, err := from.Get(context.Background(), key.Key(lnk.Hash))
 		if err != nil {
 			if err == dag.ErrNotFound {
 				// not found means we didnt modify it, and it should
@@ -168,10 +259,14 @@ func copyDag(nd *dag.Node, from, to dag.DAGService) error {
 			return err
 		}
 
-		err = copyDag(child, from, to)
+		err = copyDag(child, from, to, cb)
 		if err != nil {
 			return err
 		}
 	}
+
+	if cb != nil {
+		return cb(nd)
+	}
 	return nil
 }
@@ -1,12 +1,15 @@
 package dagutils
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	key "github.com/ipfs/go-ipfs/blocks/key"
 	dag "github.com/ipfs/go-ipfs/merkledag"
 	mdtest "github.com/ipfs/go-ipfs/merkledag/test"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	uio "github.com/ipfs/go-ipfs/unixfs/io"
 
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 )
@@ -122,3 +125,168 @@ func testInsert(t *testing.T, e *Editor, path, data string, create bool, experr
 
 	assertNodeAtPath(t, e.ds, e.root, path, ck)
 }
+
+func TestInsertShardedDir(t *testing.T) {
+	defer func(n int) { uio.ShardThreshold = n }(uio.ShardThreshold)
+	uio.ShardThreshold = 10
+
+	ctx := context.Background()
+	ds := mdtest.Mock()
+	e := NewDagEditor(ds, uio.NewEmptyDirectory())
+
+	keys := make(map[string]key.Key)
+	for i := 0; i < 50; i++ {
+		name := fmt.Sprintf("dir/file-%d", i)
+		child := &dag.Node{Data: []byte(name)}
+		k, err := ds.Add(child)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[name] = k
+
+		err = e.InsertNodeAtPath(ctx, name, child, uio.NewEmptyDirectory)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir, err := getLink(ctx, ds, e.GetNode(), "dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pbd, err := ft.FromBytes(dir.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pbd.GetType() != ft.THAMTShard {
+		t.Fatal("expected the directory to be sharded")
+	}
+
+	if err := e.RmLink(ctx, "dir/file-0"); err != nil {
+		t.Fatal(err)
+	}
+	delete(keys, "dir/file-0")
+
+	dir, err = getLink(ctx, ds, e.GetNode(), "dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, k := range keys {
+		nd, err := getLink(ctx, ds, dir, strings.TrimPrefix(name, "dir/"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nk, _ := nd.Key(); nk != k {
+			t.Fatalf("wrong node for %s", name)
+		}
+	}
+	if _, err := getLink(ctx, ds, dir, "file-0"); err != dag.ErrNotFound {
+		t.Fatal("expected ErrNotFound, got", err)
+	}
+}
+
+func TestMemoryEditor(t *testing.T) {
+	ctx := context.Background()
+	src := mdtest.Mock()
+
+	fish := &dag.Node{Data: []byte("fishcakes!")}
+	if _, err := src.Add(fish); err != nil {
+		t.Fatal(err)
+	}
+	root := &dag.Node{Data: ft.FolderPBData()}
+	if _, err := src.Add(root); err != nil {
+		t.Fatal(err)
+	}
+
+	mkdir := func() *dag.Node { return &dag.Node{Data: ft.FolderPBData()} }
+	e := NewMemoryEditor(src, root)
+	if err := e.InsertNodeAtPath(ctx, "a/fish", fish, mkdir); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.InsertNodeAtPath(ctx, "b/fish", fish, mkdir); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.RmLink(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+
+	out := e.GetNode()
+	outk, err := out.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.Get(ctx, outk); err != dag.ErrNotFound {
+		t.Fatal("edited node written before WriteOutputTo")
+	}
+
+	if err := e.WriteOutputTo(src); err != nil {
+		t.Fatal(err)
+	}
+	fk, err := fish.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertNodeAtPath(t, src, out, "b/fish", fk)
+
+	// the root holding only a, written by the first edit, is gone
+	onlyA := &dag.Node{Data: ft.FolderPBData()}
+	dirA := &dag.Node{Data: ft.FolderPBData()}
+	if err := dirA.AddNodeLinkClean("fish", fish); err != nil {
+		t.Fatal(err)
+	}
+	if err := onlyA.AddNodeLinkClean("a", dirA); err != nil {
+		t.Fatal(err)
+	}
+	k, err := onlyA.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.Get(ctx, k); err != dag.ErrNotFound {
+		t.Fatal("intermediate node written out")
+	}
+}
+
+func TestWriteOutputCB(t *testing.T) {
+	ctx := context.Background()
+	src := mdtest.Mock()
+
+	fish := &dag.Node{Data: []byte("fishcakes!")}
+	if _, err := src.Add(fish); err != nil {
+		t.Fatal(err)
+	}
+
+	mkdir := func() *dag.Node { return &dag.Node{Data: ft.FolderPBData()} }
+	e := NewMemoryEditor(src, mkdir())
+	if err := e.InsertNodeAtPath(ctx, "a/b/fish", fish, mkdir); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := mdtest.Mock()
+	var written []key.Key
+	err := e.WriteOutputCB(dst, func(nd *dag.Node) error {
+		k, err := nd.Key()
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Get(ctx, k); err != nil {
+			t.Fatal("callback called before the node was written")
+		}
+		written = append(written, k)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the fish was inserted, so copied too, then b, a and the root
+	if len(written) != 4 {
+		t.Fatalf("expected 4 nodes written, got %d", len(written))
+	}
+	rk, err := e.GetNode().Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written[len(written)-1] != rk {
+		t.Fatal("expected the root last")
+	}
+}
@@ -2,6 +2,7 @@ package namesys
 
 import (
 	"strings"
+	"time"
 
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
@@ -13,23 +14,52 @@ type resolver interface {
 	resolveOnce(ctx context.Context, name string) (value path.Path, err error)
 }
 
+// eolResolver is implemented by resolvers whose records carry an end of
+// validity.
+type eolResolver interface {
+	// resolveOnceEOL looks up a name once, and returns the EOL of the
+	// record it found. The zero time means the record has none.
+	resolveOnceEOL(ctx context.Context, name string) (value path.Path, eol time.Time, err error)
+}
+
+// resolveOnceEOL looks up a name once with r, asking for the EOL of the
+// record if r knows about it.
+func resolveOnceEOL(ctx context.Context, r resolver, name string) (path.Path, time.Time, error) {
+	if er, ok := r.(eolResolver); ok {
+		return er.resolveOnceEOL(ctx, name)
+	}
+	p, err := r.resolveOnce(ctx, name)
+	return p, time.Time{}, err
+}
+
 // resolve is a helper for implementing Resolver.ResolveN using resolveOnce.
 func resolve(ctx context.Context, r resolver, name string, depth int, prefixes ...string) (path.Path, error) {
+	p, _, err := resolveEOL(ctx, r, name, depth, prefixes...)
+	return p, err
+}
+
+// resolveEOL works like resolve, and also returns the earliest EOL of the
+// records followed along the way.
+func resolveEOL(ctx context.Context, r resolver, name string, depth int, prefixes ...string) (path.Path, time.Time, error) {
+	var eol time.Time
 	for {
-		p, err := r.resolveOnce(ctx, name)
+		p, t, err := resolveOnceEOL(ctx, r, name)
 		if err != nil {
 			log.Warningf("Could not resolve %s", name)
-			return "", err
+			return "", eol, err
+		}
+		if !t.IsZero() && (eol.IsZero() || t.Before(eol)) {
+			eol = t
 		}
 		log.Debugf("Resolved %s to %s", name, p.String())
 
 		if strings.HasPrefix(p.String(), "/ipfs/") {
 			// we've bottomed out with an IPFS path
-			return p, nil
+			return p, eol, nil
 		}
 
 		if depth == 1 {
-			return p, ErrResolveRecursion
+			return p, eol, ErrResolveRecursion
 		}
 
 		matched := false
@@ -44,7 +74,7 @@ func resolve(ctx context.Context, r resolver, name string, depth int, prefixes .
 		}
 
 		if !matched {
-			return p, nil
+			return p, eol, nil
 		}
 
 		if depth > 1 {
@@ -100,6 +100,17 @@ type Resolver interface {
 	ResolveN(ctx context.Context, name string, depth int) (value path.Path, err error)
 }
 
+// EOLResolver is a Resolver that can also report how long a resolution
+// stays valid.
+type EOLResolver interface {
+	Resolver
+
+	// ResolveWithEOL performs a recursive lookup like Resolve, and also
+	// returns the earliest EOL of the records it followed. The zero time
+	// means none of the records had an EOL (eg. DNS names).
+	ResolveWithEOL(ctx context.Context, name string) (value path.Path, eol time.Time, err error)
+}
+
 // Publisher is an object capable of publishing particular names.
 type Publisher interface {
 
@@ -57,26 +57,42 @@ func (ns *mpns) ResolveN(ctx context.Context, name string, depth int) (path.Path
 	return resolve(ctx, ns, name, depth, "/ipns/")
 }
 
+// ResolveWithEOL implements EOLResolver.
+func (ns *mpns) ResolveWithEOL(ctx context.Context, name string) (path.Path, time.Time, error) {
+	if strings.HasPrefix(name, "/ipfs/") || !strings.HasPrefix(name, "/") {
+		p, err := ns.ResolveN(ctx, name, DefaultDepthLimit)
+		return p, time.Time{}, err
+	}
+
+	return resolveEOL(ctx, ns, name, DefaultDepthLimit, "/ipns/")
+}
+
 // resolveOnce implements resolver.
 func (ns *mpns) resolveOnce(ctx context.Context, name string) (path.Path, error) {
+	p, _, err := ns.resolveOnceEOL(ctx, name)
+	return p, err
+}
+
+// resolveOnceEOL implements eolResolver.
+func (ns *mpns) resolveOnceEOL(ctx context.Context, name string) (path.Path, time.Time, error) {
 	if !strings.HasPrefix(name, "/ipns/") {
 		name = "/ipns/" + name
 	}
 	segments := strings.SplitN(name, "/", 3)
 	if len(segments) < 3 || segments[0] != "" {
 		log.Warningf("Invalid name syntax for %s", name)
-		return "", ErrResolveFailed
+		return "", time.Time{}, ErrResolveFailed
 	}
 
 	for protocol, resolver := range ns.resolvers {
 		log.Debugf("Attempting to resolve %s with %s", name, protocol)
-		p, err := resolver.resolveOnce(ctx, segments[2])
+		p, eol, err := resolveOnceEOL(ctx, resolver, segments[2])
 		if err == nil {
-			return p, err
+			return p, eol, err
 		}
 	}
 	log.Warningf("No resolver found for %s", name)
-	return "", ErrResolveFailed
+	return "", time.Time{}, ErrResolveFailed
 }
 
 // Publish implements Publisher
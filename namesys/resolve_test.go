@@ -135,3 +135,40 @@ func verifyCanResolve(r Resolver, name string, exp path.Path) error {
 
 	return nil
 }
+
+func TestRoutingResolveEOL(t *testing.T) {
+	d := mockrouting.NewServer().Client(testutil.RandIdentityOrFatal(t))
+	dstore := ds.NewMapDatastore()
+
+	ns := NewNameSystem(d, dstore)
+
+	privk, pubk, err := testutil.RandTestKeyPair(512)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := path.FromString("/ipfs/QmZULkCELmmk5XNfCgTnCyFgAVxBRBXyDHGGMVoLFLiXEN")
+	eol := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	err = ns.PublishWithEOL(context.Background(), privk, h, eol)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pubkb, err := pubk.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkhash := u.Hash(pubkb)
+	res, reseol, err := ns.(EOLResolver).ResolveWithEOL(context.Background(), "/ipns/"+key.Key(pkhash).Pretty())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res != h {
+		t.Fatal("Got back incorrect value.")
+	}
+	if !reseol.Equal(eol) {
+		t.Fatalf("expected EOL %s, got %s", eol, reseol)
+	}
+}
@@ -2,6 +2,7 @@ package namesys
 
 import (
 	"fmt"
+	"time"
 
 	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
 	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
@@ -11,6 +12,7 @@ import (
 	pb "github.com/ipfs/go-ipfs/namesys/pb"
 	path "github.com/ipfs/go-ipfs/path"
 	routing "github.com/ipfs/go-ipfs/routing"
+	u "github.com/ipfs/go-ipfs/util"
 	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
 )
 
@@ -53,11 +55,17 @@ func (r *routingResolver) ResolveN(ctx context.Context, name string, depth int)
 // resolveOnce implements resolver. Uses the IPFS routing system to
 // resolve SFS-like names.
 func (r *routingResolver) resolveOnce(ctx context.Context, name string) (path.Path, error) {
+	p, _, err := r.resolveOnceEOL(ctx, name)
+	return p, err
+}
+
+// resolveOnceEOL implements eolResolver.
+func (r *routingResolver) resolveOnceEOL(ctx context.Context, name string) (path.Path, time.Time, error) {
 	log.Debugf("RoutingResolve: '%s'", name)
 	hash, err := mh.FromB58String(name)
 	if err != nil {
 		log.Warning("RoutingResolve: bad input hash: [%s]\n", name)
-		return "", err
+		return "", time.Time{}, err
 	}
 	// name should be a multihash. if it isn't, error out here.
 
@@ -69,19 +77,19 @@ func (r *routingResolver) resolveOnce(ctx context.Context, name string) (path.Pa
 	val, err := r.routing.GetValue(ctx, ipnsKey)
 	if err != nil {
 		log.Warning("RoutingResolve get failed.")
-		return "", err
+		return "", time.Time{}, err
 	}
 
 	entry := new(pb.IpnsEntry)
 	err = proto.Unmarshal(val, entry)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
 	// name should be a public key retrievable from ipfs
 	pubkey, err := routing.GetPublicKey(r.routing, ctx, hash)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 
 	hsh, _ := pubkey.Hash()
@@ -89,19 +97,28 @@ func (r *routingResolver) resolveOnce(ctx context.Context, name string) (path.Pa
 
 	// check sig with pk
 	if ok, err := pubkey.Verify(ipnsEntryDataForSig(entry), entry.GetSignature()); err != nil || !ok {
-		return "", fmt.Errorf("Invalid value. Not signed by PrivateKey corresponding to %v", pubkey)
+		return "", time.Time{}, fmt.Errorf("Invalid value. Not signed by PrivateKey corresponding to %v", pubkey)
 	}
 
 	// ok sig checks out. this is a valid name.
 
+	var eol time.Time
+	if entry.GetValidityType() == pb.IpnsEntry_EOL {
+		eol, err = u.ParseRFC3339(string(entry.GetValidity()))
+		if err != nil {
+			return "", time.Time{}, err
+		}
+	}
+
 	// check for old style record:
 	valh, err := mh.Cast(entry.GetValue())
 	if err != nil {
 		// Not a multihash, probably a new record
-		return path.ParsePath(string(entry.GetValue()))
+		p, err := path.ParsePath(string(entry.GetValue()))
+		return p, eol, err
 	} else {
 		// Its an old style multihash record
 		log.Warning("Detected old style multihash record")
-		return path.FromKey(key.Key(valh)), nil
+		return path.FromKey(key.Key(valh)), eol, nil
 	}
 }
@@ -0,0 +1,148 @@
+package path
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	merkledag "github.com/ipfs/go-ipfs/merkledag"
+	hamt "github.com/ipfs/go-ipfs/unixfs/hamt"
+)
+
+// NodeResolver resolves path names inside the nodes of a format.
+type NodeResolver interface {
+	// Handles reports whether nd is of the format.
+	Handles(nd *merkledag.Node) bool
+
+	// ResolveNames resolves the first of names in nd. It returns the link
+	// they name and the number of names it took, or, if all the names are
+	// inside nd, a nil link and the value they name. It returns ErrNoLink
+	// if the first name is neither.
+	ResolveNames(ctx context.Context, ds merkledag.DAGService, nd *merkledag.Node, names []string) (*merkledag.Link, int, interface{}, error)
+}
+
+// DefaultFormats are the node resolvers of a Resolver without Formats, in
+// the order they are tried.
+var DefaultFormats = []NodeResolver{
+	ShardResolver{},
+	DocumentResolver{},
+	LinkResolver{},
+}
+
+// ShardResolver resolves the entries of sharded unixfs directories.
+type ShardResolver struct{}
+
+func (ShardResolver) Handles(nd *merkledag.Node) bool {
+	return hamt.IsShard(nd)
+}
+
+func (ShardResolver) ResolveNames(ctx context.Context, ds merkledag.DAGService, nd *merkledag.Node, names []string) (*merkledag.Link, int, interface{}, error) {
+	shard, err := hamt.NewHamtFromDag(ds, nd)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	lnk, err := shard.Find(ctx, names[0])
+	if err == merkledag.ErrNotFound {
+		return nil, 0, nil, noLink(nd, names[0])
+	}
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return lnk, 1, nil, nil
+}
+
+// DocumentResolver resolves paths through document nodes: to the nodes
+// their links point to, or to the values inside them.
+type DocumentResolver struct{}
+
+func (DocumentResolver) Handles(nd *merkledag.Node) bool {
+	return nd.IsDocument()
+}
+
+func (DocumentResolver) ResolveNames(ctx context.Context, ds merkledag.DAGService, nd *merkledag.Node, names []string) (*merkledag.Link, int, interface{}, error) {
+	// the links of documents are named by their path in the document, the
+	// longest one wins
+	for j := len(names); j > 0; j-- {
+		lnk, err := nd.GetNodeLink(strings.Join(names[:j], "/"))
+		if err == nil {
+			return lnk, j, nil, nil
+		}
+	}
+
+	doc, err := nd.Document()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return resolveValue(nd, doc, names)
+}
+
+// LinkResolver resolves the names of the links of nodes, and the fields
+// of the data of nodes holding a JSON object.
+type LinkResolver struct{}
+
+func (LinkResolver) Handles(nd *merkledag.Node) bool {
+	return true
+}
+
+func (LinkResolver) ResolveNames(ctx context.Context, ds merkledag.DAGService, nd *merkledag.Node, names []string) (*merkledag.Link, int, interface{}, error) {
+	// the links themselves, to keep the nodes they hold
+	for _, lnk := range nd.Links {
+		if lnk.Name == names[0] {
+			return lnk, 1, nil, nil
+		}
+	}
+
+	data := bytes.TrimSpace(nd.Data)
+	if nd.IsRaw() || nd.IsDocument() || len(data) == 0 || data[0] != '{' {
+		return nil, 0, nil, noLink(nd, names[0])
+	}
+	doc, err := merkledag.ParseDocument(data)
+	if err != nil {
+		return nil, 0, nil, noLink(nd, names[0])
+	}
+	return resolveValue(nd, doc, names)
+}
+
+// resolveValue returns the value at the path names in the value v of nd.
+func resolveValue(nd *merkledag.Node, v interface{}, names []string) (*merkledag.Link, int, interface{}, error) {
+	for _, name := range names {
+		switch cur := v.(type) {
+		case map[string]interface{}:
+			next, ok := cur[name]
+			if !ok {
+				return nil, 0, nil, noLink(nd, name)
+			}
+			v = next
+		case []interface{}:
+			i, err := strconv.Atoi(name)
+			if err != nil || i < 0 || i >= len(cur) {
+				return nil, 0, nil, noLink(nd, name)
+			}
+			v = cur[i]
+		default:
+			return nil, 0, nil, noLink(nd, name)
+		}
+	}
+	return nil, len(names), v, nil
+}
+
+func noLink(nd *merkledag.Node, name string) error {
+	h, _ := nd.Multihash()
+	return ErrNoLink{name: name, node: h}
+}
+
+// ValueData returns the data of the value v found inside a node: the text
+// of strings and numbers, the JSON encoding of other values.
+func ValueData(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case string:
+		return []byte(v), nil
+	case json.Number:
+		return []byte(v.String()), nil
+	default:
+		return json.Marshal(v)
+	}
+}
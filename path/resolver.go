@@ -30,10 +30,25 @@ func (e ErrNoLink) Error() string {
 	return fmt.Sprintf("no link named %q under %s", e.name, e.node.B58String())
 }
 
+// ErrValue is returned when a path ends inside a node, at a value of the
+// document it holds rather than at a node. Value is what the path names,
+// see ValueData.
+type ErrValue struct {
+	Value interface{}
+	node  mh.Multihash
+}
+
+func (e ErrValue) Error() string {
+	return fmt.Sprintf("path names a value inside %s, not an object", e.node.B58String())
+}
+
 // Resolver provides path resolution to IPFS
 // It has a pointer to a DAGService, which is uses to resolve nodes.
 type Resolver struct {
 	DAG merkledag.DAGService
+
+	// Formats resolve names inside nodes, DefaultFormats if nil.
+	Formats []NodeResolver
 }
 
 // SplitAbsPath clean up and split fpath. It extracts the first component (which
@@ -102,29 +117,51 @@ func (s *Resolver) ResolvePathComponents(ctx context.Context, fpath Path) ([]*me
 //
 // ResolveLinks(nd, []string{"foo", "bar", "baz"})
 // would retrieve "baz" in ("bar" in ("foo" in nd.Links).Links).Links
+//
+// Names are resolved inside each node by the first of the resolver's
+// Formats handling it. Names taken together by a node, like the path of a
+// link in a document, resolve to the node itself but for the last one. If
+// the last names are inside a node, they all resolve to the node, and the
+// error is an ErrValue holding the value they name.
 func (s *Resolver) ResolveLinks(ctx context.Context, ndd *merkledag.Node, names []string) ([]*merkledag.Node, error) {
 
 	result := make([]*merkledag.Node, 0, len(names)+1)
 	result = append(result, ndd)
 	nd := ndd // dup arg workaround
 
+	formats := s.Formats
+	if formats == nil {
+		formats = DefaultFormats
+	}
+
 	// for each of the path components
-	for _, name := range names {
-
-		var next key.Key
-		var nlink *merkledag.Link
-		// for each of the links in nd, the current object
-		for _, link := range nd.Links {
-			if link.Name == name {
-				next = key.Key(link.Hash)
-				nlink = link
+	for len(names) > 0 {
+		var nr NodeResolver
+		for _, f := range formats {
+			if f.Handles(nd) {
+				nr = f
 				break
 			}
 		}
+		if nr == nil {
+			return result, noLink(nd, names[0])
+		}
 
-		if next == "" {
-			n, _ := nd.Multihash()
-			return result, ErrNoLink{name: name, node: n}
+		nlink, taken, value, err := nr.ResolveNames(ctx, s.DAG, nd, names)
+		if err != nil {
+			return result, err
+		}
+		for i := 0; i < taken-1; i++ {
+			result = append(result, nd)
+		}
+		names = names[taken:]
+
+		if nlink == nil {
+			h, err := nd.Multihash()
+			if err != nil {
+				return result, err
+			}
+			return append(result, nd), ErrValue{Value: value, node: h}
 		}
 
 		if nlink.Node == nil {
@@ -132,7 +169,7 @@ func (s *Resolver) ResolveLinks(ctx context.Context, ndd *merkledag.Node, names
 			ctx, cancel := context.WithTimeout(ctx, time.Minute)
 			defer cancel()
 			var err error
-			nd, err = s.DAG.Get(ctx, next)
+			nd, err = nlink.GetNode(ctx, s.DAG)
 			if err != nil {
 				return append(result, nd), err
 			}
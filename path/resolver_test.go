@@ -1,6 +1,7 @@
 package path_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -71,3 +72,119 @@ func TestRecurivePathResolution(t *testing.T) {
 			p.String(), key.String(), cKey.String()))
 	}
 }
+
+func TestDocumentPathResolution(t *testing.T) {
+	ctx := context.Background()
+	dagService := dagmock.Mock()
+
+	c, cKey := randNode()
+	if _, err := dagService.Add(c); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := merkledag.NewDocumentNode(map[string]interface{}{
+		"meta": map[string]interface{}{
+			"authors": []interface{}{merkledag.DocLink{Hash: cKey}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	docKey, err := dagService.Add(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := path.FromSegments("/ipfs/", docKey.String(), "meta", "authors", "0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver := &path.Resolver{DAG: dagService}
+	nodes, err := resolver.ResolvePathComponents(ctx, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 4 {
+		t.Fatalf("expected 4 nodes, got %d", len(nodes))
+	}
+	k, err := nodes[3].Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k != cKey {
+		t.Fatalf("resolved %s, expected %s", k, cKey)
+	}
+
+	p, err = path.FromSegments("/ipfs/", docKey.String(), "meta", "missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolver.ResolvePath(ctx, p); err == nil {
+		t.Fatal("resolved a path with no link")
+	}
+}
+
+func resolveText(t *testing.T, resolver *path.Resolver, p path.Path) string {
+	nodes, err := resolver.ResolvePathComponents(context.Background(), p)
+	ev, ok := err.(path.ErrValue)
+	if !ok {
+		t.Fatalf("%s: expected a value, got %v", p, err)
+	}
+	// the names inside the node resolve to the node holding the value
+	if len(nodes) != len(p.Segments())-1 {
+		t.Fatalf("%s: got %d nodes", p, len(nodes))
+	}
+	data, err := path.ValueData(ev.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestValuePathResolution(t *testing.T) {
+	dagService := dagmock.Mock()
+	resolver := &path.Resolver{DAG: dagService}
+
+	doc, err := merkledag.NewDocumentNode(map[string]interface{}{
+		"meta": map[string]interface{}{
+			"title": "hello",
+			"tags":  []interface{}{"a", json.Number("2")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	docKey, err := dagService.Add(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plain := &merkledag.Node{Data: []byte(`{"meta": {"title": "plain"}}`)}
+	plainKey, err := dagService.Add(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for p, exp := range map[string]string{
+		"/ipfs/" + docKey.B58String() + "/meta/title":   "hello",
+		"/ipfs/" + docKey.B58String() + "/meta/tags/1":  "2",
+		"/ipfs/" + docKey.B58String() + "/meta/tags":    `["a",2]`,
+		"/ipfs/" + plainKey.B58String() + "/meta/title": "plain",
+	} {
+		if s := resolveText(t, resolver, path.Path(p)); s != exp {
+			t.Fatalf("%s: got %q, expected %q", p, s, exp)
+		}
+	}
+
+	_, err = resolver.ResolvePath(context.Background(), path.Path("/ipfs/"+docKey.B58String()+"/meta/tags/5"))
+	if _, ok := err.(path.ErrNoLink); !ok {
+		t.Fatalf("expected ErrNoLink, got %v", err)
+	}
+
+	// without the document format, documents are nodes like the others
+	plainOnly := &path.Resolver{DAG: dagService, Formats: []path.NodeResolver{path.LinkResolver{}}}
+	if _, err := plainOnly.ResolvePath(context.Background(), path.Path("/ipfs/"+docKey.B58String()+"/meta/title")); err == nil {
+		t.Fatal("resolved into a document without its format")
+	}
+}
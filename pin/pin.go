@@ -13,7 +13,9 @@ import (
 	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 	key "github.com/ipfs/go-ipfs/blocks/key"
 	"github.com/ipfs/go-ipfs/blocks/set"
+	"github.com/ipfs/go-ipfs/filestore"
 	mdag "github.com/ipfs/go-ipfs/merkledag"
+	traverse "github.com/ipfs/go-ipfs/merkledag/traverse"
 	logging "github.com/ipfs/go-ipfs/vendor/QmXJkcEXB6C9h6Ytb6rrUTFU56Ro62zxgrbxTT3dgjQGA8/go-log"
 )
 
@@ -34,7 +36,9 @@ const (
 type Pinner interface {
 	IsPinned(key.Key) bool
 	Pin(context.Context, *mdag.Node, bool) error
+	PinRecursive(context.Context, *mdag.Node, int) error
 	Unpin(context.Context, key.Key, bool) error
+	Update(context.Context, key.Key, *mdag.Node) error
 	Flush() error
 	GetManual() ManualPinner
 	DirectKeys() []key.Key
@@ -83,6 +87,10 @@ func NewPinner(dstore ds.ThreadSafeDatastore, serv mdag.DAGService) Pinner {
 
 // Pin the given node, optionally recursive
 func (p *pinner) Pin(ctx context.Context, node *mdag.Node, recurse bool) error {
+	if recurse {
+		return p.PinRecursive(ctx, node, 0)
+	}
+
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	k, err := node.Key()
@@ -90,32 +98,43 @@ func (p *pinner) Pin(ctx context.Context, node *mdag.Node, recurse bool) error {
 		return err
 	}
 
-	if recurse {
-		if p.recursePin.HasKey(k) {
-			return nil
-		}
+	if _, err := p.dserv.Get(ctx, k); err != nil {
+		return err
+	}
 
-		if p.directPin.HasKey(k) {
-			p.directPin.RemoveBlock(k)
-		}
+	if p.recursePin.HasKey(k) {
+		return fmt.Errorf("%s already pinned recursively", k.B58String())
+	}
 
-		err := p.pinLinks(ctx, node)
-		if err != nil {
-			return err
-		}
+	p.directPin.AddBlock(k)
+	return nil
+}
 
-		p.recursePin.AddBlock(k)
-	} else {
-		if _, err := p.dserv.Get(ctx, k); err != nil {
-			return err
-		}
+// PinRecursive pins node recursively. The dag below it is fetched with
+// traverse.Fetch, concurrency nodes at a time, or
+// traverse.DefaultConcurrency if not positive.
+func (p *pinner) PinRecursive(ctx context.Context, node *mdag.Node, concurrency int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	k, err := node.Key()
+	if err != nil {
+		return err
+	}
 
-		if p.recursePin.HasKey(k) {
-			return fmt.Errorf("%s already pinned recursively", k.B58String())
-		}
+	if p.recursePin.HasKey(k) {
+		return nil
+	}
 
-		p.directPin.AddBlock(k)
+	if p.directPin.HasKey(k) {
+		p.directPin.RemoveBlock(k)
+	}
+
+	err = p.pinLinksConcurrently(ctx, node, concurrency)
+	if err != nil {
+		return err
 	}
+
+	p.recursePin.AddBlock(k)
 	return nil
 }
 
@@ -145,20 +164,130 @@ func (p *pinner) Unpin(ctx context.Context, k key.Key, recursive bool) error {
 	}
 }
 
-func (p *pinner) unpinLinks(ctx context.Context, node *mdag.Node) error {
-	for _, l := range node.Links {
-		node, err := l.GetNode(ctx, p.dserv)
+// Update replaces the recursive pin of from by a recursive pin of to. Only
+// the parts of the two trees that differ are walked, so updating the pin of
+// a large tree after a small change is cheap. If from is not pinned
+// recursively, to is pinned as Pin does.
+func (p *pinner) Update(ctx context.Context, from key.Key, to *mdag.Node) error {
+	k, err := to.Key()
+	if err != nil {
+		return err
+	}
+	if k == from {
+		return nil
+	}
+
+	p.lock.Lock()
+	if !p.recursePin.HasKey(from) {
+		p.lock.Unlock()
+		return p.Pin(ctx, to, true)
+	}
+	defer p.lock.Unlock()
+
+	old, err := p.dserv.Get(ctx, from)
+	if err != nil {
+		return err
+	}
+	if p.recursePin.HasKey(k) {
+		// to is pinned already, only from is left to unpin
+		p.recursePin.RemoveBlock(from)
+		return p.unpinLinks(ctx, old)
+	}
+
+	if err := p.updateLinks(ctx, old, to); err != nil {
+		return err
+	}
+	if p.directPin.HasKey(k) {
+		p.directPin.RemoveBlock(k)
+	}
+	p.recursePin.RemoveBlock(from)
+	p.recursePin.AddBlock(k)
+	return nil
+}
+
+// updateLinks moves the indirect pins of the links of old to those of nd.
+// Links to the same object on both sides are left alone, links with the
+// same name to different objects are updated recursively, and the others
+// are pinned or unpinned as a whole.
+func (p *pinner) updateLinks(ctx context.Context, old, nd *mdag.Node) error {
+	same := make(map[key.Key]int)
+	for _, l := range old.Links {
+		same[key.Key(l.Hash)]++
+	}
+	var added []*mdag.Link
+	for _, l := range nd.Links {
+		k := key.Key(l.Hash)
+		if same[k] > 0 {
+			same[k]--
+			continue
+		}
+		added = append(added, l)
+	}
+
+	removed := make(map[string]*mdag.Link)
+	var unnamed []*mdag.Link
+	for _, l := range old.Links {
+		k := key.Key(l.Hash)
+		if same[k] == 0 {
+			continue
+		}
+		same[k]--
+		if _, dup := removed[l.Name]; dup || l.Name == "" {
+			unnamed = append(unnamed, l)
+			continue
+		}
+		removed[l.Name] = l
+	}
+
+	for _, l := range added {
+		child, err := l.GetNode(ctx, p.dserv)
 		if err != nil {
 			return err
 		}
+		p.indirPin.Increment(key.Key(l.Hash))
 
-		k, err := node.Key()
+		ol, ok := removed[l.Name]
+		if !ok || l.Name == "" {
+			if err := p.pinLinks(ctx, child); err != nil {
+				return err
+			}
+			continue
+		}
+		delete(removed, l.Name)
+		p.indirPin.Decrement(key.Key(ol.Hash))
+		oldChild, err := ol.GetNode(ctx, p.dserv)
+		if filestore.IsBroken(err) {
+			// references to files are leaves, there is nothing below
+			continue
+		}
 		if err != nil {
 			return err
 		}
+		if err := p.updateLinks(ctx, oldChild, child); err != nil {
+			return err
+		}
+	}
 
+	for _, l := range removed {
+		unnamed = append(unnamed, l)
+	}
+	return p.unpinLinks(ctx, &mdag.Node{Links: unnamed})
+}
+
+func (p *pinner) unpinLinks(ctx context.Context, node *mdag.Node) error {
+	for _, l := range node.Links {
+		k := key.Key(l.Hash)
 		p.indirPin.Decrement(k)
 
+		node, err := l.GetNode(ctx, p.dserv)
+		if filestore.IsBroken(err) {
+			// references to files are leaves, there is nothing below
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
 		err = p.unpinLinks(ctx, node)
 		if err != nil {
 			return err
@@ -167,26 +296,62 @@ func (p *pinner) unpinLinks(ctx context.Context, node *mdag.Node) error {
 	return nil
 }
 
-func (p *pinner) pinIndirectRecurse(ctx context.Context, node *mdag.Node) error {
-	k, err := node.Key()
-	if err != nil {
-		return err
-	}
-
-	p.indirPin.Increment(k)
-	return p.pinLinks(ctx, node)
+func (p *pinner) pinLinks(ctx context.Context, node *mdag.Node) error {
+	return p.pinLinksConcurrently(ctx, node, 0)
 }
 
-func (p *pinner) pinLinks(ctx context.Context, node *mdag.Node) error {
-	for _, ng := range p.dserv.GetDAG(ctx, node) {
-		subnode, err := ng.Get(ctx)
+// pinLinksConcurrently pins the nodes below node indirectly, once for
+// every path from node to them. The dag is walked once with
+// traverse.Fetch, which reads every node only once, and the number of
+// paths to each node is counted from the links it recorded.
+func (p *pinner) pinLinksConcurrently(ctx context.Context, node *mdag.Node, concurrency int) error {
+	links := make(map[key.Key][]key.Key)
+	parents := make(map[key.Key]int)
+	record := func(nd *mdag.Node) error {
+		k, err := nd.Key()
 		if err != nil {
-			// TODO: Maybe just log and continue?
 			return err
 		}
-		err = p.pinIndirectRecurse(ctx, subnode)
-		if err != nil {
-			return err
+		for _, l := range nd.Links {
+			links[k] = append(links[k], key.Key(l.Hash))
+			parents[key.Key(l.Hash)]++
+		}
+		return nil
+	}
+	if err := record(node); err != nil {
+		return err
+	}
+	err := traverse.Fetch(ctx, node, traverse.FetchOptions{
+		DAG:         p.dserv,
+		Concurrency: concurrency,
+		Visit:       record,
+	})
+	if err != nil {
+		return err
+	}
+
+	// count the paths in topological order: a node is done once all the
+	// links to it have been counted
+	rk, err := node.Key()
+	if err != nil {
+		return err
+	}
+	paths := map[key.Key]int{rk: 1}
+	queue := []key.Key{rk}
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		if k != rk {
+			for i := 0; i < paths[k]; i++ {
+				p.indirPin.Increment(k)
+			}
+		}
+		for _, c := range links[k] {
+			paths[c] += paths[k]
+			parents[c]--
+			if parents[c] == 0 {
+				queue = append(queue, c)
+			}
 		}
 	}
 	return nil
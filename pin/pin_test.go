@@ -1,6 +1,8 @@
 package pin
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -228,3 +230,263 @@ func TestPinRecursiveFail(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTempPinnerRelease(t *testing.T) {
+	ctx := context.Background()
+
+	dstore := dssync.MutexWrap(ds.NewMapDatastore())
+	bstore := blockstore.NewBlockstore(dstore)
+	bserv := bs.New(bstore, offline.Exchange(bstore))
+
+	dserv := mdag.NewDAGService(bserv)
+
+	p := NewPinner(dstore, dserv)
+
+	a, ak := randNode()
+	_, err := dserv.Add(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, _ := randNode()
+	err = b.AddNodeLink("child", a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = dserv.Add(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// pins A indirectly
+	err = p.Pin(ctx, b, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ck := randNode()
+
+	tp := NewTempPinner(p.GetManual())
+	tp.Pin(ak)
+	tp.Pin(ck)
+	if !p.IsPinned(ck) {
+		t.Fatal("temporarily pinned node not pinned")
+	}
+
+	err = tp.Release()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.IsPinned(ck) {
+		t.Fatal("released node still pinned")
+	}
+	if !p.IsPinned(ak) {
+		t.Fatal("released node lost its other pins")
+	}
+}
+
+// countingDAGService counts the nodes requested from it.
+type countingDAGService struct {
+	mdag.DAGService
+
+	lock      sync.Mutex
+	requested int
+	gets      map[key.Key]int
+}
+
+func (cds *countingDAGService) Get(ctx context.Context, k key.Key) (*mdag.Node, error) {
+	cds.lock.Lock()
+	cds.requested++
+	if cds.gets != nil {
+		cds.gets[k]++
+	}
+	cds.lock.Unlock()
+	return cds.DAGService.Get(ctx, k)
+}
+
+func (cds *countingDAGService) GetDAG(ctx context.Context, root *mdag.Node) []mdag.NodeGetter {
+	cds.lock.Lock()
+	cds.requested += len(root.Links)
+	cds.lock.Unlock()
+	return cds.DAGService.GetDAG(ctx, root)
+}
+
+func TestPinRecursiveCountsPaths(t *testing.T) {
+	ctx := context.Background()
+
+	dstore := dssync.MutexWrap(ds.NewMapDatastore())
+	bstore := blockstore.NewBlockstore(dstore)
+	bserv := bs.New(bstore, offline.Exchange(bstore))
+	dserv := &countingDAGService{DAGService: mdag.NewDAGService(bserv)}
+
+	p := NewPinner(dstore, dserv)
+
+	// c is reached by five paths: twice through each link to a, and once
+	// through b
+	root, _ := randNode()
+	a, _ := randNode()
+	b, _ := randNode()
+	c, ck := randNode()
+	for _, l := range []struct {
+		from, to *mdag.Node
+		name     string
+	}{
+		{a, c, "c1"}, {a, c, "c2"}, {b, c, "c"},
+		{root, a, "a1"}, {root, a, "a2"}, {root, b, "b"},
+	} {
+		if err := l.from.AddNodeLink(l.name, l.to); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := dserv.AddRecursive(root); err != nil {
+		t.Fatal(err)
+	}
+	rk, err := root.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ak, err := a.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bk, err := b.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// fetch through links without cached nodes
+	nroot := root.Copy()
+	for i, l := range nroot.Links {
+		cl := *l
+		cl.Node = nil
+		nroot.Links[i] = &cl
+	}
+
+	dserv.gets = make(map[key.Key]int)
+	if err := p.PinRecursive(ctx, nroot, 2); err != nil {
+		t.Fatal(err)
+	}
+	for k, n := range dserv.gets {
+		if n != 1 {
+			t.Fatalf("%s fetched %d times", k, n)
+		}
+	}
+
+	refs := p.IndirectKeys()
+	for k, exp := range map[key.Key]int{ak: 2, bk: 1, ck: 5} {
+		if refs[k] != exp {
+			t.Fatalf("%s pinned %d times, expected %d", k, refs[k], exp)
+		}
+	}
+	if _, ok := refs[rk]; ok {
+		t.Fatal("root pinned indirectly")
+	}
+
+	if err := p.Unpin(ctx, rk, true); err != nil {
+		t.Fatal(err)
+	}
+	if refs := p.IndirectKeys(); len(refs) != 0 {
+		t.Fatalf("indirect pins left after unpinning: %v", refs)
+	}
+}
+
+func TestPinUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	dstore := dssync.MutexWrap(ds.NewMapDatastore())
+	bstore := blockstore.NewBlockstore(dstore)
+	bserv := bs.New(bstore, offline.Exchange(bstore))
+	dserv := &countingDAGService{DAGService: mdag.NewDAGService(bserv)}
+
+	p := NewPinner(dstore, dserv)
+
+	// a root with ten directories of ten leaves, and a leaf they share
+	shared, _ := randNode()
+	root, _ := randNode()
+	dirs := make([]*mdag.Node, 10)
+	for i := range dirs {
+		dirs[i], _ = randNode()
+		for j := 0; j < 10; j++ {
+			leaf, _ := randNode()
+			if err := dirs[i].AddNodeLink(fmt.Sprintf("leaf%d", j), leaf); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := dirs[i].AddNodeLink("shared", shared); err != nil {
+			t.Fatal(err)
+		}
+		if err := root.AddNodeLink(fmt.Sprintf("dir%d", i), dirs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := dserv.AddRecursive(root); err != nil {
+		t.Fatal(err)
+	}
+	rk, err := root.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Pin(ctx, root, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// change a leaf of one directory, drop another directory
+	nroot := root.Copy()
+	ndir := dirs[3].Copy()
+	leaf, _ := randNode()
+	if err := ndir.RemoveNodeLink("leaf2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ndir.AddNodeLink("leaf2", leaf); err != nil {
+		t.Fatal(err)
+	}
+	if err := nroot.RemoveNodeLink("dir3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := nroot.AddNodeLink("dir3", ndir); err != nil {
+		t.Fatal(err)
+	}
+	if err := nroot.RemoveNodeLink("dir7"); err != nil {
+		t.Fatal(err)
+	}
+	if err := dserv.AddRecursive(nroot); err != nil {
+		t.Fatal(err)
+	}
+
+	dserv.requested = 0
+	if err := p.Update(ctx, rk, nroot); err != nil {
+		t.Fatal(err)
+	}
+	// the old root and changed directory, the new leaf, and the dropped
+	// directory with its leaves
+	if dserv.requested > 4+12 {
+		t.Fatalf("expected only the changes to be walked, %d nodes were requested", dserv.requested)
+	}
+
+	// the pins are the same as those of pinning the new root anew
+	fresh := NewPinner(dssync.MutexWrap(ds.NewMapDatastore()), dserv)
+	if err := fresh.Pin(ctx, nroot, true); err != nil {
+		t.Fatal(err)
+	}
+	nk, err := nroot.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.IsPinned(rk) {
+		t.Fatal("old root still pinned")
+	}
+	if keys := p.RecursiveKeys(); len(keys) != 1 || keys[0] != nk {
+		t.Fatalf("expected only the new root pinned recursively, got %v", keys)
+	}
+	got, exp := p.IndirectKeys(), fresh.IndirectKeys()
+	if len(got) != len(exp) {
+		t.Fatalf("expected %d indirect pins, got %d", len(exp), len(got))
+	}
+	for k, c := range exp {
+		if got[k] != c {
+			t.Fatalf("%s pinned %d times, expected %d", k, got[k], c)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package pin
+
+import (
+	"sync"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+)
+
+// TempPinner pins objects indirectly while they are being imported, so
+// that a concurrent garbage collection does not remove them, and removes
+// all these pins at once with Release.
+type TempPinner struct {
+	lock sync.Mutex
+	mp   ManualPinner
+	keys []key.Key
+}
+
+// NewTempPinner returns a TempPinner adding its pins to mp.
+func NewTempPinner(mp ManualPinner) *TempPinner {
+	return &TempPinner{mp: mp}
+}
+
+// Pin pins k indirectly until Release is called.
+func (tp *TempPinner) Pin(k key.Key) {
+	tp.lock.Lock()
+	defer tp.lock.Unlock()
+	tp.mp.PinWithMode(k, Indirect)
+	tp.keys = append(tp.keys, k)
+}
+
+// Release removes the pins added through tp. The objects are left to the
+// pins others hold on them.
+func (tp *TempPinner) Release() error {
+	tp.lock.Lock()
+	defer tp.lock.Unlock()
+	for _, k := range tp.keys {
+		tp.mp.RemovePinWithMode(k, Indirect)
+	}
+	tp.keys = nil
+	return tp.mp.Flush()
+}
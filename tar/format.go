@@ -10,6 +10,7 @@ import (
 
 	importer "github.com/ipfs/go-ipfs/importer"
 	chunk "github.com/ipfs/go-ipfs/importer/chunk"
+	h "github.com/ipfs/go-ipfs/importer/helpers"
 	dag "github.com/ipfs/go-ipfs/merkledag"
 	dagutil "github.com/ipfs/go-ipfs/merkledag/utils"
 	uio "github.com/ipfs/go-ipfs/unixfs/io"
@@ -33,7 +34,15 @@ func marshalHeader(h *tar.Header) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-func ImportTar(r io.Reader, ds dag.DAGService) (*dag.Node, error) {
+// ImportTar adds the tar archive read from r to ds. If ncb is not nil, it
+// is called with every node added, with last set for the root only.
+func ImportTar(r io.Reader, ds dag.DAGService, ncb h.NodeCB) (*dag.Node, error) {
+	if ncb == nil {
+		ncb = func(*dag.Node, bool) error { return nil }
+	}
+	// the roots of file data are not the last node
+	datacb := func(nd *dag.Node, _ bool) error { return ncb(nd, false) }
+
 	rall, err := ioutil.ReadAll(r)
 	if err != nil {
 		return nil, err
@@ -46,7 +55,9 @@ func ImportTar(r io.Reader, ds dag.DAGService) (*dag.Node, error) {
 	root := new(dag.Node)
 	root.Data = []byte("ipfs/tar")
 
-	e := dagutil.NewDagEditor(ds, root)
+	// the headers and the nodes above them are built in memory, and only
+	// written out once the tree is complete
+	e := dagutil.NewMemoryEditor(ds, root)
 
 	for {
 		h, err := tr.Next()
@@ -68,7 +79,7 @@ func ImportTar(r io.Reader, ds dag.DAGService) (*dag.Node, error) {
 
 		if h.Size > 0 {
 			spl := chunk.NewRabin(tr, uint64(chunk.DefaultBlockSize))
-			nd, err := importer.BuildDagFromReader(ds, spl, nil)
+			nd, err := importer.BuildDagFromReader(ds, spl, datacb)
 			if err != nil {
 				return nil, err
 			}
@@ -79,11 +90,6 @@ func ImportTar(r io.Reader, ds dag.DAGService) (*dag.Node, error) {
 			}
 		}
 
-		_, err = ds.Add(header)
-		if err != nil {
-			return nil, err
-		}
-
 		path := escapePath(h.Name)
 		err = e.InsertNodeAtPath(context.Background(), path, header, func() *dag.Node { return new(dag.Node) })
 		if err != nil {
@@ -92,7 +98,17 @@ func ImportTar(r io.Reader, ds dag.DAGService) (*dag.Node, error) {
 	}
 
 	root = e.GetNode()
-	_, err = ds.Add(root)
+	rk, err := root.Key()
+	if err != nil {
+		return nil, err
+	}
+	err = e.WriteOutputCB(ds, func(nd *dag.Node) error {
+		k, err := nd.Key()
+		if err != nil {
+			return err
+		}
+		return ncb(nd, k == rk)
+	})
 	if err != nil {
 		return nil, err
 	}
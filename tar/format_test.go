@@ -0,0 +1,76 @@
+package tarfmt
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	mdtest "github.com/ipfs/go-ipfs/merkledag/test"
+)
+
+func TestImportTarCallsBack(t *testing.T) {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for _, name := range []string{"a/b/c", "a/d", "e"} {
+		h := &tar.Header{Name: name, Mode: 0644, Typeflag: tar.TypeReg, Size: int64(len(name))}
+		if err := tw.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ds := mdtest.Mock()
+	called := make(map[key.Key]bool)
+	var last []key.Key
+	root, err := ImportTar(buf, ds, func(nd *dag.Node, isLast bool) error {
+		k, err := nd.Key()
+		if err != nil {
+			return err
+		}
+		called[k] = true
+		if isLast {
+			last = append(last, k)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rk, err := root.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(last) != 1 || last[0] != rk {
+		t.Fatalf("expected the root alone as last node, got %v", last)
+	}
+
+	// every node of the tree went through the callback, the directories
+	// above the headers included
+	var walk func(nd *dag.Node)
+	walk = func(nd *dag.Node) {
+		k, err := nd.Key()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !called[k] {
+			t.Fatalf("%s was not passed to the callback", k)
+		}
+		for _, l := range nd.Links {
+			child, err := l.GetNode(context.Background(), ds)
+			if err != nil {
+				t.Fatal(err)
+			}
+			walk(child)
+		}
+	}
+	walk(root)
+}
@@ -133,7 +133,7 @@ func DirectAddCat(data []byte, conf testutil.LatencyConfig) error {
 		return err
 	}
 
-	added, err := coreunix.Add(adder, bytes.NewReader(data))
+	added, err := coreunix.Add(adder, bytes.NewReader(data), true)
 	if err != nil {
 		return err
 	}
@@ -78,7 +78,7 @@ func benchCat(b *testing.B, data []byte, conf testutil.LatencyConfig) error {
 		return err
 	}
 
-	added, err := coreunix.Add(adder, bytes.NewReader(data))
+	added, err := coreunix.Add(adder, bytes.NewReader(data), true)
 	if err != nil {
 		return err
 	}
@@ -112,7 +112,7 @@ func RunThreeLeggedCat(data []byte, conf testutil.LatencyConfig) error {
 		return err
 	}
 
-	added, err := coreunix.Add(adder, bytes.NewReader(data))
+	added, err := coreunix.Add(adder, bytes.NewReader(data), true)
 	if err != nil {
 		return err
 	}
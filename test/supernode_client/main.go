@@ -152,7 +152,7 @@ func runFileAddingWorker(n *core.IpfsNode) error {
 					log.Fatal(err)
 				}
 			}()
-			k, err := coreunix.Add(n, piper)
+			k, err := coreunix.Add(n, piper, true)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -189,7 +189,7 @@ func runFileCattingWorker(ctx context.Context, n *core.IpfsNode) error {
 				log.Fatal(err)
 			}
 			// add to a dummy node to discover the key
-			k, err := coreunix.Add(dummy, bytes.NewReader(buf.Bytes()))
+			k, err := coreunix.Add(dummy, bytes.NewReader(buf.Bytes()), true)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -12,6 +12,15 @@ import (
 
 type Extractor struct {
 	Path string
+
+	// directories extracted so far, their mode and times are set once
+	// their contents are written
+	dirs []extractedDir
+}
+
+type extractedDir struct {
+	path   string
+	header *tar.Header
 }
 
 func (te *Extractor) Extract(reader io.Reader) error {
@@ -57,9 +66,25 @@ func (te *Extractor) Extract(reader io.Reader) error {
 			return fmt.Errorf("unrecognized tar header type: %d", header.Typeflag)
 		}
 	}
+
+	// children come after their parent, so set the deepest directories
+	// first
+	for i := len(te.dirs) - 1; i >= 0; i-- {
+		if err := setStat(te.dirs[i].path, te.dirs[i].header); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// setStat sets the mode and modification time of path to those in h.
+func setStat(path string, h *tar.Header) error {
+	if err := os.Chmod(path, os.FileMode(h.Mode).Perm()); err != nil {
+		return err
+	}
+	return os.Chtimes(path, h.ModTime, h.ModTime)
+}
+
 // outputPath returns the path at whicht o place tarPath
 func (te *Extractor) outputPath(tarPath string) string {
 	elems := strings.Split(tarPath, "/") // break into elems
@@ -83,6 +108,7 @@ func (te *Extractor) extractDir(h *tar.Header, depth int) error {
 		return err
 	}
 
+	te.dirs = append(te.dirs, extractedDir{path, h})
 	return nil
 }
 
@@ -109,12 +135,12 @@ func (te *Extractor) extractFile(h *tar.Header, r *tar.Reader, depth int, rootEx
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
 	_, err = io.Copy(file, r)
+	file.Close()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return setStat(path, h)
 }
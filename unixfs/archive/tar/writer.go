@@ -3,10 +3,10 @@ package tar
 import (
 	"archive/tar"
 	"io"
+	"os"
 	"path"
 	"time"
 
-	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
 	cxt "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	mdag "github.com/ipfs/go-ipfs/merkledag"
@@ -34,11 +34,15 @@ func NewWriter(ctx cxt.Context, dag mdag.DAGService, archive bool, compression i
 	}, nil
 }
 
-func (w *Writer) writeDir(nd *mdag.Node, fpath string) error {
-	if err := writeDirHeader(w.TarW, fpath); err != nil {
+func (w *Writer) writeDir(nd *mdag.Node, pb *upb.Data, fpath string) error {
+	if err := writeDirHeader(w.TarW, fpath, pb); err != nil {
 		return err
 	}
 
+	if pb.GetType() == upb.Data_HAMTShard {
+		return w.writeShard(nd, fpath)
+	}
+
 	for i, ng := range w.Dag.GetDAG(w.ctx, nd) {
 		child, err := ng.Get(w.ctx)
 		if err != nil {
@@ -54,8 +58,28 @@ func (w *Writer) writeDir(nd *mdag.Node, fpath string) error {
 	return nil
 }
 
+// writeShard writes the entries of a sharded directory, in name order.
+func (w *Writer) writeShard(nd *mdag.Node, fpath string) error {
+	links, err := uio.DirEntries(w.ctx, w.Dag, nd)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range links {
+		child, err := l.GetNode(w.ctx, w.Dag)
+		if err != nil {
+			return err
+		}
+
+		if err := w.WriteNode(child, path.Join(fpath, l.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (w *Writer) writeFile(nd *mdag.Node, pb *upb.Data, fpath string) error {
-	if err := writeFileHeader(w.TarW, fpath, pb.GetFilesize()); err != nil {
+	if err := writeFileHeader(w.TarW, fpath, pb); err != nil {
 		return err
 	}
 
@@ -68,16 +92,16 @@ func (w *Writer) writeFile(nd *mdag.Node, pb *upb.Data, fpath string) error {
 }
 
 func (w *Writer) WriteNode(nd *mdag.Node, fpath string) error {
-	pb := new(upb.Data)
-	if err := proto.Unmarshal(nd.Data, pb); err != nil {
+	pb, err := ft.FromNode(nd)
+	if err != nil {
 		return err
 	}
 
 	switch pb.GetType() {
 	case upb.Data_Metadata:
 		fallthrough
-	case upb.Data_Directory:
-		return w.writeDir(nd, fpath)
+	case upb.Data_Directory, upb.Data_HAMTShard:
+		return w.writeDir(nd, pb, fpath)
 	case upb.Data_Raw:
 		fallthrough
 	case upb.Data_File:
@@ -93,27 +117,42 @@ func (w *Writer) Close() error {
 	return w.TarW.Close()
 }
 
-func writeDirHeader(w *tar.Writer, fpath string) error {
+func writeDirHeader(w *tar.Writer, fpath string, pb *upb.Data) error {
 	return w.WriteHeader(&tar.Header{
 		Name:     fpath,
 		Typeflag: tar.TypeDir,
-		Mode:     0777,
-		ModTime:  time.Now(),
-		// TODO: set mode, dates, etc. when added to unixFS
+		Mode:     headerMode(pb, 0755),
+		ModTime:  headerModTime(pb),
 	})
 }
 
-func writeFileHeader(w *tar.Writer, fpath string, size uint64) error {
+func writeFileHeader(w *tar.Writer, fpath string, pb *upb.Data) error {
 	return w.WriteHeader(&tar.Header{
 		Name:     fpath,
-		Size:     int64(size),
+		Size:     int64(pb.GetFilesize()),
 		Typeflag: tar.TypeReg,
-		Mode:     0644,
-		ModTime:  time.Now(),
-		// TODO: set mode, dates, etc. when added to unixFS
+		Mode:     headerMode(pb, 0644),
+		ModTime:  headerModTime(pb),
 	})
 }
 
+// headerMode returns the permission bits recorded in pb, or def.
+func headerMode(pb *upb.Data, def os.FileMode) int64 {
+	if mode := ft.Mode(pb); mode != 0 {
+		return int64(mode)
+	}
+	return int64(def)
+}
+
+// headerModTime returns the modification time recorded in pb, or the
+// current time.
+func headerModTime(pb *upb.Data) time.Time {
+	if mtime := ft.ModTime(pb); !mtime.IsZero() {
+		return mtime
+	}
+	return time.Now()
+}
+
 func writeSymlinkHeader(w *tar.Writer, target, fpath string) error {
 	return w.WriteHeader(&tar.Header{
 		Name:     fpath,
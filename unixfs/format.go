@@ -4,8 +4,11 @@ package unixfs
 
 import (
 	"errors"
+	"os"
+	"time"
 
 	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
+	dag "github.com/ipfs/go-ipfs/merkledag"
 	pb "github.com/ipfs/go-ipfs/unixfs/pb"
 )
 
@@ -14,6 +17,8 @@ const (
 	TFile      = pb.Data_File
 	TDirectory = pb.Data_Directory
 	TMetadata  = pb.Data_Metadata
+	TSymlink   = pb.Data_Symlink
+	THAMTShard = pb.Data_HAMTShard
 )
 
 var ErrMalformedFileFormat = errors.New("malformed data in file format")
@@ -29,6 +34,37 @@ func FromBytes(data []byte) (*pb.Data, error) {
 	return pbdata, nil
 }
 
+// FromNode returns the unixfs data of nd. Raw nodes hold the data of a
+// file, they are returned as data of type Raw.
+func FromNode(nd *dag.Node) (*pb.Data, error) {
+	if nd.IsRaw() {
+		typ := pb.Data_Raw
+		return &pb.Data{
+			Type:     &typ,
+			Data:     nd.Data,
+			Filesize: proto.Uint64(uint64(len(nd.Data))),
+		}, nil
+	}
+	return FromBytes(nd.Data)
+}
+
+// IsDir reports whether nd is a unixfs directory, sharded or not.
+func IsDir(nd *dag.Node) bool {
+	if nd.IsRaw() {
+		return false
+	}
+	pbdata, err := FromBytes(nd.Data)
+	if err != nil {
+		return false
+	}
+	switch pbdata.GetType() {
+	case TDirectory, THAMTShard:
+		return true
+	default:
+		return false
+	}
+}
+
 func FilePBData(data []byte, totalsize uint64) []byte {
 	pbfile := new(pb.Data)
 	typ := pb.Data_File
@@ -62,6 +98,61 @@ func FolderPBData() []byte {
 	return data
 }
 
+// HAMTShardData returns the data of a directory shard with the given
+// bitfield of used slots.
+func HAMTShardData(bitfield []byte, fanout, hashType uint64) ([]byte, error) {
+	pbdata := new(pb.Data)
+	typ := pb.Data_HAMTShard
+	pbdata.Type = &typ
+	pbdata.Data = bitfield
+	pbdata.Fanout = proto.Uint64(fanout)
+	pbdata.HashType = proto.Uint64(hashType)
+
+	return proto.Marshal(pbdata)
+}
+
+// Mode returns the permission bits recorded in pbdata, zero if there are
+// none.
+func Mode(pbdata *pb.Data) os.FileMode {
+	return os.FileMode(pbdata.GetMode()) & os.ModePerm
+}
+
+// ModTime returns the modification time recorded in pbdata, the zero Time
+// if there is none.
+func ModTime(pbdata *pb.Data) time.Time {
+	if pbdata.Mtime == nil {
+		return time.Time{}
+	}
+	return time.Unix(pbdata.GetMtime(), int64(pbdata.GetMtimeNsecs()))
+}
+
+func setStat(pbdata *pb.Data, mode os.FileMode, mtime time.Time) {
+	pbdata.Mode = nil
+	if mode != 0 {
+		pbdata.Mode = proto.Uint32(uint32(mode & os.ModePerm))
+	}
+
+	pbdata.Mtime = nil
+	pbdata.MtimeNsecs = nil
+	if !mtime.IsZero() {
+		pbdata.Mtime = proto.Int64(mtime.Unix())
+		if ns := mtime.Nanosecond(); ns != 0 {
+			pbdata.MtimeNsecs = proto.Uint32(uint32(ns))
+		}
+	}
+}
+
+// SetStat returns data with the given permission bits and modification
+// time recorded. A zero mode or mtime removes the recorded value.
+func SetStat(data []byte, mode os.FileMode, mtime time.Time) ([]byte, error) {
+	pbdata, err := FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	setStat(pbdata, mode, mtime)
+	return proto.Marshal(pbdata)
+}
+
 func WrapData(b []byte) []byte {
 	pbdata := new(pb.Data)
 	typ := pb.Data_Raw
@@ -130,6 +221,11 @@ type FSNode struct {
 
 	// node type of this node
 	Type pb.Data_DataType
+
+	// permission bits and modification time of the file, zero if they
+	// are not recorded
+	Mode    os.FileMode
+	ModTime time.Time
 }
 
 func FSNodeFromBytes(b []byte) (*FSNode, error) {
@@ -138,13 +234,27 @@ func FSNodeFromBytes(b []byte) (*FSNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	return fsNodeFromPB(pbn), nil
+}
+
+// FSNodeFromNode returns the FSNode of nd, see FromNode.
+func FSNodeFromNode(nd *dag.Node) (*FSNode, error) {
+	pbn, err := FromNode(nd)
+	if err != nil {
+		return nil, err
+	}
+	return fsNodeFromPB(pbn), nil
+}
 
+func fsNodeFromPB(pbn *pb.Data) *FSNode {
 	n := new(FSNode)
 	n.Data = pbn.Data
 	n.blocksizes = pbn.Blocksizes
 	n.subtotal = pbn.GetFilesize() - uint64(len(n.Data))
 	n.Type = pbn.GetType()
-	return n, nil
+	n.Mode = Mode(pbn)
+	n.ModTime = ModTime(pbn)
+	return n
 }
 
 // AddBlockSize adds the size of the next child block of this node
@@ -164,6 +274,7 @@ func (n *FSNode) GetBytes() ([]byte, error) {
 	pbn.Filesize = proto.Uint64(uint64(len(n.Data)) + n.subtotal)
 	pbn.Blocksizes = n.blocksizes
 	pbn.Data = n.Data
+	setStat(pbn, n.Mode, n.ModTime)
 	return proto.Marshal(pbn)
 }
 
@@ -2,6 +2,7 @@ package unixfs
 
 import (
 	"testing"
+	"time"
 
 	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
 
@@ -37,3 +38,38 @@ func TestFSNode(t *testing.T) {
 		t.Fatal("Datasize calculations incorrect!")
 	}
 }
+
+func TestFSNodeStat(t *testing.T) {
+	mtime := time.Unix(1445000000, 123)
+
+	fsn := new(FSNode)
+	fsn.Type = TFile
+	fsn.Data = []byte("#!/bin/sh")
+	fsn.Mode = 0755
+	fsn.ModTime = mtime
+
+	b, err := fsn.GetBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nfsn, err := FSNodeFromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nfsn.Mode != 0755 || !nfsn.ModTime.Equal(mtime) {
+		t.Fatalf("stat not preserved: %s %s", nfsn.Mode, nfsn.ModTime)
+	}
+
+	b, err = SetStat(b, 0, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pbn, err := FromBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Mode(pbn) != 0 || !ModTime(pbn).IsZero() {
+		t.Fatal("stat not removed")
+	}
+}
@@ -0,0 +1,418 @@
+// Package hamt implements sharded unixfs directories.
+//
+// A directory with a large number of entries is stored as a hash array
+// mapped trie. Every node of the trie is a unixfs HAMTShard: its data holds
+// a bitfield of the slots in use, and every slot holds either an entry of
+// the directory or another shard. The slot of an entry in a shard of depth d
+// is given by the d-th group of log2(fanout) bits of the hash of its name.
+//
+// Links of a shard are named after their slot, in hexadecimal padded to the
+// width of the largest slot, followed by the name of the entry for links to
+// entries. A link to a child shard has no name after the slot:
+//
+//   0A        -> child shard
+//   0Bfoo.txt -> entry "foo.txt"
+//
+// The trie is canonical: a shard only exists below another one if at least
+// two entries share the slot, so the same set of entries always gives the
+// same DAG.
+package hamt
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	ft "github.com/ipfs/go-ipfs/unixfs"
+	upb "github.com/ipfs/go-ipfs/unixfs/pb"
+)
+
+// DefaultFanout is the number of slots of new shards.
+const DefaultFanout = 256
+
+// HashType is the multihash function names are hashed with.
+const HashType = mh.SHA2_256
+
+var ErrNotShard = errors.New("node is not a directory shard")
+
+// Shard is one node of a sharded directory, loaded in memory. Child shards
+// are loaded as they are needed.
+type Shard struct {
+	dserv dag.DAGService
+
+	fanout  int
+	bitlen  int // log2(fanout), the number of hash bits per level
+	padlen  int // length of the slot prefix of link names
+	depth   int
+	hashFn  int // multihash function of the shard nodes
+	entries map[int]*entry
+
+	// permission bits and modification time of the directory, recorded
+	// in the root shard only
+	mode  os.FileMode
+	mtime time.Time
+}
+
+// entry is a slot in use. It holds either a link to a directory entry, a
+// loaded child shard, or a link to a child shard not loaded yet.
+type entry struct {
+	name  string
+	link  *dag.Link
+	shard *Shard
+}
+
+func (e *entry) isShard() bool {
+	return e.shard != nil || e.name == ""
+}
+
+// entryLink returns a copy of the link to the directory entry in e.
+func (e *entry) entryLink() *dag.Link {
+	return &dag.Link{Name: e.name, Hash: e.link.Hash, Size: e.link.Size, Raw: e.link.Raw}
+}
+
+// NewShard returns an empty shard with the given number of slots, which
+// must be a power of two.
+func NewShard(ds dag.DAGService, fanout int) (*Shard, error) {
+	return newShard(ds, fanout, 0)
+}
+
+func newShard(ds dag.DAGService, fanout, depth int) (*Shard, error) {
+	if fanout < 2 || fanout&(fanout-1) != 0 {
+		return nil, fmt.Errorf("hamt: fanout must be a power of two, not %d", fanout)
+	}
+	bitlen := 0
+	for 1<<uint(bitlen) < fanout {
+		bitlen++
+	}
+
+	return &Shard{
+		dserv:   ds,
+		fanout:  fanout,
+		bitlen:  bitlen,
+		padlen:  len(fmt.Sprintf("%X", fanout-1)),
+		depth:   depth,
+		entries: make(map[int]*entry),
+	}, nil
+}
+
+// IsShard reports whether nd is a directory shard.
+func IsShard(nd *dag.Node) bool {
+	pbd, err := ft.FromNode(nd)
+	return err == nil && pbd.GetType() == upb.Data_HAMTShard
+}
+
+// NewHamtFromDag loads the root shard of a sharded directory.
+func NewHamtFromDag(ds dag.DAGService, nd *dag.Node) (*Shard, error) {
+	return loadShard(ds, nd, 0)
+}
+
+func loadShard(ds dag.DAGService, nd *dag.Node, depth int) (*Shard, error) {
+	pbd, err := ft.FromNode(nd)
+	if err != nil {
+		return nil, err
+	}
+	if pbd.GetType() != upb.Data_HAMTShard {
+		return nil, ErrNotShard
+	}
+	if pbd.GetHashType() != HashType {
+		return nil, fmt.Errorf("hamt: unsupported hash function %d", pbd.GetHashType())
+	}
+
+	s, err := newShard(ds, int(pbd.GetFanout()), depth)
+	if err != nil {
+		return nil, err
+	}
+	s.hashFn = nd.HashFunc()
+	if depth == 0 {
+		s.mode = ft.Mode(pbd)
+		s.mtime = ft.ModTime(pbd)
+	}
+
+	for _, l := range nd.Links {
+		if len(l.Name) < s.padlen {
+			return nil, fmt.Errorf("hamt: invalid link name %q", l.Name)
+		}
+		idx, err := strconv.ParseUint(l.Name[:s.padlen], 16, 64)
+		if err != nil || int(idx) >= s.fanout {
+			return nil, fmt.Errorf("hamt: invalid link name %q", l.Name)
+		}
+
+		s.entries[int(idx)] = &entry{
+			name: l.Name[s.padlen:],
+			link: &dag.Link{Name: l.Name[s.padlen:], Hash: l.Hash, Size: l.Size, Raw: l.Raw},
+		}
+	}
+	return s, nil
+}
+
+// SetHashFunc sets the multihash function of the nodes of the shard.
+func (s *Shard) SetHashFunc(code int) {
+	s.hashFn = code
+}
+
+// SetStat sets the permission bits and modification time recorded in the
+// root shard. Zero values are not recorded.
+func (s *Shard) SetStat(mode os.FileMode, mtime time.Time) {
+	s.mode = mode
+	s.mtime = mtime
+}
+
+// Node serializes the shard, adding the child shards that changed to the
+// DAGService. The returned node itself is not added.
+func (s *Shard) Node() (*dag.Node, error) {
+	nd := new(dag.Node)
+	if s.hashFn != 0 {
+		nd.SetHashFunc(s.hashFn)
+	}
+
+	bitfield := make([]byte, (s.fanout+7)/8)
+	for idx, e := range s.entries {
+		bitfield[len(bitfield)-1-idx/8] |= 1 << uint(idx%8)
+
+		prefix := s.prefix(idx)
+		if e.shard == nil {
+			if err := nd.AddRawLink(prefix+e.name, e.link); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		cnd, err := e.shard.Node()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.dserv.Add(cnd); err != nil {
+			return nil, err
+		}
+		if err := nd.AddNodeLinkClean(prefix, cnd); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := ft.HAMTShardData(bitfield, uint64(s.fanout), HashType)
+	if err != nil {
+		return nil, err
+	}
+	if s.mode != 0 || !s.mtime.IsZero() {
+		data, err = ft.SetStat(data, s.mode, s.mtime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	nd.Data = data
+	return nd, nil
+}
+
+func (s *Shard) prefix(idx int) string {
+	return fmt.Sprintf("%0*X", s.padlen, idx)
+}
+
+// Set adds nd to the directory under name, replacing any entry with the
+// same name. nd is not added to the DAGService.
+func (s *Shard) Set(ctx context.Context, name string, nd *dag.Node) error {
+	lnk, err := dag.MakeLink(nd)
+	if err != nil {
+		return err
+	}
+	return s.SetLink(ctx, name, lnk)
+}
+
+// SetLink adds an entry to the directory that points at the target of lnk.
+func (s *Shard) SetLink(ctx context.Context, name string, lnk *dag.Link) error {
+	if name == "" {
+		return errors.New("hamt: entries must have a name")
+	}
+	e := &entry{
+		name: name,
+		link: &dag.Link{Name: name, Hash: lnk.Hash, Size: lnk.Size, Raw: lnk.Raw},
+	}
+	return s.set(ctx, hashName(name), e)
+}
+
+func (s *Shard) set(ctx context.Context, h []byte, e *entry) error {
+	idx, err := s.slot(h)
+	if err != nil {
+		return err
+	}
+
+	cur, ok := s.entries[idx]
+	switch {
+	case !ok, !cur.isShard() && cur.name == e.name:
+		s.entries[idx] = e
+		return nil
+
+	case cur.isShard():
+		child, err := s.child(ctx, cur)
+		if err != nil {
+			return err
+		}
+		return child.set(ctx, h, e)
+
+	default:
+		// two entries in the same slot, push both down a level
+		child, err := newShard(s.dserv, s.fanout, s.depth+1)
+		if err != nil {
+			return err
+		}
+		child.hashFn = s.hashFn
+		if err := child.set(ctx, hashName(cur.name), cur); err != nil {
+			return err
+		}
+		if err := child.set(ctx, h, e); err != nil {
+			return err
+		}
+		s.entries[idx] = &entry{shard: child}
+		return nil
+	}
+}
+
+// Remove removes the entry with the given name. It returns dag.ErrNotFound
+// if there is none.
+func (s *Shard) Remove(ctx context.Context, name string) error {
+	return s.remove(ctx, name, hashName(name))
+}
+
+func (s *Shard) remove(ctx context.Context, name string, h []byte) error {
+	idx, err := s.slot(h)
+	if err != nil {
+		return err
+	}
+
+	cur, ok := s.entries[idx]
+	if !ok {
+		return dag.ErrNotFound
+	}
+	if !cur.isShard() {
+		if cur.name != name {
+			return dag.ErrNotFound
+		}
+		delete(s.entries, idx)
+		return nil
+	}
+
+	child, err := s.child(ctx, cur)
+	if err != nil {
+		return err
+	}
+	if err := child.remove(ctx, name, h); err != nil {
+		return err
+	}
+
+	// keep the trie canonical: a shard left with a single entry is
+	// replaced by that entry.
+	if len(child.entries) == 1 {
+		for _, last := range child.entries {
+			if !last.isShard() {
+				s.entries[idx] = last
+			}
+		}
+	}
+	return nil
+}
+
+// Find returns a link to the entry with the given name, or dag.ErrNotFound.
+func (s *Shard) Find(ctx context.Context, name string) (*dag.Link, error) {
+	return s.find(ctx, name, hashName(name))
+}
+
+func (s *Shard) find(ctx context.Context, name string, h []byte) (*dag.Link, error) {
+	idx, err := s.slot(h)
+	if err != nil {
+		return nil, err
+	}
+
+	cur, ok := s.entries[idx]
+	if !ok {
+		return nil, dag.ErrNotFound
+	}
+	if !cur.isShard() {
+		if cur.name != name {
+			return nil, dag.ErrNotFound
+		}
+		return cur.entryLink(), nil
+	}
+
+	child, err := s.child(ctx, cur)
+	if err != nil {
+		return nil, err
+	}
+	return child.find(ctx, name, h)
+}
+
+// ForEachLink calls f with a link to every entry of the directory, in no
+// particular order.
+func (s *Shard) ForEachLink(ctx context.Context, f func(*dag.Link) error) error {
+	for _, e := range s.entries {
+		if !e.isShard() {
+			if err := f(e.entryLink()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		child, err := s.child(ctx, e)
+		if err != nil {
+			return err
+		}
+		if err := child.ForEachLink(ctx, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnumLinks returns links to every entry of the directory.
+func (s *Shard) EnumLinks(ctx context.Context) ([]*dag.Link, error) {
+	var links []*dag.Link
+	err := s.ForEachLink(ctx, func(l *dag.Link) error {
+		links = append(links, l)
+		return nil
+	})
+	return links, err
+}
+
+// child returns the shard in e, loading it if needed.
+func (s *Shard) child(ctx context.Context, e *entry) (*Shard, error) {
+	if e.shard != nil {
+		return e.shard, nil
+	}
+
+	nd, err := e.link.GetNode(ctx, s.dserv)
+	if err != nil {
+		return nil, err
+	}
+	child, err := loadShard(s.dserv, nd, s.depth+1)
+	if err != nil {
+		return nil, err
+	}
+	e.shard = child
+	return child, nil
+}
+
+// slot returns the slot of a hashed name in the shard.
+func (s *Shard) slot(h []byte) (int, error) {
+	start := s.depth * s.bitlen
+	if start+s.bitlen > len(h)*8 {
+		return 0, errors.New("hamt: too many collisions, the trie is too deep")
+	}
+
+	idx := 0
+	for i := start; i < start+s.bitlen; i++ {
+		bit := (h[i/8] >> uint(7-i%8)) & 1
+		idx = idx<<1 | int(bit)
+	}
+	return idx, nil
+}
+
+func hashName(name string) []byte {
+	h, err := mh.Sum([]byte(name), HashType, -1)
+	if err != nil {
+		panic(err) // sha2-256 is always supported
+	}
+	return h[2:] // skip the multihash code and length
+}
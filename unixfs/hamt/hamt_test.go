@@ -0,0 +1,177 @@
+package hamt
+
+import (
+	"fmt"
+	"testing"
+
+	context "github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	mdtest "github.com/ipfs/go-ipfs/merkledag/test"
+)
+
+func makeShard(t *testing.T, ds dag.DAGService, names []string) *dag.Node {
+	ctx := context.Background()
+	s, err := NewShard(ds, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range names {
+		if err := s.Set(ctx, name, &dag.Node{Data: []byte(name)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	nd, err := s.Node()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ds.Add(nd); err != nil {
+		t.Fatal(err)
+	}
+	return nd
+}
+
+func entryNames(n int) []string {
+	var names []string
+	for i := 0; i < n; i++ {
+		names = append(names, fmt.Sprintf("entry-%d", i))
+	}
+	return names
+}
+
+func TestShardFind(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+	names := entryNames(500)
+
+	s, err := NewHamtFromDag(ds, makeShard(t, ds, names))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range names {
+		lnk, err := s.Find(ctx, name)
+		if err != nil {
+			t.Fatalf("finding %s: %s", name, err)
+		}
+		exp, err := (&dag.Node{Data: []byte(name)}).Key()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lnk.Name != name || string(lnk.Hash) != string(exp) {
+			t.Fatalf("wrong link for %s", name)
+		}
+	}
+
+	if _, err := s.Find(ctx, "missing"); err != dag.ErrNotFound {
+		t.Fatal("expected ErrNotFound, got", err)
+	}
+
+	links, err := s.EnumLinks(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(links) != len(names) {
+		t.Fatalf("expected %d links, got %d", len(names), len(links))
+	}
+}
+
+func TestShardCanonical(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+	names := entryNames(300)
+
+	reversed := make([]string, len(names))
+	for i, name := range names {
+		reversed[len(names)-1-i] = name
+	}
+
+	a, err := makeShard(t, ds, names).Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := makeShard(t, ds, reversed).Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Fatal("insertion order changed the shard")
+	}
+
+	// removing entries gives the same trie as never adding them
+	s, err := NewHamtFromDag(ds, makeShard(t, ds, names))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names[100:] {
+		if err := s.Remove(ctx, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Remove(ctx, names[200]); err != dag.ErrNotFound {
+		t.Fatal("expected ErrNotFound, got", err)
+	}
+
+	nd, err := s.Node()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := nd.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := makeShard(t, ds, names[:100]).Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != d {
+		t.Fatal("removing entries did not give the same shard")
+	}
+}
+
+func TestShardRawLinks(t *testing.T) {
+	ctx := context.Background()
+	ds := mdtest.Mock()
+	names := entryNames(100)
+
+	s, err := NewShard(ds, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names {
+		if err := s.Set(ctx, name, dag.NewRawNode([]byte(name))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	nd, err := s.Node()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ds.Add(nd); err != nil {
+		t.Fatal(err)
+	}
+
+	// read back from the dag, through child shards
+	s, err = NewHamtFromDag(ds, nd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lnk, err := s.Find(ctx, names[42])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !lnk.Raw {
+		t.Fatal("expected Find to keep the link raw")
+	}
+	err = s.ForEachLink(ctx, func(l *dag.Link) error {
+		if !l.Raw {
+			return fmt.Errorf("link %s is not raw anymore", l.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
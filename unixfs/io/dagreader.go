@@ -7,7 +7,6 @@ import (
 	"io"
 	"os"
 
-	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	mdag "github.com/ipfs/go-ipfs/merkledag"
@@ -19,6 +18,10 @@ var ErrIsDir = errors.New("this dag node is a directory")
 
 var ErrCantReadSymlinks = errors.New("cannot currently read symlinks")
 
+// prefetchWindow is the number of child nodes a DagReader requests ahead of
+// the one it is reading.
+const prefetchWindow = 16
+
 // DagReader provides a way to easily read the data contained in a dag.
 type DagReader struct {
 	serv mdag.DAGService
@@ -33,9 +36,18 @@ type DagReader struct {
 	// will either be a bytes.Reader or a child DagReader
 	buf ReadSeekCloser
 
-	// NodeGetters for each of 'nodes' child links
+	// NodeGetters for each of 'nodes' child links, nil for the links that
+	// have not been requested yet
 	promises []mdag.NodeGetter
 
+	// index of the first link after the ones requested so far
+	fetched int
+
+	// context for the child requests in flight, cancelled when seeking
+	// away from them
+	fetchCtx    context.Context
+	fetchCancel func()
+
 	// the index of the child link currently being read from
 	linkPosition int
 
@@ -59,13 +71,13 @@ type ReadSeekCloser interface {
 // NewDagReader creates a new reader object that reads the data represented by the given
 // node, using the passed in DAGService for data retreival
 func NewDagReader(ctx context.Context, n *mdag.Node, serv mdag.DAGService) (*DagReader, error) {
-	pb := new(ftpb.Data)
-	if err := proto.Unmarshal(n.Data, pb); err != nil {
+	pb, err := ft.FromNode(n)
+	if err != nil {
 		return nil, err
 	}
 
 	switch pb.GetType() {
-	case ftpb.Data_Directory:
+	case ftpb.Data_Directory, ftpb.Data_HAMTShard:
 		// Dont allow reading directories
 		return nil, ErrIsDir
 	case ftpb.Data_Raw:
@@ -88,18 +100,55 @@ func NewDagReader(ctx context.Context, n *mdag.Node, serv mdag.DAGService) (*Dag
 	}
 }
 
+// NewDataFileReader creates a reader for a file node. Children are only
+// requested once the reader gets to them, see preload.
 func NewDataFileReader(ctx context.Context, n *mdag.Node, pb *ftpb.Data, serv mdag.DAGService) *DagReader {
 	fctx, cancel := context.WithCancel(ctx)
-	promises := serv.GetDAG(fctx, n)
-	return &DagReader{
+	dr := &DagReader{
 		node:     n,
 		serv:     serv,
 		buf:      NewRSNCFromBytes(pb.GetData()),
-		promises: promises,
+		promises: make([]mdag.NodeGetter, len(n.Links)),
 		ctx:      fctx,
 		cancel:   cancel,
 		pbdata:   pb,
 	}
+	dr.resetPrefetch(0)
+	return dr
+}
+
+// resetPrefetch cancels the child requests in flight, and makes the next
+// preload start over at link position i.
+func (dr *DagReader) resetPrefetch(i int) {
+	if dr.fetchCancel != nil {
+		dr.fetchCancel()
+	}
+	dr.fetchCtx, dr.fetchCancel = context.WithCancel(dr.ctx)
+	for j := range dr.promises {
+		dr.promises[j] = nil
+	}
+	dr.fetched = i
+}
+
+// preload makes sure the children from link position i up to the end of the
+// prefetch window have been requested. New requests are only made once less
+// than half a window is left ahead of i, so they go out in batches.
+func (dr *DagReader) preload(i int) {
+	if dr.fetched < i {
+		dr.resetPrefetch(i)
+	}
+	if dr.fetched >= len(dr.promises) || dr.fetched-i >= prefetchWindow/2 {
+		return
+	}
+
+	end := i + prefetchWindow
+	if end > len(dr.promises) {
+		end = len(dr.promises)
+	}
+
+	links := dr.node.Links[dr.fetched:end]
+	copy(dr.promises[dr.fetched:end], dr.serv.GetLinks(dr.fetchCtx, links))
+	dr.fetched = end
 }
 
 // precalcNextBuf follows the next link in line and loads it from the DAGService,
@@ -110,20 +159,21 @@ func (dr *DagReader) precalcNextBuf(ctx context.Context) error {
 		return io.EOF
 	}
 
+	dr.preload(dr.linkPosition)
 	nxt, err := dr.promises[dr.linkPosition].Get(ctx)
 	if err != nil {
 		return err
 	}
+	dr.promises[dr.linkPosition] = nil // drop it, we won't come back
 	dr.linkPosition++
 
-	pb := new(ftpb.Data)
-	err = proto.Unmarshal(nxt.Data, pb)
+	pb, err := ft.FromNode(nxt)
 	if err != nil {
 		return fmt.Errorf("incorrectly formatted protobuf: %s", err)
 	}
 
 	switch pb.GetType() {
-	case ftpb.Data_Directory:
+	case ftpb.Data_Directory, ftpb.Data_HAMTShard:
 		// A directory should not exist within a file
 		return ft.ErrInvalidDirLocation
 	case ftpb.Data_File:
@@ -233,6 +283,7 @@ func (dr *DagReader) Seek(offset int64, whence int) (int64, error) {
 
 			// start reading links from the beginning
 			dr.linkPosition = 0
+			dr.resetPrefetch(0)
 			dr.offset = offset
 			return offset, nil
 		} else {
@@ -250,6 +301,10 @@ func (dr *DagReader) Seek(offset int64, whence int) (int64, error) {
 			}
 		}
 
+		// drop the requests for the children we skipped, and start
+		// prefetching at the new position.
+		dr.resetPrefetch(dr.linkPosition)
+
 		// start sub-block request
 		err := dr.precalcNextBuf(dr.ctx)
 		if err != nil {
@@ -0,0 +1,88 @@
+package io
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	key "github.com/ipfs/go-ipfs/blocks/key"
+	"github.com/ipfs/go-ipfs/importer/balanced"
+	chunk "github.com/ipfs/go-ipfs/importer/chunk"
+	h "github.com/ipfs/go-ipfs/importer/helpers"
+	dag "github.com/ipfs/go-ipfs/merkledag"
+	mdtest "github.com/ipfs/go-ipfs/merkledag/test"
+	u "github.com/ipfs/go-ipfs/util"
+)
+
+// countingDAGService records the nodes requested through any of the
+// DAGService getters.
+type countingDAGService struct {
+	dag.DAGService
+	requested int
+}
+
+func (cds *countingDAGService) Get(ctx context.Context, k key.Key) (*dag.Node, error) {
+	cds.requested++
+	return cds.DAGService.Get(ctx, k)
+}
+
+func (cds *countingDAGService) GetDAG(ctx context.Context, root *dag.Node) []dag.NodeGetter {
+	cds.requested += len(root.Links)
+	return cds.DAGService.GetDAG(ctx, root)
+}
+
+func (cds *countingDAGService) GetNodes(ctx context.Context, keys []key.Key) []dag.NodeGetter {
+	cds.requested += len(keys)
+	return cds.DAGService.GetNodes(ctx, keys)
+}
+
+func (cds *countingDAGService) GetLinks(ctx context.Context, links []*dag.Link) []dag.NodeGetter {
+	cds.requested += len(links)
+	return cds.DAGService.GetLinks(ctx, links)
+}
+
+func TestSeekOnlyFetchesWindow(t *testing.T) {
+	mock := mdtest.Mock()
+	should := make([]byte, 100*500)
+	u.NewTimeSeededRand().Read(should)
+	spl := chunk.NewSizeSplitter(bytes.NewReader(should), 500)
+	blkch, errs := chunk.Chan(spl)
+	dbp := h.DagBuilderParams{
+		Dagserv:  mock,
+		Maxlinks: h.DefaultLinksPerBlock,
+	}
+	nd, err := balanced.BalancedLayout(dbp.New(blkch, errs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nd.Links) != 100 {
+		t.Fatalf("expected a single level dag with 100 links, got %d", len(nd.Links))
+	}
+
+	ds := &countingDAGService{DAGService: mock}
+	rs, err := NewDagReader(context.Background(), nd, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds.requested != 0 {
+		t.Fatalf("expected no children to be requested before reading, got %d", ds.requested)
+	}
+
+	// read the last kilobyte, like a range request would
+	start := int64(len(should) - 1000)
+	if _, err := rs.Seek(start, os.SEEK_SET); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, should[start:]) {
+		t.Fatal("read the wrong data")
+	}
+	if ds.requested > 2 {
+		t.Fatalf("expected only the last two children to be requested, got %d", ds.requested)
+	}
+}
@@ -1,16 +1,29 @@
 package io
 
 import (
+	"errors"
+	"os"
+	"sort"
+	"time"
+
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
 
 	key "github.com/ipfs/go-ipfs/blocks/key"
 	mdag "github.com/ipfs/go-ipfs/merkledag"
 	format "github.com/ipfs/go-ipfs/unixfs"
+	hamt "github.com/ipfs/go-ipfs/unixfs/hamt"
 )
 
+// ShardThreshold is the number of entries above which a directory is
+// converted to a sharded directory, see the hamt package.
+var ShardThreshold = 1000
+
 type directoryBuilder struct {
 	dserv   mdag.DAGService
 	dirnode *mdag.Node
+
+	// once the directory grows past ShardThreshold, entries go to shard
+	shard *hamt.Shard
 }
 
 // NewEmptyDirectory returns an empty merkledag Node with a folder Data chunk
@@ -33,10 +46,204 @@ func (d *directoryBuilder) AddChild(ctx context.Context, name string, k key.Key)
 		return err
 	}
 
-	return d.dirnode.AddNodeLinkClean(name, cnode)
+	return d.AddNode(ctx, name, cnode)
+}
+
+// AddNode adds a link to nd under name to the root node, keeping nd in the
+// link. The directory is converted to a sharded directory when it grows
+// past ShardThreshold.
+func (d *directoryBuilder) AddNode(ctx context.Context, name string, nd *mdag.Node) error {
+	if d.shard != nil {
+		return d.shard.Set(ctx, name, nd)
+	}
+
+	if err := d.dirnode.AddNodeLink(name, nd); err != nil {
+		return err
+	}
+	if len(d.dirnode.Links) > ShardThreshold {
+		var err error
+		d.shard, err = toShard(ctx, d.dserv, d.dirnode)
+		return err
+	}
+	return nil
+}
+
+// SetStat sets the permission bits and modification time recorded in the
+// root node. Zero values are not recorded.
+func (d *directoryBuilder) SetStat(mode os.FileMode, mtime time.Time) error {
+	data, err := format.SetStat(d.dirnode.Data, mode, mtime)
+	if err != nil {
+		return err
+	}
+	d.dirnode.Data = data
+	if d.shard != nil {
+		d.shard.SetStat(mode, mtime)
+	}
+	return nil
+}
+
+// SetHashFunc sets the multihash function of the root node and of the
+// shards it may be split into.
+func (d *directoryBuilder) SetHashFunc(code int) {
+	d.dirnode.SetHashFunc(code)
+	if d.shard != nil {
+		d.shard.SetHashFunc(code)
+	}
 }
 
 // GetNode returns the root of this directoryBuilder
-func (d *directoryBuilder) GetNode() *mdag.Node {
-	return d.dirnode
+func (d *directoryBuilder) GetNode() (*mdag.Node, error) {
+	if d.shard != nil {
+		return d.shard.Node()
+	}
+	return d.dirnode, nil
+}
+
+// toShard returns a sharded directory with the entries of dir.
+func toShard(ctx context.Context, ds mdag.DAGService, dir *mdag.Node) (*hamt.Shard, error) {
+	s, err := hamt.NewShard(ds, hamt.DefaultFanout)
+	if err != nil {
+		return nil, err
+	}
+	s.SetHashFunc(dir.HashFunc())
+	if pbd, err := format.FromBytes(dir.Data); err == nil {
+		s.SetStat(format.Mode(pbd), format.ModTime(pbd))
+	}
+
+	for _, l := range dir.Links {
+		if err := s.SetLink(ctx, l.Name, l); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// AddDirEntry adds child to the directory dir under name, replacing any
+// entry with that name, and returns the new directory node. Directories
+// are converted to sharded directories when they grow past ShardThreshold.
+// child is expected to be in ds already, the new directory node is not
+// added.
+func AddDirEntry(ctx context.Context, ds mdag.DAGService, dir *mdag.Node, name string, child *mdag.Node) (*mdag.Node, error) {
+	if hamt.IsShard(dir) {
+		s, err := hamt.NewHamtFromDag(ds, dir)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.Set(ctx, name, child); err != nil {
+			return nil, err
+		}
+		return s.Node()
+	}
+
+	_ = dir.RemoveNodeLink(name) // ignore error, only option is ErrNotFound
+	if err := dir.AddNodeLinkClean(name, child); err != nil {
+		return nil, err
+	}
+	if len(dir.Links) <= ShardThreshold {
+		return dir, nil
+	}
+
+	s, err := toShard(ctx, ds, dir)
+	if err != nil {
+		return nil, err
+	}
+	return s.Node()
+}
+
+// RemoveDirEntry removes the entry with the given name from the directory
+// dir and returns the new directory node. It returns mdag.ErrNotFound if
+// there is no such entry.
+func RemoveDirEntry(ctx context.Context, ds mdag.DAGService, dir *mdag.Node, name string) (*mdag.Node, error) {
+	if !hamt.IsShard(dir) {
+		if err := dir.RemoveNodeLink(name); err != nil {
+			return nil, err
+		}
+		return dir, nil
+	}
+
+	s, err := hamt.NewHamtFromDag(ds, dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Remove(ctx, name); err != nil {
+		return nil, err
+	}
+
+	// a directory only stays sharded while it is past ShardThreshold, so
+	// the same entries always give the same directory
+	var links []*mdag.Link
+	err = s.ForEachLink(ctx, func(l *mdag.Link) error {
+		links = append(links, l)
+		if len(links) > ShardThreshold {
+			return errShardFull
+		}
+		return nil
+	})
+	switch err {
+	case errShardFull:
+		return s.Node()
+	case nil:
+		return fromShard(dir, links)
+	default:
+		return nil, err
+	}
+}
+
+// errShardFull stops the enumeration of a shard with more entries than
+// ShardThreshold.
+var errShardFull = errors.New("shard past the threshold")
+
+// fromShard returns a plain directory with the given entries, and the
+// hash function, mode and mtime of the sharded directory dir.
+func fromShard(dir *mdag.Node, links []*mdag.Link) (*mdag.Node, error) {
+	nd := NewEmptyDirectory()
+	nd.SetHashFunc(dir.HashFunc())
+	if pbd, err := format.FromBytes(dir.Data); err == nil {
+		data, err := format.SetStat(nd.Data, format.Mode(pbd), format.ModTime(pbd))
+		if err != nil {
+			return nil, err
+		}
+		nd.Data = data
+	}
+
+	for _, l := range links {
+		if err := nd.AddRawLink(l.Name, l); err != nil {
+			return nil, err
+		}
+	}
+	return nd, nil
+}
+
+// FindDirEntry returns the link to the entry with the given name in the
+// directory dir, sharded or not. It returns mdag.ErrNotFound if there is
+// no such entry.
+func FindDirEntry(ctx context.Context, ds mdag.DAGService, dir *mdag.Node, name string) (*mdag.Link, error) {
+	if !hamt.IsShard(dir) {
+		return dir.GetNodeLink(name)
+	}
+
+	s, err := hamt.NewHamtFromDag(ds, dir)
+	if err != nil {
+		return nil, err
+	}
+	return s.Find(ctx, name)
+}
+
+// DirEntries returns links to all the entries of the directory dir, sharded
+// or not. Links of sharded directories are sorted by name.
+func DirEntries(ctx context.Context, ds mdag.DAGService, dir *mdag.Node) ([]*mdag.Link, error) {
+	if !hamt.IsShard(dir) {
+		return dir.Links, nil
+	}
+
+	s, err := hamt.NewHamtFromDag(ds, dir)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.EnumLinks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(mdag.LinkSlice(links))
+	return links, nil
 }
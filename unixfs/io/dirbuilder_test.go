@@ -0,0 +1,70 @@
+package io
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/golang.org/x/net/context"
+	mdag "github.com/ipfs/go-ipfs/merkledag"
+	mdtest "github.com/ipfs/go-ipfs/merkledag/test"
+	hamt "github.com/ipfs/go-ipfs/unixfs/hamt"
+)
+
+func TestRemoveDirEntryUnshards(t *testing.T) {
+	defer func(n int) { ShardThreshold = n }(ShardThreshold)
+	ShardThreshold = 10
+
+	ctx := context.Background()
+	ds := mdtest.Mock()
+	add := func(dir *mdag.Node, i int) *mdag.Node {
+		child := mdag.NewRawNode([]byte(fmt.Sprintf("entry-%d", i)))
+		if _, err := ds.Add(child); err != nil {
+			t.Fatal(err)
+		}
+		dir, err := AddDirEntry(ctx, ds, dir, fmt.Sprintf("entry-%d", i), child)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+
+	dir := NewEmptyDirectory()
+	for i := 0; i < ShardThreshold; i++ {
+		dir = add(dir, i)
+	}
+	if hamt.IsShard(dir) {
+		t.Fatal("expected a plain directory up to the threshold")
+	}
+	plain, err := dir.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir = add(dir.Copy(), ShardThreshold)
+	if !hamt.IsShard(dir) {
+		t.Fatal("expected a sharded directory past the threshold")
+	}
+	if _, err := ds.Add(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err = RemoveDirEntry(ctx, ds, dir, fmt.Sprintf("entry-%d", ShardThreshold))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hamt.IsShard(dir) {
+		t.Fatal("expected the directory to be unsharded below the threshold")
+	}
+	k, err := dir.Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k != plain {
+		t.Fatal("removing the entry should give the directory without it")
+	}
+	for _, l := range dir.Links {
+		if !l.Raw {
+			t.Fatalf("link %s is not raw anymore", l.Name)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"time"
 
 	proto "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/gogo/protobuf/proto"
 	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
@@ -48,15 +49,20 @@ type DagModifier struct {
 	wrBuf      *bytes.Buffer
 
 	read *uio.DagReader
+
+	// rawLeaves is set when the file is stored with raw leaves, so the
+	// data appended to it is stored the same way
+	rawLeaves bool
 }
 
 func NewDagModifier(ctx context.Context, from *mdag.Node, serv mdag.DAGService, mp pin.ManualPinner, spl chunk.SplitterGen) (*DagModifier, error) {
 	return &DagModifier{
-		curNode:  from.Copy(),
-		dagserv:  serv,
-		splitter: spl,
-		ctx:      ctx,
-		mp:       mp,
+		curNode:   from.Copy(),
+		dagserv:   serv,
+		splitter:  spl,
+		ctx:       ctx,
+		mp:        mp,
+		rawLeaves: from.IsRaw() || (len(from.Links) > 0 && from.Links[0].Raw),
 	}, nil
 }
 
@@ -144,7 +150,7 @@ func (dm *DagModifier) Write(b []byte) (int, error) {
 }
 
 func (dm *DagModifier) Size() (int64, error) {
-	pbn, err := ft.FromBytes(dm.curNode.Data)
+	pbn, err := ft.FromNode(dm.curNode)
 	if err != nil {
 		return 0, err
 	}
@@ -158,6 +164,16 @@ func (dm *DagModifier) Size() (int64, error) {
 	return int64(pbn.GetFilesize()), nil
 }
 
+// Stat returns the permission bits and modification time recorded in the
+// root of the file, zero values if there are none.
+func (dm *DagModifier) Stat() (os.FileMode, time.Time, error) {
+	pbn, err := ft.FromNode(dm.curNode)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return ft.Mode(pbn), ft.ModTime(pbn), nil
+}
+
 // Sync writes changes to this dag to disk
 func (dm *DagModifier) Sync() error {
 	// No buffer? Nothing to do
@@ -186,7 +202,12 @@ func (dm *DagModifier) Sync() error {
 		return err
 	}
 
-	nd, err := dm.dagserv.Get(dm.ctx, thisk)
+	var nd *mdag.Node
+	if dm.curNode.IsRaw() {
+		nd, err = dm.dagserv.GetRaw(dm.ctx, thisk)
+	} else {
+		nd, err = dm.dagserv.Get(dm.ctx, thisk)
+	}
 	if err != nil {
 		return err
 	}
@@ -227,25 +248,35 @@ func (dm *DagModifier) Sync() error {
 // returns the new key of the passed in node and whether or not all the data in the reader
 // has been consumed.
 func (dm *DagModifier) modifyDag(node *mdag.Node, offset uint64, data io.Reader) (key.Key, bool, error) {
-	f, err := ft.FromBytes(node.Data)
+	f, err := ft.FromNode(node)
 	if err != nil {
 		return "", false, err
 	}
 
 	// If we've reached a leaf node.
 	if len(node.Links) == 0 {
+		if node.IsRaw() {
+			// the data of raw nodes is not a copy, don't write over it
+			f.Data = append([]byte(nil), f.Data...)
+		}
+
 		n, err := data.Read(f.Data[offset:])
 		if err != nil && err != io.EOF {
 			return "", false, err
 		}
 
 		// Update newly written node..
-		b, err := proto.Marshal(f)
-		if err != nil {
-			return "", false, err
+		var nd *mdag.Node
+		if node.IsRaw() {
+			nd = mdag.NewRawNode(f.Data)
+		} else {
+			b, err := proto.Marshal(f)
+			if err != nil {
+				return "", false, err
+			}
+			nd = &mdag.Node{Data: b}
 		}
 
-		nd := &mdag.Node{Data: b}
 		k, err := dm.dagserv.Add(nd)
 		if err != nil {
 			return "", false, err
@@ -307,9 +338,10 @@ func (dm *DagModifier) modifyDag(node *mdag.Node, offset uint64, data io.Reader)
 // appendData appends the blocks from the given chan to the end of this dag
 func (dm *DagModifier) appendData(node *mdag.Node, blks <-chan []byte, errs <-chan error) (*mdag.Node, error) {
 	dbp := &help.DagBuilderParams{
-		Dagserv:  dm.dagserv,
-		Maxlinks: help.DefaultLinksPerBlock,
-		NodeCB:   imp.BasicPinnerCB(dm.mp),
+		Dagserv:   dm.dagserv,
+		Maxlinks:  help.DefaultLinksPerBlock,
+		NodeCB:    imp.BasicPinnerCB(dm.mp),
+		RawLeaves: dm.rawLeaves,
 	}
 
 	return trickle.TrickleAppend(dm.ctx, node, dbp.New(blks, errs))
@@ -443,28 +475,36 @@ func (dm *DagModifier) Truncate(size int64) error {
 
 // dagTruncate truncates the given node to 'size' and returns the modified Node
 func dagTruncate(ctx context.Context, nd *mdag.Node, size uint64, ds mdag.DAGService) (*mdag.Node, error) {
+	if nd.IsRaw() {
+		return mdag.NewRawNode(nd.Data[:size]), nil
+	}
+
+	fsn, err := ft.FSNodeFromBytes(nd.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(nd.Links) == 0 {
-		// TODO: this can likely be done without marshaling and remarshaling
-		pbn, err := ft.FromBytes(nd.Data)
+		fsn.Data = fsn.Data[:size]
+		nd.Data, err = fsn.GetBytes()
 		if err != nil {
 			return nil, err
 		}
-
-		nd.Data = ft.WrapData(pbn.Data[:size])
 		return nd, nil
 	}
 
 	var cur uint64
 	end := 0
 	var modified *mdag.Node
-	ndata := new(ft.FSNode)
+	// keep the type, mode and mtime of the node, but not its block sizes
+	ndata := &ft.FSNode{Type: fsn.Type, Mode: fsn.Mode, ModTime: fsn.ModTime}
 	for i, lnk := range nd.Links {
 		child, err := lnk.GetNode(ctx, ds)
 		if err != nil {
 			return nil, err
 		}
 
-		childsize, err := ft.DataSize(child.Data)
+		childsize, err := dataSize(child)
 		if err != nil {
 			return nil, err
 		}
@@ -486,7 +526,7 @@ func dagTruncate(ctx context.Context, nd *mdag.Node, size uint64, ds mdag.DAGSer
 		ndata.AddBlockSize(childsize)
 	}
 
-	_, err := ds.Add(modified)
+	_, err = ds.Add(modified)
 	if err != nil {
 		return nil, err
 	}
@@ -512,3 +552,11 @@ func dagTruncate(ctx context.Context, nd *mdag.Node, size uint64, ds mdag.DAGSer
 
 	return nd, nil
 }
+
+// dataSize returns the size of the file data in nd.
+func dataSize(nd *mdag.Node) (uint64, error) {
+	if nd.IsRaw() {
+		return uint64(len(nd.Data)), nil
+	}
+	return ft.DataSize(nd.Data)
+}
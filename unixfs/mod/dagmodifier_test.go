@@ -1,12 +1,14 @@
 package mod
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-datastore/sync"
 	"github.com/ipfs/go-ipfs/blocks/blockstore"
@@ -431,6 +433,39 @@ func TestDagTruncate(t *testing.T) {
 	}
 }
 
+func TestDagTruncateKeepsStat(t *testing.T) {
+	dserv, pins := getMockDagServ(t)
+	mode := os.FileMode(0640)
+	mtime := time.Unix(1234567890, 0)
+
+	// a single leaf, and a file with children
+	for _, size := range []int64{400, 50000} {
+		_, n := getNode(t, dserv, size, pins)
+		data, err := ft.SetStat(n.Data, mode, mtime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n.Data = data
+
+		ctx, cancel := context.WithCancel(context.Background())
+		dagmod, err := NewDagModifier(ctx, n, dserv, pins, sizeSplitterGen(512))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dagmod.Truncate(123); err != nil {
+			t.Fatal(err)
+		}
+		m, mt, err := dagmod.Stat()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m != mode || !mt.Equal(mtime) {
+			t.Fatalf("truncating a %d byte file lost its stat: %s %s", size, m, mt)
+		}
+		cancel()
+	}
+}
+
 func TestSparseWrite(t *testing.T) {
 	dserv, pins := getMockDagServ(t)
 	_, n := getNode(t, dserv, 0, pins)
@@ -657,3 +692,54 @@ func printDag(nd *mdag.Node, ds mdag.DAGService, indent int) {
 	}
 	fmt.Println("}")
 }
+
+func TestDagModifierRawLeaves(t *testing.T) {
+	dserv, pins := getMockDagServ(t)
+	b := make([]byte, 50000)
+	u.NewTimeSeededRand().Read(b)
+
+	dbp := h.DagBuilderParams{
+		Dagserv:   dserv,
+		Maxlinks:  h.DefaultLinksPerBlock,
+		NodeCB:    imp.BasicPinnerCB(pins),
+		RawLeaves: true,
+	}
+	n, err := imp.BuildDag(dbp, sizeSplitterGen(500)(bytes.NewReader(b)), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dagmod, err := NewDagModifier(ctx, n, dserv, pins, sizeSplitterGen(512))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b = testModWrite(t, 1000, 4000, b, dagmod)
+	b = testModWrite(t, uint64(len(b)), 3000, b, dagmod)
+
+	nd, err := dagmod.GetNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := nd.Links[len(nd.Links)-1]
+	if !last.Raw {
+		t.Fatal("expected appended leaves to be raw")
+	}
+
+	if err := dagmod.Truncate(12345); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dagmod.Seek(0, os.SEEK_SET); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(dagmod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = arrComp(out, b[:12345]); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,6 +4,7 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"math/rand"
 	"os"
@@ -17,6 +18,8 @@ import (
 	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
 
 	"github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/mitchellh/go-homedir"
+
+	blake2b "github.com/ipfs/go-ipfs/thirdparty/blake2b"
 )
 
 // Debug is a global flag for debugging.
@@ -136,6 +139,29 @@ func Hash(data []byte) mh.Multihash {
 	return h
 }
 
+// Sum hashes data with the multihash function code, keeping the first length
+// bytes of the digest, or the default length of the function if length is
+// negative. It is mh.Sum, with blake2b added. For blake2b, length is the
+// digest size the hash is computed with, as it is one of its parameters.
+func Sum(data []byte, code int, length int) (mh.Multihash, error) {
+	if code != mh.BLAKE2B {
+		return mh.Sum(data, code, length)
+	}
+
+	if length < 0 {
+		length = mh.DefaultLengths[code]
+	}
+	if length == 0 || length > blake2b.Size {
+		return nil, fmt.Errorf("blake2b digests are 1 to %d bytes long", blake2b.Size)
+	}
+	h, err := blake2b.New(&blake2b.Config{Size: uint8(length)})
+	if err != nil {
+		return nil, err
+	}
+	h.Write(data)
+	return mh.Encode(h.Sum(nil), code)
+}
+
 // IsValidHash checks whether a given hash is valid (b58 decodable, len > 0)
 func IsValidHash(s string) bool {
 	out := b58.Decode(s)
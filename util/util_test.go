@@ -3,6 +3,9 @@ package util
 import (
 	"bytes"
 	"testing"
+
+	mh "github.com/ipfs/go-ipfs/Godeps/_workspace/src/github.com/jbenet/go-multihash"
+	blake2b "github.com/ipfs/go-ipfs/thirdparty/blake2b"
 )
 
 func TestXOR(t *testing.T) {
@@ -61,3 +64,38 @@ func BenchmarkHash1M(b *testing.B) {
 		Hash(buf)
 	}
 }
+
+func TestSumBlake2bLength(t *testing.T) {
+	data := []byte("beep boop")
+	full, err := Sum(data, mh.BLAKE2B, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	short, err := Sum(data, mh.BLAKE2B, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dfull, err := mh.Decode(full)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dshort, err := mh.Decode(short)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dshort.Length != 32 {
+		t.Fatalf("expected a 32 byte digest, got %d", dshort.Length)
+	}
+	exp := blake2b.Sum256(data)
+	if !bytes.Equal(dshort.Digest, exp[:]) {
+		t.Fatal("expected blake2b-256, not a truncated blake2b-512")
+	}
+	if bytes.Equal(dshort.Digest, dfull.Digest[:32]) {
+		t.Fatal("the digest size should change the digest")
+	}
+
+	if _, err := Sum(data, mh.BLAKE2B, 65); err == nil {
+		t.Fatal("expected an error for a digest longer than 64 bytes")
+	}
+}